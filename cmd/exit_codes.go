@@ -38,6 +38,11 @@ func ExitCodeFromError(err error) int {
 		return ExitSuccess
 	}
 
+	// External processes (plugins) keep their own exit status
+	if exitErr, ok := errors.AsType[*shared.ExitCodeError](err); ok {
+		return exitErr.Code
+	}
+
 	// Usage errors
 	if errors.Is(err, flag.ErrHelp) {
 		return ExitUsage
@@ -10,6 +10,8 @@ import (
 
 	"github.com/peterbourgon/ff/v3/ffcli"
 
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/completion"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/plugins"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/registry"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared/suggest"
@@ -30,6 +32,9 @@ func RootCommand(version string) *ffcli.Command {
 		Subcommands: registry.Subcommands(version),
 	}
 
+	// Hidden: called by the scripts from asc completion.
+	root.Subcommands = append(root.Subcommands, completion.CompleteCommand(root))
+
 	root.FlagSet.BoolVar(&versionRequested, "version", false, "Print version and exit")
 	shared.BindRootFlags(root.FlagSet)
 
@@ -44,11 +49,18 @@ func RootCommand(version string) *ffcli.Command {
 			return nil
 		}
 		if len(args) > 0 {
+			// Unknown subcommands fall through to asc-<name> plugins.
+			dirs := plugins.Dirs()
+			if plugin, ok := plugins.Find(dirs, args[0]); ok {
+				return plugins.Run(ctx, plugin, args[1:])
+			}
+
 			rootSubcommandNamesOnce.Do(func() {
 				rootSubcommandNames = make([]string, 0, len(root.Subcommands))
 				for _, sub := range root.Subcommands {
 					rootSubcommandNames = append(rootSubcommandNames, sub.Name)
 				}
+				rootSubcommandNames = append(rootSubcommandNames, plugins.Names(dirs)...)
 			})
 			unknown := shared.SanitizeTerminal(args[0])
 			fmt.Fprintf(os.Stderr, "Unknown command: %s\n\n", unknown)
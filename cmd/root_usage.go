@@ -8,6 +8,7 @@ import (
 
 	"github.com/peterbourgon/ff/v3/ffcli"
 
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/plugins"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
 )
 
@@ -46,11 +47,11 @@ var rootUsageGroups = []rootCommandGroup{
 	},
 	{
 		title:    "REVIEW & RELEASE COMMANDS",
-		commands: []string{"review", "reviews", "submit", "validate", "publish"},
+		commands: []string{"review", "reviews", "submit", "validate", "publish", "release"},
 	},
 	{
 		title:    "MONETIZATION COMMANDS",
-		commands: []string{"iap", "app-events", "subscriptions", "offer-codes", "win-back-offers", "promoted-purchases"},
+		commands: []string{"iap", "app-events", "subscriptions", "catalog", "offer-codes", "win-back-offers", "promoted-purchases"},
 	},
 	{
 		title:    "SIGNING COMMANDS",
@@ -62,7 +63,7 @@ var rootUsageGroups = []rootCommandGroup{
 	},
 	{
 		title:    "AUTOMATION COMMANDS",
-		commands: []string{"webhooks", "xcode-cloud", "notify", "migrate"},
+		commands: []string{"webhooks", "watch", "xcode-cloud", "notify", "migrate", "mock", "api", "mcp"},
 	},
 	{
 		title:    "UTILITY COMMANDS",
@@ -112,6 +113,7 @@ func RootUsageFunc(c *ffcli.Command) string {
 	}
 
 	writeRootGroupedSubcommands(&b, c.Subcommands)
+	writeRootPlugins(&b, c.Subcommands, plugins.Discover(plugins.Dirs()))
 	writeRootFlags(&b, c.FlagSet)
 	return b.String()
 }
@@ -153,7 +155,8 @@ func writeRootGroupedSubcommands(b *strings.Builder, subcommands []*ffcli.Comman
 
 	additional := make([]*ffcli.Command, 0)
 	for _, sub := range subcommands {
-		if !rendered[sub.Name] {
+		// Names starting with "__" are internal (e.g. __complete).
+		if !rendered[sub.Name] && !strings.HasPrefix(sub.Name, "__") {
 			additional = append(additional, sub)
 		}
 	}
@@ -171,6 +174,34 @@ func writeRootGroupedSubcommands(b *strings.Builder, subcommands []*ffcli.Comman
 	b.WriteString("\n")
 }
 
+// writeRootPlugins lists asc-<name> plugins that are not shadowed by a
+// built-in command.
+func writeRootPlugins(b *strings.Builder, subcommands []*ffcli.Command, found []plugins.Plugin) {
+	builtin := make(map[string]bool, len(subcommands))
+	for _, sub := range subcommands {
+		builtin[sub.Name] = true
+	}
+
+	visible := make([]plugins.Plugin, 0, len(found))
+	for _, plugin := range found {
+		if !builtin[plugin.Name] {
+			visible = append(visible, plugin)
+		}
+	}
+	if len(visible) == 0 {
+		return
+	}
+
+	b.WriteString(shared.Bold("PLUGIN COMMANDS"))
+	b.WriteString("\n")
+	tw := tabwriter.NewWriter(b, 0, 2, 2, ' ', 0)
+	for _, plugin := range visible {
+		fmt.Fprintf(tw, "  %s:\t%s\n", plugin.Name, plugin.Path)
+	}
+	tw.Flush()
+	b.WriteString("\n")
+}
+
 func writeRootFlags(b *strings.Builder, fs *flag.FlagSet) {
 	if fs == nil {
 		return
@@ -65,11 +65,19 @@ func Run(args []string, versionInfo string) int {
 	}
 
 	start := time.Now()
-	runErr := root.Run(runCtx)
+	leaf, path := findLeafCommand(root, args)
+	// Resolve bundle IDs, app names, "latest" builds and similar references
+	// to IDs before the command sees its flags. An ASC_APP_ID reference is
+	// resolved on first use, for commands without --app and for plugins.
+	defer shared.EnableDefaultAppResolution(runCtx)()
+	runErr := shared.ResolveFlagReferences(runCtx, leaf.FlagSet)
+	if runErr == nil {
+		runErr = root.Run(runCtx)
+	}
 	elapsed := time.Since(start)
 
 	// Get command name (full subcommand path)
-	commandName := getCommandName(root, args)
+	commandName := strings.Join(path, " ")
 
 	// Write JUnit report if requested
 	if shared.ReportFormat() == shared.ReportFormatJUnit && shared.ReportFile() != "" {
@@ -113,6 +121,13 @@ func isVersionOnlyInvocation(args []string) bool {
 // args is os.Args[1:] (without program name).
 // It finds the first token matching a known subcommand name, then walks the tree.
 func getCommandName(root *ffcli.Command, args []string) string {
+	_, path := findLeafCommand(root, args)
+	return strings.Join(path, " ")
+}
+
+// findLeafCommand walks args to the deepest matching subcommand and returns
+// it along with its path from root.
+func findLeafCommand(root *ffcli.Command, args []string) (*ffcli.Command, []string) {
 	current := root
 	path := []string{current.Name}
 
@@ -145,7 +160,7 @@ func getCommandName(root *ffcli.Command, args []string) string {
 		break
 	}
 
-	return strings.Join(path, " ")
+	return current, path
 }
 
 func findDirectSubcommand(current *ffcli.Command, token string) *ffcli.Command {
@@ -9,6 +9,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"runtime"
 	"strings"
 	"testing"
 	"time"
@@ -280,3 +281,46 @@ func captureCommandOutput(t *testing.T, fn func()) (string, string) {
 
 	return <-outC, <-errC
 }
+
+func TestRun_PluginReceivesArgsEnvAndExitCode(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("shell script plugins require a POSIX shell")
+	}
+	resetReportFlags(t)
+
+	dir := t.TempDir()
+	outPath := filepath.Join(dir, "plugin.out")
+	script := "#!/bin/sh\necho \"$@\" > " + outPath + "\necho \"app=$ASC_APP_ID output=$ASC_DEFAULT_OUTPUT\" >> " + outPath + "\nexit 7\n"
+	if err := os.WriteFile(filepath.Join(dir, "asc-hello"), []byte(script), 0o755); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("PATH", dir)
+	t.Setenv("ASC_APP_ID", "123")
+
+	code := Run([]string{"hello", "--name", "world"}, "1.0.0")
+	if code != 7 {
+		t.Fatalf("Run() exit code = %d, want 7", code)
+	}
+	data, err := os.ReadFile(outPath)
+	if err != nil {
+		t.Fatalf("ReadFile() error: %v", err)
+	}
+	if got := string(data); !strings.Contains(got, "--name world") || !strings.Contains(got, "app=123 output=json") {
+		t.Fatalf("unexpected plugin invocation %q", got)
+	}
+
+	stdout, _ := captureCommandOutput(t, func() {
+		Run([]string{}, "1.0.0")
+	})
+	if !strings.Contains(stdout, "PLUGIN COMMANDS") || !strings.Contains(stdout, "  hello:") {
+		t.Fatalf("expected plugin in root help, got %q", stdout)
+	}
+
+	_, stderr := captureCommandOutput(t, func() {
+		Run([]string{"helo"}, "1.0.0")
+	})
+	if !strings.Contains(stderr, "Did you mean: hello") {
+		t.Fatalf("expected plugin suggestion, got %q", stderr)
+	}
+}
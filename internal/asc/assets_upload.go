@@ -58,8 +58,12 @@ func UploadAssetFromFile(ctx context.Context, file *os.File, fileSize int64, ope
 			return fmt.Errorf("upload operation %d exceeds file size", i)
 		}
 
+		uploadURL, err := resolveUploadURL(op.URL)
+		if err != nil {
+			return fmt.Errorf("upload operation %d: %w", i, err)
+		}
 		reader := io.NewSectionReader(file, op.Offset, op.Length)
-		req, err := http.NewRequestWithContext(ctx, method, op.URL, reader)
+		req, err := http.NewRequestWithContext(ctx, method, uploadURL, reader)
 		if err != nil {
 			return fmt.Errorf("upload operation %d: %w", i, err)
 		}
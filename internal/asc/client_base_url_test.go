@@ -0,0 +1,85 @@
+package asc
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestResolveBaseURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    string
+		wantErr string
+	}{
+		{name: "unset", value: "", want: BaseURL},
+		{name: "local http", value: "http://127.0.0.1:8788/", want: "http://127.0.0.1:8788"},
+		{name: "localhost http", value: "http://localhost:8788", want: "http://localhost:8788"},
+		{name: "ipv6 loopback http", value: "http://[::1]:8788", want: "http://[::1]:8788"},
+		{name: "remote http", value: "http://asc-proxy.example.com", wantErr: "only allowed for loopback hosts"},
+		{name: "lan http", value: "http://192.168.1.10:8788", wantErr: "only allowed for loopback hosts"},
+		{name: "https proxy", value: "https://asc-proxy.example.com", want: "https://asc-proxy.example.com"},
+		{name: "missing scheme", value: "127.0.0.1:8788", wantErr: "must be an absolute URL"},
+		{name: "unsupported scheme", value: "ftp://example.com", wantErr: `unsupported scheme "ftp"`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Setenv("ASC_BASE_URL", test.value)
+			got, err := ResolveBaseURL()
+			if test.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Fatalf("ResolveBaseURL() = %q, %v; want error containing %q", got, err, test.wantErr)
+				}
+				return
+			}
+			if err != nil || got != test.want {
+				t.Fatalf("ResolveBaseURL() = %q, %v; want %q", got, err, test.want)
+			}
+		})
+	}
+}
+
+func TestNewClientRejectsInvalidBaseURLOverride(t *testing.T) {
+	t.Setenv("ASC_BASE_URL", "http://asc-proxy.example.com")
+
+	_, err := NewClient("KEY", "ISSUER", "/nonexistent/key.p8")
+	if err == nil || !strings.Contains(err.Error(), "invalid ASC_BASE_URL") {
+		t.Fatalf("expected invalid ASC_BASE_URL error, got %v", err)
+	}
+}
+
+func TestValidateNextURL_HonorsBaseURLOverride(t *testing.T) {
+	t.Setenv("ASC_BASE_URL", "http://127.0.0.1:8788")
+
+	if err := validateNextURL("http://127.0.0.1:8788/v1/apps?cursor=abc"); err != nil {
+		t.Fatalf("expected override host to be accepted, got %v", err)
+	}
+	if err := validateNextURL("https://api.appstoreconnect.apple.com/v1/apps?cursor=abc"); err == nil {
+		t.Fatal("expected the default host to be rejected while overridden")
+	}
+	err := validateNextURL("https://127.0.0.1:8788/v1/apps?cursor=abc")
+	if err == nil || !strings.Contains(err.Error(), `must match base URL scheme "http"`) {
+		t.Fatalf("expected a scheme mismatch to be rejected, got %v", err)
+	}
+}
+
+func TestNewRequest_UsesBaseURLOverride(t *testing.T) {
+	t.Setenv("ASC_BASE_URL", "http://127.0.0.1:8788")
+
+	client := newTestClient(t, nil, nil)
+	req, err := client.newRequest(context.Background(), http.MethodGet, "/v1/apps", nil)
+	if err != nil {
+		t.Fatalf("newRequest() error: %v", err)
+	}
+	if got := req.URL.String(); got != "http://127.0.0.1:8788/v1/apps" {
+		t.Fatalf("request URL = %q", got)
+	}
+	if got, err := resolveUploadURL("/upload/appScreenshots/1"); err != nil || got != "http://127.0.0.1:8788/upload/appScreenshots/1" {
+		t.Fatalf("resolveUploadURL() = %q, %v", got, err)
+	}
+	if got, err := resolveUploadURL("https://upload.example.com/part"); err != nil || got != "https://upload.example.com/part" {
+		t.Fatalf("absolute upload URL changed: %q, %v", got, err)
+	}
+}
@@ -7,7 +7,9 @@ import (
 	"fmt"
 	"log/slog"
 	"math/rand"
+	"net"
 	"net/http"
+	"net/url"
 	"os"
 	"strconv"
 	"strings"
@@ -223,6 +225,40 @@ func setConfigLoaderForTest(loader func() (*config.Config, error)) {
 	configLoaded = false
 }
 
+// ResolveBaseURL returns the App Store Connect API base URL, honoring ASC_BASE_URL.
+// The override must be an absolute https URL, or an http URL on a loopback
+// host (localhost, 127.0.0.1, ::1) so credentials never travel in cleartext
+// over the network. Invalid values are an error rather than a silent fallback
+// to BaseURL, so a script aimed at a mock server never reaches the real API.
+func ResolveBaseURL() (string, error) {
+	override, ok := envValue("ASC_BASE_URL")
+	if !ok || override == "" {
+		return BaseURL, nil
+	}
+	parsed, err := url.Parse(override)
+	if err != nil || parsed.Host == "" {
+		return "", fmt.Errorf("invalid ASC_BASE_URL %q: must be an absolute URL", override)
+	}
+	switch parsed.Scheme {
+	case "https":
+	case "http":
+		if !isLoopbackHost(parsed.Hostname()) {
+			return "", fmt.Errorf("invalid ASC_BASE_URL %q: http is only allowed for loopback hosts; use https", override)
+		}
+	default:
+		return "", fmt.Errorf("invalid ASC_BASE_URL %q: unsupported scheme %q", override, parsed.Scheme)
+	}
+	return strings.TrimRight(override, "/"), nil
+}
+
+func isLoopbackHost(host string) bool {
+	if strings.EqualFold(host, "localhost") {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
 func envValue(name string) (string, bool) {
 	value, ok := os.LookupEnv(name)
 	return strings.TrimSpace(value), ok
@@ -513,6 +549,9 @@ func newDefaultHTTPClient(timeout time.Duration) *http.Client {
 }
 
 func newClientWithHTTPClient(keyID, issuerID, privateKeyPath string, httpClient *http.Client) (*Client, error) {
+	if _, err := ResolveBaseURL(); err != nil {
+		return nil, err
+	}
 	if err := auth.ValidateKeyFile(privateKeyPath); err != nil {
 		return nil, fmt.Errorf("invalid private key: %w", err)
 	}
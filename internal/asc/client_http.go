@@ -27,7 +27,11 @@ func (c *Client) newRequest(ctx context.Context, method, path string, body io.Re
 
 	url := path
 	if !strings.HasPrefix(path, "http://") && !strings.HasPrefix(path, "https://") {
-		url = BaseURL + path
+		baseURL, err := ResolveBaseURL()
+		if err != nil {
+			return nil, err
+		}
+		url = baseURL + path
 	}
 	req, err := http.NewRequestWithContext(ctx, method, url, body)
 	if err != nil {
@@ -41,6 +45,12 @@ func (c *Client) newRequest(ctx context.Context, method, path string, body io.Re
 	return req, nil
 }
 
+// BearerToken returns a short-lived JWT for the client's credentials, for
+// handing to external tools such as plugins.
+func (c *Client) BearerToken() (string, error) {
+	return c.generateJWT()
+}
+
 // generateJWT generates a JWT for ASC API authentication
 func (c *Client) generateJWT() (string, error) {
 	now := time.Now()
@@ -274,7 +284,8 @@ func parseRetryAfterHeader(value string) time.Duration {
 }
 
 // validateNextURL validates that a pagination URL is safe to use.
-// It ensures the URL is on the same host as BaseURL and uses HTTPS.
+// It ensures the URL is on the same host as the resolved base URL and uses its scheme
+// (HTTPS unless ASC_BASE_URL points at a local http server).
 func validateNextURL(nextURL string) error {
 	if nextURL == "" {
 		return nil
@@ -291,19 +302,23 @@ func validateNextURL(nextURL string) error {
 		return fmt.Errorf("invalid pagination URL: %w", err)
 	}
 
-	baseURL, err := url.Parse(BaseURL)
+	resolvedBaseURL, err := ResolveBaseURL()
+	if err != nil {
+		return err
+	}
+	baseURL, err := url.Parse(resolvedBaseURL)
 	if err != nil {
 		return fmt.Errorf("invalid base URL: %w", err)
 	}
 
-	// Allow URLs on the same host as BaseURL
+	// Allow URLs on the same host as the base URL
 	if parsedURL.Host != baseURL.Host {
 		return fmt.Errorf("rejected pagination URL from untrusted host %q (expected %q)", parsedURL.Host, baseURL.Host)
 	}
 
-	// Require HTTPS for authentication endpoints
-	if parsedURL.Scheme != "https" {
-		return fmt.Errorf("rejected pagination URL with insecure scheme %q (expected https)", parsedURL.Scheme)
+	// Require the base URL's scheme (HTTPS for the real API)
+	if parsedURL.Scheme != baseURL.Scheme {
+		return fmt.Errorf("rejected pagination URL with scheme %q (must match base URL scheme %q)", parsedURL.Scheme, baseURL.Scheme)
 	}
 
 	return nil
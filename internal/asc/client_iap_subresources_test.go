@@ -461,6 +461,32 @@ func TestGetInAppPurchasePricePointEqualizations(t *testing.T) {
 	}
 }
 
+func TestGetInAppPurchasePricePointEqualizations_WithQuery(t *testing.T) {
+	response := jsonResponse(http.StatusOK, `{"data":[]}`)
+	client := newTestClient(t, func(req *http.Request) {
+		if req.URL.Path != "/v1/inAppPurchasePricePoints/price-1/equalizations" {
+			t.Fatalf("expected path /v1/inAppPurchasePricePoints/price-1/equalizations, got %s", req.URL.Path)
+		}
+		values := req.URL.Query()
+		if values.Get("limit") != "200" {
+			t.Fatalf("expected limit=200, got %q", values.Get("limit"))
+		}
+		if values.Get("fields[inAppPurchasePricePoints]") != "customerPrice,territory" {
+			t.Fatalf("expected fields filter, got %q", values.Get("fields[inAppPurchasePricePoints]"))
+		}
+		assertAuthorized(t, req)
+	}, response)
+
+	if _, err := client.GetInAppPurchasePricePointEqualizations(
+		context.Background(),
+		"price-1",
+		WithIAPPricePointsLimit(200),
+		WithIAPPricePointsFields([]string{"customerPrice", "territory"}),
+	); err != nil {
+		t.Fatalf("GetInAppPurchasePricePointEqualizations() error: %v", err)
+	}
+}
+
 func TestGetInAppPurchasePriceScheduleManualPrices_WithLimit(t *testing.T) {
 	response := jsonResponse(http.StatusOK, `{"data":[]}`)
 	client := newTestClient(t, func(req *http.Request) {
@@ -1461,6 +1461,15 @@ func WithBuildsPreReleaseVersion(preReleaseVersionID string) BuildsOption {
 	}
 }
 
+// WithBuildsVersion filters builds by build number (CFBundleVersion).
+func WithBuildsVersion(version string) BuildsOption {
+	return func(q *buildsQuery) {
+		if strings.TrimSpace(version) != "" {
+			q.version = strings.TrimSpace(version)
+		}
+	}
+}
+
 // WithBuildBundlesLimit sets the max number of included build bundles to return.
 func WithBuildBundlesLimit(limit int) BuildBundlesOption {
 	return func(q *buildBundlesQuery) {
@@ -2745,6 +2754,13 @@ func WithBetaAppReviewSubmissionsBuildIDs(ids []string) BetaAppReviewSubmissions
 	}
 }
 
+// WithBetaAppReviewSubmissionsInclude includes related resources (e.g. build).
+func WithBetaAppReviewSubmissionsInclude(include []string) BetaAppReviewSubmissionsOption {
+	return func(q *betaAppReviewSubmissionsQuery) {
+		q.include = normalizeList(include)
+	}
+}
+
 // WithBuildBetaDetailsLimit sets the max number of build beta details to return.
 func WithBuildBetaDetailsLimit(limit int) BuildBetaDetailsOption {
 	return func(q *buildBetaDetailsQuery) {
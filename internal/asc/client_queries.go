@@ -111,6 +111,7 @@ type buildsQuery struct {
 	listQuery
 	sort                string
 	preReleaseVersionID string
+	version             string
 }
 
 type buildUploadsQuery struct {
@@ -577,6 +578,7 @@ type betaAppReviewDetailsQuery struct {
 type betaAppReviewSubmissionsQuery struct {
 	listQuery
 	buildIDs []string
+	include  []string
 }
 
 type buildBetaDetailsQuery struct {
@@ -1082,6 +1084,7 @@ func buildBetaAppReviewDetailsQuery(appID string, query *betaAppReviewDetailsQue
 func buildBetaAppReviewSubmissionsQuery(query *betaAppReviewSubmissionsQuery) string {
 	values := url.Values{}
 	addCSV(values, "filter[build]", query.buildIDs)
+	addCSV(values, "include", query.include)
 	addLimit(values, query.limit)
 	return values.Encode()
 }
@@ -0,0 +1,127 @@
+package asc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// RawRequest performs an authenticated request against any API path.
+// It reuses JWT signing, the GET/HEAD retry policy and API error mapping.
+// Path is relative to the base URL (e.g. "/v1/apps?limit=5") or an absolute
+// URL on the same host.
+func (c *Client) RawRequest(ctx context.Context, method, path string, body []byte) ([]byte, error) {
+	method = strings.ToUpper(strings.TrimSpace(method))
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPatch, http.MethodPut, http.MethodDelete:
+	default:
+		return nil, fmt.Errorf("unsupported HTTP method %q", method)
+	}
+	if err := validateRawPath(path); err != nil {
+		return nil, err
+	}
+
+	var reader io.Reader
+	if len(body) > 0 {
+		reader = bytes.NewReader(body)
+	}
+	return c.do(ctx, method, path, reader)
+}
+
+// RawPaginate GETs path and follows links.next, merging the data and included
+// arrays of every page into a single response document.
+func (c *Client) RawPaginate(ctx context.Context, path string) ([]byte, error) {
+	if err := validateRawPath(path); err != nil {
+		return nil, err
+	}
+
+	var (
+		merged   map[string]json.RawMessage
+		data     []json.RawMessage
+		included []json.RawMessage
+		seen     = map[string]bool{}
+	)
+	for next := path; next != ""; {
+		body, err := c.do(ctx, http.MethodGet, next, nil)
+		if err != nil {
+			return nil, err
+		}
+		var page struct {
+			Data     json.RawMessage   `json:"data"`
+			Included []json.RawMessage `json:"included"`
+			Links    Links             `json:"links"`
+		}
+		if err := json.Unmarshal(body, &page); err != nil {
+			return nil, fmt.Errorf("failed to parse response: %w", err)
+		}
+		var items []json.RawMessage
+		if err := json.Unmarshal(page.Data, &items); err != nil {
+			return nil, fmt.Errorf("response data is not a collection; pagination requires a list endpoint")
+		}
+		if merged == nil {
+			if err := json.Unmarshal(body, &merged); err != nil {
+				return nil, fmt.Errorf("failed to parse response: %w", err)
+			}
+		}
+		data = append(data, items...)
+		for _, item := range page.Included {
+			// Pages often include the same related resources; keep the first copy.
+			var id ResourceData
+			if err := json.Unmarshal(item, &id); err == nil && id.ID != "" {
+				key := string(id.Type) + "/" + id.ID
+				if seen[key] {
+					continue
+				}
+				seen[key] = true
+			}
+			included = append(included, item)
+		}
+
+		if err := validateNextURL(page.Links.Next); err != nil {
+			return nil, err
+		}
+		if page.Links.Next == next {
+			return nil, fmt.Errorf("pagination did not advance (next URL repeated)")
+		}
+		next = page.Links.Next
+	}
+
+	if data == nil {
+		data = []json.RawMessage{}
+	}
+	var err error
+	if merged["data"], err = json.Marshal(data); err != nil {
+		return nil, err
+	}
+	if len(included) > 0 {
+		if merged["included"], err = json.Marshal(included); err != nil {
+			return nil, err
+		}
+	}
+	var links map[string]json.RawMessage
+	if err := json.Unmarshal(merged["links"], &links); err == nil {
+		delete(links, "next")
+		if merged["links"], err = json.Marshal(links); err != nil {
+			return nil, err
+		}
+	}
+	return json.Marshal(merged)
+}
+
+func validateRawPath(path string) error {
+	trimmed := strings.TrimSpace(path)
+	if trimmed == "" {
+		return fmt.Errorf("path is required")
+	}
+	if strings.HasPrefix(trimmed, "http://") || strings.HasPrefix(trimmed, "https://") {
+		return validateNextURL(trimmed)
+	}
+	if !strings.HasPrefix(trimmed, "/") {
+		return fmt.Errorf("path must start with / (e.g. /v1/apps)")
+	}
+	return nil
+}
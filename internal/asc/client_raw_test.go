@@ -0,0 +1,93 @@
+package asc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestRawRequestSendsBodyAndMapsErrors(t *testing.T) {
+	client := newTestClient(t, func(req *http.Request) {
+		if req.Method != http.MethodPatch {
+			t.Fatalf("expected PATCH, got %s", req.Method)
+		}
+		if req.URL.Path != "/v1/betaGroups/group-1" {
+			t.Fatalf("unexpected path %s", req.URL.Path)
+		}
+		if req.Header.Get("Content-Type") != "application/json" {
+			t.Fatalf("expected JSON content type, got %q", req.Header.Get("Content-Type"))
+		}
+		assertAuthorized(t, req)
+	}, jsonResponse(http.StatusNotFound, `{"errors":[{"status":"404","code":"NOT_FOUND","title":"Not found","detail":"No group"}]}`))
+
+	_, err := client.RawRequest(context.Background(), "patch", "/v1/betaGroups/group-1", []byte(`{"data":{}}`))
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+
+	if _, err := client.RawRequest(context.Background(), "TRACE", "/v1/apps", nil); err == nil {
+		t.Fatal("expected unsupported method error")
+	}
+	if _, err := client.RawRequest(context.Background(), http.MethodGet, "https://example.com/v1/apps", nil); err == nil {
+		t.Fatal("expected foreign host to be rejected")
+	}
+}
+
+func TestRawPaginateMergesPages(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey() error: %v", err)
+	}
+
+	pages := map[string]string{
+		"":         `{"data":[{"type":"builds","id":"1"}],"included":[{"type":"apps","id":"a"}],"links":{"self":"x","next":"` + BaseURL + `/v1/builds?cursor=2"},"meta":{"paging":{"total":2}}}`,
+		"cursor=2": `{"data":[{"type":"builds","id":"2"}],"included":[{"type":"apps","id":"a"}],"links":{"self":"y"}}`,
+	}
+	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body, ok := pages[req.URL.RawQuery]
+		if !ok {
+			t.Fatalf("unexpected query %q", req.URL.RawQuery)
+		}
+		return jsonResponse(http.StatusOK, body), nil
+	})
+	client := &Client{
+		httpClient: &http.Client{Transport: transport},
+		keyID:      "KEY123",
+		issuerID:   "ISS456",
+		privateKey: key,
+	}
+
+	body, err := client.RawPaginate(context.Background(), "/v1/builds")
+	if err != nil {
+		t.Fatalf("RawPaginate() error: %v", err)
+	}
+	var merged struct {
+		Data     []ResourceData             `json:"data"`
+		Included []ResourceData             `json:"included"`
+		Links    map[string]string          `json:"links"`
+		Meta     map[string]json.RawMessage `json:"meta"`
+	}
+	if err := json.Unmarshal(body, &merged); err != nil {
+		t.Fatalf("decode merged response: %v", err)
+	}
+	if len(merged.Data) != 2 || merged.Data[1].ID != "2" {
+		t.Fatalf("expected both pages, got %+v", merged.Data)
+	}
+	if len(merged.Included) != 1 {
+		t.Fatalf("expected deduplicated included, got %+v", merged.Included)
+	}
+	if _, ok := merged.Links["next"]; ok || merged.Links["self"] != "x" {
+		t.Fatalf("unexpected links %+v", merged.Links)
+	}
+	if merged.Meta["paging"] == nil {
+		t.Fatalf("expected first page meta to be kept")
+	}
+}
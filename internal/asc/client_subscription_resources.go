@@ -527,6 +527,133 @@ func (c *Client) UpdateSubscriptionPromotionalOffer(ctx context.Context, offerID
 	return &response, nil
 }
 
+// CreateSubscriptionPromotionalOfferWithPrices creates a promotional offer and its territory prices in one request.
+func (c *Client) CreateSubscriptionPromotionalOfferWithPrices(ctx context.Context, subscriptionID string, attrs SubscriptionPromotionalOfferCreateAttributes, prices []SubscriptionPromotionalOfferPrice) (*SubscriptionPromotionalOfferResponse, error) {
+	subscriptionID = strings.TrimSpace(subscriptionID)
+	if subscriptionID == "" {
+		return nil, fmt.Errorf("subscription ID is required")
+	}
+	priceData, included, err := buildSubscriptionPromotionalOfferPrices(prices)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := SubscriptionPromotionalOfferCreateRequest{
+		Data: SubscriptionPromotionalOfferCreateData{
+			Type:       ResourceTypeSubscriptionPromotionalOffers,
+			Attributes: attrs,
+			Relationships: SubscriptionPromotionalOfferRelationships{
+				Subscription: Relationship{
+					Data: ResourceData{
+						Type: ResourceTypeSubscriptions,
+						ID:   subscriptionID,
+					},
+				},
+				Prices: RelationshipList{Data: priceData},
+			},
+		},
+		Included: included,
+	}
+
+	body, err := BuildRequestBody(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := c.do(ctx, http.MethodPost, "/v1/subscriptionPromotionalOffers", body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response SubscriptionPromotionalOfferResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+	return &response, nil
+}
+
+// UpdateSubscriptionPromotionalOfferPrices replaces the territory prices of a promotional offer.
+func (c *Client) UpdateSubscriptionPromotionalOfferPrices(ctx context.Context, offerID string, prices []SubscriptionPromotionalOfferPrice) (*SubscriptionPromotionalOfferResponse, error) {
+	offerID = strings.TrimSpace(offerID)
+	if offerID == "" {
+		return nil, fmt.Errorf("offer ID is required")
+	}
+	priceData, included, err := buildSubscriptionPromotionalOfferPrices(prices)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := SubscriptionPromotionalOfferUpdateRequest{
+		Data: SubscriptionPromotionalOfferUpdateData{
+			Type: ResourceTypeSubscriptionPromotionalOffers,
+			ID:   offerID,
+			Relationships: &SubscriptionPromotionalOfferUpdateRelationships{
+				Prices: RelationshipList{Data: priceData},
+			},
+		},
+		Included: included,
+	}
+
+	body, err := BuildRequestBody(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("/v1/subscriptionPromotionalOffers/%s", offerID)
+	data, err := c.do(ctx, http.MethodPatch, path, body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response SubscriptionPromotionalOfferResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+	return &response, nil
+}
+
+func buildSubscriptionPromotionalOfferPrices(prices []SubscriptionPromotionalOfferPrice) ([]ResourceData, []SubscriptionPromotionalOfferPriceInlineCreate, error) {
+	if len(prices) == 0 {
+		return nil, nil, fmt.Errorf("at least one price is required")
+	}
+
+	priceData := make([]ResourceData, 0, len(prices))
+	included := make([]SubscriptionPromotionalOfferPriceInlineCreate, 0, len(prices))
+	for idx, price := range prices {
+		territoryID := strings.ToUpper(strings.TrimSpace(price.TerritoryID))
+		if territoryID == "" {
+			return nil, nil, fmt.Errorf("territory ID is required")
+		}
+		resourceID := fmt.Sprintf("${local-price-%d}", idx+1)
+		priceData = append(priceData, ResourceData{
+			Type: ResourceTypeSubscriptionPromotionalOfferPrices,
+			ID:   resourceID,
+		})
+		relationships := SubscriptionPromotionalOfferPriceRelationships{
+			Territory: Relationship{
+				Data: ResourceData{
+					Type: ResourceTypeTerritories,
+					ID:   territoryID,
+				},
+			},
+		}
+		if pricePointID := strings.TrimSpace(price.PricePointID); pricePointID != "" {
+			relationships.SubscriptionPricePoint = &Relationship{
+				Data: ResourceData{
+					Type: ResourceTypeSubscriptionPricePoints,
+					ID:   pricePointID,
+				},
+			}
+		}
+		included = append(included, SubscriptionPromotionalOfferPriceInlineCreate{
+			Type:          ResourceTypeSubscriptionPromotionalOfferPrices,
+			ID:            resourceID,
+			Relationships: relationships,
+		})
+	}
+	return priceData, included, nil
+}
+
 // DeleteSubscriptionPromotionalOffer deletes a promotional offer.
 func (c *Client) DeleteSubscriptionPromotionalOffer(ctx context.Context, offerID string) error {
 	path := fmt.Sprintf("/v1/subscriptionPromotionalOffers/%s", strings.TrimSpace(offerID))
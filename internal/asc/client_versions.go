@@ -9,11 +9,12 @@ import (
 
 // AppStoreVersionAttributes describes app store version metadata.
 type AppStoreVersionAttributes struct {
-	Platform        Platform `json:"platform,omitempty"`
-	VersionString   string   `json:"versionString,omitempty"`
-	AppStoreState   string   `json:"appStoreState,omitempty"`
-	AppVersionState string   `json:"appVersionState,omitempty"`
-	CreatedDate     string   `json:"createdDate,omitempty"`
+	Platform            Platform `json:"platform,omitempty"`
+	VersionString       string   `json:"versionString,omitempty"`
+	AppStoreState       string   `json:"appStoreState,omitempty"`
+	AppVersionState     string   `json:"appVersionState,omitempty"`
+	CreatedDate         string   `json:"createdDate,omitempty"`
+	EarliestReleaseDate string   `json:"earliestReleaseDate,omitempty"`
 }
 
 // AppStoreVersionCreateAttributes describes app store version create payload attributes.
@@ -189,7 +189,28 @@ type SubscriptionPromotionalOfferCreateData struct {
 
 // SubscriptionPromotionalOfferCreateRequest is a request to create a promotional offer.
 type SubscriptionPromotionalOfferCreateRequest struct {
-	Data SubscriptionPromotionalOfferCreateData `json:"data"`
+	Data     SubscriptionPromotionalOfferCreateData          `json:"data"`
+	Included []SubscriptionPromotionalOfferPriceInlineCreate `json:"included,omitempty"`
+}
+
+// SubscriptionPromotionalOfferPrice is a territory price for an inline promotional offer price.
+// PricePointID is empty for free trials.
+type SubscriptionPromotionalOfferPrice struct {
+	TerritoryID  string
+	PricePointID string
+}
+
+// SubscriptionPromotionalOfferPriceRelationships describes relationships for inline promotional offer prices.
+type SubscriptionPromotionalOfferPriceRelationships struct {
+	Territory              Relationship  `json:"territory"`
+	SubscriptionPricePoint *Relationship `json:"subscriptionPricePoint,omitempty"`
+}
+
+// SubscriptionPromotionalOfferPriceInlineCreate describes inline creation data for promotional offer prices.
+type SubscriptionPromotionalOfferPriceInlineCreate struct {
+	Type          ResourceType                                   `json:"type"`
+	ID            string                                         `json:"id,omitempty"`
+	Relationships SubscriptionPromotionalOfferPriceRelationships `json:"relationships"`
 }
 
 // SubscriptionPromotionalOfferUpdateRelationships describes relationships for promotional offer updates.
@@ -206,7 +227,8 @@ type SubscriptionPromotionalOfferUpdateData struct {
 
 // SubscriptionPromotionalOfferUpdateRequest is a request to update a promotional offer.
 type SubscriptionPromotionalOfferUpdateRequest struct {
-	Data SubscriptionPromotionalOfferUpdateData `json:"data"`
+	Data     SubscriptionPromotionalOfferUpdateData          `json:"data"`
+	Included []SubscriptionPromotionalOfferPriceInlineCreate `json:"included,omitempty"`
 }
 
 // SubscriptionPromotionalOfferPriceAttributes describes promotional offer price resources.
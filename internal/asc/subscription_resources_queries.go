@@ -48,6 +48,7 @@ type subscriptionImagesQuery struct {
 
 type subscriptionIntroductoryOffersQuery struct {
 	listQuery
+	include []string
 }
 
 type subscriptionPromotionalOffersQuery struct {
@@ -56,6 +57,7 @@ type subscriptionPromotionalOffersQuery struct {
 
 type subscriptionPromotionalOfferPricesQuery struct {
 	listQuery
+	include []string
 }
 
 type subscriptionOfferCodesQuery struct {
@@ -141,6 +143,13 @@ func WithSubscriptionIntroductoryOffersNextURL(next string) SubscriptionIntroduc
 	}
 }
 
+// WithSubscriptionIntroductoryOffersInclude sets the relationships to include (e.g., "territory").
+func WithSubscriptionIntroductoryOffersInclude(include []string) SubscriptionIntroductoryOffersOption {
+	return func(q *subscriptionIntroductoryOffersQuery) {
+		q.include = normalizeList(include)
+	}
+}
+
 // WithSubscriptionPromotionalOffersLimit sets the max number of offers to return.
 func WithSubscriptionPromotionalOffersLimit(limit int) SubscriptionPromotionalOffersOption {
 	return func(q *subscriptionPromotionalOffersQuery) {
@@ -168,6 +177,13 @@ func WithSubscriptionPromotionalOfferPricesLimit(limit int) SubscriptionPromotio
 	}
 }
 
+// WithSubscriptionPromotionalOfferPricesInclude sets include for promotional offer prices.
+func WithSubscriptionPromotionalOfferPricesInclude(include []string) SubscriptionPromotionalOfferPricesOption {
+	return func(q *subscriptionPromotionalOfferPricesQuery) {
+		q.include = normalizeList(include)
+	}
+}
+
 // WithSubscriptionPromotionalOfferPricesNextURL uses a next page URL directly.
 func WithSubscriptionPromotionalOfferPricesNextURL(next string) SubscriptionPromotionalOfferPricesOption {
 	return func(q *subscriptionPromotionalOfferPricesQuery) {
@@ -338,6 +354,7 @@ func buildSubscriptionImagesQuery(query *subscriptionImagesQuery) string {
 
 func buildSubscriptionIntroductoryOffersQuery(query *subscriptionIntroductoryOffersQuery) string {
 	values := url.Values{}
+	addCSV(values, "include", query.include)
 	addLimit(values, query.limit)
 	return values.Encode()
 }
@@ -350,6 +367,7 @@ func buildSubscriptionPromotionalOffersQuery(query *subscriptionPromotionalOffer
 
 func buildSubscriptionPromotionalOfferPricesQuery(query *subscriptionPromotionalOfferPricesQuery) string {
 	values := url.Values{}
+	addCSV(values, "include", query.include)
 	addLimit(values, query.limit)
 	return values.Encode()
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"strings"
 	"testing"
 )
 
@@ -278,6 +279,20 @@ func TestGetSubscriptionIntroductoryOffers_WithLimit(t *testing.T) {
 	}
 }
 
+func TestGetSubscriptionIntroductoryOffers_WithInclude(t *testing.T) {
+	response := jsonResponse(http.StatusOK, `{"data":[]}`)
+	client := newTestClient(t, func(req *http.Request) {
+		if req.URL.Query().Get("include") != "territory,subscriptionPricePoint" {
+			t.Fatalf("expected include=territory,subscriptionPricePoint, got %q", req.URL.Query().Get("include"))
+		}
+		assertAuthorized(t, req)
+	}, response)
+
+	if _, err := client.GetSubscriptionIntroductoryOffers(context.Background(), "sub-1", WithSubscriptionIntroductoryOffersInclude([]string{"territory", "subscriptionPricePoint"})); err != nil {
+		t.Fatalf("GetSubscriptionIntroductoryOffers() error: %v", err)
+	}
+}
+
 func TestGetSubscriptionIntroductoryOffer(t *testing.T) {
 	response := jsonResponse(http.StatusOK, `{"data":{"type":"subscriptionIntroductoryOffers","id":"offer-1","attributes":{"duration":"ONE_MONTH","numberOfPeriods":1,"offerMode":"FREE_TRIAL"}}}`)
 	client := newTestClient(t, func(req *http.Request) {
@@ -540,6 +555,110 @@ func TestGetSubscriptionPromotionalOfferPrices_WithLimit(t *testing.T) {
 	}
 }
 
+func TestGetSubscriptionPromotionalOfferPrices_WithInclude(t *testing.T) {
+	response := jsonResponse(http.StatusOK, `{"data":[]}`)
+	client := newTestClient(t, func(req *http.Request) {
+		if req.URL.Query().Get("include") != "territory,subscriptionPricePoint" {
+			t.Fatalf("expected include=territory,subscriptionPricePoint, got %q", req.URL.Query().Get("include"))
+		}
+		assertAuthorized(t, req)
+	}, response)
+
+	opts := []SubscriptionPromotionalOfferPricesOption{
+		WithSubscriptionPromotionalOfferPricesInclude([]string{"territory", "subscriptionPricePoint"}),
+	}
+	if _, err := client.GetSubscriptionPromotionalOfferPrices(context.Background(), "offer-1", opts...); err != nil {
+		t.Fatalf("GetSubscriptionPromotionalOfferPrices() error: %v", err)
+	}
+}
+
+func TestCreateSubscriptionPromotionalOfferWithPrices_InlinesPrices(t *testing.T) {
+	response := jsonResponse(http.StatusCreated, `{"data":{"type":"subscriptionPromotionalOffers","id":"offer-1"}}`)
+	client := newTestClient(t, func(req *http.Request) {
+		if req.Method != http.MethodPost {
+			t.Fatalf("expected POST, got %s", req.Method)
+		}
+		if req.URL.Path != "/v1/subscriptionPromotionalOffers" {
+			t.Fatalf("expected path /v1/subscriptionPromotionalOffers, got %s", req.URL.Path)
+		}
+		var payload SubscriptionPromotionalOfferCreateRequest
+		if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if payload.Data.Relationships.Subscription.Data.ID != "sub-1" {
+			t.Fatalf("unexpected subscription relationship: %+v", payload.Data.Relationships.Subscription.Data)
+		}
+		if len(payload.Data.Relationships.Prices.Data) != 2 || payload.Data.Relationships.Prices.Data[1].ID != "${local-price-2}" {
+			t.Fatalf("unexpected price relationships: %+v", payload.Data.Relationships.Prices.Data)
+		}
+		if len(payload.Included) != 2 {
+			t.Fatalf("expected 2 included prices, got %d", len(payload.Included))
+		}
+		price := payload.Included[1]
+		if price.ID != "${local-price-2}" || price.Relationships.Territory.Data.ID != "JPN" || price.Relationships.SubscriptionPricePoint == nil || price.Relationships.SubscriptionPricePoint.Data.ID != "spp-2" {
+			t.Fatalf("unexpected included price: %+v", price)
+		}
+		assertAuthorized(t, req)
+	}, response)
+
+	attrs := SubscriptionPromotionalOfferCreateAttributes{
+		Name:            "Win back",
+		OfferCode:       "WINBACK",
+		Duration:        SubscriptionOfferDurationOneMonth,
+		OfferMode:       SubscriptionOfferModePayAsYouGo,
+		NumberOfPeriods: 1,
+	}
+	prices := []SubscriptionPromotionalOfferPrice{
+		{TerritoryID: "usa", PricePointID: "spp-1"},
+		{TerritoryID: "JPN", PricePointID: "spp-2"},
+	}
+	if _, err := client.CreateSubscriptionPromotionalOfferWithPrices(context.Background(), "sub-1", attrs, prices); err != nil {
+		t.Fatalf("CreateSubscriptionPromotionalOfferWithPrices() error: %v", err)
+	}
+}
+
+func TestUpdateSubscriptionPromotionalOfferPrices_InlinesPrices(t *testing.T) {
+	response := jsonResponse(http.StatusOK, `{"data":{"type":"subscriptionPromotionalOffers","id":"offer-1"}}`)
+	client := newTestClient(t, func(req *http.Request) {
+		if req.Method != http.MethodPatch {
+			t.Fatalf("expected PATCH, got %s", req.Method)
+		}
+		if req.URL.Path != "/v1/subscriptionPromotionalOffers/offer-1" {
+			t.Fatalf("expected path /v1/subscriptionPromotionalOffers/offer-1, got %s", req.URL.Path)
+		}
+		var payload SubscriptionPromotionalOfferUpdateRequest
+		if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if payload.Data.Relationships == nil || len(payload.Data.Relationships.Prices.Data) != 1 {
+			t.Fatalf("expected prices relationship")
+		}
+		if len(payload.Included) != 1 || payload.Included[0].Relationships.Territory.Data.ID != "USA" {
+			t.Fatalf("unexpected included prices: %+v", payload.Included)
+		}
+		if payload.Included[0].Relationships.SubscriptionPricePoint != nil {
+			t.Fatalf("expected no price point for a free trial price, got %+v", payload.Included[0].Relationships.SubscriptionPricePoint)
+		}
+		assertAuthorized(t, req)
+	}, response)
+
+	prices := []SubscriptionPromotionalOfferPrice{{TerritoryID: "USA"}}
+	if _, err := client.UpdateSubscriptionPromotionalOfferPrices(context.Background(), "offer-1", prices); err != nil {
+		t.Fatalf("UpdateSubscriptionPromotionalOfferPrices() error: %v", err)
+	}
+}
+
+func TestCreateSubscriptionPromotionalOfferWithPrices_RequiresPrices(t *testing.T) {
+	client := newTestClient(t, func(req *http.Request) {
+		t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+	}, jsonResponse(http.StatusOK, `{}`))
+
+	_, err := client.CreateSubscriptionPromotionalOfferWithPrices(context.Background(), "sub-1", SubscriptionPromotionalOfferCreateAttributes{}, nil)
+	if err == nil || !strings.Contains(err.Error(), "at least one price") {
+		t.Fatalf("expected missing price error, got %v", err)
+	}
+}
+
 func TestGetSubscriptionOfferCodes_WithLimit(t *testing.T) {
 	response := jsonResponse(http.StatusOK, `{"data":[{"type":"subscriptionOfferCodes","id":"code-1","attributes":{"name":"Spring"}}]}`)
 	client := newTestClient(t, func(req *http.Request) {
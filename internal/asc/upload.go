@@ -193,9 +193,14 @@ func executeUploadOperation(ctx context.Context, file *os.File, task uploadTask,
 		method = http.MethodPut
 	}
 
-	_, err := WithRetry(ctx, func() (struct{}, error) {
+	uploadURL, err := resolveUploadURL(task.op.URL)
+	if err != nil {
+		return fmt.Errorf("upload operation %d: %w", task.index, err)
+	}
+
+	_, err = WithRetry(ctx, func() (struct{}, error) {
 		reader := io.NewSectionReader(file, task.op.Offset, task.op.Length)
-		req, err := http.NewRequestWithContext(ctx, method, task.op.URL, reader)
+		req, err := http.NewRequestWithContext(ctx, method, uploadURL, reader)
 		if err != nil {
 			return struct{}{}, err
 		}
@@ -233,6 +238,18 @@ func executeUploadOperation(ctx context.Context, file *os.File, task uploadTask,
 	return nil
 }
 
+// resolveUploadURL resolves host-relative upload operation URLs against the API base URL.
+func resolveUploadURL(raw string) (string, error) {
+	if strings.HasPrefix(raw, "/") {
+		baseURL, err := ResolveBaseURL()
+		if err != nil {
+			return "", err
+		}
+		return baseURL + raw, nil
+	}
+	return raw, nil
+}
+
 // VerifySourceFileChecksums computes and compares checksums provided by the API.
 func VerifySourceFileChecksums(filePath string, expected *Checksums) (*Checksums, error) {
 	if expected == nil {
@@ -105,9 +105,38 @@ type CiWorkflowAttributes struct {
 	IsLockedForEditing              bool                         `json:"isLockedForEditing,omitempty"`
 	Clean                           bool                         `json:"clean,omitempty"`
 	ContainerFilePath               string                       `json:"containerFilePath,omitempty"`
+	Actions                         []CiAction                   `json:"actions,omitempty"`
 	LastModifiedDate                string                       `json:"lastModifiedDate,omitempty"`
 }
 
+// CiAction describes one action (build, analyze, test, archive) in a CI workflow.
+type CiAction struct {
+	Name                      string               `json:"name,omitempty"`
+	ActionType                string               `json:"actionType,omitempty"`
+	Destination               string               `json:"destination,omitempty"`
+	BuildDistributionAudience string               `json:"buildDistributionAudience,omitempty"`
+	TestConfiguration         *CiTestConfiguration `json:"testConfiguration,omitempty"`
+	Scheme                    string               `json:"scheme,omitempty"`
+	Platform                  string               `json:"platform,omitempty"`
+	IsRequiredToPass          bool                 `json:"isRequiredToPass,omitempty"`
+}
+
+// CiTestConfiguration describes how a test action selects tests and destinations.
+type CiTestConfiguration struct {
+	Kind             string                    `json:"kind,omitempty"`
+	TestPlanName     string                    `json:"testPlanName,omitempty"`
+	TestDestinations []CiTestActionDestination `json:"testDestinations,omitempty"`
+}
+
+// CiTestActionDestination describes a device and runtime a test action runs on.
+type CiTestActionDestination struct {
+	DeviceTypeName       string                `json:"deviceTypeName,omitempty"`
+	DeviceTypeIdentifier string                `json:"deviceTypeIdentifier,omitempty"`
+	RuntimeName          string                `json:"runtimeName,omitempty"`
+	RuntimeIdentifier    string                `json:"runtimeIdentifier,omitempty"`
+	Kind                 CiTestDestinationKind `json:"kind,omitempty"`
+}
+
 // CiBranchStartCondition describes branch start conditions.
 type CiBranchStartCondition struct {
 	Source              *CiBranchPatterns      `json:"source,omitempty"`
@@ -555,6 +584,7 @@ func buildCiProductsQuery(query *ciProductsQuery) string {
 
 type ciWorkflowsQuery struct {
 	listQuery
+	include []string
 }
 
 // CiWorkflowsOption is a functional option for GetCiWorkflows.
@@ -578,8 +608,16 @@ func WithCiWorkflowsNextURL(next string) CiWorkflowsOption {
 	}
 }
 
+// WithCiWorkflowsInclude includes related resources (e.g. repository, xcodeVersion, macOsVersion).
+func WithCiWorkflowsInclude(include ...string) CiWorkflowsOption {
+	return func(q *ciWorkflowsQuery) {
+		q.include = normalizeList(include)
+	}
+}
+
 func buildCiWorkflowsQuery(query *ciWorkflowsQuery) string {
 	values := url.Values{}
+	addCSV(values, "include", query.include)
 	addLimit(values, query.limit)
 	return values.Encode()
 }
@@ -49,7 +49,7 @@ Examples:
 func AccessibilityListCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("list", flag.ExitOnError)
 
-	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (or ASC_APP_ID env)")
 	deviceFamily := fs.String("device-family", "", "Filter by device family(s), comma-separated: "+strings.Join(accessibilityDeviceFamilyList(), ", "))
 	state := fs.String("state", "", "Filter by state(s), comma-separated: "+strings.Join(accessibilityStateList(), ", "))
 	fields := fs.String("fields", "", "Fields to include: "+strings.Join(accessibilityDeclarationFieldList(), ", "))
@@ -196,7 +196,7 @@ Examples:
 func AccessibilityCreateCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("create", flag.ExitOnError)
 
-	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (or ASC_APP_ID env)")
 	deviceFamily := fs.String("device-family", "", "Device family: "+strings.Join(accessibilityDeviceFamilyList(), ", "))
 	supportsAudioDescriptions := fs.String("supports-audio-descriptions", "", "Supports audio descriptions (true/false)")
 	supportsCaptions := fs.String("supports-captions", "", "Supports captions (true/false)")
@@ -44,7 +44,7 @@ Examples:
 func accountStatusCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("account status", flag.ExitOnError)
 
-	appID := fs.String("app", "", "Optional app ID for access probe (or ASC_APP_ID env)")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "Optional app ID, bundle ID or app name for access probe (or ASC_APP_ID env)")
 	output := shared.BindOutputFlags(fs)
 
 	return &ffcli.Command{
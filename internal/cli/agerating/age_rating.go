@@ -83,9 +83,9 @@ Examples:
 func AgeRatingGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("age-rating get", flag.ExitOnError)
 
-	appID := fs.String("app", os.Getenv("ASC_APP_ID"), "App ID (required unless --app-info-id or --version-id is provided)")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, os.Getenv("ASC_APP_ID"), "App ID, bundle ID or app name (required unless --app-info-id or --version-id is provided)")
 	appInfoID := fs.String("app-info-id", "", "App info ID (optional)")
-	versionID := fs.String("version-id", "", "App Store version ID (optional)")
+	versionID := shared.ReferenceFlag(fs, shared.ReferenceVersionID, "", "App Store version ID or version string like 1.2.3 (optional)")
 	output := shared.BindOutputFlags(fs)
 
 	return &ffcli.Command{
@@ -136,9 +136,9 @@ func AgeRatingSetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("age-rating set", flag.ExitOnError)
 
 	id := fs.String("id", "", "Age rating declaration ID (optional)")
-	appID := fs.String("app", os.Getenv("ASC_APP_ID"), "App ID (required unless --id, --app-info-id, or --version-id is provided)")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, os.Getenv("ASC_APP_ID"), "App ID, bundle ID or app name (required unless --id, --app-info-id, or --version-id is provided)")
 	appInfoID := fs.String("app-info-id", "", "App info ID (optional)")
-	versionID := fs.String("version-id", "", "App Store version ID (optional)")
+	versionID := shared.ReferenceFlag(fs, shared.ReferenceVersionID, "", "App Store version ID or version string like 1.2.3 (optional)")
 
 	// Boolean content descriptors
 	advertising := fs.String("advertising", "", "Contains advertising (true/false)")
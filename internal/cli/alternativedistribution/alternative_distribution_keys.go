@@ -159,7 +159,7 @@ Examples:
 func AlternativeDistributionKeysCreateCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("create", flag.ExitOnError)
 
-	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID)")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (or ASC_APP_ID)")
 	publicKey := fs.String("public-key", "", "Public key content")
 	publicKeyPath := fs.String("public-key-path", "", "Path to public key file")
 	output := shared.BindOutputFlags(fs)
@@ -272,7 +272,7 @@ Examples:
 func AlternativeDistributionKeysAppCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("app", flag.ExitOnError)
 
-	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID)")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (or ASC_APP_ID)")
 	output := shared.BindOutputFlags(fs)
 
 	return &ffcli.Command{
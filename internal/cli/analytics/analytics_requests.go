@@ -17,7 +17,7 @@ import (
 func AnalyticsRequestCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("request", flag.ExitOnError)
 
-	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (or ASC_APP_ID env)")
 	accessType := fs.String("access-type", "", "Access type: ONGOING or ONE_TIME_SNAPSHOT")
 	output := shared.BindOutputFlags(fs)
 
@@ -77,7 +77,7 @@ Examples:
 func AnalyticsRequestsCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("requests", flag.ExitOnError)
 
-	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (or ASC_APP_ID env)")
 	requestID := fs.String("request-id", "", "Filter by request ID")
 	state := fs.String("state", "", "Filter by state: PROCESSING, COMPLETED, FAILED")
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
@@ -48,7 +48,7 @@ Examples:
 func AndroidIosMappingListCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("list", flag.ExitOnError)
 
-	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID)")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (or ASC_APP_ID)")
 	fields := fs.String("fields", "", "Fields to return (comma-separated: "+strings.Join(androidIosMappingFieldsList(), ", ")+")")
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
@@ -176,7 +176,7 @@ Examples:
 func AndroidIosMappingCreateCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("create", flag.ExitOnError)
 
-	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID)")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (or ASC_APP_ID)")
 	packageName := fs.String("android-package-name", "", "Android package name (e.g., com.example.android)")
 	fingerprints := fs.String("fingerprints", "", "Signing key fingerprints (comma-separated)")
 	output := shared.BindOutputFlags(fs)
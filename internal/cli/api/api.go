@@ -0,0 +1,308 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// queryFlag collects repeated --query "key=value" values.
+type queryFlag []string
+
+func (q *queryFlag) String() string {
+	return strings.Join(*q, "&")
+}
+
+func (q *queryFlag) Set(value string) error {
+	*q = append(*q, value)
+	return nil
+}
+
+// knownResponse decodes a JSON:API response into a typed value the output
+// registry can render as a table or markdown.
+type knownResponse struct {
+	list   func() any
+	single func() any
+}
+
+// knownResponses maps JSON:API resource types to their typed responses.
+var knownResponses = map[string]knownResponse{
+	"apps":                         {func() any { return &asc.AppsResponse{} }, func() any { return &asc.AppResponse{} }},
+	"appInfos":                     {func() any { return &asc.AppInfosResponse{} }, func() any { return &asc.AppInfoResponse{} }},
+	"appStoreVersions":             {func() any { return &asc.AppStoreVersionsResponse{} }, func() any { return &asc.AppStoreVersionResponse{} }},
+	"appStoreVersionLocalizations": {func() any { return &asc.AppStoreVersionLocalizationsResponse{} }, func() any { return &asc.AppStoreVersionLocalizationResponse{} }},
+	"builds":                       {func() any { return &asc.BuildsResponse{} }, func() any { return &asc.BuildResponse{} }},
+	"preReleaseVersions":           {func() any { return &asc.PreReleaseVersionsResponse{} }, func() any { return &asc.PreReleaseVersionResponse{} }},
+	"betaGroups":                   {func() any { return &asc.BetaGroupsResponse{} }, func() any { return &asc.BetaGroupResponse{} }},
+	"betaTesters":                  {func() any { return &asc.BetaTestersResponse{} }, func() any { return &asc.BetaTesterResponse{} }},
+	"reviewSubmissions":            {func() any { return &asc.ReviewSubmissionsResponse{} }, func() any { return &asc.ReviewSubmissionResponse{} }},
+	"bundleIds":                    {func() any { return &asc.BundleIDsResponse{} }, func() any { return &asc.BundleIDResponse{} }},
+	"certificates":                 {func() any { return &asc.CertificatesResponse{} }, func() any { return &asc.CertificateResponse{} }},
+	"profiles":                     {func() any { return &asc.ProfilesResponse{} }, func() any { return &asc.ProfileResponse{} }},
+	"devices":                      {func() any { return &asc.DevicesResponse{} }, func() any { return &asc.DeviceResponse{} }},
+	"users":                        {func() any { return &asc.UsersResponse{} }, func() any { return &asc.UserResponse{} }},
+	"ciProducts":                   {func() any { return &asc.CiProductsResponse{} }, func() any { return &asc.CiProductResponse{} }},
+	"ciWorkflows":                  {func() any { return &asc.CiWorkflowsResponse{} }, func() any { return &asc.CiWorkflowResponse{} }},
+	"ciBuildRuns":                  {func() any { return &asc.CiBuildRunsResponse{} }, func() any { return &asc.CiBuildRunResponse{} }},
+}
+
+// APICommand returns the api command.
+func APICommand() *ffcli.Command {
+	fs := flag.NewFlagSet("api", flag.ExitOnError)
+
+	var query queryFlag
+	fs.Var(&query, "query", `Query parameters as "key=value" or "a=1&b=2" (repeatable)`)
+	body := fs.String("body", "", "Request body: JSON string, @file.json, or @- for stdin")
+	paginate := fs.Bool("paginate", false, "Follow links.next and merge all pages (GET only)")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "api",
+		ShortUsage: "asc api <METHOD> <PATH> [flags]",
+		ShortHelp:  "Make an authenticated request to any App Store Connect endpoint.",
+		LongHelp: `Make an authenticated request to any App Store Connect endpoint.
+
+Use this for endpoints that do not have a dedicated command yet. Requests are
+signed with your configured API key and use the same retries, pagination
+checks, error messages and exit codes as every other command.
+
+JSON output prints the response document as returned by the API. Table and
+markdown output are available for GET requests when the response's resource
+type is known (apps, builds, appStoreVersions, betaGroups, reviewSubmissions,
+...). When table or markdown comes from ASC_DEFAULT_OUTPUT and the response
+can't be rendered, the raw JSON is printed instead with a note on stderr.
+
+Examples:
+  asc api GET /v1/apps
+  asc api GET /v1/apps/123/builds --query "limit=50" --paginate
+  asc api GET /v1/apps --query "filter[bundleId]=com.example.app" --output table
+  asc api POST /v1/betaGroups --body @group.json
+  asc api PATCH /v1/appStoreVersions/VERSION_ID --body '{"data":{"type":"appStoreVersions","id":"VERSION_ID","attributes":{"releaseType":"MANUAL"}}}'
+  asc api DELETE /v1/betaTesters/TESTER_ID`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if len(args) < 2 {
+				fmt.Fprintln(os.Stderr, "Error: METHOD and PATH are required")
+				return flag.ErrHelp
+			}
+			// Flags may follow the positional arguments.
+			if err := fs.Parse(args[2:]); err != nil {
+				return err
+			}
+			if fs.NArg() > 0 {
+				return shared.UsageErrorf("unexpected arguments: %s", strings.Join(fs.Args(), " "))
+			}
+
+			method := strings.ToUpper(strings.TrimSpace(args[0]))
+			switch method {
+			case http.MethodGet, http.MethodPost, http.MethodPatch, http.MethodPut, http.MethodDelete:
+			default:
+				return shared.UsageErrorf("unsupported method %q (use GET, POST, PATCH, PUT or DELETE)", args[0])
+			}
+			if *paginate && method != http.MethodGet {
+				return shared.UsageError("--paginate is only supported with GET")
+			}
+
+			path, err := buildRequestPath(args[1], query)
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
+
+			payload, err := readRequestBody(*body, os.Stdin)
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
+			if payload != nil && (method == http.MethodGet || method == http.MethodDelete) {
+				return shared.UsageErrorf("--body is not supported with %s", method)
+			}
+
+			format, err := shared.ValidateOutputFormat(*output.Output, *output.Pretty)
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
+			explicitFormat := false
+			fs.Visit(func(f *flag.Flag) {
+				if f.Name == "output" {
+					explicitFormat = true
+				}
+			})
+			// Rendering can only be checked after the response arrives, so
+			// reject an explicit table/markdown request before any mutation.
+			if explicitFormat && format != "json" && method != http.MethodGet {
+				return shared.UsageErrorf("--output %s is only supported with GET; use --output json", format)
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("api: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			var response []byte
+			if *paginate {
+				response, err = client.RawPaginate(requestCtx, path)
+			} else {
+				response, err = client.RawRequest(requestCtx, method, path, payload)
+			}
+			if err != nil {
+				return fmt.Errorf("api: %w", err)
+			}
+			if len(bytes.TrimSpace(response)) == 0 {
+				return nil
+			}
+
+			return printResponse(response, format, *output.Pretty, explicitFormat)
+		},
+	}
+}
+
+// buildRequestPath validates path and appends --query values to it.
+func buildRequestPath(rawPath string, query []string) (string, error) {
+	path := strings.TrimSpace(rawPath)
+	if path == "" {
+		return "", fmt.Errorf("PATH is required")
+	}
+	isAbsolute := strings.HasPrefix(path, "https://") || strings.HasPrefix(path, "http://")
+	if !isAbsolute && !strings.HasPrefix(path, "/") {
+		return "", fmt.Errorf("PATH must start with / (e.g. /v1/apps) or be a full App Store Connect URL")
+	}
+	if isAbsolute {
+		if err := shared.ValidateNextURL(path); err != nil {
+			return "", fmt.Errorf("PATH must be an App Store Connect URL")
+		}
+	}
+	if len(query) == 0 {
+		return path, nil
+	}
+
+	parsed, err := url.Parse(path)
+	if err != nil {
+		return "", fmt.Errorf("invalid PATH: %w", err)
+	}
+	values := parsed.Query()
+	for _, item := range query {
+		extra, err := url.ParseQuery(strings.TrimSpace(item))
+		if err != nil {
+			return "", fmt.Errorf("invalid --query %q: %w", item, err)
+		}
+		for key, vals := range extra {
+			if strings.TrimSpace(key) == "" {
+				return "", fmt.Errorf("invalid --query %q: missing key", item)
+			}
+			for _, val := range vals {
+				values.Add(key, val)
+			}
+		}
+	}
+	parsed.RawQuery = values.Encode()
+	return parsed.String(), nil
+}
+
+// readRequestBody resolves --body as inline JSON, @file or @- (stdin).
+func readRequestBody(value string, stdin io.Reader) ([]byte, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, nil
+	}
+
+	var data []byte
+	switch {
+	case value == "@-":
+		read, err := io.ReadAll(stdin)
+		if err != nil {
+			return nil, fmt.Errorf("--body: failed to read stdin: %w", err)
+		}
+		data = read
+	case strings.HasPrefix(value, "@"):
+		read, err := os.ReadFile(strings.TrimPrefix(value, "@"))
+		if err != nil {
+			return nil, fmt.Errorf("--body: %w", err)
+		}
+		data = read
+	default:
+		data = []byte(value)
+	}
+
+	if !json.Valid(data) {
+		return nil, fmt.Errorf("--body must be valid JSON")
+	}
+	return data, nil
+}
+
+// printResponse renders response in format. When format is a default rather
+// than an explicit --output, responses that can't be rendered fall back to
+// raw JSON so a completed request is never reported as a usage error.
+func printResponse(response []byte, format string, pretty, explicitFormat bool) error {
+	if format == "json" {
+		return shared.PrintOutput(json.RawMessage(response), format, pretty)
+	}
+
+	value, err := decodeKnownResponse(response)
+	if err != nil {
+		if explicitFormat {
+			return shared.UsageError(err.Error())
+		}
+		fmt.Fprintf(os.Stderr, "Note: %s output is not available for this response; printing JSON\n", format)
+		return shared.PrintOutput(json.RawMessage(response), "json", pretty)
+	}
+	return shared.PrintOutput(value, format, pretty)
+}
+
+// decodeKnownResponse decodes response into its typed form based on the resource type.
+func decodeKnownResponse(response []byte) (any, error) {
+	var document struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(response, &document); err != nil {
+		return nil, fmt.Errorf("response is not a JSON:API document; use --output json")
+	}
+
+	var resourceType string
+	many := strings.HasPrefix(strings.TrimSpace(string(document.Data)), "[")
+	if many {
+		var items []struct {
+			Type string `json:"type"`
+		}
+		if err := json.Unmarshal(document.Data, &items); err == nil && len(items) > 0 {
+			resourceType = items[0].Type
+		}
+	} else {
+		var item struct {
+			Type string `json:"type"`
+		}
+		if err := json.Unmarshal(document.Data, &item); err == nil {
+			resourceType = item.Type
+		}
+	}
+
+	known, ok := knownResponses[resourceType]
+	if !ok {
+		if resourceType == "" {
+			return nil, fmt.Errorf("cannot determine the resource type of an empty response; use --output json")
+		}
+		return nil, fmt.Errorf("table and markdown output are not available for %q; use --output json", resourceType)
+	}
+	value := known.single()
+	if many {
+		value = known.list()
+	}
+	if err := json.Unmarshal(response, value); err != nil {
+		return nil, fmt.Errorf("failed to decode %s response: %w", resourceType, err)
+	}
+	return value, nil
+}
@@ -0,0 +1,47 @@
+package api
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func TestBuildRequestPathMergesQuery(t *testing.T) {
+	path, err := buildRequestPath("/v1/apps/123/builds?sort=-uploadedDate", []string{"limit=50", "filter[version]=1.0&fields[builds]=version"})
+	if err != nil {
+		t.Fatalf("buildRequestPath() error: %v", err)
+	}
+	parsed, err := url.Parse(path)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	query := parsed.Query()
+	if parsed.Path != "/v1/apps/123/builds" || query.Get("limit") != "50" || query.Get("sort") != "-uploadedDate" ||
+		query.Get("filter[version]") != "1.0" || query.Get("fields[builds]") != "version" {
+		t.Fatalf("unexpected path %q", path)
+	}
+}
+
+func TestDecodeKnownResponse(t *testing.T) {
+	list, err := decodeKnownResponse([]byte(`{"data":[{"type":"apps","id":"1","attributes":{"name":"Demo"}}]}`))
+	if err != nil {
+		t.Fatalf("decodeKnownResponse() error: %v", err)
+	}
+	apps, ok := list.(*asc.AppsResponse)
+	if !ok || len(apps.Data) != 1 || apps.Data[0].Attributes.Name != "Demo" {
+		t.Fatalf("unexpected decoded list %#v", list)
+	}
+
+	single, err := decodeKnownResponse([]byte(`{"data":{"type":"builds","id":"b1","attributes":{"version":"42"}}}`))
+	if err != nil {
+		t.Fatalf("decodeKnownResponse() error: %v", err)
+	}
+	if build, ok := single.(*asc.BuildResponse); !ok || build.Data.ID != "b1" {
+		t.Fatalf("unexpected decoded single %#v", single)
+	}
+
+	if _, err := decodeKnownResponse([]byte(`{"data":[{"type":"somethingNew","id":"1"}]}`)); err == nil {
+		t.Fatal("expected unknown resource type error")
+	}
+}
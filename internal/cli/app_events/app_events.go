@@ -54,7 +54,7 @@ Examples:
 func AppEventsListCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("list", flag.ExitOnError)
 
-	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (or ASC_APP_ID env)")
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
@@ -170,7 +170,7 @@ Examples:
 func AppEventsCreateCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("create", flag.ExitOnError)
 
-	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (or ASC_APP_ID env)")
 	name := fs.String("name", "", "Reference name")
 	eventType := fs.String("event-type", "", "Event type: "+strings.Join(asc.ValidAppEventBadges, ", "))
 	start := fs.String("start", "", "Event start time (RFC3339)")
@@ -182,7 +182,7 @@ Examples:
 func AppClipAdvancedExperiencesCreateCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("create", flag.ExitOnError)
 
-	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (or ASC_APP_ID env)")
 	appClipID := fs.String("app-clip-id", "", "App Clip ID")
 	bundleID := fs.String("bundle-id", "", "App Clip bundle ID (requires --app)")
 	link := fs.String("link", "", "Invocation URL (required)")
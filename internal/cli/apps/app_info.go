@@ -47,9 +47,9 @@ Examples:
 func AppInfoGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("app-info get", flag.ExitOnError)
 
-	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (or ASC_APP_ID env)")
 	appInfoID := fs.String("app-info", "", "App Info ID (optional override)")
-	versionID := fs.String("version-id", "", "App Store version ID (optional override)")
+	versionID := shared.ReferenceFlag(fs, shared.ReferenceVersionID, "", "App Store version ID or version string like 1.2.3 (optional override)")
 	version := fs.String("version", "", "App Store version string (optional)")
 	platform := fs.String("platform", "", "Platform: IOS, MAC_OS, TV_OS, VISION_OS (required with --version)")
 	state := fs.String("state", "", "Filter by app store state(s), comma-separated")
@@ -205,8 +205,8 @@ Examples:
 func AppInfoSetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("app-info set", flag.ExitOnError)
 
-	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
-	versionID := fs.String("version-id", "", "App Store version ID (optional override)")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (or ASC_APP_ID env)")
+	versionID := shared.ReferenceFlag(fs, shared.ReferenceVersionID, "", "App Store version ID or version string like 1.2.3 (optional override)")
 	version := fs.String("version", "", "App Store version string (optional)")
 	platform := fs.String("platform", "", "Platform: IOS, MAC_OS, TV_OS, VISION_OS (required with --version)")
 	state := fs.String("state", "", "Filter by app store state(s), comma-separated")
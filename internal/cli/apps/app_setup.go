@@ -71,7 +71,7 @@ Examples:
 func AppSetupInfoSetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("app-setup info set", flag.ExitOnError)
 
-	appID := fs.String("app", os.Getenv("ASC_APP_ID"), "App Store Connect app ID (required)")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, os.Getenv("ASC_APP_ID"), "App Store Connect app ID, bundle ID or app name (required)")
 	bundleID := fs.String("bundle-id", "", "Bundle ID to set")
 	primaryLocale := fs.String("primary-locale", "", "Primary locale (e.g., en-US)")
 	locale := fs.String("locale", "", "Locale for app info localization (defaults to --primary-locale)")
@@ -386,7 +386,7 @@ func AppSetupLocalizationsUploadCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("app-setup localizations upload", flag.ExitOnError)
 
 	versionID := fs.String("version", "", "App Store version ID")
-	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (or ASC_APP_ID env)")
 	appInfoID := fs.String("app-info", "", "App Info ID (optional override)")
 	locType := fs.String("type", shared.LocalizationTypeVersion, "Localization type: version (default) or app-info")
 	locale := fs.String("locale", "", "Filter by locale(s), comma-separated")
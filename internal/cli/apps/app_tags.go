@@ -50,7 +50,7 @@ Examples:
 func AppTagsListCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("app-tags list", flag.ExitOnError)
 
-	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (or ASC_APP_ID env)")
 	visible := fs.String("visible-in-app-store", "", "Filter by visibility (true/false), comma-separated")
 	sort := fs.String("sort", "", "Sort by name or -name")
 	fields := fs.String("fields", "", "Fields to include: name, visibleInAppStore, territories")
@@ -186,7 +186,7 @@ Examples:
 func AppTagsGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("app-tags get", flag.ExitOnError)
 
-	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (or ASC_APP_ID env)")
 	tagID := fs.String("id", "", "App tag ID")
 	fields := fs.String("fields", "", "Fields to include: name, visibleInAppStore, territories")
 	include := fs.String("include", "", "Include related resources: territories")
@@ -529,7 +529,7 @@ Examples:
 func AppTagsRelationshipsCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("app-tags relationships", flag.ExitOnError)
 
-	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (or ASC_APP_ID env)")
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
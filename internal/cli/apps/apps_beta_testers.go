@@ -16,7 +16,7 @@ import (
 func AppsRemoveBetaTestersCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("remove-beta-testers", flag.ExitOnError)
 
-	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (or ASC_APP_ID env)")
 	testers := fs.String("tester", "", "Comma-separated beta tester IDs")
 	confirm := fs.Bool("confirm", false, "Confirm removal")
 	output := shared.BindOutputFlags(fs)
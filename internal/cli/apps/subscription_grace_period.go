@@ -37,7 +37,7 @@ Examples:
 func AppsSubscriptionGracePeriodGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("subscription-grace-period get", flag.ExitOnError)
 
-	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (or ASC_APP_ID env)")
 	output := shared.BindOutputFlags(fs)
 
 	return &ffcli.Command{
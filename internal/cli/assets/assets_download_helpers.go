@@ -107,6 +107,16 @@ func resolveImageAssetDownloadURL(asset *asc.ImageAsset, fileName string) (strin
 	return resolved, nil
 }
 
+// ResolveImageAssetDownloadURL expands an image asset template URL into a downloadable URL.
+func ResolveImageAssetDownloadURL(asset *asc.ImageAsset, fileName string) (string, error) {
+	return resolveImageAssetDownloadURL(asset, fileName)
+}
+
+// DownloadURLToFile downloads an asset URL to a file, retrying transient failures.
+func DownloadURLToFile(ctx context.Context, rawURL string, outputPath string, overwrite bool) (int64, string, error) {
+	return downloadURLToFile(ctx, rawURL, outputPath, overwrite)
+}
+
 func downloadURLToFile(ctx context.Context, rawURL string, outputPath string, overwrite bool) (int64, string, error) {
 	rawURL = strings.TrimSpace(rawURL)
 	if rawURL == "" {
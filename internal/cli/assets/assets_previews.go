@@ -602,6 +602,11 @@ func ensurePreviewSet(ctx context.Context, client *asc.Client, localizationID, p
 	return created.Data, nil
 }
 
+// EnsurePreviewSet returns the localization's preview set for a preview type, creating it when missing.
+func EnsurePreviewSet(ctx context.Context, client *asc.Client, localizationID, previewType string) (asc.Resource[asc.AppPreviewSetAttributes], error) {
+	return ensurePreviewSet(ctx, client, localizationID, previewType)
+}
+
 func uploadPreviewAsset(ctx context.Context, client *asc.Client, setID, filePath string) (asc.AssetUploadResultItem, error) {
 	if err := asc.ValidateImageFile(filePath); err != nil {
 		return asc.AssetUploadResultItem{}, err
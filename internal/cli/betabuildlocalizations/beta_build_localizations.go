@@ -48,7 +48,7 @@ Examples:
 func BetaBuildLocalizationsListCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("list", flag.ExitOnError)
 
-	buildID := fs.String("build", "", "Build ID")
+	buildID := shared.ReferenceFlag(fs, shared.ReferenceBuild, "", "Build ID, \"latest\" or \"1.2.3(45)\"")
 	global := fs.Bool("global", false, "List beta build localizations across all builds (top-level endpoint)")
 	locale := fs.String("locale", "", "Filter by locale(s), comma-separated")
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
@@ -210,7 +210,7 @@ Examples:
 func BetaBuildLocalizationsCreateCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("create", flag.ExitOnError)
 
-	buildID := fs.String("build", "", "Build ID")
+	buildID := shared.ReferenceFlag(fs, shared.ReferenceBuild, "", "Build ID, \"latest\" or \"1.2.3(45)\"")
 	locale := fs.String("locale", "", "Locale (e.g., en-US)")
 	whatsNew := fs.String("whats-new", "", "What to Test notes")
 	output := shared.BindOutputFlags(fs)
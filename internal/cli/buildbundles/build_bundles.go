@@ -46,7 +46,7 @@ Examples:
 func BuildBundlesListCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("list", flag.ExitOnError)
 
-	buildID := fs.String("build", "", "Build ID")
+	buildID := shared.ReferenceFlag(fs, shared.ReferenceBuild, "", "Build ID, \"latest\" or \"1.2.3(45)\"")
 	limit := fs.Int("limit", 0, "Maximum included build bundles (1-50)")
 	output := shared.BindOutputFlags(fs)
 
@@ -48,7 +48,7 @@ Examples:
 func BuildLocalizationsListCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("list", flag.ExitOnError)
 
-	buildID := fs.String("build", "", "Build ID")
+	buildID := shared.ReferenceFlag(fs, shared.ReferenceBuild, "", "Build ID, \"latest\" or \"1.2.3(45)\"")
 	locale := fs.String("locale", "", "Filter by locale(s), comma-separated")
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
@@ -178,7 +178,7 @@ Examples:
 func BuildLocalizationsCreateCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("create", flag.ExitOnError)
 
-	buildID := fs.String("build", "", "Build ID")
+	buildID := shared.ReferenceFlag(fs, shared.ReferenceBuild, "", "Build ID, \"latest\" or \"1.2.3(45)\"")
 	locale := fs.String("locale", "", "Locale (e.g., en-US)")
 	whatsNew := fs.String("whats-new", "", "Release notes (whats new)")
 	output := shared.BindOutputFlags(fs)
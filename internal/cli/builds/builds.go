@@ -17,8 +17,8 @@ import (
 func BuildsAddGroupsCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("add-groups", flag.ExitOnError)
 
-	buildID := fs.String("build", "", "Build ID")
-	groups := fs.String("group", "", "Comma-separated beta group IDs")
+	buildID := shared.ReferenceFlag(fs, shared.ReferenceBuild, "", "Build ID, \"latest\" or \"1.2.3(45)\"")
+	groups := shared.ReferenceListFlag(fs, shared.ReferenceBetaGroup, "", "Comma-separated beta group IDs or names")
 	output := shared.BindOutputFlags(fs)
 
 	return &ffcli.Command{
@@ -73,8 +73,8 @@ Examples:
 func BuildsRemoveGroupsCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("remove-groups", flag.ExitOnError)
 
-	buildID := fs.String("build", "", "Build ID")
-	groups := fs.String("group", "", "Comma-separated beta group IDs")
+	buildID := shared.ReferenceFlag(fs, shared.ReferenceBuild, "", "Build ID, \"latest\" or \"1.2.3(45)\"")
+	groups := shared.ReferenceListFlag(fs, shared.ReferenceBetaGroup, "", "Comma-separated beta group IDs or names")
 	confirm := fs.Bool("confirm", false, "Confirm removal")
 	output := shared.BindOutputFlags(fs)
 
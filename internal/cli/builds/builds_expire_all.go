@@ -26,7 +26,7 @@ type buildExpireCandidate struct {
 func BuildsExpireAllCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("builds expire-all", flag.ExitOnError)
 
-	appID := fs.String("app", "", "App Store Connect app ID (required, or ASC_APP_ID env)")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (required, or ASC_APP_ID env)")
 	olderThan := fs.String("older-than", "", "Expire builds older than duration (e.g., 90d, 2w, 30d) or date (YYYY-MM-DD)")
 	keepLatest := fs.Int("keep-latest", 0, "Keep the N most recent builds")
 	dryRun := fs.Bool("dry-run", false, "Preview builds that would be expired without expiring")
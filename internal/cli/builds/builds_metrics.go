@@ -40,7 +40,7 @@ Examples:
 func BuildsMetricsBetaUsagesCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("metrics beta-usages", flag.ExitOnError)
 
-	buildID := fs.String("build", "", "Build ID")
+	buildID := shared.ReferenceFlag(fs, shared.ReferenceBuild, "", "Build ID, \"latest\" or \"1.2.3(45)\"")
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	output := shared.BindOutputFlags(fs)
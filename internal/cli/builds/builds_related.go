@@ -40,7 +40,7 @@ Examples:
 func BuildsAppGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("app get", flag.ExitOnError)
 
-	buildID := fs.String("build", "", "Build ID")
+	buildID := shared.ReferenceFlag(fs, shared.ReferenceBuild, "", "Build ID, \"latest\" or \"1.2.3(45)\"")
 	aliasID := fs.String("id", "", "Build ID (alias of --build)")
 	output := shared.BindOutputFlags(fs)
 
@@ -112,7 +112,7 @@ Examples:
 func BuildsPreReleaseVersionGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("pre-release-version get", flag.ExitOnError)
 
-	buildID := fs.String("build", "", "Build ID")
+	buildID := shared.ReferenceFlag(fs, shared.ReferenceBuild, "", "Build ID, \"latest\" or \"1.2.3(45)\"")
 	aliasID := fs.String("id", "", "Build ID (alias of --build)")
 	output := shared.BindOutputFlags(fs)
 
@@ -184,7 +184,7 @@ Examples:
 func BuildsIconsListCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("icons list", flag.ExitOnError)
 
-	buildID := fs.String("build", "", "Build ID")
+	buildID := shared.ReferenceFlag(fs, shared.ReferenceBuild, "", "Build ID, \"latest\" or \"1.2.3(45)\"")
 	aliasID := fs.String("id", "", "Build ID (alias of --build)")
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
@@ -292,7 +292,7 @@ Examples:
 func BuildsBetaAppReviewSubmissionGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("beta-app-review-submission get", flag.ExitOnError)
 
-	buildID := fs.String("build", "", "Build ID")
+	buildID := shared.ReferenceFlag(fs, shared.ReferenceBuild, "", "Build ID, \"latest\" or \"1.2.3(45)\"")
 	aliasID := fs.String("id", "", "Build ID (alias of --build)")
 	output := shared.BindOutputFlags(fs)
 
@@ -364,7 +364,7 @@ Examples:
 func BuildsBuildBetaDetailGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("build-beta-detail get", flag.ExitOnError)
 
-	buildID := fs.String("build", "", "Build ID")
+	buildID := shared.ReferenceFlag(fs, shared.ReferenceBuild, "", "Build ID, \"latest\" or \"1.2.3(45)\"")
 	aliasID := fs.String("id", "", "Build ID (alias of --build)")
 	output := shared.BindOutputFlags(fs)
 
@@ -60,7 +60,7 @@ Examples:
 func BuildsRelationshipsGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("relationships get", flag.ExitOnError)
 
-	buildID := fs.String("build", "", "Build ID")
+	buildID := shared.ReferenceFlag(fs, shared.ReferenceBuild, "", "Build ID, \"latest\" or \"1.2.3(45)\"")
 	relType := fs.String("type", "", "Relationship type: "+strings.Join(buildRelationshipList(), ", "))
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
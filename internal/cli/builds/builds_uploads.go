@@ -46,7 +46,7 @@ Examples:
 func BuildsUploadsListCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("uploads list", flag.ExitOnError)
 
-	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (or ASC_APP_ID env)")
 	shortVersion := fs.String("cf-bundle-short-version", "", "Filter by CFBundleShortVersionString(s), comma-separated")
 	bundleVersion := fs.String("cf-bundle-version", "", "Filter by CFBundleVersion(s), comma-separated")
 	platform := fs.String("platform", "", "Filter by platform(s): IOS, MAC_OS, TV_OS, VISION_OS (comma-separated)")
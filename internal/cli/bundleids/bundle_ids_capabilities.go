@@ -28,7 +28,9 @@ Examples:
   asc bundle-ids capabilities list --bundle "BUNDLE_ID"
   asc bundle-ids capabilities add --bundle "BUNDLE_ID" --capability ICLOUD
   asc bundle-ids capabilities update --id "CAPABILITY_ID" --settings '[{"key":"ICLOUD_VERSION","options":[{"key":"XCODE_13","enabled":true}]}]'
-  asc bundle-ids capabilities remove --id "CAPABILITY_ID" --confirm`,
+  asc bundle-ids capabilities remove --id "CAPABILITY_ID" --confirm
+  asc bundle-ids capabilities apply --template caps.json --bundle-id "com.example.app" --dry-run
+  asc bundle-ids capabilities diff --bundle-id "com.example.app,com.example.app.widget"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
@@ -36,6 +38,8 @@ Examples:
 			BundleIDsCapabilitiesAddCommand(),
 			BundleIDsCapabilitiesUpdateCommand(),
 			BundleIDsCapabilitiesRemoveCommand(),
+			BundleIDsCapabilitiesApplyCommand(),
+			BundleIDsCapabilitiesDiffCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
@@ -0,0 +1,759 @@
+package bundleids
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	capabilityDriftMissing  = "missing"
+	capabilityDriftExtra    = "extra"
+	capabilityDriftSettings = "settings"
+)
+
+// CapabilityTemplate is the JSON schema for capability templates.
+type CapabilityTemplate struct {
+	Capabilities []CapabilityTemplateEntry `json:"capabilities"`
+}
+
+// CapabilityTemplateEntry declares one capability and, optionally, its settings.
+// Settings are only compared when present in the template.
+type CapabilityTemplateEntry struct {
+	CapabilityType string                  `json:"capabilityType"`
+	Settings       []asc.CapabilitySetting `json:"settings,omitempty"`
+}
+
+// CapabilityDrift describes one difference between a bundle ID and its reference.
+type CapabilityDrift struct {
+	BundleID     string `json:"bundleId"`
+	Identifier   string `json:"identifier"`
+	Capability   string `json:"capability"`
+	Status       string `json:"status"`
+	Expected     string `json:"expected,omitempty"`
+	Actual       string `json:"actual,omitempty"`
+	CapabilityID string `json:"capabilityId,omitempty"`
+
+	settings []asc.CapabilitySetting
+}
+
+// CapabilityDiffResult is the output of bundle-ids capabilities diff.
+type CapabilityDiffResult struct {
+	Reference string            `json:"reference"`
+	BundleIDs []string          `json:"bundleIds"`
+	InSync    bool              `json:"inSync"`
+	Drift     []CapabilityDrift `json:"drift"`
+}
+
+// RegeneratedProfile describes a provisioning profile recreated after a change.
+type RegeneratedProfile struct {
+	BundleID    string `json:"bundleId"`
+	Name        string `json:"name"`
+	ProfileType string `json:"profileType"`
+	OldID       string `json:"oldId"`
+	NewID       string `json:"newId"`
+}
+
+// CapabilityApplyResult is the output of bundle-ids capabilities apply.
+type CapabilityApplyResult struct {
+	Template            string               `json:"template"`
+	BundleIDs           []string             `json:"bundleIds"`
+	DryRun              bool                 `json:"dryRun"`
+	Applied             bool                 `json:"applied"`
+	Changes             []CapabilityDrift    `json:"changes"`
+	RegeneratedProfiles []RegeneratedProfile `json:"regeneratedProfiles,omitempty"`
+}
+
+type capabilityTemplateClient interface {
+	GetBundleID(ctx context.Context, id string) (*asc.BundleIDResponse, error)
+	GetBundleIDs(ctx context.Context, opts ...asc.BundleIDsOption) (*asc.BundleIDsResponse, error)
+	GetBundleIDCapabilities(ctx context.Context, bundleID string, opts ...asc.BundleIDCapabilitiesOption) (*asc.BundleIDCapabilitiesResponse, error)
+	CreateBundleIDCapability(ctx context.Context, bundleID string, attrs asc.BundleIDCapabilityCreateAttributes) (*asc.BundleIDCapabilityResponse, error)
+	UpdateBundleIDCapability(ctx context.Context, capabilityID string, attrs asc.BundleIDCapabilityUpdateAttributes) (*asc.BundleIDCapabilityResponse, error)
+	DeleteBundleIDCapability(ctx context.Context, capabilityID string) error
+	GetBundleIDProfiles(ctx context.Context, bundleID string, opts ...asc.BundleIDProfilesOption) (*asc.ProfilesResponse, error)
+	GetProfileCertificates(ctx context.Context, profileID string, opts ...asc.ProfileCertificatesOption) (*asc.CertificatesResponse, error)
+	GetProfileDevices(ctx context.Context, profileID string, opts ...asc.ProfileDevicesOption) (*asc.DevicesResponse, error)
+	DeleteProfile(ctx context.Context, id string) error
+	CreateProfile(ctx context.Context, attrs asc.ProfileCreateAttributes, bundleID string, certificateIDs []string, deviceIDs []string) (*asc.ProfileResponse, error)
+}
+
+// capabilityBundleState is a bundle ID with its current capabilities.
+type capabilityBundleState struct {
+	id           string
+	identifier   string
+	capabilities map[string]asc.Resource[asc.BundleIDCapabilityAttributes]
+}
+
+// BundleIDsCapabilitiesApplyCommand returns the bundle IDs capabilities apply subcommand.
+func BundleIDsCapabilitiesApplyCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("apply", flag.ExitOnError)
+
+	templatePath := fs.String("template", "", "Path to capability template JSON (required)")
+	bundleID := fs.String("bundle", "", "Bundle ID resource ID(s), comma-separated")
+	identifier := fs.String("bundle-id", "", "Bundle identifier(s), comma-separated (e.g., com.example.app)")
+	dryRun := fs.Bool("dry-run", false, "Preview changes without mutating App Store Connect")
+	prune := fs.Bool("prune", false, "Remove capabilities not declared in the template")
+	regenerate := fs.Bool("regenerate-profiles", false, "Recreate provisioning profiles of changed bundle IDs")
+	confirm := fs.Bool("confirm", false, "Confirm destructive operations (required with --prune or --regenerate-profiles)")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "apply",
+		ShortUsage: "asc bundle-ids capabilities apply --template caps.json --bundle-id \"com.example.app\" [flags]",
+		ShortHelp:  "Apply a capability template to bundle IDs.",
+		LongHelp: `Apply a capability template to one or more bundle IDs.
+
+Template:
+  {
+    "capabilities": [
+      {"capabilityType": "PUSH_NOTIFICATIONS"},
+      {"capabilityType": "APP_GROUPS"},
+      {"capabilityType": "ICLOUD", "settings": [{"key":"ICLOUD_VERSION","options":[{"key":"XCODE_6","enabled":true}]}]}
+    ]
+  }
+
+Examples:
+  asc bundle-ids capabilities apply --template caps.json --bundle-id "com.example.app,com.example.app.widget" --dry-run
+  asc bundle-ids capabilities apply --template caps.json --bundle "BUNDLE_ID"
+  asc bundle-ids capabilities apply --template caps.json --bundle-id "com.example.app" --prune --regenerate-profiles --confirm`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			templateValue := strings.TrimSpace(*templatePath)
+			if templateValue == "" {
+				return shared.UsageError("--template is required")
+			}
+			bundleIDs := shared.SplitCSV(*bundleID)
+			identifiers := shared.SplitCSV(*identifier)
+			if len(bundleIDs) == 0 && len(identifiers) == 0 {
+				return shared.UsageError("--bundle or --bundle-id is required")
+			}
+			if (*prune || *regenerate) && !*dryRun && !*confirm {
+				return shared.UsageError("--confirm is required with --prune or --regenerate-profiles")
+			}
+
+			template, err := readCapabilityTemplate(templateValue)
+			if err != nil {
+				return fmt.Errorf("bundle-ids capabilities apply: %w", err)
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("bundle-ids capabilities apply: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			bundles, err := loadCapabilityBundles(requestCtx, client, bundleIDs, identifiers)
+			if err != nil {
+				return fmt.Errorf("bundle-ids capabilities apply: %w", err)
+			}
+
+			changes := diffBundlesAgainstTemplate(template, bundles, *prune)
+			result := CapabilityApplyResult{
+				Template:  templateValue,
+				BundleIDs: bundleIdentifiers(bundles),
+				DryRun:    *dryRun,
+				Changes:   changes,
+			}
+
+			if !*dryRun && len(changes) > 0 {
+				applied, err := applyCapabilityChanges(requestCtx, client, changes)
+				if err != nil {
+					// Report the capabilities already changed before surfacing the failure.
+					result.Changes = applied
+					if printErr := printCapabilityApplyResult(result, *output.Output, *output.Pretty); printErr != nil {
+						return printErr
+					}
+					return shared.NewReportedError(fmt.Errorf("bundle-ids capabilities apply: %w", err))
+				}
+				result.Applied = true
+
+				if *regenerate {
+					regenerated, err := regenerateChangedProfiles(requestCtx, client, bundles, changes)
+					result.RegeneratedProfiles = regenerated
+					if err != nil {
+						// Profiles already recreated have new IDs; show them.
+						if printErr := printCapabilityApplyResult(result, *output.Output, *output.Pretty); printErr != nil {
+							return printErr
+						}
+						return shared.NewReportedError(fmt.Errorf("bundle-ids capabilities apply: %w", err))
+					}
+				}
+			}
+
+			return printCapabilityApplyResult(result, *output.Output, *output.Pretty)
+		},
+	}
+}
+
+func printCapabilityApplyResult(result CapabilityApplyResult, format string, pretty bool) error {
+	return shared.PrintOutputWithRenderers(
+		result,
+		format,
+		pretty,
+		func() error { return printCapabilityApplyTable(result) },
+		func() error { return printCapabilityApplyMarkdown(result) },
+	)
+}
+
+// BundleIDsCapabilitiesDiffCommand returns the bundle IDs capabilities diff subcommand.
+func BundleIDsCapabilitiesDiffCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("diff", flag.ExitOnError)
+
+	templatePath := fs.String("template", "", "Compare against a capability template instead of across bundle IDs")
+	bundleID := fs.String("bundle", "", "Bundle ID resource ID(s), comma-separated")
+	identifier := fs.String("bundle-id", "", "Bundle identifier(s), comma-separated (e.g., com.example.app)")
+	failOnDrift := fs.Bool("fail-on-drift", false, "Exit non-zero when drift is detected")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "diff",
+		ShortUsage: "asc bundle-ids capabilities diff --bundle-id \"com.example.app,com.example.app.widget\" [flags]",
+		ShortHelp:  "Report capability drift across bundle IDs.",
+		LongHelp: `Report capability drift across bundle IDs.
+
+Without --template, every capability enabled on any of the bundle IDs is
+expected on all of them; settings are compared with the first bundle ID
+that has the capability.
+
+Examples:
+  asc bundle-ids capabilities diff --bundle-id "com.example.app,com.example.app.widget"
+  asc bundle-ids capabilities diff --bundle-id "com.example.app,com.example.app.widget" --output markdown
+  asc bundle-ids capabilities diff --template caps.json --bundle-id "com.example.app" --fail-on-drift`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			bundleIDs := shared.SplitCSV(*bundleID)
+			identifiers := shared.SplitCSV(*identifier)
+			templateValue := strings.TrimSpace(*templatePath)
+			total := len(bundleIDs) + len(identifiers)
+			if total == 0 {
+				return shared.UsageError("--bundle or --bundle-id is required")
+			}
+			if templateValue == "" && total < 2 {
+				return shared.UsageError("at least two bundle IDs are required without --template")
+			}
+
+			var template *CapabilityTemplate
+			if templateValue != "" {
+				loaded, err := readCapabilityTemplate(templateValue)
+				if err != nil {
+					return fmt.Errorf("bundle-ids capabilities diff: %w", err)
+				}
+				template = loaded
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("bundle-ids capabilities diff: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			bundles, err := loadCapabilityBundles(requestCtx, client, bundleIDs, identifiers)
+			if err != nil {
+				return fmt.Errorf("bundle-ids capabilities diff: %w", err)
+			}
+
+			result := CapabilityDiffResult{BundleIDs: bundleIdentifiers(bundles)}
+			if template != nil {
+				result.Reference = templateValue
+				result.Drift = diffBundlesAgainstTemplate(template, bundles, true)
+			} else {
+				result.Reference = "union"
+				result.Drift = diffBundlesAcrossSet(bundles)
+			}
+			result.InSync = len(result.Drift) == 0
+
+			if err := shared.PrintOutputWithRenderers(
+				result,
+				*output.Output,
+				*output.Pretty,
+				func() error { return printCapabilityDiffTable(result) },
+				func() error { return printCapabilityDiffMarkdown(result) },
+			); err != nil {
+				return err
+			}
+			if *failOnDrift && !result.InSync {
+				return shared.NewReportedError(fmt.Errorf("bundle-ids capabilities diff: %d drift item(s) found", len(result.Drift)))
+			}
+			return nil
+		},
+	}
+}
+
+func readCapabilityTemplate(path string) (*CapabilityTemplate, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read template: %w", err)
+	}
+	var template CapabilityTemplate
+	if err := json.Unmarshal(data, &template); err != nil {
+		return nil, fmt.Errorf("parse template %s: %w", path, err)
+	}
+	if len(template.Capabilities) == 0 {
+		return nil, fmt.Errorf("template %s declares no capabilities", path)
+	}
+	seen := make(map[string]struct{}, len(template.Capabilities))
+	for i, entry := range template.Capabilities {
+		capabilityType := strings.ToUpper(strings.TrimSpace(entry.CapabilityType))
+		if capabilityType == "" {
+			return nil, fmt.Errorf("capabilities[%d]: capabilityType is required", i)
+		}
+		if _, exists := seen[capabilityType]; exists {
+			return nil, fmt.Errorf("capabilities[%d]: duplicate capabilityType %q", i, capabilityType)
+		}
+		seen[capabilityType] = struct{}{}
+		template.Capabilities[i].CapabilityType = capabilityType
+	}
+	return &template, nil
+}
+
+func loadCapabilityBundles(ctx context.Context, client capabilityTemplateClient, bundleIDs, identifiers []string) ([]capabilityBundleState, error) {
+	bundles := make([]capabilityBundleState, 0, len(bundleIDs)+len(identifiers))
+	seen := make(map[string]struct{})
+	add := func(id, identifierValue string) error {
+		if _, exists := seen[id]; exists {
+			return nil
+		}
+		seen[id] = struct{}{}
+		capabilities, err := fetchBundleCapabilities(ctx, client, id)
+		if err != nil {
+			return fmt.Errorf("fetch capabilities for %s: %w", identifierValue, err)
+		}
+		bundles = append(bundles, capabilityBundleState{id: id, identifier: identifierValue, capabilities: capabilities})
+		return nil
+	}
+
+	for _, id := range bundleIDs {
+		resp, err := client.GetBundleID(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("fetch bundle ID %s: %w", id, err)
+		}
+		if err := add(resp.Data.ID, resp.Data.Attributes.Identifier); err != nil {
+			return nil, err
+		}
+	}
+	for _, value := range identifiers {
+		resp, err := client.GetBundleIDs(ctx, asc.WithBundleIDsFilterIdentifier(value))
+		if err != nil {
+			return nil, fmt.Errorf("lookup bundle ID %s: %w", value, err)
+		}
+		// The identifier filter matches prefixes; keep exact matches only.
+		var match *asc.Resource[asc.BundleIDAttributes]
+		for i := range resp.Data {
+			if strings.EqualFold(resp.Data[i].Attributes.Identifier, value) {
+				match = &resp.Data[i]
+				break
+			}
+		}
+		if match == nil {
+			return nil, fmt.Errorf("bundle ID not found: %s", value)
+		}
+		if err := add(match.ID, match.Attributes.Identifier); err != nil {
+			return nil, err
+		}
+	}
+	return bundles, nil
+}
+
+func fetchBundleCapabilities(ctx context.Context, client capabilityTemplateClient, bundleID string) (map[string]asc.Resource[asc.BundleIDCapabilityAttributes], error) {
+	firstPage, err := client.GetBundleIDCapabilities(ctx, bundleID, asc.WithBundleIDCapabilitiesLimit(200))
+	if err != nil {
+		return nil, err
+	}
+	all, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetBundleIDCapabilities(ctx, bundleID, asc.WithBundleIDCapabilitiesNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp, ok := all.(*asc.BundleIDCapabilitiesResponse)
+	if !ok || resp == nil {
+		return nil, fmt.Errorf("unexpected bundle ID capabilities response type")
+	}
+	capabilities := make(map[string]asc.Resource[asc.BundleIDCapabilityAttributes], len(resp.Data))
+	for _, item := range resp.Data {
+		capabilities[strings.ToUpper(item.Attributes.CapabilityType)] = item
+	}
+	return capabilities, nil
+}
+
+func diffBundlesAgainstTemplate(template *CapabilityTemplate, bundles []capabilityBundleState, includeExtra bool) []CapabilityDrift {
+	drift := make([]CapabilityDrift, 0)
+	declared := make(map[string]struct{}, len(template.Capabilities))
+	for _, entry := range template.Capabilities {
+		declared[entry.CapabilityType] = struct{}{}
+	}
+
+	for _, bundle := range bundles {
+		for _, entry := range template.Capabilities {
+			current, ok := bundle.capabilities[entry.CapabilityType]
+			if !ok {
+				drift = append(drift, CapabilityDrift{
+					BundleID:   bundle.id,
+					Identifier: bundle.identifier,
+					Capability: entry.CapabilityType,
+					Status:     capabilityDriftMissing,
+					Expected:   formatCapabilitySettings(entry.Settings),
+					settings:   entry.Settings,
+				})
+				continue
+			}
+			if entry.Settings == nil {
+				continue
+			}
+			expected := formatCapabilitySettings(entry.Settings)
+			actual := formatCapabilitySettings(current.Attributes.Settings)
+			if expected != actual {
+				drift = append(drift, CapabilityDrift{
+					BundleID:     bundle.id,
+					Identifier:   bundle.identifier,
+					Capability:   entry.CapabilityType,
+					Status:       capabilityDriftSettings,
+					Expected:     expected,
+					Actual:       actual,
+					CapabilityID: current.ID,
+					settings:     entry.Settings,
+				})
+			}
+		}
+		if !includeExtra {
+			continue
+		}
+		for _, capabilityType := range sortedCapabilityTypes(bundle.capabilities) {
+			if _, ok := declared[capabilityType]; ok {
+				continue
+			}
+			current := bundle.capabilities[capabilityType]
+			drift = append(drift, CapabilityDrift{
+				BundleID:     bundle.id,
+				Identifier:   bundle.identifier,
+				Capability:   capabilityType,
+				Status:       capabilityDriftExtra,
+				Actual:       formatCapabilitySettings(current.Attributes.Settings),
+				CapabilityID: current.ID,
+			})
+		}
+	}
+	return drift
+}
+
+func diffBundlesAcrossSet(bundles []capabilityBundleState) []CapabilityDrift {
+	// The reference for each capability is the first bundle that has it.
+	reference := make(map[string]asc.BundleIDCapabilityAttributes)
+	order := make([]string, 0)
+	for _, bundle := range bundles {
+		for _, capabilityType := range sortedCapabilityTypes(bundle.capabilities) {
+			if _, ok := reference[capabilityType]; ok {
+				continue
+			}
+			reference[capabilityType] = bundle.capabilities[capabilityType].Attributes
+			order = append(order, capabilityType)
+		}
+	}
+	sort.Strings(order)
+
+	drift := make([]CapabilityDrift, 0)
+	for _, bundle := range bundles {
+		for _, capabilityType := range order {
+			expected := formatCapabilitySettings(reference[capabilityType].Settings)
+			current, ok := bundle.capabilities[capabilityType]
+			if !ok {
+				drift = append(drift, CapabilityDrift{
+					BundleID:   bundle.id,
+					Identifier: bundle.identifier,
+					Capability: capabilityType,
+					Status:     capabilityDriftMissing,
+					Expected:   expected,
+				})
+				continue
+			}
+			if actual := formatCapabilitySettings(current.Attributes.Settings); actual != expected {
+				drift = append(drift, CapabilityDrift{
+					BundleID:     bundle.id,
+					Identifier:   bundle.identifier,
+					Capability:   capabilityType,
+					Status:       capabilityDriftSettings,
+					Expected:     expected,
+					Actual:       actual,
+					CapabilityID: current.ID,
+				})
+			}
+		}
+	}
+	return drift
+}
+
+// applyCapabilityChanges executes the changes in order and returns the ones
+// applied before any failure.
+func applyCapabilityChanges(ctx context.Context, client capabilityTemplateClient, changes []CapabilityDrift) ([]CapabilityDrift, error) {
+	applied := make([]CapabilityDrift, 0, len(changes))
+	for _, change := range changes {
+		var err error
+		switch change.Status {
+		case capabilityDriftMissing:
+			_, err = client.CreateBundleIDCapability(ctx, change.BundleID, asc.BundleIDCapabilityCreateAttributes{
+				CapabilityType: change.Capability,
+				Settings:       change.settings,
+			})
+		case capabilityDriftSettings:
+			_, err = client.UpdateBundleIDCapability(ctx, change.CapabilityID, asc.BundleIDCapabilityUpdateAttributes{
+				CapabilityType: change.Capability,
+				Settings:       change.settings,
+			})
+		case capabilityDriftExtra:
+			err = client.DeleteBundleIDCapability(ctx, change.CapabilityID)
+		}
+		if err != nil {
+			return applied, fmt.Errorf("%s %s on %s: %w", change.Status, change.Capability, change.Identifier, err)
+		}
+		applied = append(applied, change)
+	}
+	return applied, nil
+}
+
+// regenerateChangedProfiles recreates the provisioning profiles of every
+// changed bundle ID, keeping name, type, certificates and devices. The old
+// profile is deleted first so the replacement can reuse its name; if the
+// create fails, the error carries everything needed to recreate it by hand.
+func regenerateChangedProfiles(ctx context.Context, client capabilityTemplateClient, bundles []capabilityBundleState, changes []CapabilityDrift) ([]RegeneratedProfile, error) {
+	changed := make(map[string]struct{})
+	for _, change := range changes {
+		changed[change.BundleID] = struct{}{}
+	}
+
+	regenerated := make([]RegeneratedProfile, 0)
+	for _, bundle := range bundles {
+		if _, ok := changed[bundle.id]; !ok {
+			continue
+		}
+		profiles, err := fetchBundleIDProfiles(ctx, client, bundle.id)
+		if err != nil {
+			return regenerated, fmt.Errorf("fetch profiles for %s: %w", bundle.identifier, err)
+		}
+		for _, profile := range profiles {
+			certificateIDs, err := fetchProfileCertificateIDs(ctx, client, profile.ID)
+			if err != nil {
+				return regenerated, fmt.Errorf("fetch certificates for profile %s: %w", profile.Attributes.Name, err)
+			}
+			deviceIDs, err := fetchProfileDeviceIDs(ctx, client, profile.ID)
+			if err != nil {
+				return regenerated, fmt.Errorf("fetch devices for profile %s: %w", profile.Attributes.Name, err)
+			}
+			if err := client.DeleteProfile(ctx, profile.ID); err != nil {
+				return regenerated, fmt.Errorf("delete profile %s: %w", profile.Attributes.Name, err)
+			}
+			created, err := client.CreateProfile(ctx, asc.ProfileCreateAttributes{
+				Name:        profile.Attributes.Name,
+				ProfileType: profile.Attributes.ProfileType,
+			}, bundle.id, certificateIDs, deviceIDs)
+			if err != nil {
+				return regenerated, fmt.Errorf(
+					"recreate profile %s (deleted %s; type %s, bundle ID %s, certificates [%s], devices [%s]): %w",
+					profile.Attributes.Name,
+					profile.ID,
+					profile.Attributes.ProfileType,
+					bundle.id,
+					strings.Join(certificateIDs, ","),
+					strings.Join(deviceIDs, ","),
+					err,
+				)
+			}
+			regenerated = append(regenerated, RegeneratedProfile{
+				BundleID:    bundle.identifier,
+				Name:        profile.Attributes.Name,
+				ProfileType: profile.Attributes.ProfileType,
+				OldID:       profile.ID,
+				NewID:       created.Data.ID,
+			})
+		}
+	}
+	return regenerated, nil
+}
+
+func fetchBundleIDProfiles(ctx context.Context, client capabilityTemplateClient, bundleID string) ([]asc.Resource[asc.ProfileAttributes], error) {
+	firstPage, err := client.GetBundleIDProfiles(ctx, bundleID, asc.WithBundleIDProfilesLimit(200))
+	if err != nil {
+		return nil, err
+	}
+	allPages, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetBundleIDProfiles(ctx, bundleID, asc.WithBundleIDProfilesNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, err
+	}
+	profiles, ok := allPages.(*asc.ProfilesResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected profiles response type %T", allPages)
+	}
+	return profiles.Data, nil
+}
+
+func fetchProfileCertificateIDs(ctx context.Context, client capabilityTemplateClient, profileID string) ([]string, error) {
+	firstPage, err := client.GetProfileCertificates(ctx, profileID, asc.WithProfileCertificatesLimit(200))
+	if err != nil {
+		return nil, err
+	}
+	allPages, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetProfileCertificates(ctx, profileID, asc.WithProfileCertificatesNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, err
+	}
+	certificates, ok := allPages.(*asc.CertificatesResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected certificates response type %T", allPages)
+	}
+	return resourceIDs(certificates.Data), nil
+}
+
+func fetchProfileDeviceIDs(ctx context.Context, client capabilityTemplateClient, profileID string) ([]string, error) {
+	firstPage, err := client.GetProfileDevices(ctx, profileID, asc.WithProfileDevicesLimit(200))
+	if err != nil {
+		return nil, err
+	}
+	allPages, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetProfileDevices(ctx, profileID, asc.WithProfileDevicesNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, err
+	}
+	devices, ok := allPages.(*asc.DevicesResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected devices response type %T", allPages)
+	}
+	return resourceIDs(devices.Data), nil
+}
+
+func resourceIDs[T any](items []asc.Resource[T]) []string {
+	ids := make([]string, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.ID)
+	}
+	return ids
+}
+
+// formatCapabilitySettings renders settings canonically as KEY=OPTION|OPTION
+// using enabled options only, so ordering differences are not drift.
+func formatCapabilitySettings(settings []asc.CapabilitySetting) string {
+	if len(settings) == 0 {
+		return ""
+	}
+	parts := make([]string, 0, len(settings))
+	for _, setting := range settings {
+		options := make([]string, 0, len(setting.Options))
+		for _, option := range setting.Options {
+			if option.Enabled != nil && !*option.Enabled {
+				continue
+			}
+			options = append(options, strings.ToUpper(option.Key))
+		}
+		sort.Strings(options)
+		parts = append(parts, strings.ToUpper(setting.Key)+"="+strings.Join(options, "|"))
+	}
+	sort.Strings(parts)
+	return strings.Join(parts, ";")
+}
+
+func sortedCapabilityTypes(capabilities map[string]asc.Resource[asc.BundleIDCapabilityAttributes]) []string {
+	types := make([]string, 0, len(capabilities))
+	for capabilityType := range capabilities {
+		types = append(types, capabilityType)
+	}
+	sort.Strings(types)
+	return types
+}
+
+func bundleIdentifiers(bundles []capabilityBundleState) []string {
+	identifiers := make([]string, 0, len(bundles))
+	for _, bundle := range bundles {
+		identifiers = append(identifiers, bundle.identifier)
+	}
+	return identifiers
+}
+
+func capabilityDriftRows(drift []CapabilityDrift) [][]string {
+	rows := make([][]string, 0, len(drift))
+	for _, item := range drift {
+		rows = append(rows, []string{item.Identifier, item.Capability, item.Status, item.Expected, item.Actual})
+	}
+	return rows
+}
+
+func printCapabilityDiffTable(result CapabilityDiffResult) error {
+	fmt.Printf("Reference: %s\n", result.Reference)
+	fmt.Printf("Bundle IDs: %s\n\n", strings.Join(result.BundleIDs, ", "))
+	if result.InSync {
+		fmt.Println("No drift. Capabilities match.")
+		return nil
+	}
+	asc.RenderTable([]string{"bundleId", "capability", "status", "expected", "actual"}, capabilityDriftRows(result.Drift))
+	return nil
+}
+
+func printCapabilityDiffMarkdown(result CapabilityDiffResult) error {
+	fmt.Printf("**Reference:** %s\n\n", result.Reference)
+	fmt.Printf("**Bundle IDs:** %s\n\n", strings.Join(result.BundleIDs, ", "))
+	if result.InSync {
+		fmt.Println("No drift. Capabilities match.")
+		return nil
+	}
+	asc.RenderMarkdown([]string{"bundleId", "capability", "status", "expected", "actual"}, capabilityDriftRows(result.Drift))
+	return nil
+}
+
+func regeneratedProfileRows(profiles []RegeneratedProfile) [][]string {
+	rows := make([][]string, 0, len(profiles))
+	for _, profile := range profiles {
+		rows = append(rows, []string{profile.BundleID, profile.Name, profile.ProfileType, profile.OldID, profile.NewID})
+	}
+	return rows
+}
+
+func printCapabilityApplyTable(result CapabilityApplyResult) error {
+	fmt.Printf("Template: %s\n", result.Template)
+	fmt.Printf("Bundle IDs: %s\n", strings.Join(result.BundleIDs, ", "))
+	fmt.Printf("Dry Run: %t\n", result.DryRun)
+	fmt.Printf("Applied: %t\n\n", result.Applied)
+	if len(result.Changes) == 0 {
+		fmt.Println("No changes. Capabilities match the template.")
+		return nil
+	}
+	asc.RenderTable([]string{"bundleId", "capability", "status", "expected", "actual"}, capabilityDriftRows(result.Changes))
+	if len(result.RegeneratedProfiles) > 0 {
+		fmt.Println()
+		asc.RenderTable([]string{"bundleId", "profile", "type", "oldId", "newId"}, regeneratedProfileRows(result.RegeneratedProfiles))
+	}
+	return nil
+}
+
+func printCapabilityApplyMarkdown(result CapabilityApplyResult) error {
+	fmt.Printf("**Template:** %s\n\n", result.Template)
+	fmt.Printf("**Bundle IDs:** %s\n\n", strings.Join(result.BundleIDs, ", "))
+	fmt.Printf("**Dry Run:** %t\n\n", result.DryRun)
+	fmt.Printf("**Applied:** %t\n\n", result.Applied)
+	if len(result.Changes) == 0 {
+		fmt.Println("No changes. Capabilities match the template.")
+		return nil
+	}
+	asc.RenderMarkdown([]string{"bundleId", "capability", "status", "expected", "actual"}, capabilityDriftRows(result.Changes))
+	if len(result.RegeneratedProfiles) > 0 {
+		fmt.Println()
+		asc.RenderMarkdown([]string{"bundleId", "profile", "type", "oldId", "newId"}, regeneratedProfileRows(result.RegeneratedProfiles))
+	}
+	return nil
+}
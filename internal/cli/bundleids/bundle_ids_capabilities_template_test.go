@@ -0,0 +1,261 @@
+package bundleids
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+type capabilityTemplateStub struct {
+	bundles      []asc.Resource[asc.BundleIDAttributes]
+	capabilities map[string][]asc.Resource[asc.BundleIDCapabilityAttributes]
+	profiles     map[string][]asc.Resource[asc.ProfileAttributes]
+	createErr    error
+	deleteErr    error
+	deviceCalls  int
+	calls        []string
+}
+
+func (s *capabilityTemplateStub) GetBundleID(ctx context.Context, id string) (*asc.BundleIDResponse, error) {
+	for _, bundle := range s.bundles {
+		if bundle.ID == id {
+			return &asc.BundleIDResponse{Data: bundle}, nil
+		}
+	}
+	return nil, fmt.Errorf("not found: %s", id)
+}
+
+func (s *capabilityTemplateStub) GetBundleIDs(ctx context.Context, opts ...asc.BundleIDsOption) (*asc.BundleIDsResponse, error) {
+	return &asc.BundleIDsResponse{Data: s.bundles}, nil
+}
+
+func (s *capabilityTemplateStub) GetBundleIDCapabilities(ctx context.Context, bundleID string, opts ...asc.BundleIDCapabilitiesOption) (*asc.BundleIDCapabilitiesResponse, error) {
+	return &asc.BundleIDCapabilitiesResponse{Data: s.capabilities[bundleID]}, nil
+}
+
+func (s *capabilityTemplateStub) CreateBundleIDCapability(ctx context.Context, bundleID string, attrs asc.BundleIDCapabilityCreateAttributes) (*asc.BundleIDCapabilityResponse, error) {
+	s.calls = append(s.calls, fmt.Sprintf("create %s %s", bundleID, attrs.CapabilityType))
+	return &asc.BundleIDCapabilityResponse{}, nil
+}
+
+func (s *capabilityTemplateStub) UpdateBundleIDCapability(ctx context.Context, capabilityID string, attrs asc.BundleIDCapabilityUpdateAttributes) (*asc.BundleIDCapabilityResponse, error) {
+	s.calls = append(s.calls, fmt.Sprintf("update %s", capabilityID))
+	return &asc.BundleIDCapabilityResponse{}, nil
+}
+
+func (s *capabilityTemplateStub) DeleteBundleIDCapability(ctx context.Context, capabilityID string) error {
+	s.calls = append(s.calls, fmt.Sprintf("delete %s", capabilityID))
+	return s.deleteErr
+}
+
+func (s *capabilityTemplateStub) GetBundleIDProfiles(ctx context.Context, bundleID string, opts ...asc.BundleIDProfilesOption) (*asc.ProfilesResponse, error) {
+	return &asc.ProfilesResponse{Data: s.profiles[bundleID]}, nil
+}
+
+func (s *capabilityTemplateStub) GetProfileCertificates(ctx context.Context, profileID string, opts ...asc.ProfileCertificatesOption) (*asc.CertificatesResponse, error) {
+	return &asc.CertificatesResponse{Data: []asc.Resource[asc.CertificateAttributes]{{ID: "cert-1"}}}, nil
+}
+
+func (s *capabilityTemplateStub) GetProfileDevices(ctx context.Context, profileID string, opts ...asc.ProfileDevicesOption) (*asc.DevicesResponse, error) {
+	s.deviceCalls++
+	if s.deviceCalls%2 == 1 {
+		return &asc.DevicesResponse{
+			Data:  []asc.Resource[asc.DeviceAttributes]{{ID: "dev-1"}},
+			Links: asc.Links{Next: "https://api.appstoreconnect.apple.com/v1/profiles/" + profileID + "/devices?cursor=2"},
+		}, nil
+	}
+	return &asc.DevicesResponse{Data: []asc.Resource[asc.DeviceAttributes]{{ID: "dev-2"}}}, nil
+}
+
+func (s *capabilityTemplateStub) DeleteProfile(ctx context.Context, id string) error {
+	s.calls = append(s.calls, fmt.Sprintf("delete profile %s", id))
+	return nil
+}
+
+func (s *capabilityTemplateStub) CreateProfile(ctx context.Context, attrs asc.ProfileCreateAttributes, bundleID string, certificateIDs []string, deviceIDs []string) (*asc.ProfileResponse, error) {
+	s.calls = append(s.calls, fmt.Sprintf("create profile %s %s %v %v", attrs.Name, bundleID, certificateIDs, deviceIDs))
+	if s.createErr != nil {
+		return nil, s.createErr
+	}
+	return &asc.ProfileResponse{Data: asc.Resource[asc.ProfileAttributes]{ID: "new-" + attrs.Name}}, nil
+}
+
+func boolPtr(value bool) *bool {
+	return &value
+}
+
+func newCapabilityTemplateStub() *capabilityTemplateStub {
+	icloud := []asc.CapabilitySetting{{Key: "ICLOUD_VERSION", Options: []asc.CapabilityOption{{Key: "XCODE_6", Enabled: boolPtr(true)}}}}
+	return &capabilityTemplateStub{
+		bundles: []asc.Resource[asc.BundleIDAttributes]{
+			{ID: "b-app", Attributes: asc.BundleIDAttributes{Identifier: "com.example.app"}},
+			{ID: "b-widget", Attributes: asc.BundleIDAttributes{Identifier: "com.example.app.widget"}},
+		},
+		capabilities: map[string][]asc.Resource[asc.BundleIDCapabilityAttributes]{
+			"b-app": {
+				{ID: "b-app_PUSH", Attributes: asc.BundleIDCapabilityAttributes{CapabilityType: "PUSH_NOTIFICATIONS"}},
+				{ID: "b-app_ICLOUD", Attributes: asc.BundleIDCapabilityAttributes{CapabilityType: "ICLOUD", Settings: icloud}},
+			},
+			"b-widget": {
+				{ID: "b-widget_ICLOUD", Attributes: asc.BundleIDCapabilityAttributes{CapabilityType: "ICLOUD"}},
+				{ID: "b-widget_MAPS", Attributes: asc.BundleIDCapabilityAttributes{CapabilityType: "MAPS"}},
+			},
+		},
+		profiles: map[string][]asc.Resource[asc.ProfileAttributes]{
+			"b-widget": {{ID: "p-1", Attributes: asc.ProfileAttributes{Name: "Widget Dev", ProfileType: "IOS_APP_DEVELOPMENT"}}},
+		},
+	}
+}
+
+func driftSummaries(drift []CapabilityDrift) []string {
+	result := make([]string, 0, len(drift))
+	for _, item := range drift {
+		result = append(result, fmt.Sprintf("%s %s %s", item.Identifier, item.Capability, item.Status))
+	}
+	return result
+}
+
+func TestDiffBundlesAcrossSet(t *testing.T) {
+	stub := newCapabilityTemplateStub()
+	bundles, err := loadCapabilityBundles(context.Background(), stub, nil, []string{"com.example.app", "com.example.app.widget"})
+	if err != nil {
+		t.Fatalf("loadCapabilityBundles() error: %v", err)
+	}
+
+	got := driftSummaries(diffBundlesAcrossSet(bundles))
+	want := []string{
+		"com.example.app MAPS missing",
+		"com.example.app.widget ICLOUD settings",
+		"com.example.app.widget PUSH_NOTIFICATIONS missing",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected drift:\n got %q\nwant %q", got, want)
+	}
+}
+
+func TestApplyCapabilityTemplate(t *testing.T) {
+	stub := newCapabilityTemplateStub()
+	path := filepath.Join(t.TempDir(), "caps.json")
+	content := `{"capabilities":[{"capabilityType":"push_notifications"},{"capabilityType":"ICLOUD","settings":[{"key":"ICLOUD_VERSION","options":[{"key":"XCODE_6","enabled":true}]}]}]}`
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("write template: %v", err)
+	}
+	template, err := readCapabilityTemplate(path)
+	if err != nil {
+		t.Fatalf("readCapabilityTemplate() error: %v", err)
+	}
+
+	bundles, err := loadCapabilityBundles(context.Background(), stub, []string{"b-app", "b-widget"}, nil)
+	if err != nil {
+		t.Fatalf("loadCapabilityBundles() error: %v", err)
+	}
+
+	withoutPrune := driftSummaries(diffBundlesAgainstTemplate(template, bundles, false))
+	wantWithoutPrune := []string{
+		"com.example.app.widget PUSH_NOTIFICATIONS missing",
+		"com.example.app.widget ICLOUD settings",
+	}
+	if !reflect.DeepEqual(withoutPrune, wantWithoutPrune) {
+		t.Fatalf("unexpected changes:\n got %q\nwant %q", withoutPrune, wantWithoutPrune)
+	}
+
+	changes := diffBundlesAgainstTemplate(template, bundles, true)
+	if _, err := applyCapabilityChanges(context.Background(), stub, changes); err != nil {
+		t.Fatalf("applyCapabilityChanges() error: %v", err)
+	}
+	regenerated, err := regenerateChangedProfiles(context.Background(), stub, bundles, changes)
+	if err != nil {
+		t.Fatalf("regenerateChangedProfiles() error: %v", err)
+	}
+
+	wantCalls := []string{
+		"create b-widget PUSH_NOTIFICATIONS",
+		"update b-widget_ICLOUD",
+		"delete b-widget_MAPS",
+		"delete profile p-1",
+		"create profile Widget Dev b-widget [cert-1] [dev-1 dev-2]",
+	}
+	if !reflect.DeepEqual(stub.calls, wantCalls) {
+		t.Fatalf("unexpected calls:\n got %q\nwant %q", stub.calls, wantCalls)
+	}
+	if len(regenerated) != 1 || regenerated[0].OldID != "p-1" || regenerated[0].NewID != "new-Widget Dev" {
+		t.Fatalf("unexpected regenerated profiles: %+v", regenerated)
+	}
+}
+
+func TestApplyCapabilityChanges_ReturnsAppliedSubsetOnFailure(t *testing.T) {
+	stub := newCapabilityTemplateStub()
+	stub.deleteErr = errors.New("forbidden")
+	changes := []CapabilityDrift{
+		{BundleID: "b-widget", Identifier: "com.example.app.widget", Capability: "PUSH_NOTIFICATIONS", Status: capabilityDriftMissing},
+		{BundleID: "b-widget", Identifier: "com.example.app.widget", Capability: "MAPS", Status: capabilityDriftExtra, CapabilityID: "b-widget_MAPS"},
+		{BundleID: "b-widget", Identifier: "com.example.app.widget", Capability: "ICLOUD", Status: capabilityDriftSettings, CapabilityID: "b-widget_ICLOUD"},
+	}
+
+	applied, err := applyCapabilityChanges(context.Background(), stub, changes)
+	if err == nil || err.Error() != "extra MAPS on com.example.app.widget: forbidden" {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if len(applied) != 1 || applied[0].Capability != "PUSH_NOTIFICATIONS" {
+		t.Fatalf("expected only the created capability to be reported, got %+v", applied)
+	}
+}
+
+func TestRegenerateChangedProfiles_ReportsDeletedProfileOnCreateFailure(t *testing.T) {
+	stub := newCapabilityTemplateStub()
+	stub.createErr = errors.New("name already in use")
+	bundles, err := loadCapabilityBundles(context.Background(), stub, []string{"b-widget"}, nil)
+	if err != nil {
+		t.Fatalf("loadCapabilityBundles() error: %v", err)
+	}
+
+	changes := []CapabilityDrift{{BundleID: "b-widget", Identifier: "com.example.app.widget", Capability: "MAPS", Status: capabilityDriftExtra}}
+	_, err = regenerateChangedProfiles(context.Background(), stub, bundles, changes)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	want := "recreate profile Widget Dev (deleted p-1; type IOS_APP_DEVELOPMENT, bundle ID b-widget, certificates [cert-1], devices [dev-1,dev-2]): name already in use"
+	if err.Error() != want {
+		t.Fatalf("error = %q, want %q", err.Error(), want)
+	}
+}
+
+func TestFormatCapabilitySettings_IgnoresOrderAndDisabledOptions(t *testing.T) {
+	a := []asc.CapabilitySetting{{Key: "DATA_PROTECTION_PERMISSION_LEVEL", Options: []asc.CapabilityOption{
+		{Key: "COMPLETE_PROTECTION", Enabled: boolPtr(true)},
+		{Key: "PROTECTED_UNLESS_OPEN", Enabled: boolPtr(false)},
+	}}}
+	b := []asc.CapabilitySetting{{Key: "data_protection_permission_level", Options: []asc.CapabilityOption{
+		{Key: "complete_protection"},
+	}}}
+	if formatCapabilitySettings(a) != formatCapabilitySettings(b) {
+		t.Fatalf("expected equal settings, got %q vs %q", formatCapabilitySettings(a), formatCapabilitySettings(b))
+	}
+}
+
+func TestBundleIDsCapabilitiesDiffCommand_RequiresTwoBundles(t *testing.T) {
+	cmd := BundleIDsCapabilitiesDiffCommand()
+	if err := cmd.FlagSet.Parse([]string{"--bundle-id", "com.example.app"}); err != nil {
+		t.Fatalf("failed to parse flags: %v", err)
+	}
+	if err := cmd.Exec(context.Background(), []string{}); !errors.Is(err, flag.ErrHelp) {
+		t.Fatalf("expected flag.ErrHelp with a single bundle ID, got %v", err)
+	}
+}
+
+func TestBundleIDsCapabilitiesApplyCommand_PruneRequiresConfirm(t *testing.T) {
+	cmd := BundleIDsCapabilitiesApplyCommand()
+	if err := cmd.FlagSet.Parse([]string{"--template", "caps.json", "--bundle", "b-app", "--prune"}); err != nil {
+		t.Fatalf("failed to parse flags: %v", err)
+	}
+	if err := cmd.Exec(context.Background(), []string{}); !errors.Is(err, flag.ErrHelp) {
+		t.Fatalf("expected flag.ErrHelp when --confirm is missing, got %v", err)
+	}
+}
@@ -0,0 +1,314 @@
+package catalog
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+	"gopkg.in/yaml.v3"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/validation"
+)
+
+// CatalogFile is the on-disk in-app purchase and subscription catalog.
+type CatalogFile struct {
+	App                string                     `yaml:"app,omitempty" json:"app,omitempty"`
+	InAppPurchases     []CatalogIAP               `yaml:"inAppPurchases,omitempty" json:"inAppPurchases,omitempty"`
+	SubscriptionGroups []CatalogSubscriptionGroup `yaml:"subscriptionGroups,omitempty" json:"subscriptionGroups,omitempty"`
+}
+
+// CatalogLocalization is a localized display name and description.
+type CatalogLocalization struct {
+	Locale        string `yaml:"locale" json:"locale"`
+	Name          string `yaml:"name" json:"name"`
+	Description   string `yaml:"description,omitempty" json:"description,omitempty"`
+	CustomAppName string `yaml:"customAppName,omitempty" json:"customAppName,omitempty"`
+}
+
+// CatalogIAP declares one in-app purchase.
+type CatalogIAP struct {
+	ProductID      string                `yaml:"productId" json:"productId"`
+	Name           string                `yaml:"name" json:"name"`
+	Type           string                `yaml:"type" json:"type"`
+	FamilySharable *bool                 `yaml:"familySharable,omitempty" json:"familySharable,omitempty"`
+	ReviewNote     string                `yaml:"reviewNote,omitempty" json:"reviewNote,omitempty"`
+	BaseTerritory  string                `yaml:"baseTerritory,omitempty" json:"baseTerritory,omitempty"`
+	Localizations  []CatalogLocalization `yaml:"localizations,omitempty" json:"localizations,omitempty"`
+	Prices         map[string]string     `yaml:"prices,omitempty" json:"prices,omitempty"`
+}
+
+// CatalogSubscriptionGroup declares a subscription group and its subscriptions.
+type CatalogSubscriptionGroup struct {
+	ReferenceName string                `yaml:"referenceName" json:"referenceName"`
+	Localizations []CatalogLocalization `yaml:"localizations,omitempty" json:"localizations,omitempty"`
+	Subscriptions []CatalogSubscription `yaml:"subscriptions,omitempty" json:"subscriptions,omitempty"`
+}
+
+// CatalogSubscription declares one auto-renewable subscription.
+type CatalogSubscription struct {
+	ProductID          string                    `yaml:"productId" json:"productId"`
+	Name               string                    `yaml:"name" json:"name"`
+	Period             string                    `yaml:"period" json:"period"`
+	GroupLevel         int                       `yaml:"groupLevel,omitempty" json:"groupLevel,omitempty"`
+	FamilySharable     *bool                     `yaml:"familySharable,omitempty" json:"familySharable,omitempty"`
+	ReviewNote         string                    `yaml:"reviewNote,omitempty" json:"reviewNote,omitempty"`
+	Localizations      []CatalogLocalization     `yaml:"localizations,omitempty" json:"localizations,omitempty"`
+	Prices             map[string]string         `yaml:"prices,omitempty" json:"prices,omitempty"`
+	IntroductoryOffers []CatalogOffer            `yaml:"introductoryOffers,omitempty" json:"introductoryOffers,omitempty"`
+	PromotionalOffers  []CatalogPromotionalOffer `yaml:"promotionalOffers,omitempty" json:"promotionalOffers,omitempty"`
+}
+
+// CatalogOffer declares an introductory offer for one territory.
+type CatalogOffer struct {
+	Territory       string `yaml:"territory" json:"territory"`
+	Duration        string `yaml:"duration" json:"duration"`
+	OfferMode       string `yaml:"offerMode" json:"offerMode"`
+	NumberOfPeriods int    `yaml:"numberOfPeriods" json:"numberOfPeriods"`
+	Price           string `yaml:"price,omitempty" json:"price,omitempty"`
+}
+
+// CatalogPromotionalOffer declares a promotional offer, matched by offer code.
+// Free trials list their territories; paid offers set a price per territory.
+type CatalogPromotionalOffer struct {
+	OfferCode       string            `yaml:"offerCode" json:"offerCode"`
+	Name            string            `yaml:"name" json:"name"`
+	Duration        string            `yaml:"duration" json:"duration"`
+	OfferMode       string            `yaml:"offerMode" json:"offerMode"`
+	NumberOfPeriods int               `yaml:"numberOfPeriods" json:"numberOfPeriods"`
+	Territories     []string          `yaml:"territories,omitempty" json:"territories,omitempty"`
+	Prices          map[string]string `yaml:"prices,omitempty" json:"prices,omitempty"`
+}
+
+// CatalogCommand returns the catalog command group.
+func CatalogCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("catalog", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "catalog",
+		ShortUsage: "asc catalog <subcommand> [flags]",
+		ShortHelp:  "Manage in-app purchases and subscriptions as code.",
+		LongHelp: `Manage in-app purchases and subscriptions from a single catalog file.
+
+The catalog (YAML or JSON) declares subscription groups, subscriptions,
+in-app purchases, localizations, per-territory prices, introductory and
+promotional offers, and review notes. Every command validates the file
+offline first.
+
+Examples:
+  asc catalog validate --file "./catalog.yaml"
+  asc catalog plan --app "APP_ID" --file "./catalog.yaml"
+  asc catalog apply --app "APP_ID" --file "./catalog.yaml"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			CatalogValidateCommand(),
+			CatalogPlanCommand(),
+			CatalogApplyCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// CatalogValidateCommand returns the offline catalog validate subcommand.
+func CatalogValidateCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("validate", flag.ExitOnError)
+
+	file := fs.String("file", "", "Path to catalog YAML or JSON file (required)")
+	strict := fs.Bool("strict", false, "Treat warnings as errors (exit non-zero)")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "validate",
+		ShortUsage: "asc catalog validate --file \"./catalog.yaml\" [flags]",
+		ShortHelp:  "Validate a catalog file offline.",
+		LongHelp: `Validate a catalog file without calling App Store Connect.
+
+Checks product IDs, types, subscription periods, localization lengths,
+territory prices, and introductory and promotional offers.
+
+Examples:
+  asc catalog validate --file "./catalog.yaml"
+  asc catalog validate --file "./catalog.yaml" --strict --output table`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			fileValue := strings.TrimSpace(*file)
+			if fileValue == "" {
+				return shared.UsageError("--file is required")
+			}
+
+			catalog, err := readCatalogFile(fileValue)
+			if err != nil {
+				return fmt.Errorf("catalog validate: %w", err)
+			}
+
+			report := validation.ValidateCatalog(catalogValidationInput(catalog), *strict)
+			if err := shared.PrintOutput(&report, *output.Output, *output.Pretty); err != nil {
+				return err
+			}
+			if report.Summary.Blocking > 0 {
+				return shared.NewReportedError(fmt.Errorf("catalog validate: found %d blocking issue(s)", report.Summary.Blocking))
+			}
+			return nil
+		},
+	}
+}
+
+func readCatalogFile(path string) (*CatalogFile, error) {
+	data, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, fmt.Errorf("read catalog: %w", err)
+	}
+
+	var catalog CatalogFile
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&catalog); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("catalog %s is empty", path)
+		}
+		return nil, fmt.Errorf("parse catalog: %w", err)
+	}
+	if len(catalog.InAppPurchases) == 0 && len(catalog.SubscriptionGroups) == 0 {
+		return nil, fmt.Errorf("catalog %s declares no in-app purchases or subscription groups", path)
+	}
+
+	normalizeCatalog(&catalog)
+	return &catalog, nil
+}
+
+func normalizeCatalog(catalog *CatalogFile) {
+	catalog.App = strings.TrimSpace(catalog.App)
+	for i := range catalog.InAppPurchases {
+		iap := &catalog.InAppPurchases[i]
+		iap.ProductID = strings.TrimSpace(iap.ProductID)
+		iap.Name = strings.TrimSpace(iap.Name)
+		iap.Type = strings.ToUpper(strings.TrimSpace(iap.Type))
+		iap.BaseTerritory = strings.ToUpper(strings.TrimSpace(iap.BaseTerritory))
+		iap.Prices = normalizeCatalogPrices(iap.Prices)
+		normalizeCatalogLocalizations(iap.Localizations)
+	}
+	for i := range catalog.SubscriptionGroups {
+		group := &catalog.SubscriptionGroups[i]
+		group.ReferenceName = strings.TrimSpace(group.ReferenceName)
+		normalizeCatalogLocalizations(group.Localizations)
+		for j := range group.Subscriptions {
+			sub := &group.Subscriptions[j]
+			sub.ProductID = strings.TrimSpace(sub.ProductID)
+			sub.Name = strings.TrimSpace(sub.Name)
+			sub.Period = strings.ToUpper(strings.TrimSpace(sub.Period))
+			sub.Prices = normalizeCatalogPrices(sub.Prices)
+			normalizeCatalogLocalizations(sub.Localizations)
+			for k := range sub.IntroductoryOffers {
+				offer := &sub.IntroductoryOffers[k]
+				offer.Territory = strings.ToUpper(strings.TrimSpace(offer.Territory))
+				offer.Duration = strings.ToUpper(strings.TrimSpace(offer.Duration))
+				offer.OfferMode = strings.ToUpper(strings.TrimSpace(offer.OfferMode))
+				offer.Price = strings.TrimSpace(offer.Price)
+			}
+			for k := range sub.PromotionalOffers {
+				offer := &sub.PromotionalOffers[k]
+				offer.OfferCode = strings.TrimSpace(offer.OfferCode)
+				offer.Name = strings.TrimSpace(offer.Name)
+				offer.Duration = strings.ToUpper(strings.TrimSpace(offer.Duration))
+				offer.OfferMode = strings.ToUpper(strings.TrimSpace(offer.OfferMode))
+				for t := range offer.Territories {
+					offer.Territories[t] = strings.ToUpper(strings.TrimSpace(offer.Territories[t]))
+				}
+				offer.Prices = normalizeCatalogPrices(offer.Prices)
+			}
+		}
+	}
+}
+
+func normalizeCatalogLocalizations(localizations []CatalogLocalization) {
+	for i := range localizations {
+		localizations[i].Locale = strings.TrimSpace(localizations[i].Locale)
+		localizations[i].Name = strings.TrimSpace(localizations[i].Name)
+		localizations[i].Description = strings.TrimSpace(localizations[i].Description)
+		localizations[i].CustomAppName = strings.TrimSpace(localizations[i].CustomAppName)
+	}
+}
+
+func normalizeCatalogPrices(prices map[string]string) map[string]string {
+	if len(prices) == 0 {
+		return nil
+	}
+	normalized := make(map[string]string, len(prices))
+	for territory, price := range prices {
+		normalized[strings.ToUpper(strings.TrimSpace(territory))] = strings.TrimSpace(price)
+	}
+	return normalized
+}
+
+func catalogValidationInput(catalog *CatalogFile) validation.CatalogInput {
+	input := validation.CatalogInput{AppID: catalog.App}
+	for _, iap := range catalog.InAppPurchases {
+		input.IAPs = append(input.IAPs, validation.CatalogIAP{
+			ProductID:     iap.ProductID,
+			Name:          iap.Name,
+			Type:          iap.Type,
+			ReviewNote:    iap.ReviewNote,
+			BaseTerritory: iap.BaseTerritory,
+			Localizations: validationLocalizations(iap.Localizations),
+			Prices:        iap.Prices,
+		})
+	}
+	for _, group := range catalog.SubscriptionGroups {
+		validationGroup := validation.CatalogSubscriptionGroup{
+			ReferenceName: group.ReferenceName,
+			Localizations: validationLocalizations(group.Localizations),
+		}
+		for _, sub := range group.Subscriptions {
+			offers := make([]validation.CatalogOffer, 0, len(sub.IntroductoryOffers))
+			for _, offer := range sub.IntroductoryOffers {
+				offers = append(offers, validation.CatalogOffer(offer))
+			}
+			promoOffers := make([]validation.CatalogPromotionalOffer, 0, len(sub.PromotionalOffers))
+			for _, offer := range sub.PromotionalOffers {
+				promoOffers = append(promoOffers, validation.CatalogPromotionalOffer(offer))
+			}
+			validationGroup.Subscriptions = append(validationGroup.Subscriptions, validation.CatalogSubscription{
+				ProductID:          sub.ProductID,
+				Name:               sub.Name,
+				Period:             sub.Period,
+				GroupLevel:         sub.GroupLevel,
+				ReviewNote:         sub.ReviewNote,
+				Localizations:      validationLocalizations(sub.Localizations),
+				Prices:             sub.Prices,
+				IntroductoryOffers: offers,
+				PromotionalOffers:  promoOffers,
+			})
+		}
+		input.SubscriptionGroups = append(input.SubscriptionGroups, validationGroup)
+	}
+	return input
+}
+
+func validationLocalizations(localizations []CatalogLocalization) []validation.CatalogLocalization {
+	result := make([]validation.CatalogLocalization, 0, len(localizations))
+	for _, loc := range localizations {
+		result = append(result, validation.CatalogLocalization(loc))
+	}
+	return result
+}
+
+func sortedTerritories(prices map[string]string) []string {
+	territories := make([]string, 0, len(prices))
+	for territory := range prices {
+		territories = append(territories, territory)
+	}
+	sort.Strings(territories)
+	return territories
+}
@@ -0,0 +1,454 @@
+package catalog
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// CatalogApplyCommand returns the catalog apply subcommand.
+func CatalogApplyCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("apply", flag.ExitOnError)
+
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (or ASC_APP_ID env; defaults to app in the file)")
+	file := fs.String("file", "", "Path to catalog YAML or JSON file (required)")
+	strict := fs.Bool("strict", false, "Treat validation warnings as errors")
+	confirm := fs.Bool("confirm", false, "Confirm replacing existing offers and price schedules")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "apply",
+		ShortUsage: "asc catalog apply --file \"./catalog.yaml\" [flags]",
+		ShortHelp:  "Apply a catalog file to App Store Connect.",
+		LongHelp: `Apply a catalog file to App Store Connect.
+
+Computes the same plan as "asc catalog plan" and executes it in dependency
+order: subscription groups, products, localizations, prices, then
+introductory and promotional offers. Running apply again with an unchanged
+file is a no-op.
+
+A plan that only creates or updates resources runs as is. A plan with
+replace steps (an offer is deleted and recreated, or an in-app purchase
+price schedule is overwritten) requires --confirm.
+
+Examples:
+  asc catalog apply --app "APP_ID" --file "./catalog.yaml"
+  asc catalog apply --app "APP_ID" --file "./catalog.yaml" --confirm
+  asc catalog apply --app "APP_ID" --file "./catalog.json" --strict
+
+Notes:
+  - groups are matched by reference name; products by product ID.
+  - products, locales and offers missing from the file are never deleted.
+  - in-app purchase prices replace the manual price schedule with the
+    declared territories; other territories follow the base territory.
+  - an introductory offer whose terms changed is deleted and recreated.
+  - promotional offers are matched by offer code. One whose name, mode,
+    duration or periods changed is deleted and recreated; a price change
+    updates it in place and sets exactly the declared territories.`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			return runCatalog(ctx, "catalog apply", *appID, *file, *strict, true, *confirm, *output.Output, *output.Pretty)
+		},
+	}
+}
+
+// countCatalogReplacements returns the number of steps that overwrite or
+// delete existing App Store Connect state.
+func countCatalogReplacements(changes []CatalogChange) int {
+	count := 0
+	for _, change := range changes {
+		if change.Action == catalogActionReplace {
+			count++
+		}
+	}
+	return count
+}
+
+// applyCatalogPlan executes the plan in order, resolving IDs for resources
+// created by earlier steps. It returns the changes applied so far.
+func applyCatalogPlan(ctx context.Context, client catalogClient, appID string, state *catalogRemoteState, changes []CatalogChange) ([]CatalogChange, error) {
+	applied := make([]CatalogChange, 0, len(changes))
+	for _, change := range changes {
+		if err := applyCatalogChange(ctx, client, appID, state, &change); err != nil {
+			return applied, fmt.Errorf("%s %s %s: %w", change.Action, change.Resource, catalogChangeLabel(change), err)
+		}
+		applied = append(applied, change)
+	}
+	return applied, nil
+}
+
+func applyCatalogChange(ctx context.Context, client catalogClient, appID string, state *catalogRemoteState, change *CatalogChange) error {
+	switch change.Resource {
+	case catalogResourceGroup:
+		resp, err := client.CreateSubscriptionGroup(ctx, appID, asc.SubscriptionGroupCreateAttributes{ReferenceName: change.group})
+		if err != nil {
+			return err
+		}
+		change.ID = resp.Data.ID
+		state.groupIDs[strings.ToLower(change.group)] = resp.Data.ID
+
+	case catalogResourceGroupLocalization:
+		if change.Action == catalogActionUpdate {
+			_, err := client.UpdateSubscriptionGroupLocalization(ctx, change.ID, asc.SubscriptionGroupLocalizationUpdateAttributes{
+				Name:          &change.localization.Name,
+				CustomAppName: &change.localization.CustomAppName,
+			})
+			return err
+		}
+		groupID, err := requireCatalogID(state.groupIDs, strings.ToLower(change.group))
+		if err != nil {
+			return err
+		}
+		resp, err := client.CreateSubscriptionGroupLocalization(ctx, groupID, asc.SubscriptionGroupLocalizationCreateAttributes{
+			Name:          change.localization.Name,
+			CustomAppName: change.localization.CustomAppName,
+			Locale:        change.localization.Locale,
+		})
+		if err != nil {
+			return err
+		}
+		change.ID = resp.Data.ID
+
+	case catalogResourceIAP:
+		if change.Action == catalogActionUpdate {
+			_, err := client.UpdateInAppPurchaseV2(ctx, change.ID, *change.iapUpdate)
+			return err
+		}
+		attrs := asc.InAppPurchaseV2CreateAttributes{
+			Name:              change.iap.Name,
+			ProductID:         change.iap.ProductID,
+			InAppPurchaseType: change.iap.Type,
+			ReviewNote:        change.iap.ReviewNote,
+		}
+		if change.iap.FamilySharable != nil {
+			attrs.FamilySharable = *change.iap.FamilySharable
+		}
+		resp, err := client.CreateInAppPurchaseV2(ctx, appID, attrs)
+		if err != nil {
+			return err
+		}
+		change.ID = resp.Data.ID
+		state.productIDs[change.Target] = resp.Data.ID
+
+	case catalogResourceSubscription:
+		if change.Action == catalogActionUpdate {
+			_, err := client.UpdateSubscription(ctx, change.ID, *change.subUpdate)
+			return err
+		}
+		groupID, err := requireCatalogID(state.groupIDs, strings.ToLower(change.group))
+		if err != nil {
+			return err
+		}
+		attrs := asc.SubscriptionCreateAttributes{
+			Name:               change.sub.Name,
+			ProductID:          change.sub.ProductID,
+			FamilySharable:     change.sub.FamilySharable,
+			SubscriptionPeriod: change.sub.Period,
+			ReviewNote:         change.sub.ReviewNote,
+		}
+		if change.sub.GroupLevel > 0 {
+			attrs.GroupLevel = &change.sub.GroupLevel
+		}
+		resp, err := client.CreateSubscription(ctx, groupID, attrs)
+		if err != nil {
+			return err
+		}
+		change.ID = resp.Data.ID
+		state.productIDs[change.Target] = resp.Data.ID
+
+	case catalogResourceIAPLocalization:
+		if change.Action == catalogActionUpdate {
+			_, err := client.UpdateInAppPurchaseLocalization(ctx, change.ID, asc.InAppPurchaseLocalizationUpdateAttributes{
+				Name:        &change.localization.Name,
+				Description: &change.localization.Description,
+			})
+			return err
+		}
+		iapID, err := requireCatalogID(state.productIDs, change.Target)
+		if err != nil {
+			return err
+		}
+		resp, err := client.CreateInAppPurchaseLocalization(ctx, iapID, asc.InAppPurchaseLocalizationCreateAttributes{
+			Name:        change.localization.Name,
+			Locale:      change.localization.Locale,
+			Description: change.localization.Description,
+		})
+		if err != nil {
+			return err
+		}
+		change.ID = resp.Data.ID
+
+	case catalogResourceSubscriptionLocalization:
+		if change.Action == catalogActionUpdate {
+			_, err := client.UpdateSubscriptionLocalization(ctx, change.ID, asc.SubscriptionLocalizationUpdateAttributes{
+				Name:        &change.localization.Name,
+				Description: &change.localization.Description,
+			})
+			return err
+		}
+		subID, err := requireCatalogID(state.productIDs, change.Target)
+		if err != nil {
+			return err
+		}
+		resp, err := client.CreateSubscriptionLocalization(ctx, subID, asc.SubscriptionLocalizationCreateAttributes{
+			Name:        change.localization.Name,
+			Locale:      change.localization.Locale,
+			Description: change.localization.Description,
+		})
+		if err != nil {
+			return err
+		}
+		change.ID = resp.Data.ID
+
+	case catalogResourceIAPPriceSchedule:
+		iapID, err := requireCatalogID(state.productIDs, change.Target)
+		if err != nil {
+			return err
+		}
+		attrs := asc.InAppPurchasePriceScheduleCreateAttributes{BaseTerritoryID: change.Territory}
+		for _, territory := range sortedTerritories(change.iap.Prices) {
+			pricePointID, err := resolveCatalogIAPPricePoint(ctx, client, iapID, territory, change.iap.Prices[territory])
+			if err != nil {
+				return err
+			}
+			attrs.Prices = append(attrs.Prices, asc.InAppPurchasePriceSchedulePrice{PricePointID: pricePointID})
+		}
+		resp, err := client.CreateInAppPurchasePriceSchedule(ctx, iapID, attrs)
+		if err != nil {
+			return err
+		}
+		change.ID = resp.Data.ID
+
+	case catalogResourceSubscriptionPrice:
+		subID, err := requireCatalogID(state.productIDs, change.Target)
+		if err != nil {
+			return err
+		}
+		pricePointID, err := resolveCatalogSubscriptionPricePoint(ctx, client, subID, change.Territory, change.price)
+		if err != nil {
+			return err
+		}
+		resp, err := client.CreateSubscriptionPrice(ctx, subID, pricePointID, change.Territory, asc.SubscriptionPriceCreateAttributes{})
+		if err != nil {
+			return err
+		}
+		change.ID = resp.Data.ID
+
+	case catalogResourceIntroductoryOffer:
+		subID, err := requireCatalogID(state.productIDs, change.Target)
+		if err != nil {
+			return err
+		}
+		pricePointID := ""
+		if change.offer.Price != "" {
+			pricePointID, err = resolveCatalogSubscriptionPricePoint(ctx, client, subID, change.Territory, change.offer.Price)
+			if err != nil {
+				return err
+			}
+		}
+		// A territory can only have one introductory offer, so the existing
+		// one must be deleted before its replacement can be created.
+		if change.Action == catalogActionReplace {
+			if err := client.DeleteSubscriptionIntroductoryOffer(ctx, change.ID); err != nil {
+				return fmt.Errorf("delete existing offer: %w", err)
+			}
+		}
+		resp, err := client.CreateSubscriptionIntroductoryOffer(ctx, subID, asc.SubscriptionIntroductoryOfferCreateAttributes{
+			Duration:        asc.SubscriptionOfferDuration(change.offer.Duration),
+			OfferMode:       asc.SubscriptionOfferMode(change.offer.OfferMode),
+			NumberOfPeriods: change.offer.NumberOfPeriods,
+		}, change.Territory, pricePointID)
+		if err != nil {
+			if change.Action == catalogActionReplace {
+				return fmt.Errorf("create replacement offer (deleted offer %s was %s): %w", change.ID, formatCatalogOffer(change.replaced), err)
+			}
+			return err
+		}
+		change.ID = resp.Data.ID
+
+	case catalogResourcePromotionalOffer:
+		subID, err := requireCatalogID(state.productIDs, change.Target)
+		if err != nil {
+			return err
+		}
+		prices, err := resolveCatalogPromotionalOfferPrices(ctx, client, subID, change.promoOffer)
+		if err != nil {
+			return err
+		}
+		if change.Action == catalogActionUpdate {
+			_, err := client.UpdateSubscriptionPromotionalOfferPrices(ctx, change.ID, prices)
+			return err
+		}
+		// Offer codes are unique per subscription and offer terms cannot be
+		// edited, so the existing offer is deleted before it is recreated.
+		if change.Action == catalogActionReplace {
+			if err := client.DeleteSubscriptionPromotionalOffer(ctx, change.ID); err != nil {
+				return fmt.Errorf("delete existing offer: %w", err)
+			}
+		}
+		resp, err := client.CreateSubscriptionPromotionalOfferWithPrices(ctx, subID, asc.SubscriptionPromotionalOfferCreateAttributes{
+			Duration:        asc.SubscriptionOfferDuration(change.promoOffer.Duration),
+			Name:            change.promoOffer.Name,
+			NumberOfPeriods: change.promoOffer.NumberOfPeriods,
+			OfferCode:       change.promoOffer.OfferCode,
+			OfferMode:       asc.SubscriptionOfferMode(change.promoOffer.OfferMode),
+		}, prices)
+		if err != nil {
+			if change.Action == catalogActionReplace {
+				return fmt.Errorf("create replacement offer (deleted offer %s was %s): %w", change.ID, formatCatalogPromotionalOffer(change.replacedPromo), err)
+			}
+			return err
+		}
+		change.ID = resp.Data.ID
+
+	default:
+		return fmt.Errorf("unsupported catalog resource %q", change.Resource)
+	}
+	return nil
+}
+
+func requireCatalogID(ids map[string]string, key string) (string, error) {
+	id := strings.TrimSpace(ids[key])
+	if id == "" {
+		return "", fmt.Errorf("no App Store Connect ID for %q", key)
+	}
+	return id, nil
+}
+
+// resolveCatalogPromotionalOfferPrices resolves a price point per declared
+// territory. Free trial territories carry no price point.
+func resolveCatalogPromotionalOfferPrices(ctx context.Context, client catalogClient, subID string, offer CatalogPromotionalOffer) ([]asc.SubscriptionPromotionalOfferPrice, error) {
+	prices := make([]asc.SubscriptionPromotionalOfferPrice, 0, len(offer.Territories)+len(offer.Prices))
+	for _, territory := range offer.Territories {
+		prices = append(prices, asc.SubscriptionPromotionalOfferPrice{TerritoryID: territory})
+	}
+	for _, territory := range sortedTerritories(offer.Prices) {
+		pricePointID, err := resolveCatalogSubscriptionPricePoint(ctx, client, subID, territory, offer.Prices[territory])
+		if err != nil {
+			return nil, err
+		}
+		prices = append(prices, asc.SubscriptionPromotionalOfferPrice{TerritoryID: territory, PricePointID: pricePointID})
+	}
+	return prices, nil
+}
+
+func resolveCatalogIAPPricePoint(ctx context.Context, client catalogClient, iapID, territory, price string) (string, error) {
+	opts := []asc.IAPPricePointsOption{
+		asc.WithIAPPricePointsTerritory(territory),
+		asc.WithIAPPricePointsFields([]string{"customerPrice"}),
+		asc.WithIAPPricePointsLimit(8000),
+	}
+	for {
+		resp, err := client.GetInAppPurchasePricePoints(ctx, iapID, opts...)
+		if err != nil {
+			return "", fmt.Errorf("fetch price points for %s: %w", territory, err)
+		}
+		for _, point := range resp.Data {
+			if catalogPriceEqual(point.Attributes.CustomerPrice, price) {
+				return point.ID, nil
+			}
+		}
+		if strings.TrimSpace(resp.Links.Next) == "" {
+			return "", fmt.Errorf("no price point for %s %s", territory, price)
+		}
+		opts = []asc.IAPPricePointsOption{asc.WithIAPPricePointsNextURL(resp.Links.Next)}
+	}
+}
+
+func resolveCatalogSubscriptionPricePoint(ctx context.Context, client catalogClient, subID, territory, price string) (string, error) {
+	opts := []asc.SubscriptionPricePointsOption{
+		asc.WithSubscriptionPricePointsTerritory(territory),
+		asc.WithSubscriptionPricePointsLimit(200),
+	}
+	for {
+		resp, err := client.GetSubscriptionPricePoints(ctx, subID, opts...)
+		if err != nil {
+			return "", fmt.Errorf("fetch price points for %s: %w", territory, err)
+		}
+		for _, point := range resp.Data {
+			if catalogPriceEqual(point.Attributes.CustomerPrice, price) {
+				return point.ID, nil
+			}
+		}
+		if strings.TrimSpace(resp.Links.Next) == "" {
+			return "", fmt.Errorf("no price point for %s %s", territory, price)
+		}
+		opts = []asc.SubscriptionPricePointsOption{asc.WithSubscriptionPricePointsNextURL(resp.Links.Next)}
+	}
+}
+
+func catalogChangeLabel(change CatalogChange) string {
+	label := change.Target
+	if change.OfferCode != "" {
+		label += " " + change.OfferCode
+	}
+	if change.Locale != "" {
+		label += " " + change.Locale
+	}
+	if change.Territory != "" {
+		label += " " + change.Territory
+	}
+	return label
+}
+
+func catalogPlanRows(result CatalogPlanResult) [][]string {
+	rows := make([][]string, 0, len(result.Changes))
+	for _, change := range result.Changes {
+		target := change.Target
+		if change.OfferCode != "" {
+			target += " " + change.OfferCode
+		}
+		rows = append(rows, []string{
+			change.Action,
+			change.Resource,
+			target,
+			shared.OrNA(change.Locale),
+			shared.OrNA(change.Territory),
+			shared.OrNA(change.ID),
+			change.Detail,
+		})
+	}
+	return rows
+}
+
+func catalogPlanStatus(result CatalogPlanResult) string {
+	switch {
+	case result.NoChanges:
+		return "in sync"
+	case result.Applied:
+		return "applied"
+	default:
+		return "planned"
+	}
+}
+
+func printCatalogPlanTable(result CatalogPlanResult) error {
+	fmt.Printf("File: %s\n", result.File)
+	fmt.Printf("App ID: %s\n", result.AppID)
+	fmt.Printf("Status: %s\n", catalogPlanStatus(result))
+	fmt.Printf("Validation: %d error(s), %d warning(s)\n\n", result.Validation.Summary.Errors, result.Validation.Summary.Warnings)
+	if len(result.Changes) == 0 {
+		return nil
+	}
+	asc.RenderTable([]string{"action", "resource", "target", "locale", "territory", "id", "detail"}, catalogPlanRows(result))
+	return nil
+}
+
+func printCatalogPlanMarkdown(result CatalogPlanResult) error {
+	fmt.Printf("**File:** %s\n\n", result.File)
+	fmt.Printf("**App ID:** %s\n\n", result.AppID)
+	fmt.Printf("**Status:** %s\n\n", catalogPlanStatus(result))
+	fmt.Printf("**Validation:** %d error(s), %d warning(s)\n\n", result.Validation.Summary.Errors, result.Validation.Summary.Warnings)
+	if len(result.Changes) == 0 {
+		return nil
+	}
+	asc.RenderMarkdown([]string{"action", "resource", "target", "locale", "territory", "id", "detail"}, catalogPlanRows(result))
+	return nil
+}
@@ -0,0 +1,1038 @@
+package catalog
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/validation"
+)
+
+const (
+	catalogActionCreate  = "create"
+	catalogActionUpdate  = "update"
+	catalogActionReplace = "replace"
+	catalogActionSet     = "set"
+
+	catalogResourceGroup                    = "subscriptionGroup"
+	catalogResourceGroupLocalization        = "subscriptionGroupLocalization"
+	catalogResourceIAP                      = "inAppPurchase"
+	catalogResourceSubscription             = "subscription"
+	catalogResourceIAPLocalization          = "inAppPurchaseLocalization"
+	catalogResourceSubscriptionLocalization = "subscriptionLocalization"
+	catalogResourceIAPPriceSchedule         = "inAppPurchasePriceSchedule"
+	catalogResourceSubscriptionPrice        = "subscriptionPrice"
+	catalogResourceIntroductoryOffer        = "introductoryOffer"
+	catalogResourcePromotionalOffer         = "promotionalOffer"
+)
+
+// Apply phases, in dependency order.
+const (
+	catalogPhaseGroups = iota
+	catalogPhaseProducts
+	catalogPhaseLocalizations
+	catalogPhasePrices
+	catalogPhaseOffers
+)
+
+// CatalogChange is one step of a catalog plan.
+type CatalogChange struct {
+	Action    string `json:"action"`
+	Resource  string `json:"resource"`
+	Target    string `json:"target"`
+	Locale    string `json:"locale,omitempty"`
+	Territory string `json:"territory,omitempty"`
+	OfferCode string `json:"offerCode,omitempty"`
+	ID        string `json:"id,omitempty"`
+	Detail    string `json:"detail,omitempty"`
+
+	phase         int
+	group         string
+	iap           *CatalogIAP
+	sub           *CatalogSubscription
+	localization  CatalogLocalization
+	offer         CatalogOffer
+	replaced      CatalogOffer
+	promoOffer    CatalogPromotionalOffer
+	replacedPromo CatalogPromotionalOffer
+	price         string
+	iapUpdate     *asc.InAppPurchaseV2UpdateAttributes
+	subUpdate     *asc.SubscriptionUpdateAttributes
+}
+
+// CatalogPlanResult is the output of catalog plan and catalog apply.
+type CatalogPlanResult struct {
+	File       string                   `json:"file"`
+	AppID      string                   `json:"appId"`
+	Applied    bool                     `json:"applied"`
+	NoChanges  bool                     `json:"noChanges"`
+	Validation validation.CatalogReport `json:"validation"`
+	Changes    []CatalogChange          `json:"changes"`
+}
+
+type catalogClient interface {
+	GetInAppPurchasesV2(ctx context.Context, appID string, opts ...asc.IAPOption) (*asc.InAppPurchasesV2Response, error)
+	CreateInAppPurchaseV2(ctx context.Context, appID string, attrs asc.InAppPurchaseV2CreateAttributes) (*asc.InAppPurchaseV2Response, error)
+	UpdateInAppPurchaseV2(ctx context.Context, iapID string, attrs asc.InAppPurchaseV2UpdateAttributes) (*asc.InAppPurchaseV2Response, error)
+	GetInAppPurchaseLocalizations(ctx context.Context, iapID string, opts ...asc.IAPLocalizationsOption) (*asc.InAppPurchaseLocalizationsResponse, error)
+	CreateInAppPurchaseLocalization(ctx context.Context, iapID string, attrs asc.InAppPurchaseLocalizationCreateAttributes) (*asc.InAppPurchaseLocalizationResponse, error)
+	UpdateInAppPurchaseLocalization(ctx context.Context, localizationID string, attrs asc.InAppPurchaseLocalizationUpdateAttributes) (*asc.InAppPurchaseLocalizationResponse, error)
+	GetInAppPurchasePriceSchedule(ctx context.Context, iapID string, opts ...asc.IAPPriceScheduleOption) (*asc.InAppPurchasePriceScheduleResponse, error)
+	GetInAppPurchasePriceScheduleManualPrices(ctx context.Context, scheduleID string, opts ...asc.IAPPriceSchedulePricesOption) (*asc.InAppPurchasePricesResponse, error)
+	GetInAppPurchasePricePoints(ctx context.Context, iapID string, opts ...asc.IAPPricePointsOption) (*asc.InAppPurchasePricePointsResponse, error)
+	CreateInAppPurchasePriceSchedule(ctx context.Context, iapID string, attrs asc.InAppPurchasePriceScheduleCreateAttributes) (*asc.InAppPurchasePriceScheduleResponse, error)
+	GetSubscriptionGroups(ctx context.Context, appID string, opts ...asc.SubscriptionGroupsOption) (*asc.SubscriptionGroupsResponse, error)
+	CreateSubscriptionGroup(ctx context.Context, appID string, attrs asc.SubscriptionGroupCreateAttributes) (*asc.SubscriptionGroupResponse, error)
+	GetSubscriptionGroupLocalizations(ctx context.Context, groupID string, opts ...asc.SubscriptionGroupLocalizationsOption) (*asc.SubscriptionGroupLocalizationsResponse, error)
+	CreateSubscriptionGroupLocalization(ctx context.Context, groupID string, attrs asc.SubscriptionGroupLocalizationCreateAttributes) (*asc.SubscriptionGroupLocalizationResponse, error)
+	UpdateSubscriptionGroupLocalization(ctx context.Context, localizationID string, attrs asc.SubscriptionGroupLocalizationUpdateAttributes) (*asc.SubscriptionGroupLocalizationResponse, error)
+	GetSubscriptions(ctx context.Context, groupID string, opts ...asc.SubscriptionsOption) (*asc.SubscriptionsResponse, error)
+	CreateSubscription(ctx context.Context, groupID string, attrs asc.SubscriptionCreateAttributes) (*asc.SubscriptionResponse, error)
+	UpdateSubscription(ctx context.Context, subID string, attrs asc.SubscriptionUpdateAttributes) (*asc.SubscriptionResponse, error)
+	GetSubscriptionLocalizations(ctx context.Context, subscriptionID string, opts ...asc.SubscriptionLocalizationsOption) (*asc.SubscriptionLocalizationsResponse, error)
+	CreateSubscriptionLocalization(ctx context.Context, subscriptionID string, attrs asc.SubscriptionLocalizationCreateAttributes) (*asc.SubscriptionLocalizationResponse, error)
+	UpdateSubscriptionLocalization(ctx context.Context, localizationID string, attrs asc.SubscriptionLocalizationUpdateAttributes) (*asc.SubscriptionLocalizationResponse, error)
+	GetSubscriptionPrices(ctx context.Context, subscriptionID string, opts ...asc.SubscriptionPricesOption) (*asc.SubscriptionPricesResponse, error)
+	GetSubscriptionPricePoints(ctx context.Context, subscriptionID string, opts ...asc.SubscriptionPricePointsOption) (*asc.SubscriptionPricePointsResponse, error)
+	CreateSubscriptionPrice(ctx context.Context, subID, pricePointID, territoryID string, attrs asc.SubscriptionPriceCreateAttributes) (*asc.SubscriptionPriceResponse, error)
+	GetSubscriptionIntroductoryOffers(ctx context.Context, subscriptionID string, opts ...asc.SubscriptionIntroductoryOffersOption) (*asc.SubscriptionIntroductoryOffersResponse, error)
+	CreateSubscriptionIntroductoryOffer(ctx context.Context, subscriptionID string, attrs asc.SubscriptionIntroductoryOfferCreateAttributes, territoryID, pricePointID string) (*asc.SubscriptionIntroductoryOfferResponse, error)
+	DeleteSubscriptionIntroductoryOffer(ctx context.Context, offerID string) error
+	GetSubscriptionPromotionalOffers(ctx context.Context, subscriptionID string, opts ...asc.SubscriptionPromotionalOffersOption) (*asc.SubscriptionPromotionalOffersResponse, error)
+	GetSubscriptionPromotionalOfferPrices(ctx context.Context, offerID string, opts ...asc.SubscriptionPromotionalOfferPricesOption) (*asc.SubscriptionPromotionalOfferPricesResponse, error)
+	CreateSubscriptionPromotionalOfferWithPrices(ctx context.Context, subscriptionID string, attrs asc.SubscriptionPromotionalOfferCreateAttributes, prices []asc.SubscriptionPromotionalOfferPrice) (*asc.SubscriptionPromotionalOfferResponse, error)
+	UpdateSubscriptionPromotionalOfferPrices(ctx context.Context, offerID string, prices []asc.SubscriptionPromotionalOfferPrice) (*asc.SubscriptionPromotionalOfferResponse, error)
+	DeleteSubscriptionPromotionalOffer(ctx context.Context, offerID string) error
+}
+
+// catalogRemoteState is the App Store Connect state the plan was computed from.
+type catalogRemoteState struct {
+	groupIDs   map[string]string
+	productIDs map[string]string
+	iaps       map[string]asc.Resource[asc.InAppPurchaseV2Attributes]
+	subs       map[string]asc.Resource[asc.SubscriptionAttributes]
+	subGroups  map[string]string
+}
+
+// remoteLocalization is a localization keyed by locale.
+type remoteLocalization struct {
+	id            string
+	name          string
+	description   string
+	customAppName string
+}
+
+// remoteOffer is an introductory offer keyed by territory.
+type remoteOffer struct {
+	id    string
+	attrs asc.SubscriptionIntroductoryOfferAttributes
+	price string
+}
+
+// remotePromotionalOffer is a promotional offer keyed by offer code. Prices
+// map territories to customer prices; free trial territories map to "".
+type remotePromotionalOffer struct {
+	id     string
+	attrs  asc.SubscriptionPromotionalOfferAttributes
+	prices map[string]string
+}
+
+// CatalogPlanCommand returns the catalog plan subcommand.
+func CatalogPlanCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("plan", flag.ExitOnError)
+
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (or ASC_APP_ID env; defaults to app in the file)")
+	file := fs.String("file", "", "Path to catalog YAML or JSON file (required)")
+	strict := fs.Bool("strict", false, "Treat validation warnings as errors")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "plan",
+		ShortUsage: "asc catalog plan --file \"./catalog.yaml\" [flags]",
+		ShortHelp:  "Show the changes needed to match a catalog file.",
+		LongHelp: `Show the changes needed to make App Store Connect match a catalog file.
+
+The file is validated offline first; blocking issues stop the plan before
+any request is made.
+
+Examples:
+  asc catalog plan --app "APP_ID" --file "./catalog.yaml"
+  asc catalog plan --app "APP_ID" --file "./catalog.yaml" --output table`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			return runCatalog(ctx, "catalog plan", *appID, *file, *strict, false, false, *output.Output, *output.Pretty)
+		},
+	}
+}
+
+func runCatalog(ctx context.Context, commandName, appFlag, fileFlag string, strict, apply, confirm bool, format string, pretty bool) error {
+	fileValue := strings.TrimSpace(fileFlag)
+	if fileValue == "" {
+		return shared.UsageError("--file is required")
+	}
+
+	catalog, err := readCatalogFile(fileValue)
+	if err != nil {
+		return fmt.Errorf("%s: %w", commandName, err)
+	}
+
+	result := CatalogPlanResult{
+		File:       filepath.Clean(fileValue),
+		Validation: validation.ValidateCatalog(catalogValidationInput(catalog), strict),
+		Changes:    []CatalogChange{},
+	}
+	if result.Validation.Summary.Blocking > 0 {
+		if err := shared.PrintOutput(&result.Validation, format, pretty); err != nil {
+			return err
+		}
+		return shared.NewReportedError(fmt.Errorf("%s: catalog has %d blocking validation issue(s)", commandName, result.Validation.Summary.Blocking))
+	}
+
+	resolvedAppID := shared.ResolveAppID(appFlag)
+	if resolvedAppID == "" {
+		resolvedAppID = catalog.App
+	}
+	if resolvedAppID == "" {
+		return shared.UsageError("--app is required (or set ASC_APP_ID or app in the file)")
+	}
+	result.AppID = resolvedAppID
+
+	client, err := shared.GetASCClient()
+	if err != nil {
+		return fmt.Errorf("%s: %w", commandName, err)
+	}
+
+	requestCtx, cancel := shared.ContextWithTimeout(ctx)
+	defer cancel()
+
+	changes, state, err := planCatalog(requestCtx, client, resolvedAppID, catalog)
+	if err != nil {
+		return fmt.Errorf("%s: %w", commandName, err)
+	}
+	result.Changes = changes
+	result.NoChanges = len(changes) == 0
+
+	if apply && !confirm {
+		if replacements := countCatalogReplacements(changes); replacements > 0 {
+			return shared.UsageErrorf("--confirm is required to apply a plan that replaces %d existing resource(s) (preview with \"asc catalog plan\")", replacements)
+		}
+	}
+
+	if apply && len(changes) > 0 {
+		applied, applyErr := applyCatalogPlan(requestCtx, client, resolvedAppID, state, changes)
+		result.Changes = applied
+		if applyErr != nil {
+			// Report the steps that already ran before surfacing the failure.
+			if err := printCatalogPlanResult(result, format, pretty); err != nil {
+				return err
+			}
+			return shared.NewReportedError(fmt.Errorf("%s: %w", commandName, applyErr))
+		}
+		result.Applied = true
+	}
+
+	return printCatalogPlanResult(result, format, pretty)
+}
+
+func printCatalogPlanResult(result CatalogPlanResult, format string, pretty bool) error {
+	return shared.PrintOutputWithRenderers(
+		result,
+		format,
+		pretty,
+		func() error { return printCatalogPlanTable(result) },
+		func() error { return printCatalogPlanMarkdown(result) },
+	)
+}
+
+func planCatalog(ctx context.Context, client catalogClient, appID string, catalog *CatalogFile) ([]CatalogChange, *catalogRemoteState, error) {
+	state, err := fetchCatalogRemoteState(ctx, client, appID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var changes []CatalogChange
+	for _, group := range catalog.SubscriptionGroups {
+		groupChanges, err := planCatalogGroup(ctx, client, state, group)
+		if err != nil {
+			return nil, nil, err
+		}
+		changes = append(changes, groupChanges...)
+	}
+	for i := range catalog.InAppPurchases {
+		iapChanges, err := planCatalogIAP(ctx, client, state, &catalog.InAppPurchases[i])
+		if err != nil {
+			return nil, nil, err
+		}
+		changes = append(changes, iapChanges...)
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].phase < changes[j].phase
+	})
+	return changes, state, nil
+}
+
+func fetchCatalogRemoteState(ctx context.Context, client catalogClient, appID string) (*catalogRemoteState, error) {
+	state := &catalogRemoteState{
+		groupIDs:   make(map[string]string),
+		productIDs: make(map[string]string),
+		iaps:       make(map[string]asc.Resource[asc.InAppPurchaseV2Attributes]),
+		subs:       make(map[string]asc.Resource[asc.SubscriptionAttributes]),
+		subGroups:  make(map[string]string),
+	}
+
+	iapsFirst, err := client.GetInAppPurchasesV2(ctx, appID, asc.WithIAPLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("fetch in-app purchases: %w", err)
+	}
+	iapsAll, err := asc.PaginateAll(ctx, iapsFirst, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetInAppPurchasesV2(ctx, appID, asc.WithIAPNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("paginate in-app purchases: %w", err)
+	}
+	iaps, ok := iapsAll.(*asc.InAppPurchasesV2Response)
+	if !ok {
+		return nil, fmt.Errorf("unexpected in-app purchases response type %T", iapsAll)
+	}
+	for _, item := range iaps.Data {
+		productID := strings.TrimSpace(item.Attributes.ProductID)
+		state.iaps[productID] = item
+		state.productIDs[productID] = item.ID
+	}
+
+	groupsFirst, err := client.GetSubscriptionGroups(ctx, appID, asc.WithSubscriptionGroupsLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("fetch subscription groups: %w", err)
+	}
+	groupsAll, err := asc.PaginateAll(ctx, groupsFirst, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetSubscriptionGroups(ctx, appID, asc.WithSubscriptionGroupsNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("paginate subscription groups: %w", err)
+	}
+	groups, ok := groupsAll.(*asc.SubscriptionGroupsResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected subscription groups response type %T", groupsAll)
+	}
+	for _, group := range groups.Data {
+		name := strings.TrimSpace(group.Attributes.ReferenceName)
+		state.groupIDs[strings.ToLower(name)] = group.ID
+
+		subsFirst, err := client.GetSubscriptions(ctx, group.ID, asc.WithSubscriptionsLimit(200))
+		if err != nil {
+			return nil, fmt.Errorf("fetch subscriptions for group %q: %w", name, err)
+		}
+		subsAll, err := asc.PaginateAll(ctx, subsFirst, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+			return client.GetSubscriptions(ctx, group.ID, asc.WithSubscriptionsNextURL(nextURL))
+		})
+		if err != nil {
+			return nil, fmt.Errorf("paginate subscriptions for group %q: %w", name, err)
+		}
+		subs, ok := subsAll.(*asc.SubscriptionsResponse)
+		if !ok {
+			return nil, fmt.Errorf("unexpected subscriptions response type %T", subsAll)
+		}
+		for _, sub := range subs.Data {
+			productID := strings.TrimSpace(sub.Attributes.ProductID)
+			state.subs[productID] = sub
+			state.subGroups[productID] = name
+			state.productIDs[productID] = sub.ID
+		}
+	}
+
+	return state, nil
+}
+
+func planCatalogGroup(ctx context.Context, client catalogClient, state *catalogRemoteState, group CatalogSubscriptionGroup) ([]CatalogChange, error) {
+	var changes []CatalogChange
+	groupID := state.groupIDs[strings.ToLower(group.ReferenceName)]
+
+	if groupID == "" {
+		changes = append(changes, CatalogChange{
+			Action:   catalogActionCreate,
+			Resource: catalogResourceGroup,
+			Target:   group.ReferenceName,
+			phase:    catalogPhaseGroups,
+			group:    group.ReferenceName,
+		})
+	}
+
+	remoteLocs := map[string]remoteLocalization{}
+	if groupID != "" {
+		resp, err := client.GetSubscriptionGroupLocalizations(ctx, groupID, asc.WithSubscriptionGroupLocalizationsLimit(200))
+		if err != nil {
+			return nil, fmt.Errorf("fetch localizations for group %q: %w", group.ReferenceName, err)
+		}
+		for _, item := range resp.Data {
+			remoteLocs[strings.ToLower(item.Attributes.Locale)] = remoteLocalization{
+				id:            item.ID,
+				name:          item.Attributes.Name,
+				customAppName: item.Attributes.CustomAppName,
+			}
+		}
+	}
+	changes = append(changes, planCatalogLocalizations(catalogResourceGroupLocalization, group.ReferenceName, group.ReferenceName, group.Localizations, remoteLocs)...)
+
+	for i := range group.Subscriptions {
+		subChanges, err := planCatalogSubscription(ctx, client, state, group.ReferenceName, &group.Subscriptions[i])
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, subChanges...)
+	}
+	return changes, nil
+}
+
+func planCatalogIAP(ctx context.Context, client catalogClient, state *catalogRemoteState, iap *CatalogIAP) ([]CatalogChange, error) {
+	var changes []CatalogChange
+	remoteLocs := map[string]remoteLocalization{}
+	remotePrices := map[string]string{}
+
+	remote, exists := state.iaps[iap.ProductID]
+	if !exists {
+		changes = append(changes, CatalogChange{
+			Action:   catalogActionCreate,
+			Resource: catalogResourceIAP,
+			Target:   iap.ProductID,
+			Detail:   fmt.Sprintf("%s %q", iap.Type, iap.Name),
+			phase:    catalogPhaseProducts,
+			iap:      iap,
+		})
+	} else {
+		if !strings.EqualFold(remote.Attributes.InAppPurchaseType, iap.Type) {
+			return nil, fmt.Errorf("in-app purchase %s is %s in App Store Connect; type cannot be changed to %s", iap.ProductID, remote.Attributes.InAppPurchaseType, iap.Type)
+		}
+		update, details := diffCatalogIAP(remote.Attributes, iap)
+		if len(details) > 0 {
+			changes = append(changes, CatalogChange{
+				Action:    catalogActionUpdate,
+				Resource:  catalogResourceIAP,
+				Target:    iap.ProductID,
+				ID:        remote.ID,
+				Detail:    strings.Join(details, "; "),
+				phase:     catalogPhaseProducts,
+				iap:       iap,
+				iapUpdate: update,
+			})
+		}
+
+		resp, err := client.GetInAppPurchaseLocalizations(ctx, remote.ID, asc.WithIAPLocalizationsLimit(200))
+		if err != nil {
+			return nil, fmt.Errorf("fetch localizations for %s: %w", iap.ProductID, err)
+		}
+		for _, item := range resp.Data {
+			remoteLocs[strings.ToLower(item.Attributes.Locale)] = remoteLocalization{
+				id:          item.ID,
+				name:        item.Attributes.Name,
+				description: item.Attributes.Description,
+			}
+		}
+
+		remotePrices, err = fetchCatalogIAPPrices(ctx, client, remote.ID)
+		if err != nil {
+			return nil, fmt.Errorf("fetch prices for %s: %w", iap.ProductID, err)
+		}
+	}
+
+	changes = append(changes, planCatalogLocalizations(catalogResourceIAPLocalization, iap.ProductID, "", iap.Localizations, remoteLocs)...)
+
+	if len(iap.Prices) > 0 && !catalogPricesMatch(iap.Prices, remotePrices) {
+		change := CatalogChange{
+			Action:    catalogActionSet,
+			Resource:  catalogResourceIAPPriceSchedule,
+			Target:    iap.ProductID,
+			Territory: catalogBaseTerritory(iap),
+			Detail:    formatCatalogPrices(iap.Prices),
+			phase:     catalogPhasePrices,
+			iap:       iap,
+		}
+		// A new schedule overwrites the existing manual prices.
+		if len(remotePrices) > 0 {
+			change.Action = catalogActionReplace
+			change.Detail = fmt.Sprintf("%s -> %s", formatCatalogPrices(remotePrices), change.Detail)
+		}
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
+
+func planCatalogSubscription(ctx context.Context, client catalogClient, state *catalogRemoteState, groupName string, sub *CatalogSubscription) ([]CatalogChange, error) {
+	var changes []CatalogChange
+	remoteLocs := map[string]remoteLocalization{}
+	remotePrices := map[string]string{}
+	remoteOffers := map[string]remoteOffer{}
+	remotePromoOffers := map[string]remotePromotionalOffer{}
+
+	remote, exists := state.subs[sub.ProductID]
+	if !exists {
+		changes = append(changes, CatalogChange{
+			Action:   catalogActionCreate,
+			Resource: catalogResourceSubscription,
+			Target:   sub.ProductID,
+			Detail:   fmt.Sprintf("%s %q in %q", sub.Period, sub.Name, groupName),
+			phase:    catalogPhaseProducts,
+			group:    groupName,
+			sub:      sub,
+		})
+	} else {
+		if remoteGroup := state.subGroups[sub.ProductID]; !strings.EqualFold(remoteGroup, groupName) {
+			return nil, fmt.Errorf("subscription %s belongs to group %q in App Store Connect; moving it to %q is not supported", sub.ProductID, remoteGroup, groupName)
+		}
+		update, details := diffCatalogSubscription(remote.Attributes, sub)
+		if len(details) > 0 {
+			changes = append(changes, CatalogChange{
+				Action:    catalogActionUpdate,
+				Resource:  catalogResourceSubscription,
+				Target:    sub.ProductID,
+				ID:        remote.ID,
+				Detail:    strings.Join(details, "; "),
+				phase:     catalogPhaseProducts,
+				group:     groupName,
+				sub:       sub,
+				subUpdate: update,
+			})
+		}
+
+		resp, err := client.GetSubscriptionLocalizations(ctx, remote.ID, asc.WithSubscriptionLocalizationsLimit(200))
+		if err != nil {
+			return nil, fmt.Errorf("fetch localizations for %s: %w", sub.ProductID, err)
+		}
+		for _, item := range resp.Data {
+			remoteLocs[strings.ToLower(item.Attributes.Locale)] = remoteLocalization{
+				id:          item.ID,
+				name:        item.Attributes.Name,
+				description: item.Attributes.Description,
+			}
+		}
+
+		remotePrices, err = fetchCatalogSubscriptionPrices(ctx, client, remote.ID)
+		if err != nil {
+			return nil, fmt.Errorf("fetch prices for %s: %w", sub.ProductID, err)
+		}
+		remoteOffers, err = fetchCatalogIntroductoryOffers(ctx, client, remote.ID)
+		if err != nil {
+			return nil, fmt.Errorf("fetch introductory offers for %s: %w", sub.ProductID, err)
+		}
+		if len(sub.PromotionalOffers) > 0 {
+			remotePromoOffers, err = fetchCatalogPromotionalOffers(ctx, client, remote.ID, sub.PromotionalOffers)
+			if err != nil {
+				return nil, fmt.Errorf("fetch promotional offers for %s: %w", sub.ProductID, err)
+			}
+		}
+	}
+
+	changes = append(changes, planCatalogLocalizations(catalogResourceSubscriptionLocalization, sub.ProductID, "", sub.Localizations, remoteLocs)...)
+
+	for _, territory := range sortedTerritories(sub.Prices) {
+		desired := sub.Prices[territory]
+		current, ok := remotePrices[territory]
+		if ok && catalogPriceEqual(desired, current) {
+			continue
+		}
+		detail := desired
+		if ok {
+			detail = fmt.Sprintf("%s -> %s", current, desired)
+		}
+		changes = append(changes, CatalogChange{
+			Action:    catalogActionSet,
+			Resource:  catalogResourceSubscriptionPrice,
+			Target:    sub.ProductID,
+			Territory: territory,
+			Detail:    detail,
+			phase:     catalogPhasePrices,
+			sub:       sub,
+			price:     desired,
+		})
+	}
+
+	for _, offer := range sub.IntroductoryOffers {
+		detail := formatCatalogOffer(offer)
+		current, ok := remoteOffers[offer.Territory]
+		if ok && catalogOfferMatches(offer, current) {
+			continue
+		}
+		change := CatalogChange{
+			Action:    catalogActionCreate,
+			Resource:  catalogResourceIntroductoryOffer,
+			Target:    sub.ProductID,
+			Territory: offer.Territory,
+			Detail:    detail,
+			phase:     catalogPhaseOffers,
+			sub:       sub,
+			offer:     offer,
+		}
+		if ok {
+			change.Action = catalogActionReplace
+			change.ID = current.id
+			change.replaced = CatalogOffer{
+				Territory:       offer.Territory,
+				Duration:        string(current.attrs.Duration),
+				OfferMode:       string(current.attrs.OfferMode),
+				NumberOfPeriods: current.attrs.NumberOfPeriods,
+				Price:           current.price,
+			}
+		}
+		changes = append(changes, change)
+	}
+
+	for _, offer := range sub.PromotionalOffers {
+		change := CatalogChange{
+			Action:     catalogActionCreate,
+			Resource:   catalogResourcePromotionalOffer,
+			Target:     sub.ProductID,
+			OfferCode:  offer.OfferCode,
+			Detail:     formatCatalogPromotionalOffer(offer),
+			phase:      catalogPhaseOffers,
+			sub:        sub,
+			promoOffer: offer,
+		}
+		current, ok := remotePromoOffers[offer.OfferCode]
+		if ok {
+			change.ID = current.id
+			switch {
+			case !catalogPromotionalOfferTermsMatch(offer, current.attrs):
+				// Offer terms are immutable, so the offer is recreated.
+				change.Action = catalogActionReplace
+				change.replacedPromo = CatalogPromotionalOffer{
+					OfferCode:       offer.OfferCode,
+					Name:            current.attrs.Name,
+					Duration:        string(current.attrs.Duration),
+					OfferMode:       string(current.attrs.OfferMode),
+					NumberOfPeriods: current.attrs.NumberOfPeriods,
+				}
+			case !catalogPromotionalOfferPricesMatch(offer, current.prices):
+				change.Action = catalogActionUpdate
+				change.Detail = fmt.Sprintf("prices: %s -> %s", formatCatalogPrices(current.prices), formatCatalogPrices(catalogPromotionalOfferPrices(offer)))
+			default:
+				continue
+			}
+		}
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
+
+// planCatalogLocalizations creates missing locales and updates changed ones.
+// Locales that exist remotely but are not declared are left untouched.
+func planCatalogLocalizations(resource, target, group string, desired []CatalogLocalization, remote map[string]remoteLocalization) []CatalogChange {
+	var changes []CatalogChange
+	for _, loc := range desired {
+		current, ok := remote[strings.ToLower(loc.Locale)]
+		change := CatalogChange{
+			Action:       catalogActionCreate,
+			Resource:     resource,
+			Target:       target,
+			Locale:       loc.Locale,
+			phase:        catalogPhaseLocalizations,
+			group:        group,
+			localization: loc,
+		}
+		if ok {
+			var details []string
+			if current.name != loc.Name {
+				details = append(details, fmt.Sprintf("name: %q -> %q", current.name, loc.Name))
+			}
+			if resource == catalogResourceGroupLocalization {
+				if current.customAppName != loc.CustomAppName {
+					details = append(details, "customAppName changed")
+				}
+			} else if current.description != loc.Description {
+				details = append(details, "description changed")
+			}
+			if len(details) == 0 {
+				continue
+			}
+			change.Action = catalogActionUpdate
+			change.ID = current.id
+			change.Detail = strings.Join(details, "; ")
+		}
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+func diffCatalogIAP(current asc.InAppPurchaseV2Attributes, desired *CatalogIAP) (*asc.InAppPurchaseV2UpdateAttributes, []string) {
+	update := &asc.InAppPurchaseV2UpdateAttributes{}
+	var details []string
+	if current.Name != desired.Name {
+		update.Name = &desired.Name
+		details = append(details, fmt.Sprintf("name: %q -> %q", current.Name, desired.Name))
+	}
+	if current.ReviewNote != desired.ReviewNote {
+		update.ReviewNote = &desired.ReviewNote
+		details = append(details, "reviewNote changed")
+	}
+	if desired.FamilySharable != nil && current.FamilySharable != *desired.FamilySharable {
+		update.FamilySharable = desired.FamilySharable
+		details = append(details, fmt.Sprintf("familySharable: %t -> %t", current.FamilySharable, *desired.FamilySharable))
+	}
+	return update, details
+}
+
+func diffCatalogSubscription(current asc.SubscriptionAttributes, desired *CatalogSubscription) (*asc.SubscriptionUpdateAttributes, []string) {
+	update := &asc.SubscriptionUpdateAttributes{}
+	var details []string
+	if current.Name != desired.Name {
+		update.Name = &desired.Name
+		details = append(details, fmt.Sprintf("name: %q -> %q", current.Name, desired.Name))
+	}
+	if current.ReviewNote != desired.ReviewNote {
+		update.ReviewNote = &desired.ReviewNote
+		details = append(details, "reviewNote changed")
+	}
+	if !strings.EqualFold(current.SubscriptionPeriod, desired.Period) {
+		update.SubscriptionPeriod = &desired.Period
+		details = append(details, fmt.Sprintf("period: %s -> %s", current.SubscriptionPeriod, desired.Period))
+	}
+	if desired.GroupLevel > 0 && current.GroupLevel != desired.GroupLevel {
+		update.GroupLevel = &desired.GroupLevel
+		details = append(details, fmt.Sprintf("groupLevel: %d -> %d", current.GroupLevel, desired.GroupLevel))
+	}
+	if desired.FamilySharable != nil && current.FamilySharable != *desired.FamilySharable {
+		update.FamilySharable = desired.FamilySharable
+		details = append(details, fmt.Sprintf("familySharable: %t -> %t", current.FamilySharable, *desired.FamilySharable))
+	}
+	return update, details
+}
+
+// fetchCatalogIAPPrices returns the open-ended manual price per territory.
+func fetchCatalogIAPPrices(ctx context.Context, client catalogClient, iapID string) (map[string]string, error) {
+	prices := make(map[string]string)
+	schedule, err := client.GetInAppPurchasePriceSchedule(ctx, iapID)
+	if err != nil {
+		if asc.IsNotFound(err) {
+			return prices, nil
+		}
+		return nil, err
+	}
+
+	starts := make(map[string]string)
+	opts := []asc.IAPPriceSchedulePricesOption{
+		asc.WithIAPPriceSchedulePricesInclude([]string{"inAppPurchasePricePoint", "territory"}),
+		asc.WithIAPPriceSchedulePricesPricePointFields([]string{"customerPrice"}),
+		asc.WithIAPPriceSchedulePricesLimit(200),
+	}
+	for {
+		resp, err := client.GetInAppPurchasePriceScheduleManualPrices(ctx, schedule.Data.ID, opts...)
+		if err != nil {
+			return nil, err
+		}
+		values := includedCustomerPrices(resp.Included, string(asc.ResourceTypeInAppPurchasePricePoints))
+		for _, item := range resp.Data {
+			if strings.TrimSpace(item.Attributes.EndDate) != "" {
+				continue
+			}
+			territory := strings.ToUpper(relationshipDataID(item.Relationships, "territory"))
+			price, ok := values[relationshipDataID(item.Relationships, "inAppPurchasePricePoint")]
+			if territory == "" || !ok {
+				continue
+			}
+			if start, seen := starts[territory]; seen && start > item.Attributes.StartDate {
+				continue
+			}
+			starts[territory] = item.Attributes.StartDate
+			prices[territory] = price
+		}
+		if strings.TrimSpace(resp.Links.Next) == "" {
+			return prices, nil
+		}
+		opts = []asc.IAPPriceSchedulePricesOption{asc.WithIAPPriceSchedulePricesNextURL(resp.Links.Next)}
+	}
+}
+
+// fetchCatalogSubscriptionPrices returns the price in effect today per territory.
+func fetchCatalogSubscriptionPrices(ctx context.Context, client catalogClient, subID string) (map[string]string, error) {
+	prices := make(map[string]string)
+	starts := make(map[string]string)
+	today := time.Now().UTC().Format("2006-01-02")
+
+	opts := []asc.SubscriptionPricesOption{
+		asc.WithSubscriptionPricesInclude([]string{"subscriptionPricePoint", "territory"}),
+		asc.WithSubscriptionPricesPricePointFields([]string{"customerPrice"}),
+		asc.WithSubscriptionPricesLimit(200),
+	}
+	for {
+		resp, err := client.GetSubscriptionPrices(ctx, subID, opts...)
+		if err != nil {
+			return nil, err
+		}
+		values := includedCustomerPrices(resp.Included, string(asc.ResourceTypeSubscriptionPricePoints))
+		for _, item := range resp.Data {
+			start := strings.TrimSpace(item.Attributes.StartDate)
+			if start > today {
+				continue
+			}
+			territory := strings.ToUpper(relationshipDataID(item.Relationships, "territory"))
+			price, ok := values[relationshipDataID(item.Relationships, "subscriptionPricePoint")]
+			if territory == "" || !ok {
+				continue
+			}
+			if seenStart, seen := starts[territory]; seen && seenStart > start {
+				continue
+			}
+			starts[territory] = start
+			prices[territory] = price
+		}
+		if strings.TrimSpace(resp.Links.Next) == "" {
+			return prices, nil
+		}
+		opts = []asc.SubscriptionPricesOption{asc.WithSubscriptionPricesNextURL(resp.Links.Next)}
+	}
+}
+
+func fetchCatalogIntroductoryOffers(ctx context.Context, client catalogClient, subID string) (map[string]remoteOffer, error) {
+	offers := make(map[string]remoteOffer)
+	opts := []asc.SubscriptionIntroductoryOffersOption{
+		asc.WithSubscriptionIntroductoryOffersInclude([]string{"territory", "subscriptionPricePoint"}),
+		asc.WithSubscriptionIntroductoryOffersLimit(200),
+	}
+	for {
+		resp, err := client.GetSubscriptionIntroductoryOffers(ctx, subID, opts...)
+		if err != nil {
+			return nil, err
+		}
+		values := includedCustomerPrices(resp.Included, string(asc.ResourceTypeSubscriptionPricePoints))
+		for _, item := range resp.Data {
+			territory := strings.ToUpper(relationshipDataID(item.Relationships, "territory"))
+			if territory == "" {
+				continue
+			}
+			offers[territory] = remoteOffer{
+				id:    item.ID,
+				attrs: item.Attributes,
+				price: values[relationshipDataID(item.Relationships, "subscriptionPricePoint")],
+			}
+		}
+		if strings.TrimSpace(resp.Links.Next) == "" {
+			return offers, nil
+		}
+		opts = []asc.SubscriptionIntroductoryOffersOption{asc.WithSubscriptionIntroductoryOffersNextURL(resp.Links.Next)}
+	}
+}
+
+// fetchCatalogPromotionalOffers returns the subscription's declared
+// promotional offers keyed by offer code, with their territory prices.
+func fetchCatalogPromotionalOffers(ctx context.Context, client catalogClient, subID string, declared []CatalogPromotionalOffer) (map[string]remotePromotionalOffer, error) {
+	codes := make(map[string]struct{}, len(declared))
+	for _, offer := range declared {
+		codes[offer.OfferCode] = struct{}{}
+	}
+
+	offers := make(map[string]remotePromotionalOffer)
+	opts := []asc.SubscriptionPromotionalOffersOption{asc.WithSubscriptionPromotionalOffersLimit(200)}
+	for {
+		resp, err := client.GetSubscriptionPromotionalOffers(ctx, subID, opts...)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range resp.Data {
+			code := strings.TrimSpace(item.Attributes.OfferCode)
+			if _, ok := codes[code]; !ok {
+				continue
+			}
+			prices, err := fetchCatalogPromotionalOfferPrices(ctx, client, item.ID)
+			if err != nil {
+				return nil, fmt.Errorf("offer %s: %w", code, err)
+			}
+			offers[code] = remotePromotionalOffer{
+				id:     item.ID,
+				attrs:  item.Attributes,
+				prices: prices,
+			}
+		}
+		if strings.TrimSpace(resp.Links.Next) == "" {
+			return offers, nil
+		}
+		opts = []asc.SubscriptionPromotionalOffersOption{asc.WithSubscriptionPromotionalOffersNextURL(resp.Links.Next)}
+	}
+}
+
+func fetchCatalogPromotionalOfferPrices(ctx context.Context, client catalogClient, offerID string) (map[string]string, error) {
+	prices := make(map[string]string)
+	opts := []asc.SubscriptionPromotionalOfferPricesOption{
+		asc.WithSubscriptionPromotionalOfferPricesInclude([]string{"territory", "subscriptionPricePoint"}),
+		asc.WithSubscriptionPromotionalOfferPricesLimit(200),
+	}
+	for {
+		resp, err := client.GetSubscriptionPromotionalOfferPrices(ctx, offerID, opts...)
+		if err != nil {
+			return nil, err
+		}
+		values := includedCustomerPrices(resp.Included, string(asc.ResourceTypeSubscriptionPricePoints))
+		for _, item := range resp.Data {
+			territory := strings.ToUpper(relationshipDataID(item.Relationships, "territory"))
+			if territory == "" {
+				continue
+			}
+			prices[territory] = values[relationshipDataID(item.Relationships, "subscriptionPricePoint")]
+		}
+		if strings.TrimSpace(resp.Links.Next) == "" {
+			return prices, nil
+		}
+		opts = []asc.SubscriptionPromotionalOfferPricesOption{asc.WithSubscriptionPromotionalOfferPricesNextURL(resp.Links.Next)}
+	}
+}
+
+func catalogPromotionalOfferTermsMatch(desired CatalogPromotionalOffer, current asc.SubscriptionPromotionalOfferAttributes) bool {
+	return current.Name == desired.Name &&
+		strings.EqualFold(string(current.Duration), desired.Duration) &&
+		strings.EqualFold(string(current.OfferMode), desired.OfferMode) &&
+		current.NumberOfPeriods == desired.NumberOfPeriods
+}
+
+// catalogPromotionalOfferPricesMatch reports whether the offer is available in
+// exactly the declared territories at the declared prices.
+func catalogPromotionalOfferPricesMatch(desired CatalogPromotionalOffer, current map[string]string) bool {
+	prices := catalogPromotionalOfferPrices(desired)
+	if len(prices) != len(current) {
+		return false
+	}
+	for territory, price := range prices {
+		currentPrice, ok := current[territory]
+		if !ok {
+			return false
+		}
+		if price != "" && !catalogPriceEqual(price, currentPrice) {
+			return false
+		}
+	}
+	return true
+}
+
+// catalogPromotionalOfferPrices returns the declared price per territory;
+// free trial territories map to "".
+func catalogPromotionalOfferPrices(offer CatalogPromotionalOffer) map[string]string {
+	if len(offer.Prices) > 0 {
+		return offer.Prices
+	}
+	prices := make(map[string]string, len(offer.Territories))
+	for _, territory := range offer.Territories {
+		prices[territory] = ""
+	}
+	return prices
+}
+
+func catalogOfferMatches(desired CatalogOffer, current remoteOffer) bool {
+	if !strings.EqualFold(string(current.attrs.Duration), desired.Duration) ||
+		!strings.EqualFold(string(current.attrs.OfferMode), desired.OfferMode) ||
+		current.attrs.NumberOfPeriods != desired.NumberOfPeriods {
+		return false
+	}
+	if desired.Price == "" {
+		return true
+	}
+	return catalogPriceEqual(desired.Price, current.price)
+}
+
+func catalogPricesMatch(desired, current map[string]string) bool {
+	for territory, price := range desired {
+		if !catalogPriceEqual(price, current[territory]) {
+			return false
+		}
+	}
+	return true
+}
+
+func catalogPriceEqual(a, b string) bool {
+	left, errLeft := strconv.ParseFloat(strings.TrimSpace(a), 64)
+	right, errRight := strconv.ParseFloat(strings.TrimSpace(b), 64)
+	if errLeft != nil || errRight != nil {
+		return strings.TrimSpace(a) == strings.TrimSpace(b)
+	}
+	diff := left - right
+	return diff < 0.000001 && diff > -0.000001
+}
+
+// catalogBaseTerritory returns the declared base territory, USA when priced,
+// or the first priced territory.
+func catalogBaseTerritory(iap *CatalogIAP) string {
+	if iap.BaseTerritory != "" {
+		return iap.BaseTerritory
+	}
+	if _, ok := iap.Prices["USA"]; ok {
+		return "USA"
+	}
+	territories := sortedTerritories(iap.Prices)
+	if len(territories) == 0 {
+		return ""
+	}
+	return territories[0]
+}
+
+func formatCatalogPrices(prices map[string]string) string {
+	parts := make([]string, 0, len(prices))
+	for _, territory := range sortedTerritories(prices) {
+		parts = append(parts, strings.TrimSpace(territory+" "+prices[territory]))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func formatCatalogOffer(offer CatalogOffer) string {
+	detail := fmt.Sprintf("%s %s x%d", offer.OfferMode, offer.Duration, offer.NumberOfPeriods)
+	if offer.Price != "" {
+		detail += " at " + offer.Price
+	}
+	return detail
+}
+
+func formatCatalogPromotionalOffer(offer CatalogPromotionalOffer) string {
+	detail := fmt.Sprintf("%q %s %s x%d", offer.Name, offer.OfferMode, offer.Duration, offer.NumberOfPeriods)
+	if len(offer.Prices) > 0 {
+		return detail + " at " + formatCatalogPrices(offer.Prices)
+	}
+	return detail + " in " + strings.Join(offer.Territories, ", ")
+}
+
+func includedCustomerPrices(raw json.RawMessage, resourceType string) map[string]string {
+	values := make(map[string]string)
+	if len(raw) == 0 {
+		return values
+	}
+	var included []struct {
+		Type       string `json:"type"`
+		ID         string `json:"id"`
+		Attributes struct {
+			CustomerPrice string `json:"customerPrice"`
+		} `json:"attributes"`
+	}
+	if err := json.Unmarshal(raw, &included); err != nil {
+		return values
+	}
+	for _, item := range included {
+		if item.Type == resourceType {
+			values[item.ID] = strings.TrimSpace(item.Attributes.CustomerPrice)
+		}
+	}
+	return values
+}
+
+func relationshipDataID(raw json.RawMessage, key string) string {
+	if len(raw) == 0 {
+		return ""
+	}
+	var relationships map[string]struct {
+		Data *struct {
+			ID string `json:"id"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(raw, &relationships); err != nil {
+		return ""
+	}
+	relationship, ok := relationships[key]
+	if !ok || relationship.Data == nil {
+		return ""
+	}
+	return strings.TrimSpace(relationship.Data.ID)
+}
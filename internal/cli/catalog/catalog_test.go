@@ -0,0 +1,622 @@
+package catalog
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+type catalogStub struct {
+	iaps          []asc.Resource[asc.InAppPurchaseV2Attributes]
+	groups        []asc.Resource[asc.SubscriptionGroupAttributes]
+	subs          map[string][]asc.Resource[asc.SubscriptionAttributes]
+	iapLocs       map[string][]asc.Resource[asc.InAppPurchaseLocalizationAttributes]
+	iapPrices     *asc.InAppPurchasePricesResponse
+	subLocs       map[string][]asc.Resource[asc.SubscriptionLocalizationAttributes]
+	subPrices     map[string]*asc.SubscriptionPricesResponse
+	offers        map[string]*asc.SubscriptionIntroductoryOffersResponse
+	promoOffers   map[string][]asc.Resource[asc.SubscriptionPromotionalOfferAttributes]
+	promoPrices   map[string]*asc.SubscriptionPromotionalOfferPricesResponse
+	promoCalls    [][]asc.SubscriptionPromotionalOfferPrice
+	subPoints     []asc.Resource[asc.SubscriptionPricePointAttributes]
+	iapPoints     []asc.Resource[asc.InAppPurchasePricePointAttributes]
+	offerErr      error
+	calls         []string
+	scheduleCalls []asc.InAppPurchasePriceScheduleCreateAttributes
+}
+
+func (s *catalogStub) record(format string, args ...any) {
+	s.calls = append(s.calls, fmt.Sprintf(format, args...))
+}
+
+func (s *catalogStub) GetInAppPurchasesV2(ctx context.Context, appID string, opts ...asc.IAPOption) (*asc.InAppPurchasesV2Response, error) {
+	return &asc.InAppPurchasesV2Response{Data: s.iaps}, nil
+}
+
+func (s *catalogStub) CreateInAppPurchaseV2(ctx context.Context, appID string, attrs asc.InAppPurchaseV2CreateAttributes) (*asc.InAppPurchaseV2Response, error) {
+	s.record("create iap %s %s", attrs.ProductID, attrs.InAppPurchaseType)
+	return &asc.InAppPurchaseV2Response{Data: asc.Resource[asc.InAppPurchaseV2Attributes]{ID: "iap-" + attrs.ProductID}}, nil
+}
+
+func (s *catalogStub) UpdateInAppPurchaseV2(ctx context.Context, iapID string, attrs asc.InAppPurchaseV2UpdateAttributes) (*asc.InAppPurchaseV2Response, error) {
+	s.record("update iap %s", iapID)
+	return &asc.InAppPurchaseV2Response{}, nil
+}
+
+func (s *catalogStub) GetInAppPurchaseLocalizations(ctx context.Context, iapID string, opts ...asc.IAPLocalizationsOption) (*asc.InAppPurchaseLocalizationsResponse, error) {
+	return &asc.InAppPurchaseLocalizationsResponse{Data: s.iapLocs[iapID]}, nil
+}
+
+func (s *catalogStub) CreateInAppPurchaseLocalization(ctx context.Context, iapID string, attrs asc.InAppPurchaseLocalizationCreateAttributes) (*asc.InAppPurchaseLocalizationResponse, error) {
+	s.record("create iap localization %s %s", iapID, attrs.Locale)
+	return &asc.InAppPurchaseLocalizationResponse{}, nil
+}
+
+func (s *catalogStub) UpdateInAppPurchaseLocalization(ctx context.Context, localizationID string, attrs asc.InAppPurchaseLocalizationUpdateAttributes) (*asc.InAppPurchaseLocalizationResponse, error) {
+	s.record("update iap localization %s", localizationID)
+	return &asc.InAppPurchaseLocalizationResponse{}, nil
+}
+
+func (s *catalogStub) GetInAppPurchasePriceSchedule(ctx context.Context, iapID string, opts ...asc.IAPPriceScheduleOption) (*asc.InAppPurchasePriceScheduleResponse, error) {
+	if s.iapPrices == nil {
+		return nil, asc.ErrNotFound
+	}
+	return &asc.InAppPurchasePriceScheduleResponse{Data: asc.Resource[asc.InAppPurchasePriceScheduleAttributes]{ID: "schedule-" + iapID}}, nil
+}
+
+func (s *catalogStub) GetInAppPurchasePriceScheduleManualPrices(ctx context.Context, scheduleID string, opts ...asc.IAPPriceSchedulePricesOption) (*asc.InAppPurchasePricesResponse, error) {
+	if s.iapPrices == nil {
+		return &asc.InAppPurchasePricesResponse{}, nil
+	}
+	return s.iapPrices, nil
+}
+
+func (s *catalogStub) GetInAppPurchasePricePoints(ctx context.Context, iapID string, opts ...asc.IAPPricePointsOption) (*asc.InAppPurchasePricePointsResponse, error) {
+	return &asc.InAppPurchasePricePointsResponse{Data: s.iapPoints}, nil
+}
+
+func (s *catalogStub) CreateInAppPurchasePriceSchedule(ctx context.Context, iapID string, attrs asc.InAppPurchasePriceScheduleCreateAttributes) (*asc.InAppPurchasePriceScheduleResponse, error) {
+	s.record("set iap prices %s base %s", iapID, attrs.BaseTerritoryID)
+	s.scheduleCalls = append(s.scheduleCalls, attrs)
+	return &asc.InAppPurchasePriceScheduleResponse{}, nil
+}
+
+func (s *catalogStub) GetSubscriptionGroups(ctx context.Context, appID string, opts ...asc.SubscriptionGroupsOption) (*asc.SubscriptionGroupsResponse, error) {
+	return &asc.SubscriptionGroupsResponse{Data: s.groups}, nil
+}
+
+func (s *catalogStub) CreateSubscriptionGroup(ctx context.Context, appID string, attrs asc.SubscriptionGroupCreateAttributes) (*asc.SubscriptionGroupResponse, error) {
+	s.record("create group %s", attrs.ReferenceName)
+	return &asc.SubscriptionGroupResponse{Data: asc.Resource[asc.SubscriptionGroupAttributes]{ID: "group-new"}}, nil
+}
+
+func (s *catalogStub) GetSubscriptionGroupLocalizations(ctx context.Context, groupID string, opts ...asc.SubscriptionGroupLocalizationsOption) (*asc.SubscriptionGroupLocalizationsResponse, error) {
+	return &asc.SubscriptionGroupLocalizationsResponse{}, nil
+}
+
+func (s *catalogStub) CreateSubscriptionGroupLocalization(ctx context.Context, groupID string, attrs asc.SubscriptionGroupLocalizationCreateAttributes) (*asc.SubscriptionGroupLocalizationResponse, error) {
+	s.record("create group localization %s %s", groupID, attrs.Locale)
+	return &asc.SubscriptionGroupLocalizationResponse{}, nil
+}
+
+func (s *catalogStub) UpdateSubscriptionGroupLocalization(ctx context.Context, localizationID string, attrs asc.SubscriptionGroupLocalizationUpdateAttributes) (*asc.SubscriptionGroupLocalizationResponse, error) {
+	s.record("update group localization %s", localizationID)
+	return &asc.SubscriptionGroupLocalizationResponse{}, nil
+}
+
+func (s *catalogStub) GetSubscriptions(ctx context.Context, groupID string, opts ...asc.SubscriptionsOption) (*asc.SubscriptionsResponse, error) {
+	return &asc.SubscriptionsResponse{Data: s.subs[groupID]}, nil
+}
+
+func (s *catalogStub) CreateSubscription(ctx context.Context, groupID string, attrs asc.SubscriptionCreateAttributes) (*asc.SubscriptionResponse, error) {
+	s.record("create subscription %s in %s", attrs.ProductID, groupID)
+	return &asc.SubscriptionResponse{Data: asc.Resource[asc.SubscriptionAttributes]{ID: "sub-" + attrs.ProductID}}, nil
+}
+
+func (s *catalogStub) UpdateSubscription(ctx context.Context, subID string, attrs asc.SubscriptionUpdateAttributes) (*asc.SubscriptionResponse, error) {
+	s.record("update subscription %s", subID)
+	return &asc.SubscriptionResponse{}, nil
+}
+
+func (s *catalogStub) GetSubscriptionLocalizations(ctx context.Context, subscriptionID string, opts ...asc.SubscriptionLocalizationsOption) (*asc.SubscriptionLocalizationsResponse, error) {
+	return &asc.SubscriptionLocalizationsResponse{Data: s.subLocs[subscriptionID]}, nil
+}
+
+func (s *catalogStub) CreateSubscriptionLocalization(ctx context.Context, subscriptionID string, attrs asc.SubscriptionLocalizationCreateAttributes) (*asc.SubscriptionLocalizationResponse, error) {
+	s.record("create subscription localization %s %s", subscriptionID, attrs.Locale)
+	return &asc.SubscriptionLocalizationResponse{}, nil
+}
+
+func (s *catalogStub) UpdateSubscriptionLocalization(ctx context.Context, localizationID string, attrs asc.SubscriptionLocalizationUpdateAttributes) (*asc.SubscriptionLocalizationResponse, error) {
+	s.record("update subscription localization %s", localizationID)
+	return &asc.SubscriptionLocalizationResponse{}, nil
+}
+
+func (s *catalogStub) GetSubscriptionPrices(ctx context.Context, subscriptionID string, opts ...asc.SubscriptionPricesOption) (*asc.SubscriptionPricesResponse, error) {
+	if resp, ok := s.subPrices[subscriptionID]; ok {
+		return resp, nil
+	}
+	return &asc.SubscriptionPricesResponse{}, nil
+}
+
+func (s *catalogStub) GetSubscriptionPricePoints(ctx context.Context, subscriptionID string, opts ...asc.SubscriptionPricePointsOption) (*asc.SubscriptionPricePointsResponse, error) {
+	return &asc.SubscriptionPricePointsResponse{Data: s.subPoints}, nil
+}
+
+func (s *catalogStub) CreateSubscriptionPrice(ctx context.Context, subID, pricePointID, territoryID string, attrs asc.SubscriptionPriceCreateAttributes) (*asc.SubscriptionPriceResponse, error) {
+	s.record("set subscription price %s %s %s", subID, territoryID, pricePointID)
+	return &asc.SubscriptionPriceResponse{}, nil
+}
+
+func (s *catalogStub) GetSubscriptionIntroductoryOffers(ctx context.Context, subscriptionID string, opts ...asc.SubscriptionIntroductoryOffersOption) (*asc.SubscriptionIntroductoryOffersResponse, error) {
+	if resp, ok := s.offers[subscriptionID]; ok {
+		return resp, nil
+	}
+	return &asc.SubscriptionIntroductoryOffersResponse{}, nil
+}
+
+func (s *catalogStub) CreateSubscriptionIntroductoryOffer(ctx context.Context, subscriptionID string, attrs asc.SubscriptionIntroductoryOfferCreateAttributes, territoryID, pricePointID string) (*asc.SubscriptionIntroductoryOfferResponse, error) {
+	s.record("create offer %s %s %s %s", subscriptionID, territoryID, attrs.OfferMode, pricePointID)
+	if s.offerErr != nil {
+		return nil, s.offerErr
+	}
+	return &asc.SubscriptionIntroductoryOfferResponse{}, nil
+}
+
+func (s *catalogStub) DeleteSubscriptionIntroductoryOffer(ctx context.Context, offerID string) error {
+	s.record("delete offer %s", offerID)
+	return nil
+}
+
+func (s *catalogStub) GetSubscriptionPromotionalOffers(ctx context.Context, subscriptionID string, opts ...asc.SubscriptionPromotionalOffersOption) (*asc.SubscriptionPromotionalOffersResponse, error) {
+	return &asc.SubscriptionPromotionalOffersResponse{Data: s.promoOffers[subscriptionID]}, nil
+}
+
+func (s *catalogStub) GetSubscriptionPromotionalOfferPrices(ctx context.Context, offerID string, opts ...asc.SubscriptionPromotionalOfferPricesOption) (*asc.SubscriptionPromotionalOfferPricesResponse, error) {
+	if resp, ok := s.promoPrices[offerID]; ok {
+		return resp, nil
+	}
+	return &asc.SubscriptionPromotionalOfferPricesResponse{}, nil
+}
+
+func (s *catalogStub) CreateSubscriptionPromotionalOfferWithPrices(ctx context.Context, subscriptionID string, attrs asc.SubscriptionPromotionalOfferCreateAttributes, prices []asc.SubscriptionPromotionalOfferPrice) (*asc.SubscriptionPromotionalOfferResponse, error) {
+	s.record("create promotional offer %s %s %s", subscriptionID, attrs.OfferCode, attrs.OfferMode)
+	s.promoCalls = append(s.promoCalls, prices)
+	return &asc.SubscriptionPromotionalOfferResponse{Data: asc.Resource[asc.SubscriptionPromotionalOfferAttributes]{ID: "promo-" + attrs.OfferCode}}, nil
+}
+
+func (s *catalogStub) UpdateSubscriptionPromotionalOfferPrices(ctx context.Context, offerID string, prices []asc.SubscriptionPromotionalOfferPrice) (*asc.SubscriptionPromotionalOfferResponse, error) {
+	s.record("update promotional offer prices %s", offerID)
+	s.promoCalls = append(s.promoCalls, prices)
+	return &asc.SubscriptionPromotionalOfferResponse{}, nil
+}
+
+func (s *catalogStub) DeleteSubscriptionPromotionalOffer(ctx context.Context, offerID string) error {
+	s.record("delete promotional offer %s", offerID)
+	return nil
+}
+
+func writeCatalogFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "catalog.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("write catalog: %v", err)
+	}
+	return path
+}
+
+const testCatalogYAML = `app: "app-1"
+inAppPurchases:
+  - productId: com.example.coins
+    name: Coins
+    type: consumable
+    localizations:
+      - locale: en-US
+        name: 100 Coins
+    prices:
+      usa: 0.99
+subscriptionGroups:
+  - referenceName: Premium
+    localizations:
+      - locale: en-US
+        name: Premium
+    subscriptions:
+      - productId: com.example.monthly
+        name: Monthly
+        period: ONE_MONTH
+        groupLevel: 1
+        reviewNote: Use the demo account
+        localizations:
+          - locale: en-US
+            name: Monthly
+            description: Billed monthly
+        prices:
+          USA: "4.99"
+          JPN: "800"
+        introductoryOffers:
+          - territory: USA
+            duration: ONE_WEEK
+            offerMode: FREE_TRIAL
+            numberOfPeriods: 1
+          - territory: JPN
+            duration: ONE_MONTH
+            offerMode: PAY_AS_YOU_GO
+            numberOfPeriods: 3
+            price: "400"
+        promotionalOffers:
+          - offerCode: WINBACK
+            name: Win back
+            duration: ONE_MONTH
+            offerMode: pay_as_you_go
+            numberOfPeriods: 1
+            prices:
+              usa: "4.99"
+          - offerCode: RETRY
+            name: Retry
+            duration: ONE_WEEK
+            offerMode: FREE_TRIAL
+            numberOfPeriods: 1
+            territories: [usa, JPN]
+`
+
+func TestReadCatalogFile_Normalizes(t *testing.T) {
+	catalog, err := readCatalogFile(writeCatalogFile(t, testCatalogYAML))
+	if err != nil {
+		t.Fatalf("readCatalogFile() error: %v", err)
+	}
+	iap := catalog.InAppPurchases[0]
+	if iap.Type != "CONSUMABLE" || iap.Prices["USA"] != "0.99" {
+		t.Fatalf("expected normalized IAP, got %+v", iap)
+	}
+	if catalog.App != "app-1" || len(catalog.SubscriptionGroups[0].Subscriptions[0].IntroductoryOffers) != 2 {
+		t.Fatalf("unexpected catalog: %+v", catalog)
+	}
+	promo := catalog.SubscriptionGroups[0].Subscriptions[0].PromotionalOffers
+	if len(promo) != 2 || promo[0].OfferMode != "PAY_AS_YOU_GO" || promo[0].Prices["USA"] != "4.99" || promo[1].Territories[0] != "USA" {
+		t.Fatalf("expected normalized promotional offers, got %+v", promo)
+	}
+}
+
+func TestReadCatalogFile_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{name: "empty", content: "", wantErr: "is empty"},
+		{name: "no products", content: "app: \"1\"\n", wantErr: "declares no in-app purchases"},
+		{name: "unknown field", content: "inAppPurchases:\n  - productId: a\n    price: 1\n", wantErr: "price"},
+		{
+			name:    "unknown promotional offer field",
+			content: "subscriptionGroups:\n  - referenceName: g\n    subscriptions:\n      - productId: com.example.monthly\n        promotionalOffers:\n          - offerCode: WINBACK\n            price: 1\n",
+			wantErr: "price",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := readCatalogFile(writeCatalogFile(t, test.content))
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestPlanAndApplyCatalog_NewProducts(t *testing.T) {
+	catalog, err := readCatalogFile(writeCatalogFile(t, testCatalogYAML))
+	if err != nil {
+		t.Fatalf("readCatalogFile() error: %v", err)
+	}
+	stub := &catalogStub{
+		subPoints: []asc.Resource[asc.SubscriptionPricePointAttributes]{
+			{ID: "spp-499", Attributes: asc.SubscriptionPricePointAttributes{CustomerPrice: "4.99"}},
+			{ID: "spp-800", Attributes: asc.SubscriptionPricePointAttributes{CustomerPrice: "800.0"}},
+			{ID: "spp-400", Attributes: asc.SubscriptionPricePointAttributes{CustomerPrice: "400"}},
+		},
+		iapPoints: []asc.Resource[asc.InAppPurchasePricePointAttributes]{
+			{ID: "ipp-099", Attributes: asc.InAppPurchasePricePointAttributes{CustomerPrice: "0.99"}},
+		},
+	}
+
+	changes, state, err := planCatalog(context.Background(), stub, "app-1", catalog)
+	if err != nil {
+		t.Fatalf("planCatalog() error: %v", err)
+	}
+	applied, err := applyCatalogPlan(context.Background(), stub, "app-1", state, changes)
+	if err != nil {
+		t.Fatalf("applyCatalogPlan() error: %v", err)
+	}
+	if len(applied) != len(changes) {
+		t.Fatalf("expected %d applied changes, got %d", len(changes), len(applied))
+	}
+
+	wantCalls := []string{
+		"create group Premium",
+		"create subscription com.example.monthly in group-new",
+		"create iap com.example.coins CONSUMABLE",
+		"create group localization group-new en-US",
+		"create subscription localization sub-com.example.monthly en-US",
+		"create iap localization iap-com.example.coins en-US",
+		"set subscription price sub-com.example.monthly JPN spp-800",
+		"set subscription price sub-com.example.monthly USA spp-499",
+		"set iap prices iap-com.example.coins base USA",
+		"create offer sub-com.example.monthly USA FREE_TRIAL ",
+		"create offer sub-com.example.monthly JPN PAY_AS_YOU_GO spp-400",
+		"create promotional offer sub-com.example.monthly WINBACK PAY_AS_YOU_GO",
+		"create promotional offer sub-com.example.monthly RETRY FREE_TRIAL",
+	}
+	if !reflect.DeepEqual(stub.calls, wantCalls) {
+		t.Fatalf("unexpected calls:\n got %q\nwant %q", stub.calls, wantCalls)
+	}
+	if len(stub.scheduleCalls) != 1 || stub.scheduleCalls[0].Prices[0].PricePointID != "ipp-099" {
+		t.Fatalf("unexpected schedule calls: %+v", stub.scheduleCalls)
+	}
+	wantPromoPrices := [][]asc.SubscriptionPromotionalOfferPrice{
+		{{TerritoryID: "USA", PricePointID: "spp-499"}},
+		{{TerritoryID: "USA"}, {TerritoryID: "JPN"}},
+	}
+	if !reflect.DeepEqual(stub.promoCalls, wantPromoPrices) {
+		t.Fatalf("unexpected promotional offer prices %+v", stub.promoCalls)
+	}
+}
+
+func TestPlanCatalog_ExistingProductsIdempotent(t *testing.T) {
+	catalog, err := readCatalogFile(writeCatalogFile(t, testCatalogYAML))
+	if err != nil {
+		t.Fatalf("readCatalogFile() error: %v", err)
+	}
+	// Drop the IAP so only the subscription side is compared.
+	catalog.InAppPurchases = nil
+	catalog.SubscriptionGroups[0].Subscriptions[0].PromotionalOffers = nil
+
+	territoryRel := func(rel, territory, pricePoint string) json.RawMessage {
+		return json.RawMessage(fmt.Sprintf(`{"territory":{"data":{"type":"territories","id":%q}},%q:{"data":{"type":"subscriptionPricePoints","id":%q}}}`, territory, rel, pricePoint))
+	}
+	stub := &catalogStub{
+		groups: []asc.Resource[asc.SubscriptionGroupAttributes]{{ID: "group-1", Attributes: asc.SubscriptionGroupAttributes{ReferenceName: "premium"}}},
+		subs: map[string][]asc.Resource[asc.SubscriptionAttributes]{
+			"group-1": {{ID: "sub-1", Attributes: asc.SubscriptionAttributes{
+				Name: "Monthly", ProductID: "com.example.monthly", SubscriptionPeriod: "ONE_MONTH", GroupLevel: 1, ReviewNote: "Old note",
+			}}},
+		},
+		subLocs: map[string][]asc.Resource[asc.SubscriptionLocalizationAttributes]{
+			"sub-1": {{ID: "loc-1", Attributes: asc.SubscriptionLocalizationAttributes{Locale: "en-US", Name: "Monthly", Description: "Billed monthly"}}},
+		},
+		subPrices: map[string]*asc.SubscriptionPricesResponse{
+			"sub-1": {
+				Data: []asc.Resource[asc.SubscriptionPriceAttributes]{
+					{ID: "price-usa", Attributes: asc.SubscriptionPriceAttributes{StartDate: "2024-01-01"}, Relationships: territoryRel("subscriptionPricePoint", "USA", "spp-499")},
+					{ID: "price-jpn", Relationships: territoryRel("subscriptionPricePoint", "JPN", "spp-700")},
+				},
+				Included: json.RawMessage(`[{"type":"subscriptionPricePoints","id":"spp-499","attributes":{"customerPrice":"4.99"}},{"type":"subscriptionPricePoints","id":"spp-700","attributes":{"customerPrice":"700"}}]`),
+			},
+		},
+		offers: map[string]*asc.SubscriptionIntroductoryOffersResponse{
+			"sub-1": {
+				Data: []asc.Resource[asc.SubscriptionIntroductoryOfferAttributes]{
+					{ID: "offer-usa", Attributes: asc.SubscriptionIntroductoryOfferAttributes{Duration: "ONE_WEEK", OfferMode: "FREE_TRIAL", NumberOfPeriods: 1}, Relationships: territoryRel("subscriptionPricePoint", "USA", "")},
+					{ID: "offer-jpn", Attributes: asc.SubscriptionIntroductoryOfferAttributes{Duration: "ONE_MONTH", OfferMode: "PAY_AS_YOU_GO", NumberOfPeriods: 2}, Relationships: territoryRel("subscriptionPricePoint", "JPN", "spp-400")},
+				},
+				Included: json.RawMessage(`[{"type":"subscriptionPricePoints","id":"spp-400","attributes":{"customerPrice":"400"}}]`),
+			},
+		},
+	}
+
+	changes, _, err := planCatalog(context.Background(), stub, "app-1", catalog)
+	if err != nil {
+		t.Fatalf("planCatalog() error: %v", err)
+	}
+
+	got := make([]string, 0, len(changes))
+	for _, change := range changes {
+		got = append(got, fmt.Sprintf("%s %s %s %s", change.Action, change.Resource, catalogChangeLabel(change), change.ID))
+	}
+	want := []string{
+		"update subscription com.example.monthly sub-1",
+		"create subscriptionGroupLocalization Premium en-US ",
+		"set subscriptionPrice com.example.monthly JPN ",
+		"replace introductoryOffer com.example.monthly JPN offer-jpn",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected plan:\n got %q\nwant %q", got, want)
+	}
+	if changes[0].Detail != "reviewNote changed" || changes[2].Detail != "700 -> 800" {
+		t.Fatalf("unexpected details: %q / %q", changes[0].Detail, changes[2].Detail)
+	}
+	if got := formatCatalogOffer(changes[3].replaced); got != "PAY_AS_YOU_GO ONE_MONTH x2 at 400" {
+		t.Fatalf("expected replaced offer to be recorded, got %q", got)
+	}
+}
+
+func TestPlanAndApplyCatalog_ExistingPromotionalOffers(t *testing.T) {
+	catalog, err := readCatalogFile(writeCatalogFile(t, testCatalogYAML))
+	if err != nil {
+		t.Fatalf("readCatalogFile() error: %v", err)
+	}
+	catalog.InAppPurchases = nil
+	catalog.SubscriptionGroups[0].Localizations = nil
+	sub := &catalog.SubscriptionGroups[0].Subscriptions[0]
+	sub.IntroductoryOffers = nil
+	sub.Prices = nil
+	sub.ReviewNote = ""
+	sub.PromotionalOffers = append(sub.PromotionalOffers, CatalogPromotionalOffer{
+		OfferCode: "LOYAL", Name: "Loyal", Duration: "ONE_MONTH", OfferMode: "PAY_UP_FRONT", NumberOfPeriods: 1, Prices: map[string]string{"USA": "4.99"},
+	})
+
+	priceRel := func(territory, pricePoint string) json.RawMessage {
+		if pricePoint == "" {
+			return json.RawMessage(fmt.Sprintf(`{"territory":{"data":{"type":"territories","id":%q}}}`, territory))
+		}
+		return json.RawMessage(fmt.Sprintf(`{"territory":{"data":{"type":"territories","id":%q}},"subscriptionPricePoint":{"data":{"type":"subscriptionPricePoints","id":%q}}}`, territory, pricePoint))
+	}
+	included := json.RawMessage(`[{"type":"subscriptionPricePoints","id":"spp-499","attributes":{"customerPrice":"4.99"}},{"type":"subscriptionPricePoints","id":"spp-299","attributes":{"customerPrice":"2.99"}}]`)
+	stub := &catalogStub{
+		groups: []asc.Resource[asc.SubscriptionGroupAttributes]{{ID: "group-1", Attributes: asc.SubscriptionGroupAttributes{ReferenceName: "Premium"}}},
+		subs: map[string][]asc.Resource[asc.SubscriptionAttributes]{
+			"group-1": {{ID: "sub-1", Attributes: asc.SubscriptionAttributes{Name: "Monthly", ProductID: "com.example.monthly", SubscriptionPeriod: "ONE_MONTH", GroupLevel: 1}}},
+		},
+		subLocs: map[string][]asc.Resource[asc.SubscriptionLocalizationAttributes]{
+			"sub-1": {{ID: "loc-1", Attributes: asc.SubscriptionLocalizationAttributes{Locale: "en-US", Name: "Monthly", Description: "Billed monthly"}}},
+		},
+		promoOffers: map[string][]asc.Resource[asc.SubscriptionPromotionalOfferAttributes]{
+			"sub-1": {
+				{ID: "promo-winback", Attributes: asc.SubscriptionPromotionalOfferAttributes{OfferCode: "WINBACK", Name: "Win back", Duration: "ONE_MONTH", OfferMode: "PAY_AS_YOU_GO", NumberOfPeriods: 1}},
+				{ID: "promo-retry", Attributes: asc.SubscriptionPromotionalOfferAttributes{OfferCode: "RETRY", Name: "Retry", Duration: "ONE_WEEK", OfferMode: "FREE_TRIAL", NumberOfPeriods: 1}},
+				{ID: "promo-loyal", Attributes: asc.SubscriptionPromotionalOfferAttributes{OfferCode: "LOYAL", Name: "Loyal", Duration: "ONE_WEEK", OfferMode: "PAY_UP_FRONT", NumberOfPeriods: 1}},
+				{ID: "promo-other", Attributes: asc.SubscriptionPromotionalOfferAttributes{OfferCode: "OTHER"}},
+			},
+		},
+		promoPrices: map[string]*asc.SubscriptionPromotionalOfferPricesResponse{
+			"promo-winback": {
+				Data:     []asc.Resource[asc.SubscriptionPromotionalOfferPriceAttributes]{{ID: "p-1", Relationships: priceRel("USA", "spp-299")}},
+				Included: included,
+			},
+			"promo-retry": {
+				Data: []asc.Resource[asc.SubscriptionPromotionalOfferPriceAttributes]{{ID: "p-2", Relationships: priceRel("USA", "")}, {ID: "p-3", Relationships: priceRel("JPN", "")}},
+			},
+			"promo-loyal": {
+				Data:     []asc.Resource[asc.SubscriptionPromotionalOfferPriceAttributes]{{ID: "p-4", Relationships: priceRel("USA", "spp-499")}},
+				Included: included,
+			},
+		},
+		subPoints: []asc.Resource[asc.SubscriptionPricePointAttributes]{
+			{ID: "spp-499", Attributes: asc.SubscriptionPricePointAttributes{CustomerPrice: "4.99"}},
+		},
+	}
+
+	changes, state, err := planCatalog(context.Background(), stub, "app-1", catalog)
+	if err != nil {
+		t.Fatalf("planCatalog() error: %v", err)
+	}
+	got := make([]string, 0, len(changes))
+	for _, change := range changes {
+		got = append(got, fmt.Sprintf("%s %s %s %s", change.Action, change.Resource, catalogChangeLabel(change), change.ID))
+	}
+	want := []string{
+		"update promotionalOffer com.example.monthly WINBACK promo-winback",
+		"replace promotionalOffer com.example.monthly LOYAL promo-loyal",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected plan:\n got %q\nwant %q", got, want)
+	}
+	if changes[0].Detail != "prices: USA 2.99 -> USA 4.99" {
+		t.Fatalf("unexpected update detail %q", changes[0].Detail)
+	}
+
+	if _, err := applyCatalogPlan(context.Background(), stub, "app-1", state, changes); err != nil {
+		t.Fatalf("applyCatalogPlan() error: %v", err)
+	}
+	wantCalls := []string{
+		"update promotional offer prices promo-winback",
+		"delete promotional offer promo-loyal",
+		"create promotional offer sub-1 LOYAL PAY_UP_FRONT",
+	}
+	if !reflect.DeepEqual(stub.calls, wantCalls) {
+		t.Fatalf("unexpected calls:\n got %q\nwant %q", stub.calls, wantCalls)
+	}
+}
+
+func TestPlanCatalog_ExistingIAPPriceScheduleIsReplacement(t *testing.T) {
+	catalog, err := readCatalogFile(writeCatalogFile(t, testCatalogYAML))
+	if err != nil {
+		t.Fatalf("readCatalogFile() error: %v", err)
+	}
+	catalog.SubscriptionGroups = nil
+
+	stub := &catalogStub{
+		iaps: []asc.Resource[asc.InAppPurchaseV2Attributes]{
+			{ID: "iap-1", Attributes: asc.InAppPurchaseV2Attributes{ProductID: "com.example.coins", Name: "Coins", InAppPurchaseType: "CONSUMABLE"}},
+		},
+		iapLocs: map[string][]asc.Resource[asc.InAppPurchaseLocalizationAttributes]{
+			"iap-1": {{ID: "loc-1", Attributes: asc.InAppPurchaseLocalizationAttributes{Locale: "en-US", Name: "100 Coins"}}},
+		},
+		iapPrices: &asc.InAppPurchasePricesResponse{
+			Data: []asc.Resource[asc.InAppPurchasePriceAttributes]{{
+				ID:            "price-usa",
+				Relationships: json.RawMessage(`{"territory":{"data":{"type":"territories","id":"USA"}},"inAppPurchasePricePoint":{"data":{"type":"inAppPurchasePricePoints","id":"ipp-199"}}}`),
+			}},
+			Included: json.RawMessage(`[{"type":"inAppPurchasePricePoints","id":"ipp-199","attributes":{"customerPrice":"1.99"}}]`),
+		},
+	}
+
+	changes, _, err := planCatalog(context.Background(), stub, "app-1", catalog)
+	if err != nil {
+		t.Fatalf("planCatalog() error: %v", err)
+	}
+	if len(changes) != 1 || changes[0].Action != catalogActionReplace || changes[0].Resource != catalogResourceIAPPriceSchedule {
+		t.Fatalf("expected one price schedule replacement, got %+v", changes)
+	}
+	if changes[0].Detail != "USA 1.99 -> USA 0.99" {
+		t.Fatalf("unexpected detail %q", changes[0].Detail)
+	}
+	if got := countCatalogReplacements(changes); got != 1 {
+		t.Fatalf("expected 1 replacement, got %d", got)
+	}
+}
+
+func TestApplyCatalogPlan_ReportsDeletedOfferWhenReplacementFails(t *testing.T) {
+	stub := &catalogStub{offerErr: errors.New("invalid offer")}
+	state := &catalogRemoteState{productIDs: map[string]string{"com.example.monthly": "sub-1"}}
+	changes := []CatalogChange{{
+		Action:    catalogActionReplace,
+		Resource:  catalogResourceIntroductoryOffer,
+		Target:    "com.example.monthly",
+		Territory: "USA",
+		ID:        "offer-usa",
+		phase:     catalogPhaseOffers,
+		offer:     CatalogOffer{Territory: "USA", Duration: "ONE_MONTH", OfferMode: "FREE_TRIAL", NumberOfPeriods: 1},
+		replaced:  CatalogOffer{Territory: "USA", Duration: "ONE_WEEK", OfferMode: "FREE_TRIAL", NumberOfPeriods: 1},
+	}}
+
+	_, err := applyCatalogPlan(context.Background(), stub, "app-1", state, changes)
+	if err == nil || !strings.Contains(err.Error(), "deleted offer offer-usa was FREE_TRIAL ONE_WEEK x1): invalid offer") {
+		t.Fatalf("expected error describing the deleted offer, got %v", err)
+	}
+	if want := []string{"delete offer offer-usa", "create offer sub-1 USA FREE_TRIAL "}; !reflect.DeepEqual(stub.calls, want) {
+		t.Fatalf("unexpected calls %q", stub.calls)
+	}
+}
+
+func TestPlanCatalog_RejectsImmutableChanges(t *testing.T) {
+	catalog, err := readCatalogFile(writeCatalogFile(t, testCatalogYAML))
+	if err != nil {
+		t.Fatalf("readCatalogFile() error: %v", err)
+	}
+	stub := &catalogStub{
+		iaps: []asc.Resource[asc.InAppPurchaseV2Attributes]{
+			{ID: "iap-1", Attributes: asc.InAppPurchaseV2Attributes{ProductID: "com.example.coins", InAppPurchaseType: "NON_CONSUMABLE"}},
+		},
+	}
+	if _, _, err := planCatalog(context.Background(), stub, "app-1", catalog); err == nil || !strings.Contains(err.Error(), "type cannot be changed") {
+		t.Fatalf("expected type change error, got %v", err)
+	}
+
+	stub = &catalogStub{
+		groups: []asc.Resource[asc.SubscriptionGroupAttributes]{{ID: "group-2", Attributes: asc.SubscriptionGroupAttributes{ReferenceName: "Legacy"}}},
+		subs: map[string][]asc.Resource[asc.SubscriptionAttributes]{
+			"group-2": {{ID: "sub-1", Attributes: asc.SubscriptionAttributes{ProductID: "com.example.monthly"}}},
+		},
+	}
+	if _, _, err := planCatalog(context.Background(), stub, "app-1", catalog); err == nil || !strings.Contains(err.Error(), "moving it") {
+		t.Fatalf("expected group move error, got %v", err)
+	}
+}
+
+func TestCatalogPlanCommand_RequiresFile(t *testing.T) {
+	cmd := CatalogPlanCommand()
+	if err := cmd.FlagSet.Parse([]string{"--app", "APP_ID"}); err != nil {
+		t.Fatalf("failed to parse flags: %v", err)
+	}
+	if err := cmd.Exec(context.Background(), []string{}); !errors.Is(err, flag.ErrHelp) {
+		t.Fatalf("expected flag.ErrHelp without --file, got %v", err)
+	}
+}
@@ -0,0 +1,133 @@
+package cmdtest
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestAPIValidationErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "missing path",
+			args:    []string{"api", "GET"},
+			wantErr: "METHOD and PATH are required",
+		},
+		{
+			name:    "unsupported method",
+			args:    []string{"api", "TRACE", "/v1/apps"},
+			wantErr: `unsupported method "TRACE"`,
+		},
+		{
+			name:    "relative path",
+			args:    []string{"api", "GET", "v1/apps"},
+			wantErr: "PATH must start with /",
+		},
+		{
+			name:    "foreign host",
+			args:    []string{"api", "GET", "https://example.com/v1/apps"},
+			wantErr: "PATH must be an App Store Connect URL",
+		},
+		{
+			name:    "paginate with post",
+			args:    []string{"api", "POST", "/v1/betaGroups", "--paginate"},
+			wantErr: "--paginate is only supported with GET",
+		},
+		{
+			name:    "body with get",
+			args:    []string{"api", "GET", "/v1/apps", "--body", `{"data":{}}`},
+			wantErr: "--body is not supported with GET",
+		},
+		{
+			name:    "invalid body",
+			args:    []string{"api", "POST", "/v1/betaGroups", "--body", "{"},
+			wantErr: "--body must be valid JSON",
+		},
+		{
+			name:    "explicit table with post",
+			args:    []string{"api", "POST", "/v1/betaGroups", "--body", `{"data":{}}`, "--output", "table"},
+			wantErr: "--output table is only supported with GET",
+		},
+		{
+			name:    "extra arguments",
+			args:    []string{"api", "GET", "/v1/apps", "extra"},
+			wantErr: "unexpected arguments: extra",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				err := root.Run(context.Background())
+				if !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func TestAPIDefaultTableOutputFallsBackToJSON(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_DEFAULT_OUTPUT", "table")
+	resetDefaultOutput(t)
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	requests := 0
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		requests++
+		if req.Method != http.MethodPost || req.URL.Path != "/v1/somethingNews" {
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+		}
+		return jsonResponse(http.StatusCreated, `{"data":{"type":"somethingNews","id":"n1"}}`)
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"api", "POST", "/v1/somethingNews", "--body", `{"data":{"type":"somethingNews"}}`}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if requests != 1 {
+		t.Fatalf("expected one request, got %d", requests)
+	}
+	if strings.TrimSpace(stdout) != `{"data":{"type":"somethingNews","id":"n1"}}` {
+		t.Fatalf("expected raw JSON, got %q", stdout)
+	}
+	if !strings.Contains(stderr, "table output is not available for this response; printing JSON") {
+		t.Fatalf("expected fallback note, got %q", stderr)
+	}
+}
@@ -2931,6 +2931,16 @@ func TestTestFlightSyncValidationErrors(t *testing.T) {
 			args:    []string{"testflight", "sync", "pull", "--app", "APP_ID", "--output", "./testflight.yaml", "--tester", "tester@example.com"},
 			wantErr: "--tester requires --include-testers",
 		},
+		{
+			name:    "testflight sync push missing file",
+			args:    []string{"testflight", "sync", "push", "--app", "APP_ID"},
+			wantErr: "--file is required",
+		},
+		{
+			name:    "testflight sync push prune without confirm",
+			args:    []string{"testflight", "sync", "push", "--app", "APP_ID", "--file", "./testflight.yaml", "--prune"},
+			wantErr: "--confirm is required with --prune",
+		},
 	}
 
 	for _, test := range tests {
@@ -4949,6 +4959,16 @@ func TestXcodeCloudValidationErrors(t *testing.T) {
 			args:    []string{"xcode-cloud", "test-results", "get"},
 			wantErr: "--id is required",
 		},
+		{
+			name:    "xcode-cloud build-runs summary missing id",
+			args:    []string{"xcode-cloud", "build-runs", "summary"},
+			wantErr: "--id is required",
+		},
+		{
+			name:    "xcode-cloud status follow without wait",
+			args:    []string{"xcode-cloud", "status", "--run-id", "RUN_ID", "--follow"},
+			wantErr: "--follow requires --wait",
+		},
 		{
 			name:    "xcode-cloud issues list missing action-id",
 			args:    []string{"xcode-cloud", "issues", "list"},
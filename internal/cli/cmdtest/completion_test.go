@@ -0,0 +1,65 @@
+package cmdtest
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestCompleteWalksCommandTree(t *testing.T) {
+	t.Setenv("PATH", t.TempDir())
+
+	tests := []struct {
+		name    string
+		args    []string
+		want    []string
+		notWant string
+	}{
+		{
+			name:    "root subcommands",
+			args:    []string{"__complete", "--", "test"},
+			want:    []string{"testflight\t"},
+			notWant: "__complete",
+		},
+		{
+			name: "nested flags",
+			args: []string{"__complete", "--", "builds", "latest", "--pla"},
+			want: []string{"--platform\t"},
+		},
+		{
+			name: "platform values",
+			args: []string{"__complete", "--", "builds", "latest", "--platform", ""},
+			want: []string{"IOS\n", "VISION_OS\n"},
+		},
+		{
+			name: "screenshot device types",
+			args: []string{"__complete", "--", "screenshots", "upload", "--device-type", "IPHONE_6"},
+			want: []string{"IPHONE_65\n"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, _ := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				if err := root.Run(context.Background()); err != nil {
+					t.Fatalf("run error: %v", err)
+				}
+			})
+			for _, want := range test.want {
+				if !strings.Contains(stdout, want) {
+					t.Fatalf("expected stdout to contain %q, got %q", want, stdout)
+				}
+			}
+			if test.notWant != "" && strings.Contains(stdout, test.notWant) {
+				t.Fatalf("expected stdout not to contain %q, got %q", test.notWant, stdout)
+			}
+		})
+	}
+}
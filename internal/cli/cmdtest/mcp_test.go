@@ -0,0 +1,63 @@
+package cmdtest
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestMCPServeValidationErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "positional args",
+			args:    []string{"mcp", "serve", "extra"},
+			wantErr: "mcp serve does not accept positional arguments",
+		},
+		{
+			name:    "invalid transport",
+			args:    []string{"mcp", "serve", "--transport", "sse"},
+			wantErr: "--transport must be one of: stdio, http",
+		},
+		{
+			name:    "invalid port",
+			args:    []string{"mcp", "serve", "--transport", "http", "--port", "70000"},
+			wantErr: "--port must be between 0 and 65535",
+		},
+		{
+			name:    "allow read-only tool",
+			args:    []string{"mcp", "serve", "--allow", "apps_list"},
+			wantErr: `--allow: "apps_list" is not a mutating tool (available: builds_expire, testflight_beta_testers_add, submit_cancel, reviews_respond)`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				err := root.Run(context.Background())
+				if !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
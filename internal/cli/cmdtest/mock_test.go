@@ -0,0 +1,68 @@
+package cmdtest
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestMockServeValidationErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "positional args",
+			args:    []string{"mock", "serve", "extra"},
+			wantErr: "mock serve does not accept positional arguments",
+		},
+		{
+			name:    "invalid port",
+			args:    []string{"mock", "serve", "--port", "70000"},
+			wantErr: "--port must be between 0 and 65535",
+		},
+		{
+			name:    "record and replay",
+			args:    []string{"mock", "serve", "--record", "./a", "--replay", "./b"},
+			wantErr: "--record and --replay are mutually exclusive",
+		},
+		{
+			name:    "seed with replay",
+			args:    []string{"mock", "serve", "--seed", "./seed.json", "--replay", "./b"},
+			wantErr: "--seed is only supported in memory mode",
+		},
+		{
+			name:    "invalid upstream",
+			args:    []string{"mock", "serve", "--record", t.TempDir(), "--upstream", "api.example.com"},
+			wantErr: "--upstream must be an http(s) URL",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				err := root.Run(context.Background())
+				if !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
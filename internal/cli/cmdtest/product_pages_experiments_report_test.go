@@ -0,0 +1,358 @@
+package cmdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestProductPagesExperimentsReportConcludeValidationErrors(t *testing.T) {
+	t.Setenv("ASC_APP_ID", "")
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "report missing experiment id",
+			args:    []string{"product-pages", "experiments", "report", "--app", "app-1"},
+			wantErr: "Error: --experiment-id is required",
+		},
+		{
+			name:    "report missing app",
+			args:    []string{"product-pages", "experiments", "report", "--experiment-id", "exp-1"},
+			wantErr: "Error: --app is required (or set ASC_APP_ID)",
+		},
+		{
+			name:    "conclude missing app and treatment",
+			args:    []string{"product-pages", "experiments", "conclude", "--experiment-id", "exp-1", "--confirm"},
+			wantErr: "--app is required to select a winner from analytics",
+		},
+		{
+			name:    "conclude apply winner missing version",
+			args:    []string{"product-pages", "experiments", "conclude", "--experiment-id", "exp-1", "--treatment-id", "treat-1", "--apply-winner", "--confirm"},
+			wantErr: "Error: --version-id is required with --apply-winner",
+		},
+		{
+			name:    "conclude invalid min confidence",
+			args:    []string{"product-pages", "experiments", "conclude", "--experiment-id", "exp-1", "--app", "app-1", "--min-confidence", "150", "--confirm"},
+			wantErr: "Error: --min-confidence must be between 0 and 100",
+		},
+		{
+			name:    "conclude missing confirm",
+			args:    []string{"product-pages", "experiments", "conclude", "--experiment-id", "exp-1", "--app", "app-1"},
+			wantErr: "Error: --confirm is required",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				err := root.Run(context.Background())
+				if !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func TestProductPagesExperimentsReportWithoutAnalytics(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet {
+			t.Fatalf("expected GET, got %s", req.Method)
+		}
+		switch req.URL.Path {
+		case "/v1/appStoreVersionExperiments/exp-1":
+			return jsonResponse(http.StatusOK, `{"data":{"type":"appStoreVersionExperiments","id":"exp-1","attributes":{"name":"Icon Test","state":"ACCEPTED","trafficProportion":50}}}`)
+		case "/v1/appStoreVersionExperiments/exp-1/appStoreVersionExperimentTreatments":
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"appStoreVersionExperimentTreatments","id":"treat-1","attributes":{"name":"Bold Icon"}}]}`)
+		case "/v1/apps/app-1/analyticsReportRequests":
+			return jsonResponse(http.StatusOK, `{"data":[]}`)
+		default:
+			t.Fatalf("unexpected path %s", req.URL.Path)
+			return nil, nil
+		}
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, stderr := captureOutput(t, func() {
+		if err := root.Parse([]string{"product-pages", "experiments", "report", "--experiment-id", "exp-1", "--app", "app-1"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	if stderr != "" {
+		t.Fatalf("expected empty stderr, got %q", stderr)
+	}
+
+	var report struct {
+		ExperimentID string `json:"experimentId"`
+		Analytics    struct {
+			Status string `json:"status"`
+			Reason string `json:"reason"`
+		} `json:"analytics"`
+		Treatments []struct {
+			ID         string   `json:"id"`
+			Name       string   `json:"name"`
+			Confidence *float64 `json:"confidence"`
+		} `json:"treatments"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &report); err != nil {
+		t.Fatalf("failed to parse output %q: %v", stdout, err)
+	}
+	if report.ExperimentID != "exp-1" {
+		t.Fatalf("expected experiment exp-1, got %q", report.ExperimentID)
+	}
+	if report.Analytics.Status != "unavailable" || report.Analytics.Reason == "" {
+		t.Fatalf("expected unavailable analytics with a reason, got %+v", report.Analytics)
+	}
+	if len(report.Treatments) != 1 || report.Treatments[0].ID != "treat-1" || report.Treatments[0].Confidence != nil {
+		t.Fatalf("unexpected treatments %+v", report.Treatments)
+	}
+}
+
+func TestProductPagesExperimentsReportCountsOverlappingRequestsOnce(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	rows := "Date\tExperiment ID\tTreatment\tImpressions\tConversions\n" +
+		"2026-10-01\texp-1\tOriginal\t1000\t100\n" +
+		"2026-10-01\texp-1\ttreat-1\t1000\t150\n"
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch req.URL.Path {
+		case "/v1/appStoreVersionExperiments/exp-1":
+			return jsonResponse(http.StatusOK, `{"data":{"type":"appStoreVersionExperiments","id":"exp-1","attributes":{"name":"Icon Test","state":"ACCEPTED"}}}`)
+		case "/v1/appStoreVersionExperiments/exp-1/appStoreVersionExperimentTreatments":
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"appStoreVersionExperimentTreatments","id":"treat-1","attributes":{"name":"Bold Icon"}}]}`)
+		case "/v1/apps/app-1/analyticsReportRequests":
+			return jsonResponse(http.StatusOK, `{"data":[`+
+				`{"type":"analyticsReportRequests","id":"req-ongoing","attributes":{"accessType":"ONGOING"}},`+
+				`{"type":"analyticsReportRequests","id":"req-snapshot","attributes":{"accessType":"ONE_TIME_SNAPSHOT"}}]}`)
+		case "/v1/analyticsReportRequests/req-ongoing/reports", "/v1/analyticsReportRequests/req-snapshot/reports":
+			requestID := strings.Split(req.URL.Path, "/")[3]
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"analyticsReports","id":"report-`+requestID+`","attributes":{"name":"App Store Product Page Optimization","category":"APP_STORE_ENGAGEMENT"}}]}`)
+		case "/v1/analyticsReports/report-req-ongoing/instances", "/v1/analyticsReports/report-req-snapshot/instances":
+			reportID := strings.Split(req.URL.Path, "/")[3]
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"analyticsReportInstances","id":"instance-`+reportID+`","attributes":{"granularity":"DAILY","processingDate":"2026-10-01"}}]}`)
+		case "/v1/analyticsReportInstances/instance-report-req-ongoing/segments", "/v1/analyticsReportInstances/instance-report-req-snapshot/segments":
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"analyticsReportSegments","id":"segment-1","attributes":{"url":"https://analytics.apple.com/segment-1.gz"}}]}`)
+		case "/segment-1.gz":
+			return insightsGzipResponse(rows), nil
+		default:
+			t.Fatalf("unexpected path %s", req.URL.Path)
+			return nil, nil
+		}
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"product-pages", "experiments", "report", "--experiment-id", "exp-1", "--app", "app-1"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if err := root.Run(context.Background()); err != nil {
+			t.Fatalf("run error: %v", err)
+		}
+	})
+
+	var report struct {
+		Analytics struct {
+			Status    string   `json:"status"`
+			Reports   []string `json:"reports"`
+			Instances int      `json:"instances"`
+		} `json:"analytics"`
+		Baseline struct {
+			Impressions *int `json:"impressions"`
+		} `json:"baseline"`
+		Treatments []struct {
+			Impressions *int `json:"impressions"`
+			Conversions *int `json:"conversions"`
+		} `json:"treatments"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &report); err != nil {
+		t.Fatalf("failed to parse output %q: %v", stdout, err)
+	}
+	if report.Analytics.Status != "ok" || report.Analytics.Instances != 1 || len(report.Analytics.Reports) != 1 {
+		t.Fatalf("expected one deduplicated instance, got %+v", report.Analytics)
+	}
+	if report.Baseline.Impressions == nil || *report.Baseline.Impressions != 1000 {
+		t.Fatalf("expected baseline impressions 1000, got %v", report.Baseline.Impressions)
+	}
+	if len(report.Treatments) != 1 || report.Treatments[0].Conversions == nil || *report.Treatments[0].Conversions != 150 {
+		t.Fatalf("unexpected treatments %s", stdout)
+	}
+}
+
+func TestProductPagesExperimentsConcludeDownloadsAllLocalesBeforeReplacing(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_APP_ID", "")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	var previewDetails []string
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method != http.MethodGet {
+			t.Fatalf("expected no changes before all media is downloaded, got %s %s", req.Method, req.URL.Path)
+		}
+		switch req.URL.Path {
+		case "/v1/appStoreVersionExperiments/exp-1":
+			return jsonResponse(http.StatusOK, `{"data":{"type":"appStoreVersionExperiments","id":"exp-1","attributes":{"name":"Icon Test","state":"ACCEPTED"}}}`)
+		case "/v1/appStoreVersionExperiments/exp-1/appStoreVersionExperimentTreatments":
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"appStoreVersionExperimentTreatments","id":"treat-1","attributes":{"name":"Bold Icon"}}]}`)
+		case "/v1/appStoreVersionExperimentTreatments/treat-1/appStoreVersionExperimentTreatmentLocalizations":
+			return jsonResponse(http.StatusOK, `{"data":[`+
+				`{"type":"appStoreVersionExperimentTreatmentLocalizations","id":"tloc-en","attributes":{"locale":"en-US"}},`+
+				`{"type":"appStoreVersionExperimentTreatmentLocalizations","id":"tloc-fr","attributes":{"locale":"fr-FR"}}]}`)
+		case "/v1/appStoreVersions/ver-1/appStoreVersionLocalizations":
+			return jsonResponse(http.StatusOK, `{"data":[`+
+				`{"type":"appStoreVersionLocalizations","id":"vloc-en","attributes":{"locale":"en-US"}},`+
+				`{"type":"appStoreVersionLocalizations","id":"vloc-fr","attributes":{"locale":"fr-FR"}}]}`)
+		case "/v1/appStoreVersionExperimentTreatmentLocalizations/tloc-en/appScreenshotSets",
+			"/v1/appStoreVersionExperimentTreatmentLocalizations/tloc-fr/appScreenshotSets":
+			return jsonResponse(http.StatusOK, `{"data":[]}`)
+		case "/v1/appStoreVersionExperimentTreatmentLocalizations/tloc-en/appPreviewSets":
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"appPreviewSets","id":"pset-en","attributes":{"previewType":"IPHONE_67"}}]}`)
+		case "/v1/appStoreVersionExperimentTreatmentLocalizations/tloc-fr/appPreviewSets":
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"appPreviewSets","id":"pset-fr","attributes":{"previewType":"IPHONE_67"}}]}`)
+		case "/v1/appPreviewSets/pset-en/appPreviews":
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"appPreviews","id":"prev-en","attributes":{"fileName":"en.mov"}}]}`)
+		case "/v1/appPreviewSets/pset-fr/appPreviews":
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"appPreviews","id":"prev-fr","attributes":{"fileName":"fr.mov"}}]}`)
+		case "/v1/appPreviews/prev-en":
+			previewDetails = append(previewDetails, "prev-en")
+			return jsonResponse(http.StatusOK, `{"data":{"type":"appPreviews","id":"prev-en","attributes":{"fileName":"en.mov","videoUrl":"https://media.example.com/en.mov"}}}`)
+		case "/v1/appPreviews/prev-fr":
+			previewDetails = append(previewDetails, "prev-fr")
+			return jsonResponse(http.StatusOK, `{"data":{"type":"appPreviews","id":"prev-fr","attributes":{"fileName":"fr.mov"}}}`)
+		case "/en.mov":
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"video/quicktime"}},
+				Body:       io.NopCloser(strings.NewReader("video")),
+			}, nil
+		default:
+			t.Fatalf("unexpected path %s", req.URL.Path)
+			return nil, nil
+		}
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	captureOutput(t, func() {
+		if err := root.Parse([]string{"product-pages", "experiments", "conclude", "--experiment-id", "exp-1", "--treatment-id", "treat-1", "--version-id", "ver-1", "--apply-winner", "--confirm"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	if runErr == nil || !strings.Contains(runErr.Error(), "failed to download fr-FR previews: preview prev-fr has no videoUrl") {
+		t.Fatalf("expected fr-FR download error, got %v", runErr)
+	}
+	if strings.Join(previewDetails, ",") != "prev-en,prev-fr" {
+		t.Fatalf("expected previews without videoUrl to be fetched individually, got %v", previewDetails)
+	}
+}
+
+func TestProductPagesExperimentsConcludeReportsAppliedWinnerWhenStopFails(t *testing.T) {
+	setupAuth(t)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv("ASC_APP_ID", "")
+
+	originalTransport := http.DefaultTransport
+	t.Cleanup(func() {
+		http.DefaultTransport = originalTransport
+	})
+
+	http.DefaultTransport = roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch req.URL.Path {
+		case "/v1/appStoreVersionExperiments/exp-1":
+			if req.Method == http.MethodPatch {
+				return jsonResponse(http.StatusConflict, `{"errors":[{"status":"409","code":"CONFLICT","title":"Conflict","detail":"experiment is locked"}]}`)
+			}
+			return jsonResponse(http.StatusOK, `{"data":{"type":"appStoreVersionExperiments","id":"exp-1","attributes":{"name":"Icon Test","state":"IN_REVIEW"}}}`)
+		case "/v1/appStoreVersionExperiments/exp-1/appStoreVersionExperimentTreatments":
+			return jsonResponse(http.StatusOK, `{"data":[{"type":"appStoreVersionExperimentTreatments","id":"treat-1","attributes":{"name":"Bold Icon"}}]}`)
+		case "/v1/appStoreVersionExperimentTreatments/treat-1/appStoreVersionExperimentTreatmentLocalizations":
+			return jsonResponse(http.StatusOK, `{"data":[]}`)
+		case "/v1/appStoreVersions/ver-1/appStoreVersionLocalizations":
+			return jsonResponse(http.StatusOK, `{"data":[]}`)
+		default:
+			t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+			return nil, nil
+		}
+	})
+
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+
+	var runErr error
+	stdout, _ := captureOutput(t, func() {
+		if err := root.Parse([]string{"product-pages", "experiments", "conclude", "--experiment-id", "exp-1", "--treatment-id", "treat-1", "--version-id", "ver-1", "--apply-winner", "--confirm"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		runErr = root.Run(context.Background())
+	})
+
+	if _, ok := errors.AsType[ReportedError](runErr); !ok || !strings.Contains(runErr.Error(), "failed to stop experiment") {
+		t.Fatalf("expected reported stop error, got %v", runErr)
+	}
+	var result struct {
+		WinnerID  string `json:"winnerTreatmentId"`
+		VersionID string `json:"versionId"`
+		Stopped   bool   `json:"stopped"`
+		Error     string `json:"error"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("failed to parse output %q: %v", stdout, err)
+	}
+	if result.WinnerID != "treat-1" || result.VersionID != "ver-1" || result.Stopped || !strings.Contains(result.Error, "experiment is locked") {
+		t.Fatalf("unexpected conclusion %+v", result)
+	}
+}
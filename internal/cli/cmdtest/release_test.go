@@ -0,0 +1,75 @@
+package cmdtest
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"io"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestReleaseValidationErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "missing config",
+			args:    []string{"release", "--confirm"},
+			wantErr: "Error: --config is required",
+		},
+		{
+			name:    "missing confirm",
+			args:    []string{"release", "--config", "release.yaml"},
+			wantErr: "Error: --confirm is required to execute a release (use --plan to preview)",
+		},
+		{
+			name:    "unsupported output",
+			args:    []string{"release", "--config", "release.yaml", "--plan", "--output", "github"},
+			wantErr: "Error: unsupported format: github",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			root := RootCommand("1.2.3")
+			root.FlagSet.SetOutput(io.Discard)
+
+			stdout, stderr := captureOutput(t, func() {
+				if err := root.Parse(test.args); err != nil {
+					t.Fatalf("parse error: %v", err)
+				}
+				err := root.Run(context.Background())
+				if !errors.Is(err, flag.ErrHelp) {
+					t.Fatalf("expected ErrHelp, got %v", err)
+				}
+			})
+
+			if stdout != "" {
+				t.Fatalf("expected empty stdout, got %q", stdout)
+			}
+			if !strings.Contains(stderr, test.wantErr) {
+				t.Fatalf("expected error %q, got %q", test.wantErr, stderr)
+			}
+		})
+	}
+}
+
+func TestReleaseReportsMissingConfigFile(t *testing.T) {
+	root := RootCommand("1.2.3")
+	root.FlagSet.SetOutput(io.Discard)
+	path := filepath.Join(t.TempDir(), "release.yaml")
+
+	captureOutput(t, func() {
+		if err := root.Parse([]string{"release", "--config", path, "--plan"}); err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		err := root.Run(context.Background())
+		if err == nil || !strings.Contains(err.Error(), "release: read config:") {
+			t.Fatalf("expected read config error, got %v", err)
+		}
+	})
+}
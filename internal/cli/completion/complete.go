@@ -0,0 +1,270 @@
+package completion
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/plugins"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// CompleteCommandName is the hidden command the shell scripts call.
+const CompleteCommandName = "__complete"
+
+// Candidate is a single completion suggestion.
+type Candidate struct {
+	Value       string `json:"value"`
+	Description string `json:"description,omitempty"`
+}
+
+// valueSource supplies live flag values from the API.
+type valueSource interface {
+	Values(ctx context.Context, kind string, typed map[string]string) []Candidate
+}
+
+// CompleteCommand returns the hidden __complete command. Shell scripts call
+// `asc __complete -- <words...>` with the words after "asc", the last being
+// the word under the cursor, and read one "value<TAB>description" per line.
+func CompleteCommand(root *ffcli.Command) *ffcli.Command {
+	fs := flag.NewFlagSet(CompleteCommandName, flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       CompleteCommandName,
+		ShortUsage: "asc __complete -- <words...>",
+		ShortHelp:  "Print completion candidates (used by shell completion scripts).",
+		FlagSet:    fs,
+		UsageFunc:  shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			for _, candidate := range Complete(ctx, root, args, newLiveValues()) {
+				if candidate.Description == "" {
+					fmt.Fprintln(os.Stdout, candidate.Value)
+					continue
+				}
+				fmt.Fprintf(os.Stdout, "%s\t%s\n", candidate.Value, oneLine(candidate.Description))
+			}
+			return nil
+		},
+	}
+}
+
+// Complete returns candidates for the last word in words, given the words
+// before it. live may be nil to skip API lookups.
+func Complete(ctx context.Context, root *ffcli.Command, words []string, live valueSource) []Candidate {
+	if len(words) == 0 {
+		words = []string{""}
+	}
+	current := root
+	typed := map[string]string{}
+	var pending *flag.Flag
+
+	prior, cur := words[:len(words)-1], words[len(words)-1]
+	for _, token := range prior {
+		if pending != nil {
+			// bash splits "--flag=value" into "--flag", "=", "value".
+			if token == "=" {
+				continue
+			}
+			typed[pending.Name] = token
+			pending = nil
+			continue
+		}
+		if strings.HasPrefix(token, "-") && token != "-" && token != "--" {
+			name, value, hasValue := strings.Cut(strings.TrimLeft(token, "-"), "=")
+			f := lookupFlag(current, name)
+			if f == nil {
+				continue
+			}
+			if hasValue {
+				typed[f.Name] = value
+			} else if !isBoolFlag(f) {
+				pending = f
+			}
+			continue
+		}
+		if sub := findSubcommand(current, token); sub != nil {
+			current = sub
+		}
+	}
+
+	if pending != nil {
+		if cur == "=" {
+			cur = ""
+		}
+		return filterPrefix(flagValues(ctx, pending, typed, live), cur, "")
+	}
+	if strings.HasPrefix(cur, "-") {
+		trimmed := strings.TrimLeft(cur, "-")
+		if name, value, ok := strings.Cut(trimmed, "="); ok {
+			f := lookupFlag(current, name)
+			if f == nil {
+				return nil
+			}
+			return filterPrefix(flagValues(ctx, f, typed, live), value, cur[:len(cur)-len(value)])
+		}
+		return filterPrefix(flagNames(current), cur, "")
+	}
+	return filterPrefix(subcommandNames(current, current == root), cur, "")
+}
+
+func lookupFlag(cmd *ffcli.Command, name string) *flag.Flag {
+	if cmd == nil || cmd.FlagSet == nil || name == "" {
+		return nil
+	}
+	return cmd.FlagSet.Lookup(name)
+}
+
+func findSubcommand(cmd *ffcli.Command, name string) *ffcli.Command {
+	for _, sub := range cmd.Subcommands {
+		if sub != nil && strings.EqualFold(sub.Name, name) {
+			return sub
+		}
+	}
+	return nil
+}
+
+func isBoolFlag(f *flag.Flag) bool {
+	boolFlag, ok := f.Value.(interface{ IsBoolFlag() bool })
+	return ok && boolFlag.IsBoolFlag()
+}
+
+func subcommandNames(cmd *ffcli.Command, includePlugins bool) []Candidate {
+	candidates := make([]Candidate, 0, len(cmd.Subcommands))
+	seen := map[string]bool{}
+	for _, sub := range cmd.Subcommands {
+		if sub == nil || strings.HasPrefix(sub.Name, "__") || seen[sub.Name] {
+			continue
+		}
+		seen[sub.Name] = true
+		candidates = append(candidates, Candidate{Value: sub.Name, Description: sub.ShortHelp})
+	}
+	if includePlugins {
+		for _, name := range plugins.Names(plugins.Dirs()) {
+			if !seen[name] {
+				seen[name] = true
+				candidates = append(candidates, Candidate{Value: name, Description: "Plugin"})
+			}
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i].Value < candidates[j].Value })
+	return candidates
+}
+
+func flagNames(cmd *ffcli.Command) []Candidate {
+	if cmd.FlagSet == nil {
+		return nil
+	}
+	var candidates []Candidate
+	cmd.FlagSet.VisitAll(func(f *flag.Flag) {
+		candidates = append(candidates, Candidate{Value: "--" + f.Name, Description: f.Usage})
+	})
+	return candidates
+}
+
+// flagValues returns the known values for a flag: enums from the flag's usage
+// text and known catalogs first, then live API values.
+func flagValues(ctx context.Context, f *flag.Flag, typed map[string]string, live valueSource) []Candidate {
+	if values := staticFlagValues(f); len(values) > 0 {
+		return values
+	}
+	kind := shared.FlagReferenceKind(f)
+	if kind == "" && f.Name == "version" {
+		switch {
+		case strings.HasPrefix(f.Usage, "App Store version ID"):
+			kind = shared.ReferenceVersionID
+		case strings.Contains(strings.ToLower(f.Usage), "version string"):
+			kind = liveVersionString
+		}
+	}
+	var candidates []Candidate
+	if kind == shared.ReferenceBuild {
+		candidates = append(candidates, Candidate{Value: "latest", Description: "Most recently uploaded build"})
+	}
+	if kind != "" && live != nil {
+		candidates = append(candidates, live.Values(ctx, kind, typed)...)
+	}
+	return candidates
+}
+
+// enumListPattern matches usage text such as "Platform: IOS, MAC_OS, or TV_OS"
+// or "Output format: json (default), table, markdown".
+var (
+	enumListPattern = regexp.MustCompile(`:\s*(\w+(?:\s*\(default\))?(?:\s*[,|]\s*(?:or\s+)?\w+(?:\s*\(default\))?|\s+or\s+\w+)+)`)
+	enumWordPattern = regexp.MustCompile(`\w+`)
+)
+
+func staticFlagValues(f *flag.Flag) []Candidate {
+	if isBoolFlag(f) {
+		return []Candidate{{Value: "true"}, {Value: "false"}}
+	}
+	switch {
+	case f.Name == "display-type":
+		return candidatesFrom(asc.ScreenshotDisplayTypes())
+	case f.Name == "device-type" && strings.Contains(f.Usage, "IPHONE_65"):
+		return candidatesFrom(screenshotDeviceTypes())
+	}
+
+	match := enumListPattern.FindStringSubmatch(f.Usage)
+	if match == nil {
+		if f.Name == "platform" {
+			return candidatesFrom(shared.PlatformList())
+		}
+		return nil
+	}
+	var values []string
+	for _, word := range enumWordPattern.FindAllString(match[1], -1) {
+		if word == "or" || word == "default" {
+			continue
+		}
+		values = append(values, word)
+	}
+	return candidatesFrom(values)
+}
+
+// screenshotDeviceTypes lists screenshot display types in the short form
+// accepted by --device-type ("IPHONE_65" for "APP_IPHONE_65").
+func screenshotDeviceTypes() []string {
+	types := asc.ScreenshotDisplayTypes()
+	values := make([]string, 0, len(types))
+	for _, displayType := range types {
+		values = append(values, strings.TrimPrefix(displayType, "APP_"))
+	}
+	return values
+}
+
+func candidatesFrom(values []string) []Candidate {
+	candidates := make([]Candidate, 0, len(values))
+	for _, value := range values {
+		candidates = append(candidates, Candidate{Value: value})
+	}
+	return candidates
+}
+
+// filterPrefix keeps candidates starting with prefix (case-insensitively)
+// and prepends insert to each value.
+func filterPrefix(candidates []Candidate, prefix, insert string) []Candidate {
+	filtered := make([]Candidate, 0, len(candidates))
+	lower := strings.ToLower(prefix)
+	for _, candidate := range candidates {
+		if strings.HasPrefix(strings.ToLower(candidate.Value), lower) {
+			candidate.Value = insert + candidate.Value
+			filtered = append(filtered, candidate)
+		}
+	}
+	return filtered
+}
+
+func oneLine(value string) string {
+	value = strings.Join(strings.Fields(value), " ")
+	if len(value) > 80 {
+		value = value[:77] + "..."
+	}
+	return value
+}
@@ -0,0 +1,195 @@
+package completion
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+type fakeValueSource struct {
+	kind  string
+	typed map[string]string
+}
+
+func (f *fakeValueSource) Values(_ context.Context, kind string, typed map[string]string) []Candidate {
+	f.kind = kind
+	f.typed = typed
+	return []Candidate{{Value: "live-" + kind}}
+}
+
+func testCompletionTree() *ffcli.Command {
+	listFlags := flag.NewFlagSet("list", flag.ContinueOnError)
+	shared.ReferenceFlag(listFlags, shared.ReferenceApp, "", "App Store Connect app ID (or ASC_APP_ID env)")
+	listFlags.String("platform", "", "Platform: IOS, MAC_OS, TV_OS, VISION_OS")
+	listFlags.String("output", "json", "Output format: json (default), table, markdown")
+	shared.ReferenceFlag(listFlags, shared.ReferenceBetaGroup, "", "Beta group name or ID")
+	shared.ReferenceFlag(listFlags, shared.ReferenceBuild, "", "Build ID")
+	listFlags.Bool("paginate", false, "Automatically fetch all pages")
+
+	builds := &ffcli.Command{
+		Name:        "builds",
+		ShortHelp:   "Manage builds.",
+		FlagSet:     flag.NewFlagSet("builds", flag.ContinueOnError),
+		Subcommands: []*ffcli.Command{{Name: "list", ShortHelp: "List builds.", FlagSet: listFlags}},
+	}
+	rootFlags := flag.NewFlagSet("asc", flag.ContinueOnError)
+	rootFlags.String("profile", "", "Use named authentication profile")
+	return &ffcli.Command{
+		Name:    "asc",
+		FlagSet: rootFlags,
+		Subcommands: []*ffcli.Command{
+			builds,
+			{Name: "apps", ShortHelp: "Manage apps."},
+			{Name: CompleteCommandName},
+		},
+	}
+}
+
+func candidateValues(candidates []Candidate) []string {
+	values := make([]string, 0, len(candidates))
+	for _, candidate := range candidates {
+		values = append(values, candidate.Value)
+	}
+	return values
+}
+
+func TestComplete(t *testing.T) {
+	t.Setenv("PATH", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+
+	tests := []struct {
+		name  string
+		words []string
+		want  []string
+	}{
+		{name: "root subcommands hide internal commands", words: []string{""}, want: []string{"apps", "builds"}},
+		{name: "root prefix", words: []string{"b"}, want: []string{"builds"}},
+		{name: "nested subcommand", words: []string{"builds", ""}, want: []string{"list"}},
+		{name: "root flags", words: []string{"--pro"}, want: []string{"--profile"}},
+		{name: "leaf flags", words: []string{"--profile", "dev", "builds", "list", "--p"}, want: []string{"--paginate", "--platform"}},
+		{name: "enum values", words: []string{"builds", "list", "--platform", "m"}, want: []string{"MAC_OS"}},
+		{name: "inline enum values", words: []string{"builds", "list", "--output=ta"}, want: []string{"--output=table"}},
+		{name: "bash split equals", words: []string{"builds", "list", "--output", "=", "m"}, want: []string{"markdown"}},
+		{name: "bool flag takes no value", words: []string{"builds", "list", "--paginate", "--ou"}, want: []string{"--output"}},
+		{name: "build latest", words: []string{"builds", "list", "--build", "la"}, want: []string{"latest"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := candidateValues(Complete(context.Background(), testCompletionTree(), test.words, nil))
+			if !reflect.DeepEqual(got, test.want) {
+				t.Fatalf("Complete(%q) = %v, want %v", test.words, got, test.want)
+			}
+		})
+	}
+}
+
+func TestCompleteLiveValuesReceiveTypedFlags(t *testing.T) {
+	live := &fakeValueSource{}
+	got := candidateValues(Complete(context.Background(), testCompletionTree(), []string{"builds", "list", "--app", "123", "--group", ""}, live))
+	if !reflect.DeepEqual(got, []string{"live-group"}) {
+		t.Fatalf("unexpected candidates %v", got)
+	}
+	if live.typed["app"] != "123" {
+		t.Fatalf("expected typed app 123, got %v", live.typed)
+	}
+}
+
+func TestStaticFlagValuesFromUsage(t *testing.T) {
+	tests := []struct {
+		usage string
+		want  []string
+	}{
+		{usage: "Shell: bash, zsh, fish, or powershell", want: []string{"bash", "zsh", "fish", "powershell"}},
+		{usage: "Report format version: 1_0 (default), 1_1, 1_3", want: []string{"1_0", "1_1", "1_3"}},
+		{usage: "Transport: stdio or http", want: []string{"stdio", "http"}},
+		{usage: "App Store Connect app ID (or ASC_APP_ID env)", want: nil},
+		{usage: "Sort by uploadedDate or -uploadedDate", want: nil},
+	}
+	for _, test := range tests {
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		fs.String("value", "", test.usage)
+		got := candidateValues(staticFlagValues(fs.Lookup("value")))
+		if len(got) == 0 {
+			got = nil
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Fatalf("staticFlagValues(%q) = %v, want %v", test.usage, got, test.want)
+		}
+	}
+}
+
+type fakeLiveClient struct {
+	appsCalls int
+}
+
+func (c *fakeLiveClient) GetApps(_ context.Context, _ ...asc.AppsOption) (*asc.AppsResponse, error) {
+	c.appsCalls++
+	return &asc.AppsResponse{Data: []asc.Resource[asc.AppAttributes]{
+		{ID: "111", Attributes: asc.AppAttributes{Name: "Demo", BundleID: "com.example.demo"}},
+	}}, nil
+}
+
+func (c *fakeLiveClient) GetAppStoreVersions(_ context.Context, _ string, _ ...asc.AppStoreVersionsOption) (*asc.AppStoreVersionsResponse, error) {
+	return &asc.AppStoreVersionsResponse{Data: []asc.Resource[asc.AppStoreVersionAttributes]{
+		{ID: "v-ios", Attributes: asc.AppStoreVersionAttributes{VersionString: "1.0", Platform: "IOS"}},
+		{ID: "v-mac", Attributes: asc.AppStoreVersionAttributes{VersionString: "1.0", Platform: "MAC_OS"}},
+	}}, nil
+}
+
+func (c *fakeLiveClient) GetBetaGroups(_ context.Context, _ string, _ ...asc.BetaGroupsOption) (*asc.BetaGroupsResponse, error) {
+	return &asc.BetaGroupsResponse{Data: []asc.Resource[asc.BetaGroupAttributes]{
+		{ID: "group-1", Attributes: asc.BetaGroupAttributes{Name: "Internal"}},
+	}}, nil
+}
+
+func TestLiveValuesUseShortTTLCache(t *testing.T) {
+	t.Setenv("ASC_APP_ID", "")
+	client := &fakeLiveClient{}
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	live := &liveValues{
+		path: filepath.Join(t.TempDir(), "cache", "completion-default.json"),
+		now:  func() time.Time { return now },
+		newClient: func() (liveClient, error) {
+			return client, nil
+		},
+	}
+
+	apps := live.Values(context.Background(), "app", nil)
+	if len(apps) != 1 || apps[0].Value != "111" || apps[0].Description != "Demo (com.example.demo)" {
+		t.Fatalf("unexpected apps %+v", apps)
+	}
+
+	live.newClient = func() (liveClient, error) { return nil, errors.New("offline") }
+	if cached := live.Values(context.Background(), "app", nil); len(cached) != 1 {
+		t.Fatalf("expected cached apps, got %+v", cached)
+	}
+
+	now = now.Add(liveCacheTTL)
+	if expired := live.Values(context.Background(), "app", nil); len(expired) != 0 {
+		t.Fatalf("expected expired cache and failed fetch to give no candidates, got %+v", expired)
+	}
+
+	live.newClient = func() (liveClient, error) { return client, nil }
+	typed := map[string]string{"app": "111"}
+	if got := candidateValues(live.Values(context.Background(), "group", typed)); !reflect.DeepEqual(got, []string{"Internal"}) {
+		t.Fatalf("unexpected groups %v", got)
+	}
+	if got := candidateValues(live.Values(context.Background(), liveVersionString, typed)); !reflect.DeepEqual(got, []string{"1.0"}) {
+		t.Fatalf("unexpected version strings %v", got)
+	}
+	if got := candidateValues(live.Values(context.Background(), "version-id", typed)); !reflect.DeepEqual(got, []string{"v-ios", "v-mac"}) {
+		t.Fatalf("unexpected version IDs %v", got)
+	}
+	if got := live.Values(context.Background(), "group", map[string]string{"app": "com.example.demo"}); got != nil {
+		t.Fatalf("expected no group candidates without a numeric app ID, got %v", got)
+	}
+}
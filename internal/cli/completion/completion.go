@@ -5,7 +5,6 @@ import (
 	"flag"
 	"fmt"
 	"os"
-	"sort"
 	"strings"
 
 	"github.com/peterbourgon/ff/v3/ffcli"
@@ -14,17 +13,30 @@ import (
 )
 
 // CompletionCommand prints shell completion scripts to stdout.
-// It is intentionally simple and does not require auth or network access.
-func CompletionCommand(rootSubcommands []*ffcli.Command) *ffcli.Command {
+// The scripts call the hidden `asc __complete` command, so completions always
+// follow the installed command tree.
+func CompletionCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("completion", flag.ExitOnError)
-	shell := fs.String("shell", "", "Shell: bash, zsh, or fish")
+	shell := fs.String("shell", "", "Shell: bash, zsh, fish, or powershell")
 
 	cmd := &ffcli.Command{
 		Name:       "completion",
-		ShortUsage: "asc completion --shell <bash|zsh|fish>",
+		ShortUsage: "asc completion --shell <bash|zsh|fish|powershell>",
 		ShortHelp:  "Print shell completion scripts.",
-		FlagSet:    fs,
-		UsageFunc:  shared.DefaultUsageFunc,
+		LongHelp: `Print shell completion scripts.
+
+Completes subcommands, flags and flag values at any depth. Enum values such as
+platforms, output formats and screenshot device types complete offline. App
+IDs, beta group names and versions are fetched from App Store Connect on
+demand and cached for a few minutes per profile.
+
+Examples:
+  source <(asc completion --shell bash)
+  asc completion --shell zsh > "${fpath[1]}/_asc"
+  asc completion --shell fish > ~/.config/fish/completions/asc.fish
+  asc completion --shell powershell | Out-String | Invoke-Expression`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
 	}
 
 	cmd.Exec = func(ctx context.Context, args []string) error {
@@ -37,16 +49,18 @@ func CompletionCommand(rootSubcommands []*ffcli.Command) *ffcli.Command {
 			return flag.ErrHelp
 		}
 
-		names := rootCommandNames(rootSubcommands)
 		switch s {
 		case "bash":
-			fmt.Fprint(os.Stdout, bashScript(names))
+			fmt.Fprint(os.Stdout, bashScript())
 			return nil
 		case "zsh":
-			fmt.Fprint(os.Stdout, zshScript(names))
+			fmt.Fprint(os.Stdout, zshScript())
 			return nil
 		case "fish":
-			fmt.Fprint(os.Stdout, fishScript(names))
+			fmt.Fprint(os.Stdout, fishScript())
+			return nil
+		case "powershell", "pwsh":
+			fmt.Fprint(os.Stdout, powershellScript())
 			return nil
 		default:
 			fmt.Fprintf(os.Stderr, "Error: unsupported shell: %s\n", shared.SanitizeTerminal(s))
@@ -57,63 +71,77 @@ func CompletionCommand(rootSubcommands []*ffcli.Command) *ffcli.Command {
 	return cmd
 }
 
-func rootCommandNames(rootSubcommands []*ffcli.Command) []string {
-	set := make(map[string]struct{}, len(rootSubcommands)+1)
-	for _, c := range rootSubcommands {
-		if c == nil {
-			continue
-		}
-		name := strings.TrimSpace(c.Name)
-		if name == "" {
-			continue
-		}
-		set[name] = struct{}{}
-	}
-
-	// Ensure the completion command can complete itself even if the slice passed
-	// doesn't include it (by design).
-	set["completion"] = struct{}{}
-
-	names := make([]string, 0, len(set))
-	for name := range set {
-		names = append(names, name)
-	}
-	sort.Strings(names)
-	return names
-}
-
-func bashScript(subcommands []string) string {
-	words := strings.Join(subcommands, " ")
-	return fmt.Sprintf(`# bash completion for asc
+func bashScript() string {
+	return `# bash completion for asc
 _asc_completions() {
-  local cur
-  COMPREPLY=()
-  cur="${COMP_WORDS[COMP_CWORD]}"
-
-  if [[ $COMP_CWORD -eq 1 ]]; then
-    COMPREPLY=( $(compgen -W "%s" -- "$cur") )
-    return 0
+  local cur="${COMP_WORDS[COMP_CWORD]}"
+  local IFS=$'\n'
+  local candidates
+  candidates=$(asc __complete -- "${COMP_WORDS[@]:1:COMP_CWORD}" 2>/dev/null | cut -f1)
+  if [[ "$cur" == "=" ]]; then
+    cur=""
+  fi
+  COMPREPLY=( $(compgen -W "${candidates}" -- "$cur") )
+  # Fall back to file names for flags such as --path.
+  if [[ ${#COMPREPLY[@]} -eq 0 ]]; then
+    compopt -o default 2>/dev/null
   fi
 }
 
 complete -F _asc_completions asc
-`, words)
+`
+}
+
+func zshScript() string {
+	return `#compdef asc
+
+_asc() {
+  local -a candidates
+  local value desc
+  while IFS=$'\t' read -r value desc; do
+    value=${value//:/\\:}
+    if [[ -n "$desc" ]]; then
+      candidates+=("${value}:${desc}")
+    else
+      candidates+=("${value}")
+    fi
+  done < <(asc __complete -- "${(@)words[2,CURRENT]}" 2>/dev/null)
+  _describe 'asc' candidates
+}
+
+if [[ "${funcstack[1]}" == "_asc" ]]; then
+  _asc "$@"
+else
+  compdef _asc asc
+fi
+`
 }
 
-func zshScript(subcommands []string) string {
-	// zsh _arguments wants a space-separated list inside ((...))
-	words := strings.Join(subcommands, " ")
-	return fmt.Sprintf(`#compdef asc
+func fishScript() string {
+	return `# fish completion for asc
+function __asc_complete
+    set -l tokens (commandline -opc) (commandline -ct)
+    asc __complete -- $tokens[2..-1] 2>/dev/null
+end
 
-_arguments \
-  '1:command:(%s)' \
-  '*::arg:->args'
-`, words)
+complete -c asc -f -a '(__asc_complete)'
+`
 }
 
-func fishScript(subcommands []string) string {
-	words := strings.Join(subcommands, " ")
-	return fmt.Sprintf(`# fish completion for asc
-complete -c asc -f -a '%s'
-`, words)
+func powershellScript() string {
+	return `# PowerShell completion for asc
+Register-ArgumentCompleter -Native -CommandName asc -ScriptBlock {
+    param($wordToComplete, $commandAst, $cursorPosition)
+    $words = @($commandAst.CommandElements |
+        Where-Object { $_.Extent.StartOffset -lt $cursorPosition } |
+        Select-Object -Skip 1 |
+        ForEach-Object { $_.ToString() })
+    if ($wordToComplete -eq '') { $words += '' }
+    asc __complete -- @words 2>$null | ForEach-Object {
+        $value, $description = $_ -split "` + "`" + `t", 2
+        if (-not $description) { $description = $value }
+        [System.Management.Automation.CompletionResult]::new($value, $value, 'ParameterValue', $description)
+    }
+}
+`
 }
@@ -9,35 +9,10 @@ import (
 	"os"
 	"strings"
 	"testing"
-
-	"github.com/peterbourgon/ff/v3/ffcli"
 )
 
-func TestRootCommandNamesSortedAndDeduplicated(t *testing.T) {
-	names := rootCommandNames([]*ffcli.Command{
-		{Name: "apps"},
-		{Name: "builds"},
-		{Name: "apps"},
-		nil,
-		{Name: "   "},
-	})
-
-	expected := []string{"apps", "builds", "completion"}
-	if len(names) != len(expected) {
-		t.Fatalf("unexpected names length: got %d want %d (%v)", len(names), len(expected), names)
-	}
-	for i := range expected {
-		if names[i] != expected[i] {
-			t.Fatalf("unexpected names[%d]: got %q want %q", i, names[i], expected[i])
-		}
-	}
-}
-
 func TestCompletionCommandValidationAndOutput(t *testing.T) {
-	cmd := CompletionCommand([]*ffcli.Command{
-		{Name: "apps"},
-		{Name: "builds"},
-	})
+	cmd := CompletionCommand()
 
 	// Missing shell should fail with ErrHelp.
 	if err := cmd.FlagSet.Parse([]string{}); err != nil {
@@ -48,7 +23,7 @@ func TestCompletionCommandValidationAndOutput(t *testing.T) {
 	}
 
 	// Unsupported shell should fail with ErrHelp.
-	cmd = CompletionCommand([]*ffcli.Command{{Name: "apps"}})
+	cmd = CompletionCommand()
 	if err := cmd.FlagSet.Parse([]string{"--shell", "tcsh"}); err != nil {
 		t.Fatalf("failed to parse flags: %v", err)
 	}
@@ -57,7 +32,7 @@ func TestCompletionCommandValidationAndOutput(t *testing.T) {
 	}
 
 	// Supported shell should print script and succeed.
-	cmd = CompletionCommand([]*ffcli.Command{{Name: "apps"}, {Name: "builds"}})
+	cmd = CompletionCommand()
 	if err := cmd.FlagSet.Parse([]string{"--shell", "bash"}); err != nil {
 		t.Fatalf("failed to parse flags: %v", err)
 	}
@@ -70,15 +45,26 @@ func TestCompletionCommandValidationAndOutput(t *testing.T) {
 }
 
 func TestCompletionScriptHelpers(t *testing.T) {
-	if !strings.Contains(bashScript([]string{"apps"}), "apps") {
-		t.Fatalf("bash script missing command names")
+	scripts := map[string]string{
+		"bash":       bashScript(),
+		"zsh":        zshScript(),
+		"fish":       fishScript(),
+		"powershell": powershellScript(),
 	}
-	if !strings.Contains(zshScript([]string{"apps"}), "#compdef asc") {
+	for shell, script := range scripts {
+		if !strings.Contains(script, "asc __complete --") {
+			t.Fatalf("%s script does not call asc __complete", shell)
+		}
+	}
+	if !strings.Contains(zshScript(), "#compdef asc") {
 		t.Fatalf("zsh script missing compdef header")
 	}
-	if !strings.Contains(fishScript([]string{"apps"}), "complete -c asc") {
+	if !strings.Contains(fishScript(), "complete -c asc") {
 		t.Fatalf("fish script missing completion command")
 	}
+	if !strings.Contains(powershellScript(), "Register-ArgumentCompleter -Native -CommandName asc") {
+		t.Fatalf("powershell script missing argument completer")
+	}
 }
 
 func captureStdout(t *testing.T, fn func() error) string {
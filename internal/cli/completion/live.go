@@ -0,0 +1,204 @@
+package completion
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// liveVersionString marks --version flags that take a version string rather
+// than a version ID.
+const liveVersionString = "version-string"
+
+const (
+	// liveCacheTTL keeps completions responsive while typing a command
+	// without serving stale data for long.
+	liveCacheTTL = 5 * time.Minute
+	// liveFetchTimeout bounds how long a TAB press may wait on the API.
+	liveFetchTimeout = 5 * time.Second
+)
+
+var numericAppID = regexp.MustCompile(`^\d+$`)
+
+// liveClient is the API surface used for live completions.
+type liveClient interface {
+	shared.BetaGroupsClient
+	GetApps(ctx context.Context, opts ...asc.AppsOption) (*asc.AppsResponse, error)
+	GetAppStoreVersions(ctx context.Context, appID string, opts ...asc.AppStoreVersionsOption) (*asc.AppStoreVersionsResponse, error)
+}
+
+type liveCacheEntry struct {
+	FetchedAt  time.Time   `json:"fetchedAt"`
+	Candidates []Candidate `json:"candidates"`
+}
+
+// liveValues fetches app IDs, beta group names and versions for completion,
+// caching results per credential profile. Failures (no credentials, network
+// errors) produce no candidates rather than errors.
+type liveValues struct {
+	path      string
+	now       func() time.Time
+	newClient func() (liveClient, error)
+}
+
+func newLiveValues() *liveValues {
+	return &liveValues{
+		path: shared.CacheFilePath("completion"),
+		now:  time.Now,
+		newClient: func() (liveClient, error) {
+			return shared.GetASCClient()
+		},
+	}
+}
+
+// Values implements valueSource.
+func (l *liveValues) Values(ctx context.Context, kind string, typed map[string]string) []Candidate {
+	if kind == shared.ReferenceApp {
+		return l.cached(ctx, "apps", fetchApps)
+	}
+
+	appID := strings.TrimSpace(typed["app"])
+	if appID == "" {
+		appID = shared.ResolveAppID("")
+	}
+	if !numericAppID.MatchString(appID) {
+		return nil
+	}
+
+	switch kind {
+	case shared.ReferenceBetaGroup:
+		return l.cached(ctx, "groups:"+appID, func(ctx context.Context, client liveClient) ([]Candidate, error) {
+			return fetchBetaGroups(ctx, client, appID)
+		})
+	case shared.ReferenceVersionID:
+		return l.cached(ctx, "versions:"+appID, func(ctx context.Context, client liveClient) ([]Candidate, error) {
+			return fetchVersions(ctx, client, appID, false)
+		})
+	case liveVersionString:
+		return l.cached(ctx, "version-strings:"+appID, func(ctx context.Context, client liveClient) ([]Candidate, error) {
+			return fetchVersions(ctx, client, appID, true)
+		})
+	default:
+		return nil
+	}
+}
+
+func (l *liveValues) cached(ctx context.Context, key string, fetch func(context.Context, liveClient) ([]Candidate, error)) []Candidate {
+	entries := l.load()
+	if entry, ok := entries[key]; ok && l.now().Sub(entry.FetchedAt) < liveCacheTTL {
+		return entry.Candidates
+	}
+
+	client, err := l.newClient()
+	if err != nil {
+		return nil
+	}
+	fetchCtx, cancel := context.WithTimeout(ctx, liveFetchTimeout)
+	defer cancel()
+	candidates, err := fetch(fetchCtx, client)
+	if err != nil {
+		return nil
+	}
+
+	entries[key] = liveCacheEntry{FetchedAt: l.now().UTC(), Candidates: candidates}
+	l.save(entries)
+	return candidates
+}
+
+func fetchApps(ctx context.Context, client liveClient) ([]Candidate, error) {
+	resp, err := client.GetApps(ctx, asc.WithAppsLimit(200))
+	if err != nil {
+		return nil, err
+	}
+	candidates := make([]Candidate, 0, len(resp.Data))
+	for _, app := range resp.Data {
+		candidates = append(candidates, Candidate{
+			Value:       app.ID,
+			Description: fmt.Sprintf("%s (%s)", app.Attributes.Name, app.Attributes.BundleID),
+		})
+	}
+	return candidates, nil
+}
+
+func fetchBetaGroups(ctx context.Context, client liveClient, appID string) ([]Candidate, error) {
+	groups, err := shared.ListAllBetaGroups(ctx, client, appID)
+	if err != nil {
+		return nil, err
+	}
+	candidates := make([]Candidate, 0, len(groups.Data))
+	for _, group := range groups.Data {
+		candidates = append(candidates, Candidate{Value: group.Attributes.Name, Description: group.ID})
+	}
+	return candidates, nil
+}
+
+// fetchVersions lists App Store versions as IDs or, with asStrings set, as
+// unique version strings.
+func fetchVersions(ctx context.Context, client liveClient, appID string, asStrings bool) ([]Candidate, error) {
+	resp, err := client.GetAppStoreVersions(ctx, appID, asc.WithAppStoreVersionsLimit(200))
+	if err != nil {
+		return nil, err
+	}
+	seen := map[string]bool{}
+	candidates := make([]Candidate, 0, len(resp.Data))
+	for _, version := range resp.Data {
+		attrs := version.Attributes
+		if !asStrings {
+			candidates = append(candidates, Candidate{
+				Value:       version.ID,
+				Description: fmt.Sprintf("%s %s %s", attrs.VersionString, attrs.Platform, attrs.AppStoreState),
+			})
+			continue
+		}
+		if attrs.VersionString == "" || seen[attrs.VersionString] {
+			continue
+		}
+		seen[attrs.VersionString] = true
+		candidates = append(candidates, Candidate{Value: attrs.VersionString, Description: attrs.AppStoreState})
+	}
+	return candidates, nil
+}
+
+func (l *liveValues) load() map[string]liveCacheEntry {
+	entries := map[string]liveCacheEntry{}
+	if l.path == "" {
+		return entries
+	}
+	data, err := os.ReadFile(l.path)
+	if err != nil {
+		return entries
+	}
+	if err := json.Unmarshal(data, &entries); err != nil || entries == nil {
+		return map[string]liveCacheEntry{}
+	}
+	return entries
+}
+
+func (l *liveValues) save(entries map[string]liveCacheEntry) {
+	if l.path == "" {
+		return
+	}
+	// Drop expired entries so the file doesn't grow with every app visited.
+	for key, entry := range entries {
+		if l.now().Sub(entry.FetchedAt) >= liveCacheTTL {
+			delete(entries, key)
+		}
+	}
+	data, err := json.Marshal(entries)
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(l.path), 0o700); err != nil {
+		return
+	}
+	_, _ = shared.WriteFileNoSymlinkOverwrite(l.path, bytes.NewReader(data), 0o600, ".completion-*", ".completion-backup-*")
+}
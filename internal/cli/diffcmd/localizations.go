@@ -44,7 +44,7 @@ type localizationDiffPlan struct {
 func DiffLocalizationsCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("localizations", flag.ExitOnError)
 
-	appID := fs.String("app", "", "App Store Connect app ID (required, or ASC_APP_ID env)")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (required, or ASC_APP_ID env)")
 	path := fs.String("path", "", "Local .strings directory or file (source)")
 	fromVersion := fs.String("from-version", "", "Remote source app store version ID")
 	version := fs.String("version", "", "Remote target app store version ID (when using --path)")
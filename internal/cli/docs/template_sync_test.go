@@ -20,6 +20,10 @@ func TestASCTemplateIncludesAllRootSubcommands(t *testing.T) {
 	root := cmd.RootCommand("test")
 	rootCommands := make([]string, 0, len(root.Subcommands))
 	for _, sub := range root.Subcommands {
+		// Hidden internal commands (e.g. __complete) are not documented.
+		if strings.HasPrefix(sub.Name, "__") {
+			continue
+		}
 		rootCommands = append(rootCommands, sub.Name)
 	}
 
@@ -54,7 +54,7 @@ Examples:
 func insightsWeeklyCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("insights weekly", flag.ExitOnError)
 
-	appID := fs.String("app", "", "App Store Connect app ID (required, or ASC_APP_ID env)")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (required, or ASC_APP_ID env)")
 	source := fs.String("source", "", "Insights source: analytics or sales")
 	week := fs.String("week", "", "Week start date (YYYY-MM-DD)")
 	vendor := fs.String("vendor", "", "Vendor number for sales source (or ASC_VENDOR_NUMBER)")
@@ -135,7 +135,7 @@ Examples:
 func insightsDailyCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("insights daily", flag.ExitOnError)
 
-	appID := fs.String("app", "", "App Store Connect app ID (required, or ASC_APP_ID env)")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (required, or ASC_APP_ID env)")
 	vendor := fs.String("vendor", "", "Vendor number for sales source (or ASC_VENDOR_NUMBER)")
 	date := fs.String("date", "", "Report date (YYYY-MM-DD)")
 	output := shared.BindOutputFlags(fs)
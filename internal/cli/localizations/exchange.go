@@ -0,0 +1,663 @@
+package localizations
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/validation"
+)
+
+// Exchange formats for translation vendors and Xcode String Catalogs.
+const (
+	exchangeFormatXLIFF     = "xliff"
+	exchangeFormatXLIFF2    = "xliff2"
+	exchangeFormatXCStrings = "xcstrings"
+	exchangeFormatCSV       = "csv"
+)
+
+// Normalized translation states shared by all exchange formats.
+const (
+	exchangeStateNew         = "new"
+	exchangeStateNeedsReview = "needs-review"
+	exchangeStateTranslated  = "translated"
+	exchangeStateReviewed    = "reviewed"
+	exchangeStateFinal       = "final"
+)
+
+var exchangeFieldLimits = map[string]int{
+	"description":     validation.LimitDescription,
+	"keywords":        validation.LimitKeywords,
+	"whatsNew":        validation.LimitWhatsNew,
+	"promotionalText": validation.LimitPromotionalText,
+	"name":            validation.LimitName,
+	"subtitle":        validation.LimitSubtitle,
+}
+
+var exchangeCSVHeader = []string{"key", "locale", "source", "target", "state", "maxLength", "comment"}
+
+// exchangeCatalog holds source and target values for one localization type.
+type exchangeCatalog struct {
+	Type         string
+	SourceLocale string
+	Keys         []string
+	// Values and States are keyed by locale, then by field key.
+	Values map[string]map[string]string
+	States map[string]map[string]string
+}
+
+func newExchangeCatalog(locType, sourceLocale string) *exchangeCatalog {
+	return &exchangeCatalog{
+		Type:         locType,
+		SourceLocale: sourceLocale,
+		Values:       map[string]map[string]string{},
+		States:       map[string]map[string]string{},
+	}
+}
+
+func (c *exchangeCatalog) set(locale, key, value, state string) {
+	if c.Values[locale] == nil {
+		c.Values[locale] = map[string]string{}
+		c.States[locale] = map[string]string{}
+	}
+	c.Values[locale][key] = value
+	c.States[locale][key] = state
+	for _, existing := range c.Keys {
+		if existing == key {
+			return
+		}
+	}
+	c.Keys = append(c.Keys, key)
+}
+
+func (c *exchangeCatalog) source(key string) string {
+	return c.Values[c.SourceLocale][key]
+}
+
+// targetLocales returns every locale except the source, sorted.
+func (c *exchangeCatalog) targetLocales() []string {
+	locales := make([]string, 0, len(c.Values))
+	for locale := range c.Values {
+		if locale != c.SourceLocale {
+			locales = append(locales, locale)
+		}
+	}
+	sort.Strings(locales)
+	return locales
+}
+
+// exchangeNote is the translator comment for a field, including its character limit.
+func exchangeNote(key string) string {
+	if limit, ok := exchangeFieldLimits[key]; ok {
+		return fmt.Sprintf("App Store %s. Maximum %d characters.", key, limit)
+	}
+	if strings.HasSuffix(key, "Url") {
+		return fmt.Sprintf("App Store %s. Must be a valid URL.", key)
+	}
+	return fmt.Sprintf("App Store %s.", key)
+}
+
+// exportState is the state written for a target value fetched from App Store Connect.
+func exportState(value string) string {
+	if strings.TrimSpace(value) == "" {
+		return exchangeStateNew
+	}
+	return exchangeStateTranslated
+}
+
+func normalizeExchangeFormat(value string) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "xliff", "xliff12", "xlf":
+		return exchangeFormatXLIFF, nil
+	case "xliff2", "xliff20":
+		return exchangeFormatXLIFF2, nil
+	case "xcstrings":
+		return exchangeFormatXCStrings, nil
+	case "csv":
+		return exchangeFormatCSV, nil
+	default:
+		return "", fmt.Errorf("--format must be one of: xliff, xliff2, xcstrings, csv")
+	}
+}
+
+// exchangeFormatUsesDirectory reports whether a format writes one file per target locale.
+func exchangeFormatUsesDirectory(format string) bool {
+	return format == exchangeFormatXLIFF || format == exchangeFormatXLIFF2
+}
+
+// --- XLIFF 1.2 ---
+
+type xliff12Document struct {
+	XMLName xml.Name      `xml:"xliff"`
+	Version string        `xml:"version,attr"`
+	XMLNS   string        `xml:"xmlns,attr,omitempty"`
+	Files   []xliff12File `xml:"file"`
+}
+
+type xliff12File struct {
+	Original       string             `xml:"original,attr"`
+	SourceLanguage string             `xml:"source-language,attr"`
+	TargetLanguage string             `xml:"target-language,attr"`
+	Datatype       string             `xml:"datatype,attr"`
+	Units          []xliff12TransUnit `xml:"body>trans-unit"`
+}
+
+type xliff12TransUnit struct {
+	ID       string         `xml:"id,attr"`
+	MaxWidth string         `xml:"maxwidth,attr,omitempty"`
+	SizeUnit string         `xml:"size-unit,attr,omitempty"`
+	Source   string         `xml:"source"`
+	Target   *xliff12Target `xml:"target"`
+	Note     string         `xml:"note,omitempty"`
+}
+
+type xliff12Target struct {
+	State string `xml:"state,attr,omitempty"`
+	Value string `xml:",chardata"`
+}
+
+var xliff12States = map[string]string{
+	exchangeStateNew:         "new",
+	exchangeStateNeedsReview: "needs-review-translation",
+	exchangeStateTranslated:  "translated",
+	exchangeStateReviewed:    "signed-off",
+	exchangeStateFinal:       "final",
+}
+
+func encodeXLIFF12(catalog *exchangeCatalog, locale string) ([]byte, error) {
+	file := xliff12File{
+		Original:       "app-store/" + catalog.Type,
+		SourceLanguage: catalog.SourceLocale,
+		TargetLanguage: locale,
+		Datatype:       "plaintext",
+	}
+	for _, key := range catalog.Keys {
+		unit := xliff12TransUnit{
+			ID:     key,
+			Source: catalog.source(key),
+			Target: &xliff12Target{
+				State: xliff12States[catalog.States[locale][key]],
+				Value: catalog.Values[locale][key],
+			},
+			Note: exchangeNote(key),
+		}
+		if limit, ok := exchangeFieldLimits[key]; ok {
+			unit.MaxWidth = strconv.Itoa(limit)
+			unit.SizeUnit = "char"
+		}
+		file.Units = append(file.Units, unit)
+	}
+	return marshalXMLDocument(xliff12Document{
+		Version: "1.2",
+		XMLNS:   "urn:oasis:names:tc:xliff:document:1.2",
+		Files:   []xliff12File{file},
+	})
+}
+
+func decodeXLIFF12(data []byte, catalog *exchangeCatalog) error {
+	var doc xliff12Document
+	if err := xml.Unmarshal(data, &doc); err != nil {
+		return fmt.Errorf("parse XLIFF 1.2: %w", err)
+	}
+	for _, file := range doc.Files {
+		if err := setCatalogSourceLocale(catalog, file.SourceLanguage); err != nil {
+			return err
+		}
+		if strings.TrimSpace(file.TargetLanguage) == "" {
+			return fmt.Errorf("XLIFF file %q has no target-language", file.Original)
+		}
+		for _, unit := range file.Units {
+			catalog.set(catalog.SourceLocale, unit.ID, unit.Source, exchangeStateFinal)
+			if unit.Target == nil {
+				catalog.set(file.TargetLanguage, unit.ID, "", exchangeStateNew)
+				continue
+			}
+			catalog.set(file.TargetLanguage, unit.ID, unit.Target.Value, lookupExchangeState(xliff12States, unit.Target.State, unit.Target.Value))
+		}
+	}
+	return nil
+}
+
+// --- XLIFF 2.0 ---
+
+type xliff20Document struct {
+	XMLName xml.Name      `xml:"xliff"`
+	XMLNS   string        `xml:"xmlns,attr,omitempty"`
+	Version string        `xml:"version,attr"`
+	SrcLang string        `xml:"srcLang,attr"`
+	TrgLang string        `xml:"trgLang,attr"`
+	Files   []xliff20File `xml:"file"`
+}
+
+type xliff20File struct {
+	ID    string        `xml:"id,attr"`
+	Units []xliff20Unit `xml:"unit"`
+}
+
+type xliff20Unit struct {
+	ID       string           `xml:"id,attr"`
+	Notes    []xliff20Note    `xml:"notes>note,omitempty"`
+	Segments []xliff20Segment `xml:"segment"`
+}
+
+type xliff20Note struct {
+	Category string `xml:"category,attr,omitempty"`
+	Value    string `xml:",chardata"`
+}
+
+type xliff20Segment struct {
+	State  string  `xml:"state,attr,omitempty"`
+	Source string  `xml:"source"`
+	Target *string `xml:"target"`
+}
+
+var xliff20States = map[string]string{
+	exchangeStateNew:         "initial",
+	exchangeStateNeedsReview: "initial",
+	exchangeStateTranslated:  "translated",
+	exchangeStateReviewed:    "reviewed",
+	exchangeStateFinal:       "final",
+}
+
+func encodeXLIFF20(catalog *exchangeCatalog, locale string) ([]byte, error) {
+	file := xliff20File{ID: "app-store-" + catalog.Type}
+	for _, key := range catalog.Keys {
+		target := catalog.Values[locale][key]
+		file.Units = append(file.Units, xliff20Unit{
+			ID:    key,
+			Notes: []xliff20Note{{Category: "description", Value: exchangeNote(key)}},
+			Segments: []xliff20Segment{{
+				State:  xliff20States[catalog.States[locale][key]],
+				Source: catalog.source(key),
+				Target: &target,
+			}},
+		})
+	}
+	return marshalXMLDocument(xliff20Document{
+		XMLNS:   "urn:oasis:names:tc:xliff:document:2.0",
+		Version: "2.0",
+		SrcLang: catalog.SourceLocale,
+		TrgLang: locale,
+		Files:   []xliff20File{file},
+	})
+}
+
+func decodeXLIFF20(data []byte, catalog *exchangeCatalog) error {
+	var doc xliff20Document
+	if err := xml.Unmarshal(data, &doc); err != nil {
+		return fmt.Errorf("parse XLIFF 2.0: %w", err)
+	}
+	if err := setCatalogSourceLocale(catalog, doc.SrcLang); err != nil {
+		return err
+	}
+	if strings.TrimSpace(doc.TrgLang) == "" {
+		return fmt.Errorf("XLIFF 2.0 document has no trgLang")
+	}
+	for _, file := range doc.Files {
+		for _, unit := range file.Units {
+			var source, target strings.Builder
+			state := ""
+			hasTarget := false
+			for _, segment := range unit.Segments {
+				source.WriteString(segment.Source)
+				if segment.Target != nil {
+					hasTarget = true
+					target.WriteString(*segment.Target)
+				}
+				if state == "" {
+					state = segment.State
+				}
+			}
+			catalog.set(catalog.SourceLocale, unit.ID, source.String(), exchangeStateFinal)
+			if !hasTarget {
+				catalog.set(doc.TrgLang, unit.ID, "", exchangeStateNew)
+				continue
+			}
+			catalog.set(doc.TrgLang, unit.ID, target.String(), lookupExchangeState(xliff20States, state, target.String()))
+		}
+	}
+	return nil
+}
+
+// --- String Catalog (.xcstrings) ---
+
+type xcStringsDocument struct {
+	SourceLanguage string                    `json:"sourceLanguage"`
+	Strings        map[string]xcStringsEntry `json:"strings"`
+	Version        string                    `json:"version"`
+}
+
+type xcStringsEntry struct {
+	Comment         string                           `json:"comment,omitempty"`
+	ExtractionState string                           `json:"extractionState,omitempty"`
+	Localizations   map[string]xcStringsLocalization `json:"localizations,omitempty"`
+}
+
+type xcStringsLocalization struct {
+	StringUnit *xcStringsUnit `json:"stringUnit,omitempty"`
+}
+
+type xcStringsUnit struct {
+	State string `json:"state"`
+	Value string `json:"value"`
+}
+
+var xcStringsStates = map[string]string{
+	exchangeStateNew:         "new",
+	exchangeStateNeedsReview: "needs_review",
+	exchangeStateTranslated:  "translated",
+	exchangeStateReviewed:    "translated",
+	exchangeStateFinal:       "translated",
+}
+
+func encodeXCStrings(catalog *exchangeCatalog) ([]byte, error) {
+	doc := xcStringsDocument{
+		SourceLanguage: catalog.SourceLocale,
+		Strings:        map[string]xcStringsEntry{},
+		Version:        "1.0",
+	}
+	locales := append([]string{catalog.SourceLocale}, catalog.targetLocales()...)
+	for _, key := range catalog.Keys {
+		entry := xcStringsEntry{
+			Comment:         exchangeNote(key),
+			ExtractionState: "manual",
+			Localizations:   map[string]xcStringsLocalization{},
+		}
+		for _, locale := range locales {
+			state := catalog.States[locale][key]
+			if locale == catalog.SourceLocale {
+				state = exchangeStateTranslated
+			}
+			entry.Localizations[locale] = xcStringsLocalization{StringUnit: &xcStringsUnit{
+				State: xcStringsStates[state],
+				Value: catalog.Values[locale][key],
+			}}
+		}
+		doc.Strings[key] = entry
+	}
+	data, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+func decodeXCStrings(data []byte, catalog *exchangeCatalog) error {
+	var doc xcStringsDocument
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return fmt.Errorf("parse String Catalog: %w", err)
+	}
+	if err := setCatalogSourceLocale(catalog, doc.SourceLanguage); err != nil {
+		return err
+	}
+	keys := make([]string, 0, len(doc.Strings))
+	for key := range doc.Strings {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		for locale, localization := range doc.Strings[key].Localizations {
+			if localization.StringUnit == nil {
+				continue
+			}
+			state := localization.StringUnit.State
+			if state == "stale" {
+				state = xcStringsStates[exchangeStateNeedsReview]
+			}
+			catalog.set(locale, key, localization.StringUnit.Value, lookupExchangeState(xcStringsStates, state, localization.StringUnit.Value))
+		}
+	}
+	return nil
+}
+
+// --- CSV ---
+
+func encodeExchangeCSV(catalog *exchangeCatalog) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	if err := writer.Write(exchangeCSVHeader); err != nil {
+		return nil, err
+	}
+	for _, locale := range catalog.targetLocales() {
+		for _, key := range catalog.Keys {
+			maxLength := ""
+			if limit, ok := exchangeFieldLimits[key]; ok {
+				maxLength = strconv.Itoa(limit)
+			}
+			if err := writer.Write([]string{
+				key,
+				locale,
+				catalog.source(key),
+				catalog.Values[locale][key],
+				catalog.States[locale][key],
+				maxLength,
+				exchangeNote(key),
+			}); err != nil {
+				return nil, err
+			}
+		}
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func decodeExchangeCSV(data []byte, catalog *exchangeCatalog, sourceLocale string) error {
+	if err := setCatalogSourceLocale(catalog, sourceLocale); err != nil {
+		return err
+	}
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	records, err := reader.ReadAll()
+	if err != nil {
+		return fmt.Errorf("parse CSV: %w", err)
+	}
+	if len(records) == 0 {
+		return fmt.Errorf("CSV is empty")
+	}
+	columns := map[string]int{}
+	for i, name := range records[0] {
+		columns[strings.TrimSpace(name)] = i
+	}
+	for _, required := range []string{"key", "locale", "target"} {
+		if _, ok := columns[required]; !ok {
+			return fmt.Errorf("CSV is missing the %q column", required)
+		}
+	}
+	column := func(record []string, name string) string {
+		index, ok := columns[name]
+		if !ok || index >= len(record) {
+			return ""
+		}
+		return record[index]
+	}
+	for line, record := range records[1:] {
+		key := strings.TrimSpace(column(record, "key"))
+		locale := strings.TrimSpace(column(record, "locale"))
+		if key == "" || locale == "" {
+			return fmt.Errorf("CSV row %d: key and locale are required", line+2)
+		}
+		target := column(record, "target")
+		state := strings.TrimSpace(column(record, "state"))
+		if state == "" {
+			state = exportState(target)
+		}
+		if !isExchangeState(state) {
+			return fmt.Errorf("CSV row %d: unknown state %q", line+2, state)
+		}
+		catalog.set(catalog.SourceLocale, key, column(record, "source"), exchangeStateFinal)
+		catalog.set(locale, key, target, state)
+	}
+	return nil
+}
+
+// --- shared helpers ---
+
+func marshalXMLDocument(doc any) ([]byte, error) {
+	data, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), append(data, '\n')...), nil
+}
+
+func setCatalogSourceLocale(catalog *exchangeCatalog, locale string) error {
+	locale = strings.TrimSpace(locale)
+	if locale == "" {
+		return fmt.Errorf("source locale is missing")
+	}
+	if catalog.SourceLocale != "" && catalog.SourceLocale != locale {
+		return fmt.Errorf("source locale %q does not match %q", locale, catalog.SourceLocale)
+	}
+	catalog.SourceLocale = locale
+	return nil
+}
+
+// lookupExchangeState maps a format-specific state back to a normalized state,
+// preferring the least advanced state when a format merges several.
+func lookupExchangeState(states map[string]string, value, target string) string {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return exportState(target)
+	}
+	if value == "needs-translation" {
+		return exchangeStateNew
+	}
+	for _, normalized := range []string{exchangeStateNew, exchangeStateNeedsReview, exchangeStateTranslated, exchangeStateReviewed, exchangeStateFinal} {
+		if states[normalized] == value {
+			return normalized
+		}
+	}
+	if strings.HasPrefix(value, "needs-") {
+		return exchangeStateNeedsReview
+	}
+	return exchangeStateTranslated
+}
+
+func isExchangeState(state string) bool {
+	switch state {
+	case exchangeStateNew, exchangeStateNeedsReview, exchangeStateTranslated, exchangeStateReviewed, exchangeStateFinal:
+		return true
+	}
+	return false
+}
+
+// detectExchangeFormat infers a format from a file extension and, for XLIFF, its version.
+func detectExchangeFormat(path string, data []byte) (string, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".xcstrings":
+		return exchangeFormatXCStrings, nil
+	case ".csv":
+		return exchangeFormatCSV, nil
+	case ".xliff", ".xlf":
+		decoder := xml.NewDecoder(bytes.NewReader(data))
+		for {
+			token, err := decoder.Token()
+			if err != nil {
+				return "", fmt.Errorf("parse %s: %w", path, err)
+			}
+			if start, ok := token.(xml.StartElement); ok {
+				for _, attr := range start.Attr {
+					if attr.Name.Local == "version" && strings.HasPrefix(attr.Value, "2") {
+						return exchangeFormatXLIFF2, nil
+					}
+				}
+				return exchangeFormatXLIFF, nil
+			}
+		}
+	default:
+		return "", fmt.Errorf("cannot infer format from %q (use --format)", path)
+	}
+}
+
+// readExchangeCatalog loads a file, or every exchange file in a directory, into one catalog.
+func readExchangeCatalog(path, format, locType, sourceLocale string) (*exchangeCatalog, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	paths := []string{path}
+	if info.IsDir() {
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			return nil, err
+		}
+		paths = nil
+		for _, entry := range entries {
+			if entry.IsDir() {
+				continue
+			}
+			switch strings.ToLower(filepath.Ext(entry.Name())) {
+			case ".xliff", ".xlf", ".xcstrings", ".csv":
+				paths = append(paths, filepath.Join(path, entry.Name()))
+			}
+		}
+		if len(paths) == 0 {
+			return nil, fmt.Errorf("no .xliff, .xcstrings or .csv files found in %q", path)
+		}
+	}
+
+	catalog := newExchangeCatalog(locType, "")
+	for _, filePath := range paths {
+		data, err := readExchangeFile(filePath)
+		if err != nil {
+			return nil, err
+		}
+		fileFormat := format
+		if fileFormat == "" {
+			fileFormat, err = detectExchangeFormat(filePath, data)
+			if err != nil {
+				return nil, err
+			}
+		}
+		switch fileFormat {
+		case exchangeFormatXLIFF:
+			err = decodeXLIFF12(data, catalog)
+		case exchangeFormatXLIFF2:
+			err = decodeXLIFF20(data, catalog)
+		case exchangeFormatXCStrings:
+			err = decodeXCStrings(data, catalog)
+		case exchangeFormatCSV:
+			err = decodeExchangeCSV(data, catalog, sourceLocale)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", filePath, err)
+		}
+	}
+	return catalog, nil
+}
+
+func readExchangeFile(path string) ([]byte, error) {
+	info, err := os.Lstat(path)
+	if err != nil {
+		return nil, err
+	}
+	if info.Mode()&os.ModeSymlink != 0 {
+		return nil, fmt.Errorf("refusing to read symlink %q", path)
+	}
+	file, err := shared.OpenExistingNoFollow(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return io.ReadAll(file)
+}
+
+func writeExchangeFile(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	_, err := shared.WriteFileNoSymlinkOverwrite(path, bytes.NewReader(data), 0o644, ".asc-localizations-*", ".asc-localizations-backup-*")
+	return err
+}
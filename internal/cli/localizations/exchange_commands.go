@@ -0,0 +1,479 @@
+package localizations
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/validation"
+)
+
+const exchangeDefaultSourceLocale = "en-US"
+
+// LocalizationsExportCommand returns the export localizations subcommand.
+func LocalizationsExportCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+
+	versionID := fs.String("version", "", "App Store version ID")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (or ASC_APP_ID env)")
+	appInfoID := fs.String("app-info", "", "App Info ID (optional override)")
+	locType := fs.String("type", shared.LocalizationTypeVersion, "Localization type: version (default) or app-info")
+	format := fs.String("format", "", "Exchange format: xliff, xliff2, xcstrings, or csv")
+	sourceLocale := fs.String("source-locale", exchangeDefaultSourceLocale, "Source locale translators translate from")
+	locale := fs.String("locale", "", "Target locale(s), comma-separated (default: all existing)")
+	path := fs.String("path", "", "Output directory (xliff, xliff2) or file (xcstrings, csv)")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "export",
+		ShortUsage: "asc localizations export --format FORMAT --path PATH [flags]",
+		ShortHelp:  "Export localizations as XLIFF, String Catalog, or CSV.",
+		LongHelp: `Export localizations as XLIFF, String Catalog, or CSV.
+
+Each field becomes one translation unit with the source locale value, the
+target value, a translation state, and a translator comment with the field's
+App Store character limit. XLIFF formats write one <locale>.xliff file per
+target locale into --path; xcstrings and csv write a single file.
+
+Examples:
+  asc localizations export --version "VERSION_ID" --format xliff --path "./xliff"
+  asc localizations export --version "VERSION_ID" --format xliff2 --locale "de-DE,fr-FR" --path "./xliff"
+  asc localizations export --app "APP_ID" --type app-info --format xcstrings --path "AppStore.xcstrings"
+  asc localizations export --version "VERSION_ID" --format csv --source-locale "en-GB" --path "store.csv"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if strings.TrimSpace(*format) == "" {
+				return shared.UsageError("--format is required")
+			}
+			normalizedFormat, err := normalizeExchangeFormat(*format)
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
+			if strings.TrimSpace(*path) == "" {
+				return shared.UsageError("--path is required")
+			}
+			if strings.TrimSpace(*sourceLocale) == "" {
+				return shared.UsageError("--source-locale is required")
+			}
+			normalizedType, err := shared.NormalizeLocalizationType(*locType)
+			if err != nil {
+				return fmt.Errorf("localizations export: %w", err)
+			}
+
+			result := asc.LocalizationDownloadResult{Type: normalizedType, OutputPath: *path}
+			var (
+				keys           []string
+				valuesByLocale map[string]map[string]string
+			)
+
+			switch normalizedType {
+			case shared.LocalizationTypeVersion:
+				if strings.TrimSpace(*versionID) == "" {
+					return shared.UsageError("--version is required for version localizations")
+				}
+				client, err := shared.GetASCClient()
+				if err != nil {
+					return fmt.Errorf("localizations export: %w", err)
+				}
+				requestCtx, cancel := shared.ContextWithTimeout(ctx)
+				defer cancel()
+
+				result.VersionID = strings.TrimSpace(*versionID)
+				valuesByLocale, err = fetchVersionLocalizationValues(requestCtx, client, result.VersionID)
+				if err != nil {
+					return fmt.Errorf("localizations export: %w", err)
+				}
+				keys = shared.VersionLocalizationKeys()
+			case shared.LocalizationTypeAppInfo:
+				resolvedAppID := shared.ResolveAppID(*appID)
+				if resolvedAppID == "" {
+					return shared.UsageError("--app is required for app-info localizations")
+				}
+				client, err := shared.GetASCClient()
+				if err != nil {
+					return fmt.Errorf("localizations export: %w", err)
+				}
+				requestCtx, cancel := shared.ContextWithTimeout(ctx)
+				defer cancel()
+
+				appInfo, err := shared.ResolveAppInfoID(requestCtx, client, resolvedAppID, strings.TrimSpace(*appInfoID))
+				if err != nil {
+					return fmt.Errorf("localizations export: %w", err)
+				}
+				result.AppID = resolvedAppID
+				result.AppInfoID = appInfo
+				valuesByLocale, err = fetchAppInfoLocalizationValues(requestCtx, client, appInfo)
+				if err != nil {
+					return fmt.Errorf("localizations export: %w", err)
+				}
+				keys = shared.AppInfoLocalizationKeys()
+			default:
+				return fmt.Errorf("localizations export: unsupported type %q", normalizedType)
+			}
+
+			catalog, err := buildExchangeCatalog(normalizedType, strings.TrimSpace(*sourceLocale), keys, valuesByLocale, shared.SplitCSV(*locale))
+			if err != nil {
+				return fmt.Errorf("localizations export: %w", err)
+			}
+			files, err := writeExchangeCatalog(catalog, normalizedFormat, *path)
+			if err != nil {
+				return fmt.Errorf("localizations export: %w", err)
+			}
+			result.Files = files
+
+			return shared.PrintOutput(&result, *output.Output, *output.Pretty)
+		},
+	}
+}
+
+// LocalizationsImportCommand returns the import localizations subcommand.
+func LocalizationsImportCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+
+	versionID := fs.String("version", "", "App Store version ID")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (or ASC_APP_ID env)")
+	appInfoID := fs.String("app-info", "", "App Info ID (optional override)")
+	locType := fs.String("type", shared.LocalizationTypeVersion, "Localization type: version (default) or app-info")
+	format := fs.String("format", "", "Exchange format: xliff, xliff2, xcstrings, or csv (default: from file extension)")
+	sourceLocale := fs.String("source-locale", exchangeDefaultSourceLocale, "Source locale for CSV files")
+	locale := fs.String("locale", "", "Only import these target locale(s), comma-separated")
+	path := fs.String("path", "", "Input file, or directory of exchange files")
+	dryRun := fs.Bool("dry-run", false, "Validate files without uploading")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "import",
+		ShortUsage: "asc localizations import --path PATH [flags]",
+		ShortHelp:  "Import translated XLIFF, String Catalog, or CSV files.",
+		LongHelp: `Import translated XLIFF, String Catalog, or CSV files.
+
+Only target locales are uploaded; the source locale is never changed. Units in
+the "new" or "needs-review" state, or with an empty target, are skipped with a
+warning. Every value is checked against the App Store character limits before
+anything is uploaded.
+
+Examples:
+  asc localizations import --version "VERSION_ID" --path "./xliff"
+  asc localizations import --version "VERSION_ID" --path "de-DE.xliff" --dry-run
+  asc localizations import --app "APP_ID" --type app-info --path "AppStore.xcstrings" --locale "ja"
+  asc localizations import --version "VERSION_ID" --path "store.csv" --source-locale "en-GB"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if strings.TrimSpace(*path) == "" {
+				return shared.UsageError("--path is required")
+			}
+			normalizedFormat := ""
+			if strings.TrimSpace(*format) != "" {
+				var err error
+				normalizedFormat, err = normalizeExchangeFormat(*format)
+				if err != nil {
+					return shared.UsageError(err.Error())
+				}
+			}
+			normalizedType, err := shared.NormalizeLocalizationType(*locType)
+			if err != nil {
+				return fmt.Errorf("localizations import: %w", err)
+			}
+
+			catalog, err := readExchangeCatalog(*path, normalizedFormat, normalizedType, strings.TrimSpace(*sourceLocale))
+			if err != nil {
+				return fmt.Errorf("localizations import: %w", err)
+			}
+			valuesByLocale, skipped := exchangeUploadValues(catalog, shared.SplitCSV(*locale))
+			for _, skip := range skipped {
+				fmt.Fprintf(os.Stderr, "Warning: skipping %s %s (%s)\n", skip.Locale, skip.Key, skip.Reason)
+			}
+			if len(valuesByLocale) == 0 {
+				return fmt.Errorf("localizations import: no translated values to import")
+			}
+			if err := validateExchangeLimits(normalizedType, valuesByLocale); err != nil {
+				return fmt.Errorf("localizations import: %w", err)
+			}
+
+			switch normalizedType {
+			case shared.LocalizationTypeVersion:
+				if strings.TrimSpace(*versionID) == "" {
+					return shared.UsageError("--version is required for version localizations")
+				}
+				client, err := shared.GetASCClient()
+				if err != nil {
+					return fmt.Errorf("localizations import: %w", err)
+				}
+				requestCtx, cancel := shared.ContextWithTimeout(ctx)
+				defer cancel()
+
+				results, err := shared.UploadVersionLocalizations(requestCtx, client, strings.TrimSpace(*versionID), valuesByLocale, *dryRun)
+				if err != nil {
+					return fmt.Errorf("localizations import: %w", err)
+				}
+				result := asc.LocalizationUploadResult{
+					Type:      normalizedType,
+					VersionID: strings.TrimSpace(*versionID),
+					DryRun:    *dryRun,
+					Results:   results,
+				}
+				return shared.PrintOutput(&result, *output.Output, *output.Pretty)
+			case shared.LocalizationTypeAppInfo:
+				resolvedAppID := shared.ResolveAppID(*appID)
+				if resolvedAppID == "" {
+					return shared.UsageError("--app is required for app-info localizations")
+				}
+				client, err := shared.GetASCClient()
+				if err != nil {
+					return fmt.Errorf("localizations import: %w", err)
+				}
+				requestCtx, cancel := shared.ContextWithTimeout(ctx)
+				defer cancel()
+
+				appInfo, err := shared.ResolveAppInfoID(requestCtx, client, resolvedAppID, strings.TrimSpace(*appInfoID))
+				if err != nil {
+					return fmt.Errorf("localizations import: %w", err)
+				}
+				results, err := shared.UploadAppInfoLocalizations(requestCtx, client, appInfo, valuesByLocale, *dryRun)
+				if err != nil {
+					return fmt.Errorf("localizations import: %w", err)
+				}
+				result := asc.LocalizationUploadResult{
+					Type:      normalizedType,
+					AppID:     resolvedAppID,
+					AppInfoID: appInfo,
+					DryRun:    *dryRun,
+					Results:   results,
+				}
+				return shared.PrintOutput(&result, *output.Output, *output.Pretty)
+			default:
+				return fmt.Errorf("localizations import: unsupported type %q", normalizedType)
+			}
+		},
+	}
+}
+
+func fetchVersionLocalizationValues(ctx context.Context, client *asc.Client, versionID string) (map[string]map[string]string, error) {
+	firstPage, err := client.GetAppStoreVersionLocalizations(ctx, versionID, asc.WithAppStoreVersionLocalizationsLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch: %w", err)
+	}
+	resp, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetAppStoreVersionLocalizations(ctx, versionID, asc.WithAppStoreVersionLocalizationsNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, err
+	}
+	aggregated, ok := resp.(*asc.AppStoreVersionLocalizationsResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected pagination response type")
+	}
+	values := make(map[string]map[string]string, len(aggregated.Data))
+	for _, item := range aggregated.Data {
+		if locale := strings.TrimSpace(item.Attributes.Locale); locale != "" {
+			values[locale] = shared.MapVersionLocalizationStrings(item.Attributes)
+		}
+	}
+	return values, nil
+}
+
+func fetchAppInfoLocalizationValues(ctx context.Context, client *asc.Client, appInfoID string) (map[string]map[string]string, error) {
+	firstPage, err := client.GetAppInfoLocalizations(ctx, appInfoID, asc.WithAppInfoLocalizationsLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch: %w", err)
+	}
+	resp, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetAppInfoLocalizations(ctx, appInfoID, asc.WithAppInfoLocalizationsNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, err
+	}
+	aggregated, ok := resp.(*asc.AppInfoLocalizationsResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected pagination response type")
+	}
+	values := make(map[string]map[string]string, len(aggregated.Data))
+	for _, item := range aggregated.Data {
+		if locale := strings.TrimSpace(item.Attributes.Locale); locale != "" {
+			values[locale] = shared.MapAppInfoLocalizationStrings(item.Attributes)
+		}
+	}
+	return values, nil
+}
+
+// buildExchangeCatalog builds an export catalog from fetched values.
+// Keys without a value in any locale are left out.
+func buildExchangeCatalog(locType, sourceLocale string, keys []string, valuesByLocale map[string]map[string]string, targets []string) (*exchangeCatalog, error) {
+	if _, ok := valuesByLocale[sourceLocale]; !ok {
+		return nil, fmt.Errorf("source locale %q not found (use --source-locale)", sourceLocale)
+	}
+	if len(targets) == 0 {
+		for locale := range valuesByLocale {
+			if locale != sourceLocale {
+				targets = append(targets, locale)
+			}
+		}
+		sort.Strings(targets)
+	}
+	if len(targets) == 0 {
+		return nil, fmt.Errorf("no target locales to export")
+	}
+
+	catalog := newExchangeCatalog(locType, sourceLocale)
+	for _, key := range keys {
+		used := false
+		for _, values := range valuesByLocale {
+			if strings.TrimSpace(values[key]) != "" {
+				used = true
+				break
+			}
+		}
+		if !used {
+			continue
+		}
+		catalog.set(sourceLocale, key, valuesByLocale[sourceLocale][key], exchangeStateFinal)
+		for _, locale := range targets {
+			if locale == sourceLocale {
+				continue
+			}
+			value := valuesByLocale[locale][key]
+			catalog.set(locale, key, value, exportState(value))
+		}
+	}
+	if len(catalog.Keys) == 0 {
+		return nil, fmt.Errorf("no localization values to export")
+	}
+	return catalog, nil
+}
+
+func writeExchangeCatalog(catalog *exchangeCatalog, format, path string) ([]asc.LocalizationFileResult, error) {
+	if exchangeFormatUsesDirectory(format) {
+		var files []asc.LocalizationFileResult
+		for _, locale := range catalog.targetLocales() {
+			if filepath.Base(locale) != locale || strings.Contains(locale, "..") {
+				return nil, fmt.Errorf("invalid locale code %q", locale)
+			}
+			var (
+				data []byte
+				err  error
+			)
+			if format == exchangeFormatXLIFF2 {
+				data, err = encodeXLIFF20(catalog, locale)
+			} else {
+				data, err = encodeXLIFF12(catalog, locale)
+			}
+			if err != nil {
+				return nil, err
+			}
+			filePath := filepath.Join(path, locale+".xliff")
+			if err := writeExchangeFile(filePath, data); err != nil {
+				return nil, err
+			}
+			files = append(files, asc.LocalizationFileResult{Locale: locale, Path: filePath})
+		}
+		return files, nil
+	}
+
+	var (
+		data []byte
+		err  error
+	)
+	if format == exchangeFormatXCStrings {
+		data, err = encodeXCStrings(catalog)
+	} else {
+		data, err = encodeExchangeCSV(catalog)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := writeExchangeFile(path, data); err != nil {
+		return nil, err
+	}
+	files := make([]asc.LocalizationFileResult, 0, len(catalog.Values))
+	for _, locale := range catalog.targetLocales() {
+		files = append(files, asc.LocalizationFileResult{Locale: locale, Path: path})
+	}
+	return files, nil
+}
+
+type exchangeSkip struct {
+	Locale string
+	Key    string
+	Reason string
+}
+
+// exchangeUploadValues returns the importable target values and the units that were skipped.
+func exchangeUploadValues(catalog *exchangeCatalog, locales []string) (map[string]map[string]string, []exchangeSkip) {
+	filter := make(map[string]bool, len(locales))
+	for _, locale := range locales {
+		filter[locale] = true
+	}
+
+	values := map[string]map[string]string{}
+	var skipped []exchangeSkip
+	for _, locale := range catalog.targetLocales() {
+		if len(filter) > 0 && !filter[locale] {
+			continue
+		}
+		for _, key := range catalog.Keys {
+			value, ok := catalog.Values[locale][key]
+			if !ok {
+				continue
+			}
+			state := catalog.States[locale][key]
+			switch {
+			case state == exchangeStateNew || strings.TrimSpace(value) == "":
+				skipped = append(skipped, exchangeSkip{Locale: locale, Key: key, Reason: "not translated"})
+				continue
+			case state == exchangeStateNeedsReview:
+				skipped = append(skipped, exchangeSkip{Locale: locale, Key: key, Reason: "needs review"})
+				continue
+			}
+			if values[locale] == nil {
+				values[locale] = map[string]string{}
+			}
+			values[locale][key] = value
+		}
+	}
+	return values, skipped
+}
+
+// validateExchangeLimits rejects the import if any value exceeds its App Store character limit.
+func validateExchangeLimits(locType string, valuesByLocale map[string]map[string]string) error {
+	locales := make([]string, 0, len(valuesByLocale))
+	for locale := range valuesByLocale {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+
+	var problems []string
+	for _, locale := range locales {
+		values := valuesByLocale[locale]
+		var issues []validation.MetadataLengthIssue
+		if locType == shared.LocalizationTypeAppInfo {
+			issues = validation.AppInfoLocalizationLengthIssues(validation.AppInfoLocalization{
+				Name:     values["name"],
+				Subtitle: values["subtitle"],
+			})
+		} else {
+			issues = validation.VersionLocalizationLengthIssues(validation.VersionLocalization{
+				Description:     values["description"],
+				Keywords:        values["keywords"],
+				WhatsNew:        values["whatsNew"],
+				PromotionalText: values["promotionalText"],
+			})
+		}
+		for _, issue := range issues {
+			problems = append(problems, fmt.Sprintf("%s %s is %d characters (limit %d)", locale, issue.Field, issue.Length, issue.Limit))
+		}
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("character limits exceeded:\n  %s", strings.Join(problems, "\n  "))
+	}
+	return nil
+}
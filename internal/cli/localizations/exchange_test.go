@@ -0,0 +1,145 @@
+package localizations
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+func exchangeTestCatalog(t *testing.T) *exchangeCatalog {
+	t.Helper()
+	catalog, err := buildExchangeCatalog(shared.LocalizationTypeVersion, "en-US", shared.VersionLocalizationKeys(), map[string]map[string]string{
+		"en-US": {"description": "A <great> app & more", "keywords": "notes,sync"},
+		"de-DE": {"description": "Eine tolle App"},
+		"ja":    {},
+	}, nil)
+	if err != nil {
+		t.Fatalf("buildExchangeCatalog error: %v", err)
+	}
+	return catalog
+}
+
+func TestBuildExchangeCatalogStatesAndKeys(t *testing.T) {
+	catalog := exchangeTestCatalog(t)
+	if want := []string{"description", "keywords"}; !reflect.DeepEqual(catalog.Keys, want) {
+		t.Fatalf("expected only used keys, got %v", catalog.Keys)
+	}
+	if got := catalog.targetLocales(); !reflect.DeepEqual(got, []string{"de-DE", "ja"}) {
+		t.Fatalf("unexpected targets %v", got)
+	}
+	if catalog.States["de-DE"]["description"] != exchangeStateTranslated || catalog.States["de-DE"]["keywords"] != exchangeStateNew {
+		t.Fatalf("unexpected de-DE states %v", catalog.States["de-DE"])
+	}
+
+	if _, err := buildExchangeCatalog(shared.LocalizationTypeVersion, "fr-FR", shared.VersionLocalizationKeys(), map[string]map[string]string{"en-US": {"description": "x"}}, nil); err == nil {
+		t.Fatal("expected missing source locale error")
+	}
+}
+
+func TestExchangeFormatsRoundTrip(t *testing.T) {
+	for _, format := range []string{exchangeFormatXLIFF, exchangeFormatXLIFF2, exchangeFormatXCStrings, exchangeFormatCSV} {
+		t.Run(format, func(t *testing.T) {
+			catalog := exchangeTestCatalog(t)
+			path := t.TempDir()
+			if !exchangeFormatUsesDirectory(format) {
+				path = filepath.Join(path, "store."+format)
+			}
+			files, err := writeExchangeCatalog(catalog, format, path)
+			if err != nil {
+				t.Fatalf("write error: %v", err)
+			}
+			if len(files) != 2 {
+				t.Fatalf("expected files for 2 target locales, got %+v", files)
+			}
+
+			read, err := readExchangeCatalog(path, "", shared.LocalizationTypeVersion, "en-US")
+			if err != nil {
+				t.Fatalf("read error: %v", err)
+			}
+			if read.SourceLocale != "en-US" || read.source("description") != "A <great> app & more" {
+				t.Fatalf("unexpected source: %q %q", read.SourceLocale, read.source("description"))
+			}
+			if read.Values["de-DE"]["description"] != "Eine tolle App" {
+				t.Fatalf("unexpected de-DE value %q", read.Values["de-DE"]["description"])
+			}
+			if read.States["de-DE"]["description"] != exchangeStateTranslated || read.States["ja"]["keywords"] != exchangeStateNew {
+				t.Fatalf("states not preserved: %v", read.States)
+			}
+		})
+	}
+}
+
+func TestExchangeExportIncludesLimitNotes(t *testing.T) {
+	catalog := exchangeTestCatalog(t)
+
+	xliff, err := encodeXLIFF12(catalog, "de-DE")
+	if err != nil {
+		t.Fatalf("encode error: %v", err)
+	}
+	for _, want := range []string{`maxwidth="100"`, `source-language="en-US"`, `target-language="de-DE"`, "Maximum 100 characters.", `state="new"`} {
+		if !strings.Contains(string(xliff), want) {
+			t.Fatalf("expected %q in XLIFF:\n%s", want, xliff)
+		}
+	}
+
+	xcstrings, err := encodeXCStrings(catalog)
+	if err != nil {
+		t.Fatalf("encode error: %v", err)
+	}
+	if !strings.Contains(string(xcstrings), `"comment": "App Store description. Maximum 4000 characters."`) {
+		t.Fatalf("expected limit comment in String Catalog:\n%s", xcstrings)
+	}
+}
+
+func TestExchangeUploadValuesSkipsUntranslated(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "store.csv")
+	content := "key,locale,source,target,state\n" +
+		"description,de-DE,Hello,Hallo,translated\n" +
+		"keywords,de-DE,a,b,needs-review\n" +
+		"description,ja,Hello,,new\n" +
+		"description,fr-FR,Hello,Bonjour,final\n"
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("write csv: %v", err)
+	}
+	catalog, err := readExchangeCatalog(path, "", shared.LocalizationTypeVersion, "en-US")
+	if err != nil {
+		t.Fatalf("read error: %v", err)
+	}
+
+	values, skipped := exchangeUploadValues(catalog, []string{"de-DE", "ja"})
+	if want := map[string]map[string]string{"de-DE": {"description": "Hallo"}}; !reflect.DeepEqual(values, want) {
+		t.Fatalf("unexpected values %v", values)
+	}
+	if len(skipped) != 2 || skipped[0].Reason != "needs review" || skipped[1].Reason != "not translated" {
+		t.Fatalf("unexpected skips %+v", skipped)
+	}
+}
+
+func TestValidateExchangeLimits(t *testing.T) {
+	err := validateExchangeLimits(shared.LocalizationTypeVersion, map[string]map[string]string{
+		"de-DE": {"keywords": strings.Repeat("ä", 101)},
+		"fr-FR": {"keywords": strings.Repeat("é", 100)},
+	})
+	if err == nil || !strings.Contains(err.Error(), "de-DE keywords is 101 characters (limit 100)") || strings.Contains(err.Error(), "fr-FR") {
+		t.Fatalf("unexpected limit error: %v", err)
+	}
+
+	if err := validateExchangeLimits(shared.LocalizationTypeAppInfo, map[string]map[string]string{
+		"ja": {"subtitle": strings.Repeat("x", 31)},
+	}); err == nil {
+		t.Fatal("expected subtitle limit error")
+	}
+}
+
+func TestNormalizeExchangeFormat(t *testing.T) {
+	if got, err := normalizeExchangeFormat("XLF"); err != nil || got != exchangeFormatXLIFF {
+		t.Fatalf("unexpected %q (%v)", got, err)
+	}
+	if _, err := normalizeExchangeFormat("po"); err == nil {
+		t.Fatal("expected unsupported format error")
+	}
+}
@@ -0,0 +1,215 @@
+package mcp
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	mcpServeDefaultHost = "127.0.0.1"
+	mcpServeDefaultPort = 8789
+
+	mcpTransportStdio = "stdio"
+	mcpTransportHTTP  = "http"
+
+	// mcpTokenEnvVar holds the bearer token required by the http transport
+	// when it binds beyond loopback.
+	mcpTokenEnvVar = "ASC_MCP_TOKEN"
+)
+
+// MCPCommand returns the mcp command group. It needs the root subcommands to
+// generate tool schemas from their flag sets.
+func MCPCommand(version string, rootSubcommands []*ffcli.Command) *ffcli.Command {
+	fs := flag.NewFlagSet("mcp", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "mcp",
+		ShortUsage: "asc mcp <subcommand> [flags]",
+		ShortHelp:  "Expose asc commands as Model Context Protocol tools.",
+		LongHelp: `Expose asc commands as Model Context Protocol tools.
+
+Lets AI assistants and bots call a curated set of asc commands and get
+structured JSON back instead of scraping terminal output.
+
+Examples:
+  asc mcp serve
+  asc mcp serve --transport http --port 8789
+  asc mcp serve --allow builds_expire`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			MCPServeCommand(version, rootSubcommands),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// MCPServeCommand returns the mcp serve subcommand.
+func MCPServeCommand(version string, rootSubcommands []*ffcli.Command) *ffcli.Command {
+	fs := flag.NewFlagSet("serve", flag.ExitOnError)
+
+	transport := fs.String("transport", mcpTransportStdio, "Transport: stdio or http")
+	host := fs.String("host", mcpServeDefaultHost, "Host to bind in http mode")
+	port := fs.Int("port", mcpServeDefaultPort, "Port to bind in http mode (0-65535)")
+	allow := fs.String("allow", "", "Mutating tools to enable, comma-separated (e.g. builds_expire,reviews_respond)")
+
+	return &ffcli.Command{
+		Name:       "serve",
+		ShortUsage: "asc mcp serve [flags]",
+		ShortHelp:  "Serve asc commands as MCP tools over stdio or HTTP.",
+		LongHelp: `Serve asc commands as MCP tools over stdio or HTTP.
+
+Tools cover the app release dashboard (status), apps, builds, TestFlight
+groups and testers, submission status, review submissions, customer reviews
+and offline metadata validation. Each
+tool's input schema is generated from the command's flags, and results are
+returned as structured JSON.
+
+Tools that change App Store Connect data (builds_expire,
+testflight_beta_testers_add, submit_cancel and reviews_respond) are hidden
+unless listed in --allow. Allowed tools whose command has a --confirm flag run
+with --confirm.
+
+The stdio transport reads newline-delimited JSON-RPC messages from stdin and
+writes responses to stdout. The http transport accepts JSON-RPC POST requests
+at /mcp with Content-Type: application/json and binds to localhost by
+default. Requests from non-localhost browser origins, or whose Host header
+does not match the bind address, are rejected. Binding to any other --host
+requires ASC_MCP_TOKEN; clients must then send it as
+"Authorization: Bearer <token>". When set, the token is also enforced on
+loopback binds.
+
+Tool calls use the same credentials and profile as the server process.
+
+Examples:
+  asc mcp serve
+  asc mcp serve --transport http
+  asc mcp serve --transport http --host 127.0.0.1 --port 9000
+  ASC_MCP_TOKEN="$(openssl rand -hex 32)" asc mcp serve --transport http --host 0.0.0.0
+  asc mcp serve --allow builds_expire,submit_cancel`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if len(args) > 0 {
+				fmt.Fprintln(os.Stderr, "Error: mcp serve does not accept positional arguments")
+				return flag.ErrHelp
+			}
+
+			mode := strings.ToLower(strings.TrimSpace(*transport))
+			if mode != mcpTransportStdio && mode != mcpTransportHTTP {
+				return shared.UsageError("--transport must be one of: stdio, http")
+			}
+			bindHost := strings.TrimSpace(*host)
+			if mode == mcpTransportHTTP && bindHost == "" {
+				return shared.UsageError("--host is required")
+			}
+			if *port < 0 || *port > 65535 {
+				return shared.UsageError("--port must be between 0 and 65535")
+			}
+			var token string
+			if mode == mcpTransportHTTP {
+				resolved, err := resolveMCPHTTPToken(bindHost)
+				if err != nil {
+					return shared.UsageError(err.Error())
+				}
+				token = resolved
+			}
+
+			mutating := mutatingMCPToolNames()
+			allowed := map[string]bool{}
+			for _, name := range shared.SplitCSV(*allow) {
+				if !slices.Contains(mutating, name) {
+					return shared.UsageErrorf("--allow: %q is not a mutating tool (available: %s)", name, strings.Join(mutating, ", "))
+				}
+				allowed[name] = true
+			}
+
+			tools, err := buildMCPTools(rootSubcommands, allowed)
+			if err != nil {
+				return fmt.Errorf("mcp serve: %w", err)
+			}
+			server := newMCPServer(version, tools, execRunner)
+
+			if mode == mcpTransportStdio {
+				if err := server.serveStdio(ctx, os.Stdin, os.Stdout); err != nil {
+					return fmt.Errorf("mcp serve: %w", err)
+				}
+				return nil
+			}
+			return serveMCPHTTP(ctx, server, bindHost, *port, token)
+		},
+	}
+}
+
+// resolveMCPHTTPToken returns the bearer token from ASC_MCP_TOKEN. It is
+// required unless host is a loopback address.
+func resolveMCPHTTPToken(host string) (string, error) {
+	token := strings.TrimSpace(os.Getenv(mcpTokenEnvVar))
+	if token == "" && !isLoopbackHost(strings.Trim(strings.ToLower(host), "[]")) {
+		return "", fmt.Errorf("--host %s is reachable from the network; set %s to require a bearer token", host, mcpTokenEnvVar)
+	}
+	return token, nil
+}
+
+func serveMCPHTTP(ctx context.Context, handler http.Handler, host string, port int, token string) error {
+	address := net.JoinHostPort(host, strconv.Itoa(port))
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return fmt.Errorf("mcp serve: failed to listen on %s: %w", address, err)
+	}
+	defer listener.Close()
+
+	boundPort := port
+	if tcpAddr, ok := listener.Addr().(*net.TCPAddr); ok {
+		boundPort = tcpAddr.Port
+	}
+	server := &http.Server{
+		Handler:           newMCPHTTPGuard(handler, host, boundPort, token),
+		ReadHeaderTimeout: 5 * time.Second,
+		IdleTimeout:       60 * time.Second,
+	}
+
+	serveErrCh := make(chan error, 1)
+	go func() {
+		err := server.Serve(listener)
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			serveErrCh <- err
+			return
+		}
+		serveErrCh <- nil
+	}()
+
+	// stdout stays clean for scripts; the endpoint is announced on stderr.
+	fmt.Fprintf(os.Stderr, "MCP server listening on http://%s/mcp\n", listener.Addr().String())
+
+	select {
+	case err := <-serveErrCh:
+		if err != nil {
+			return fmt.Errorf("mcp serve: %w", err)
+		}
+		return nil
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+		if err := <-serveErrCh; err != nil {
+			return fmt.Errorf("mcp serve: %w", err)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,290 @@
+package mcp
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	mcpProtocolVersion = "2025-06-18"
+
+	rpcParseError     = -32700
+	rpcInvalidRequest = -32600
+	rpcMethodNotFound = -32601
+	rpcInvalidParams  = -32602
+	rpcInternalError  = -32603
+
+	// mcpMaxMessageBytes bounds a single JSON-RPC message.
+	mcpMaxMessageBytes = 4 << 20
+)
+
+type rpcRequest struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+type rpcResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  any             `json:"result,omitempty"`
+	Error   *rpcError       `json:"error,omitempty"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// mcpServer answers Model Context Protocol requests over any transport.
+type mcpServer struct {
+	version string
+	tools   []*mcpTool
+	byName  map[string]*mcpTool
+	run     mcpRunner
+}
+
+func newMCPServer(version string, tools []*mcpTool, run mcpRunner) *mcpServer {
+	byName := make(map[string]*mcpTool, len(tools))
+	for _, tool := range tools {
+		byName[tool.Name] = tool
+	}
+	return &mcpServer{version: version, tools: tools, byName: byName, run: run}
+}
+
+// handleMessage processes one JSON-RPC message. It returns nil for notifications.
+func (s *mcpServer) handleMessage(ctx context.Context, data []byte) *rpcResponse {
+	var req rpcRequest
+	if err := json.Unmarshal(data, &req); err != nil {
+		return errorResponse(json.RawMessage("null"), rpcParseError, "parse error")
+	}
+	if req.JSONRPC != "2.0" || req.Method == "" {
+		id := req.ID
+		if len(id) == 0 {
+			id = json.RawMessage("null")
+		}
+		return errorResponse(id, rpcInvalidRequest, "invalid request")
+	}
+	// Requests without an id are notifications and get no response.
+	if len(req.ID) == 0 {
+		return nil
+	}
+
+	result, rpcErr := s.dispatch(ctx, req)
+	if rpcErr != nil {
+		return &rpcResponse{JSONRPC: "2.0", ID: req.ID, Error: rpcErr}
+	}
+	return &rpcResponse{JSONRPC: "2.0", ID: req.ID, Result: result}
+}
+
+func (s *mcpServer) dispatch(ctx context.Context, req rpcRequest) (any, *rpcError) {
+	switch req.Method {
+	case "initialize":
+		var params struct {
+			ProtocolVersion string `json:"protocolVersion"`
+		}
+		_ = json.Unmarshal(req.Params, &params)
+		version := params.ProtocolVersion
+		if version == "" {
+			version = mcpProtocolVersion
+		}
+		return map[string]any{
+			"protocolVersion": version,
+			"capabilities":    map[string]any{"tools": map[string]any{"listChanged": false}},
+			"serverInfo":      map[string]any{"name": "asc", "version": s.version},
+		}, nil
+	case "ping":
+		return map[string]any{}, nil
+	case "tools/list":
+		return map[string]any{"tools": s.tools}, nil
+	case "tools/call":
+		var params struct {
+			Name      string         `json:"name"`
+			Arguments map[string]any `json:"arguments"`
+		}
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			return nil, &rpcError{Code: rpcInvalidParams, Message: "invalid params"}
+		}
+		tool, ok := s.byName[params.Name]
+		if !ok {
+			return nil, &rpcError{Code: rpcInvalidParams, Message: fmt.Sprintf("unknown tool %q", params.Name)}
+		}
+		args, err := tool.commandArgs(params.Arguments)
+		if err != nil {
+			return nil, &rpcError{Code: rpcInvalidParams, Message: err.Error()}
+		}
+		callCtx, cancel := shared.ContextWithTimeout(ctx)
+		defer cancel()
+		result, err := callTool(callCtx, tool, args, s.run)
+		if err != nil {
+			return nil, &rpcError{Code: rpcInternalError, Message: err.Error()}
+		}
+		return result, nil
+	default:
+		return nil, &rpcError{Code: rpcMethodNotFound, Message: fmt.Sprintf("method not found: %s", req.Method)}
+	}
+}
+
+func errorResponse(id json.RawMessage, code int, message string) *rpcResponse {
+	return &rpcResponse{JSONRPC: "2.0", ID: id, Error: &rpcError{Code: code, Message: message}}
+}
+
+// serveStdio reads newline-delimited JSON-RPC messages from in and writes
+// responses to out. Requests are handled concurrently so a slow tool call
+// does not block pings or other calls.
+func (s *mcpServer) serveStdio(ctx context.Context, in io.Reader, out io.Writer) error {
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 0, 64*1024), mcpMaxMessageBytes)
+
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+	)
+	encoder := json.NewEncoder(out)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		message := append([]byte(nil), line...)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp := s.handleMessage(ctx, message)
+			if resp == nil {
+				return
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			_ = encoder.Encode(resp)
+		}()
+	}
+	wg.Wait()
+	return scanner.Err()
+}
+
+// ServeHTTP implements the streamable HTTP transport with plain JSON responses.
+func (s *mcpServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/mcp" {
+		http.NotFound(w, r)
+		return
+	}
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type")); err != nil || mediaType != "application/json" {
+		http.Error(w, "Content-Type must be application/json", http.StatusUnsupportedMediaType)
+		return
+	}
+
+	data, err := io.ReadAll(io.LimitReader(r.Body, mcpMaxMessageBytes))
+	if err != nil {
+		http.Error(w, "failed to read request", http.StatusBadRequest)
+		return
+	}
+	resp := s.handleMessage(r.Context(), data)
+	if resp == nil {
+		w.WriteHeader(http.StatusAccepted)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// mcpHTTPGuard rejects requests a browser could forge against the local
+// server: cross-site requests (Origin not localhost) and DNS rebinding
+// (Host not the bind address). When token is set, every request must also
+// carry it as a bearer token.
+type mcpHTTPGuard struct {
+	next     http.Handler
+	bindHost string
+	port     string
+	token    string
+}
+
+func newMCPHTTPGuard(next http.Handler, bindHost string, port int, token string) *mcpHTTPGuard {
+	return &mcpHTTPGuard{
+		next:     next,
+		bindHost: strings.Trim(strings.ToLower(bindHost), "[]"),
+		port:     strconv.Itoa(port),
+		token:    token,
+	}
+}
+
+func (g *mcpHTTPGuard) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if g.token != "" && !g.authorized(r.Header.Get("Authorization")) {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	if !g.allowedHost(r.Host) {
+		http.Error(w, "invalid Host header", http.StatusMisdirectedRequest)
+		return
+	}
+	if origin := r.Header.Get("Origin"); origin != "" && !isLoopbackOrigin(origin) {
+		http.Error(w, "cross-origin requests are not allowed", http.StatusForbidden)
+		return
+	}
+	g.next.ServeHTTP(w, r)
+}
+
+func (g *mcpHTTPGuard) authorized(header string) bool {
+	scheme, token, ok := strings.Cut(strings.TrimSpace(header), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(strings.TrimSpace(token)), []byte(g.token)) == 1
+}
+
+// allowedHost accepts the bind address itself, loopback names when bound to
+// loopback or all interfaces, and IP literals when bound to all interfaces.
+// IP literals cannot be rebound, unlike DNS names.
+func (g *mcpHTTPGuard) allowedHost(value string) bool {
+	host, port, err := net.SplitHostPort(value)
+	if err != nil || port != g.port {
+		return false
+	}
+	host = strings.ToLower(host)
+	if host == g.bindHost {
+		return true
+	}
+	wildcard := g.bindHost == "0.0.0.0" || g.bindHost == "::"
+	if isLoopbackHost(host) {
+		return wildcard || isLoopbackHost(g.bindHost)
+	}
+	return wildcard && net.ParseIP(host) != nil
+}
+
+func isLoopbackOrigin(origin string) bool {
+	parsed, err := url.Parse(origin)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+		return false
+	}
+	return isLoopbackHost(strings.ToLower(parsed.Hostname()))
+}
+
+func isLoopbackHost(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
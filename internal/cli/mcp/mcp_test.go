@@ -0,0 +1,320 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/status"
+)
+
+func testMCPCommands() []*ffcli.Command {
+	list := flag.NewFlagSet("list", flag.ContinueOnError)
+	list.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	list.Int("limit", 0, "Maximum results per page (1-200)")
+	list.Bool("paginate", false, "Automatically fetch all pages")
+	list.String("output", "json", "Output format")
+
+	expire := flag.NewFlagSet("expire", flag.ContinueOnError)
+	expire.String("build", "", "Build ID")
+	expire.Bool("confirm", false, "Confirm expiration")
+
+	notify := flag.NewFlagSet("notify", flag.ContinueOnError)
+	notify.String("build", "", "Build ID")
+
+	return []*ffcli.Command{{
+		Name: "builds",
+		Subcommands: []*ffcli.Command{
+			{Name: "list", ShortHelp: "List builds.", FlagSet: list},
+			{Name: "expire", ShortHelp: "Expire a build.", FlagSet: expire},
+			{Name: "notify", ShortHelp: "Notify testers.", FlagSet: notify},
+		},
+	}}
+}
+
+func withTestToolPaths(t *testing.T) {
+	t.Helper()
+	previous := mcpToolSpecs
+	mcpToolSpecs = []mcpToolSpec{
+		{path: []string{"builds", "list"}, required: []string{"app"}},
+		{path: []string{"builds", "expire"}, mutating: true, required: []string{"build"}},
+		{path: []string{"builds", "notify"}, mutating: true},
+	}
+	t.Cleanup(func() { mcpToolSpecs = previous })
+}
+
+func TestBuildMCPToolsGeneratesSchemasAndGatesMutatingTools(t *testing.T) {
+	withTestToolPaths(t)
+
+	tools, err := buildMCPTools(testMCPCommands(), nil)
+	if err != nil {
+		t.Fatalf("buildMCPTools() error: %v", err)
+	}
+	if len(tools) != 1 || tools[0].Name != "builds_list" {
+		t.Fatalf("expected only builds_list without an allowlist, got %+v", tools)
+	}
+
+	schema := tools[0].InputSchema
+	properties := schema["properties"].(map[string]any)
+	if _, ok := properties["output"]; ok {
+		t.Fatal("expected --output to be hidden from the schema")
+	}
+	if properties["limit"].(map[string]any)["type"] != "integer" || properties["paginate"].(map[string]any)["type"] != "boolean" {
+		t.Fatalf("unexpected property types %+v", properties)
+	}
+	if required := schema["required"].([]string); !slices.Equal(required, []string{"app"}) {
+		t.Fatalf("unexpected required %v", required)
+	}
+
+	tools, err = buildMCPTools(testMCPCommands(), map[string]bool{"builds_expire": true})
+	if err != nil {
+		t.Fatalf("buildMCPTools() error: %v", err)
+	}
+	if len(tools) != 2 || !tools[1].mutating || !tools[1].confirm {
+		t.Fatalf("expected allowlisted builds_expire, got %+v", tools)
+	}
+	if required := tools[1].InputSchema["required"].([]string); !slices.Equal(required, []string{"build"}) {
+		t.Fatalf("unexpected builds_expire required %v", required)
+	}
+	if names := mutatingMCPToolNames(); !slices.Equal(names, []string{"builds_expire", "builds_notify"}) {
+		t.Fatalf("unexpected mutating tools %v", names)
+	}
+
+	mcpToolSpecs = []mcpToolSpec{{path: []string{"builds", "notify"}, required: []string{"id"}}}
+	if _, err := buildMCPTools(testMCPCommands(), nil); err == nil || !strings.Contains(err.Error(), "no required flag --id") {
+		t.Fatalf("expected unknown required flag error, got %v", err)
+	}
+}
+
+func TestBuildMCPToolsIncludesStatusDashboard(t *testing.T) {
+	previous := mcpToolSpecs
+	t.Cleanup(func() { mcpToolSpecs = previous })
+	mcpToolSpecs = slices.DeleteFunc(slices.Clone(previous), func(spec mcpToolSpec) bool {
+		return !slices.Equal(spec.path, []string{"status"})
+	})
+	if len(mcpToolSpecs) != 1 {
+		t.Fatalf("expected a curated status tool, got %+v", mcpToolSpecs)
+	}
+
+	tools, err := buildMCPTools([]*ffcli.Command{status.StatusCommand()}, nil)
+	if err != nil {
+		t.Fatalf("buildMCPTools() error: %v", err)
+	}
+	if len(tools) != 1 || tools[0].Name != "status" || tools[0].mutating {
+		t.Fatalf("expected a read-only status tool, got %+v", tools)
+	}
+	properties := tools[0].InputSchema["properties"].(map[string]any)
+	for _, name := range []string{"app", "include"} {
+		if _, ok := properties[name]; !ok {
+			t.Fatalf("expected --%s in the status schema, got %+v", name, properties)
+		}
+	}
+	if _, ok := properties["output"]; ok {
+		t.Fatal("expected --output to be hidden from the status schema")
+	}
+}
+
+func TestMCPServerToolsCall(t *testing.T) {
+	withTestToolPaths(t)
+	tools, err := buildMCPTools(testMCPCommands(), map[string]bool{"builds_expire": true, "builds_notify": true})
+	if err != nil {
+		t.Fatalf("buildMCPTools() error: %v", err)
+	}
+
+	var gotArgs [][]string
+	run := func(ctx context.Context, args []string) ([]byte, []byte, int, error) {
+		gotArgs = append(gotArgs, args)
+		if args[1] == "expire" {
+			return nil, []byte("Error: build not found"), 4, nil
+		}
+		return []byte(`{"data":[{"type":"builds","id":"1"}]}`), nil, 0, nil
+	}
+	server := newMCPServer("1.2.3", tools, run)
+
+	resp := server.handleMessage(context.Background(), []byte(`{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"builds_list","arguments":{"app":"123","limit":50,"paginate":true}}}`))
+	if resp.Error != nil {
+		t.Fatalf("unexpected error %+v", resp.Error)
+	}
+	result := resp.Result.(*mcpToolResult)
+	if result.IsError || result.StructuredContent.(map[string]any)["data"] == nil {
+		t.Fatalf("unexpected result %+v", result)
+	}
+	want := []string{"builds", "list", "--app=123", "--limit=50", "--paginate=true", "--output", "json"}
+	if !slices.Equal(gotArgs[0], want) {
+		t.Fatalf("args = %v, want %v", gotArgs[0], want)
+	}
+
+	resp = server.handleMessage(context.Background(), []byte(`{"jsonrpc":"2.0","id":2,"method":"tools/call","params":{"name":"builds_expire","arguments":{"build":"b1"}}}`))
+	result = resp.Result.(*mcpToolResult)
+	if !result.IsError || result.Content[0].Text != "Error: build not found" {
+		t.Fatalf("expected tool error, got %+v", result)
+	}
+	if !slices.Contains(gotArgs[1], "--confirm") {
+		t.Fatalf("expected --confirm for allowlisted tool, got %v", gotArgs[1])
+	}
+
+	server.handleMessage(context.Background(), []byte(`{"jsonrpc":"2.0","id":5,"method":"tools/call","params":{"name":"builds_notify","arguments":{"build":"b1"}}}`))
+	if want := []string{"builds", "notify", "--build=b1", "--output", "json"}; !slices.Equal(gotArgs[2], want) {
+		t.Fatalf("args = %v, want %v", gotArgs[2], want)
+	}
+
+	resp = server.handleMessage(context.Background(), []byte(`{"jsonrpc":"2.0","id":3,"method":"tools/call","params":{"name":"builds_list","arguments":{"limit":"fifty"}}}`))
+	if resp.Error == nil || resp.Error.Code != rpcInvalidParams {
+		t.Fatalf("expected invalid params, got %+v", resp)
+	}
+	if resp := server.handleMessage(context.Background(), []byte(`{"jsonrpc":"2.0","method":"notifications/initialized"}`)); resp != nil {
+		t.Fatalf("expected no response for notification, got %+v", resp)
+	}
+	if resp := server.handleMessage(context.Background(), []byte(`{"jsonrpc":"2.0","id":4,"method":"resources/list"}`)); resp.Error == nil || resp.Error.Code != rpcMethodNotFound {
+		t.Fatalf("expected method not found, got %+v", resp)
+	}
+}
+
+func TestMCPServerTransports(t *testing.T) {
+	withTestToolPaths(t)
+	tools, err := buildMCPTools(testMCPCommands(), nil)
+	if err != nil {
+		t.Fatalf("buildMCPTools() error: %v", err)
+	}
+	server := newMCPServer("1.2.3", tools, nil)
+
+	var out strings.Builder
+	in := strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"protocolVersion":"2025-03-26"}}` + "\n" +
+		`{"jsonrpc":"2.0","method":"notifications/initialized"}` + "\n")
+	if err := server.serveStdio(context.Background(), in, &out); err != nil {
+		t.Fatalf("serveStdio() error: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 1 || !strings.Contains(lines[0], `"protocolVersion":"2025-03-26"`) {
+		t.Fatalf("unexpected stdio output %q", out.String())
+	}
+
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+	resp, err := http.Post(httpServer.URL+"/mcp", "application/json", strings.NewReader(`{"jsonrpc":"2.0","id":"a","method":"tools/list"}`))
+	if err != nil {
+		t.Fatalf("POST /mcp: %v", err)
+	}
+	defer resp.Body.Close()
+	var payload struct {
+		ID     string `json:"id"`
+		Result struct {
+			Tools []struct {
+				Name string `json:"name"`
+			} `json:"tools"`
+		} `json:"result"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&payload); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if payload.ID != "a" || len(payload.Result.Tools) != 1 || payload.Result.Tools[0].Name != "builds_list" {
+		t.Fatalf("unexpected tools/list response %+v", payload)
+	}
+}
+
+func TestMCPHTTPGuardRejectsForgedRequests(t *testing.T) {
+	withTestToolPaths(t)
+	tools, err := buildMCPTools(testMCPCommands(), nil)
+	if err != nil {
+		t.Fatalf("buildMCPTools() error: %v", err)
+	}
+	handler := newMCPHTTPGuard(newMCPServer("1.2.3", tools, nil), "127.0.0.1", 8789, "")
+
+	tests := []struct {
+		name        string
+		host        string
+		origin      string
+		contentType string
+		wantStatus  int
+	}{
+		{name: "loopback", host: "127.0.0.1:8789", contentType: "application/json", wantStatus: http.StatusOK},
+		{name: "localhost origin", host: "localhost:8789", origin: "http://localhost:3000", contentType: "application/json; charset=utf-8", wantStatus: http.StatusOK},
+		{name: "foreign origin", host: "127.0.0.1:8789", origin: "https://evil.example", contentType: "application/json", wantStatus: http.StatusForbidden},
+		{name: "null origin", host: "127.0.0.1:8789", origin: "null", contentType: "application/json", wantStatus: http.StatusForbidden},
+		{name: "rebound host", host: "evil.example:8789", contentType: "application/json", wantStatus: http.StatusMisdirectedRequest},
+		{name: "wrong port", host: "127.0.0.1:9999", contentType: "application/json", wantStatus: http.StatusMisdirectedRequest},
+		{name: "form content type", host: "127.0.0.1:8789", contentType: "text/plain", wantStatus: http.StatusUnsupportedMediaType},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/mcp", strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"tools/list"}`))
+			req.Host = test.host
+			req.Header.Set("Content-Type", test.contentType)
+			if test.origin != "" {
+				req.Header.Set("Origin", test.origin)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			if rec.Code != test.wantStatus {
+				t.Fatalf("expected status %d, got %d (%s)", test.wantStatus, rec.Code, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestMCPHTTPGuardWildcardBindAcceptsIPLiterals(t *testing.T) {
+	guard := newMCPHTTPGuard(http.NotFoundHandler(), "0.0.0.0", 8789, "")
+	if !guard.allowedHost("192.168.1.20:8789") || !guard.allowedHost("localhost:8789") {
+		t.Fatal("expected IP literal and loopback hosts to be allowed on a wildcard bind")
+	}
+	if guard.allowedHost("attacker.example:8789") {
+		t.Fatal("expected DNS names to be rejected on a wildcard bind")
+	}
+}
+
+func TestMCPHTTPGuardRequiresBearerToken(t *testing.T) {
+	guard := newMCPHTTPGuard(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}), "0.0.0.0", 8789, "s3cret")
+
+	tests := []struct {
+		name          string
+		authorization string
+		wantStatus    int
+	}{
+		{name: "missing", wantStatus: http.StatusUnauthorized},
+		{name: "wrong token", authorization: "Bearer nope", wantStatus: http.StatusUnauthorized},
+		{name: "wrong scheme", authorization: "Basic s3cret", wantStatus: http.StatusUnauthorized},
+		{name: "valid", authorization: "Bearer s3cret", wantStatus: http.StatusNoContent},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/mcp", strings.NewReader(`{}`))
+			req.Host = "192.168.1.20:8789"
+			if test.authorization != "" {
+				req.Header.Set("Authorization", test.authorization)
+			}
+			rec := httptest.NewRecorder()
+			guard.ServeHTTP(rec, req)
+			if rec.Code != test.wantStatus {
+				t.Fatalf("expected status %d, got %d", test.wantStatus, rec.Code)
+			}
+		})
+	}
+}
+
+func TestResolveMCPHTTPTokenRequiresTokenBeyondLoopback(t *testing.T) {
+	t.Setenv(mcpTokenEnvVar, "")
+	for _, host := range []string{"127.0.0.1", "localhost", "[::1]"} {
+		if token, err := resolveMCPHTTPToken(host); err != nil || token != "" {
+			t.Fatalf("expected %s to be allowed without a token, got %q, %v", host, token, err)
+		}
+	}
+	for _, host := range []string{"0.0.0.0", "::", "192.168.1.20"} {
+		if _, err := resolveMCPHTTPToken(host); err == nil || !strings.Contains(err.Error(), mcpTokenEnvVar) {
+			t.Fatalf("expected %s to require a token, got %v", host, err)
+		}
+	}
+
+	t.Setenv(mcpTokenEnvVar, " s3cret ")
+	if token, err := resolveMCPHTTPToken("0.0.0.0"); err != nil || token != "s3cret" {
+		t.Fatalf("expected token from env, got %q, %v", token, err)
+	}
+}
@@ -0,0 +1,359 @@
+package mcp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// mcpToolSpec names a curated command. Mutating tools change App Store
+// Connect state and are only exposed when allowlisted. Required lists the
+// flags the command cannot run without; flags with an env fallback such as
+// --app are left optional.
+type mcpToolSpec struct {
+	path     []string
+	mutating bool
+	required []string
+}
+
+// mcpToolSpecs is the curated set of commands exposed as tools.
+var mcpToolSpecs = []mcpToolSpec{
+	{path: []string{"status"}},
+	{path: []string{"apps", "list"}},
+	{path: []string{"apps", "get"}, required: []string{"id"}},
+	{path: []string{"builds", "list"}},
+	{path: []string{"builds", "latest"}},
+	{path: []string{"builds", "info"}, required: []string{"build"}},
+	{path: []string{"builds", "expire"}, mutating: true, required: []string{"build"}},
+	{path: []string{"testflight", "beta-groups", "list"}},
+	{path: []string{"testflight", "beta-testers", "list"}},
+	{path: []string{"testflight", "beta-testers", "add"}, mutating: true, required: []string{"email", "group"}},
+	{path: []string{"submit", "status"}},
+	{path: []string{"submit", "cancel"}, mutating: true},
+	{path: []string{"review", "submissions-list"}},
+	{path: []string{"reviews", "list"}},
+	{path: []string{"reviews", "get"}, required: []string{"id"}},
+	{path: []string{"reviews", "ratings"}, required: []string{"app"}},
+	{path: []string{"reviews", "respond"}, mutating: true, required: []string{"review-id", "response"}},
+	{path: []string{"metadata", "validate"}, required: []string{"dir"}},
+}
+
+// mcpHiddenFlags are controlled by the server rather than the caller.
+var mcpHiddenFlags = map[string]bool{
+	"output":  true,
+	"pretty":  true,
+	"confirm": true,
+	"stream":  true,
+}
+
+type mcpTool struct {
+	Name        string         `json:"name"`
+	Description string         `json:"description,omitempty"`
+	InputSchema map[string]any `json:"inputSchema"`
+
+	path     []string
+	flags    map[string]string
+	mutating bool
+	confirm  bool
+}
+
+type mcpToolResult struct {
+	Content           []mcpContent `json:"content"`
+	StructuredContent any          `json:"structuredContent,omitempty"`
+	IsError           bool         `json:"isError"`
+}
+
+type mcpContent struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// mcpRunner executes asc with args and returns stdout, stderr and the exit code.
+type mcpRunner func(ctx context.Context, args []string) (stdout, stderr []byte, exitCode int, err error)
+
+// buildMCPTools resolves the curated tool paths against the command tree.
+// Mutating tools are dropped unless their name is in allow.
+func buildMCPTools(rootSubcommands []*ffcli.Command, allow map[string]bool) ([]*mcpTool, error) {
+	tools := make([]*mcpTool, 0, len(mcpToolSpecs))
+	for _, spec := range mcpToolSpecs {
+		cmd := findCommand(rootSubcommands, spec.path)
+		if cmd == nil || cmd.FlagSet == nil {
+			return nil, fmt.Errorf("command %q not found", strings.Join(spec.path, " "))
+		}
+		tool, err := newMCPTool(spec, cmd)
+		if err != nil {
+			return nil, err
+		}
+		if tool.mutating && !allow[tool.Name] {
+			continue
+		}
+		tools = append(tools, tool)
+	}
+	return tools, nil
+}
+
+// mcpToolName converts a command path like "testflight beta-groups list" to
+// "testflight_beta_groups_list".
+func mcpToolName(path []string) string {
+	return strings.ReplaceAll(strings.Join(path, "_"), "-", "_")
+}
+
+// mutatingMCPToolNames lists the names of curated tools marked as mutating.
+func mutatingMCPToolNames() []string {
+	var names []string
+	for _, spec := range mcpToolSpecs {
+		if spec.mutating {
+			names = append(names, mcpToolName(spec.path))
+		}
+	}
+	return names
+}
+
+func findCommand(commands []*ffcli.Command, path []string) *ffcli.Command {
+	var current *ffcli.Command
+	for _, name := range path {
+		current = nil
+		for _, cmd := range commands {
+			if cmd != nil && cmd.Name == name {
+				current = cmd
+				break
+			}
+		}
+		if current == nil {
+			return nil
+		}
+		commands = current.Subcommands
+	}
+	return current
+}
+
+// newMCPTool generates the tool's JSON schema from the command's flag set.
+func newMCPTool(spec mcpToolSpec, cmd *ffcli.Command) (*mcpTool, error) {
+	tool := &mcpTool{
+		Name:        mcpToolName(spec.path),
+		Description: strings.TrimSpace(cmd.ShortHelp),
+		path:        spec.path,
+		flags:       map[string]string{},
+		mutating:    spec.mutating,
+		confirm:     cmd.FlagSet.Lookup("confirm") != nil,
+	}
+	switch {
+	case tool.confirm:
+		tool.Description += " Mutating: runs with --confirm."
+	case tool.mutating:
+		tool.Description += " Mutating: changes App Store Connect data."
+	}
+
+	properties := map[string]any{}
+	cmd.FlagSet.VisitAll(func(f *flag.Flag) {
+		if mcpHiddenFlags[f.Name] {
+			return
+		}
+		kind := flagSchemaType(f)
+		property := map[string]any{
+			"type":        kind,
+			"description": f.Usage,
+		}
+		if def := f.DefValue; def != "" && def != "0" && def != "false" && def != "[]" {
+			if value, ok := schemaDefault(kind, def); ok {
+				property["default"] = value
+			}
+		}
+		properties[f.Name] = property
+		tool.flags[f.Name] = kind
+	})
+
+	required := make([]string, 0, len(spec.required))
+	for _, name := range spec.required {
+		if _, ok := tool.flags[name]; !ok {
+			return nil, fmt.Errorf("command %q has no required flag --%s", strings.Join(spec.path, " "), name)
+		}
+		required = append(required, name)
+	}
+	sort.Strings(required)
+
+	schema := map[string]any{
+		"type":                 "object",
+		"properties":           properties,
+		"additionalProperties": false,
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	tool.InputSchema = schema
+	return tool, nil
+}
+
+// flagSchemaType maps a flag's value type to a JSON schema type.
+func flagSchemaType(f *flag.Flag) string {
+	if boolFlag, ok := f.Value.(interface{ IsBoolFlag() bool }); ok && boolFlag.IsBoolFlag() {
+		return "boolean"
+	}
+	switch reflect.TypeOf(f.Value).String() {
+	case "*flag.intValue", "*flag.int64Value", "*flag.uintValue", "*flag.uint64Value":
+		return "integer"
+	case "*flag.float64Value":
+		return "number"
+	default:
+		return "string"
+	}
+}
+
+func schemaDefault(kind, value string) (any, bool) {
+	switch kind {
+	case "integer":
+		n, err := strconv.ParseInt(value, 10, 64)
+		return n, err == nil
+	case "number":
+		n, err := strconv.ParseFloat(value, 64)
+		return n, err == nil
+	case "boolean":
+		b, err := strconv.ParseBool(value)
+		return b, err == nil
+	default:
+		return value, true
+	}
+}
+
+// commandArgs converts tool call arguments into command-line arguments.
+func (tool *mcpTool) commandArgs(arguments map[string]any) ([]string, error) {
+	names := make([]string, 0, len(arguments))
+	for name := range arguments {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	args := append([]string{}, tool.path...)
+	for _, name := range names {
+		kind, ok := tool.flags[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown argument %q", name)
+		}
+		value, err := flagArgValue(kind, arguments[name])
+		if err != nil {
+			return nil, fmt.Errorf("argument %q: %w", name, err)
+		}
+		args = append(args, "--"+name+"="+value)
+	}
+	if tool.confirm {
+		args = append(args, "--confirm")
+	}
+	return append(args, "--output", "json"), nil
+}
+
+func flagArgValue(kind string, value any) (string, error) {
+	switch v := value.(type) {
+	case bool:
+		if kind != "boolean" {
+			return "", fmt.Errorf("expected %s, got boolean", kind)
+		}
+		return strconv.FormatBool(v), nil
+	case float64:
+		if kind == "boolean" {
+			return "", fmt.Errorf("expected boolean, got number")
+		}
+		if kind == "integer" && v != float64(int64(v)) {
+			return "", fmt.Errorf("expected integer, got %v", v)
+		}
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case string:
+		if kind == "boolean" || kind == "integer" || kind == "number" {
+			return "", fmt.Errorf("expected %s, got string", kind)
+		}
+		return v, nil
+	case []any:
+		// Comma-separated flags accept a list.
+		if kind != "string" {
+			return "", fmt.Errorf("expected %s, got array", kind)
+		}
+		items := make([]string, 0, len(v))
+		for _, item := range v {
+			text, ok := item.(string)
+			if !ok {
+				return "", fmt.Errorf("array items must be strings")
+			}
+			items = append(items, text)
+		}
+		return strings.Join(items, ","), nil
+	default:
+		return "", fmt.Errorf("unsupported value type %T", value)
+	}
+}
+
+// callTool runs the tool's command and converts its output to a tool result.
+// Command failures are reported as tool errors rather than protocol errors;
+// commands that print a JSON report before failing (e.g. metadata validate)
+// keep that report as structured content.
+func callTool(ctx context.Context, tool *mcpTool, args []string, run mcpRunner) (*mcpToolResult, error) {
+	stdout, stderr, exitCode, err := run(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+
+	trimmed := bytes.TrimSpace(stdout)
+	var structured any
+	if len(trimmed) > 0 && json.Unmarshal(trimmed, &structured) == nil {
+		// Structured content must be an object.
+		if _, ok := structured.(map[string]any); !ok {
+			structured = map[string]any{"result": structured}
+		}
+	}
+
+	if exitCode == 0 {
+		return &mcpToolResult{
+			Content:           []mcpContent{{Type: "text", Text: string(trimmed)}},
+			StructuredContent: structured,
+		}, nil
+	}
+
+	message := strings.TrimSpace(string(stderr))
+	if message == "" {
+		message = fmt.Sprintf("%s exited with code %d", tool.Name, exitCode)
+	}
+	content := []mcpContent{{Type: "text", Text: message}}
+	if structured == nil {
+		structured = map[string]any{"error": message, "exitCode": exitCode}
+	} else {
+		content = append(content, mcpContent{Type: "text", Text: string(trimmed)})
+	}
+	return &mcpToolResult{Content: content, StructuredContent: structured, IsError: true}, nil
+}
+
+// execRunner runs the current asc executable as a subprocess so tool output
+// never mixes with the server's own stdout.
+func execRunner(ctx context.Context, args []string) ([]byte, []byte, int, error) {
+	executable, err := os.Executable()
+	if err != nil {
+		return nil, nil, 0, fmt.Errorf("failed to locate asc executable: %w", err)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, executable, args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	cmd.Env = os.Environ()
+	if profile := shared.SelectedProfile(); profile != "" {
+		cmd.Env = append(cmd.Env, "ASC_PROFILE="+profile)
+	}
+
+	if err := cmd.Run(); err != nil {
+		if exitErr, ok := errors.AsType[*exec.ExitError](err); ok {
+			return stdout.Bytes(), stderr.Bytes(), exitErr.ExitCode(), nil
+		}
+		return nil, nil, 0, err
+	}
+	return stdout.Bytes(), stderr.Bytes(), 0, nil
+}
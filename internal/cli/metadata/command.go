@@ -28,13 +28,17 @@ Not yet included in this group:
 
 Examples:
   asc metadata pull --app "APP_ID" --version "1.2.3" --dir "./metadata"
-  asc metadata pull --app "APP_ID" --version "1.2.3" --platform IOS --dir "./metadata"`,
+  asc metadata pull --app "APP_ID" --version "1.2.3" --platform IOS --dir "./metadata"
+  asc metadata translate --dir "./metadata" --version "1.2.3" --from en-US --to de-DE,ja --provider deepl
+  asc metadata keywords analyze --dir "./metadata" --version "1.2.3"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			MetadataPullCommand(),
 			MetadataPushCommand(),
 			MetadataValidateCommand(),
+			MetadataTranslateCommand(),
+			MetadataKeywordsCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
@@ -0,0 +1,607 @@
+package metadata
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/validation"
+)
+
+const (
+	keywordFindingNameOverlap = "name-overlap"
+	keywordFindingDuplicate   = "duplicate"
+	keywordFindingCommaSpace  = "comma-space"
+	keywordFindingPlural      = "plural"
+	keywordFindingStopWord    = "stop-word"
+	keywordFindingCrossLocale = "cross-locale"
+	keywordSeverityWarning    = "warning"
+	keywordSeverityInfo       = "info"
+)
+
+// keywordStorefrontLocales lists storefronts that index more than one locale.
+// Keywords repeated across these locales use space twice for the same search
+// coverage. Based on Apple's published additional-language indexing; not exhaustive.
+var keywordStorefrontLocales = map[string][]string{
+	"USA": {"en-US", "es-MX", "ar-SA", "zh-Hans", "zh-Hant", "fr-FR", "ko", "pt-BR", "ru", "vi"},
+	"CAN": {"en-CA", "fr-CA"},
+	"MEX": {"es-MX", "en-US"},
+	"GBR": {"en-GB", "en-US"},
+	"AUS": {"en-AU", "en-GB"},
+	"CHE": {"de-DE", "fr-FR", "it"},
+	"BEL": {"nl-NL", "fr-FR", "en-GB"},
+	"JPN": {"ja", "en-US"},
+	"CHN": {"zh-Hans", "en-GB"},
+}
+
+// keywordStopWords are words Apple already matches or ignores, keyed by language.
+var keywordStopWords = map[string]map[string]bool{
+	"en": wordSet("a", "an", "and", "app", "apps", "by", "for", "free", "iphone", "ipad", "in", "of", "on", "or", "the", "to", "with"),
+	"de": wordSet("app", "apps", "das", "der", "die", "für", "gratis", "kostenlos", "mit", "und"),
+	"fr": wordSet("app", "appli", "application", "de", "des", "et", "gratuit", "la", "le", "les", "pour"),
+	"es": wordSet("app", "aplicación", "con", "de", "el", "gratis", "la", "las", "los", "para", "y"),
+	"it": wordSet("app", "con", "di", "e", "gratis", "il", "la", "per"),
+	"pt": wordSet("app", "com", "de", "e", "grátis", "o", "a", "para"),
+	"nl": wordSet("app", "de", "en", "gratis", "het", "met", "voor"),
+}
+
+// KeywordFinding is one keyword optimization suggestion.
+type KeywordFinding struct {
+	Locale   string `json:"locale"`
+	Type     string `json:"type"`
+	Severity string `json:"severity"`
+	Term     string `json:"term,omitempty"`
+	Message  string `json:"message"`
+	Savings  int    `json:"savings,omitempty"`
+}
+
+// KeywordLocaleBudget summarizes keyword usage for one locale.
+type KeywordLocaleBudget struct {
+	Locale           string `json:"locale"`
+	Keywords         string `json:"keywords"`
+	Used             int    `json:"used"`
+	Limit            int    `json:"limit"`
+	Remaining        int    `json:"remaining"`
+	PotentialSavings int    `json:"potentialSavings"`
+}
+
+// KeywordsAnalyzeResult is the structured result for metadata keywords analyze.
+type KeywordsAnalyzeResult struct {
+	Dir      string                `json:"dir"`
+	Version  string                `json:"version"`
+	Locales  []KeywordLocaleBudget `json:"locales"`
+	Findings []KeywordFinding      `json:"findings"`
+}
+
+// keywordLocaleInput is the metadata Apple indexes together for one locale.
+type keywordLocaleInput struct {
+	Name     string
+	Subtitle string
+	Keywords string
+}
+
+// MetadataKeywordsCommand returns the metadata keywords command group.
+func MetadataKeywordsCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("metadata keywords", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "keywords",
+		ShortUsage: "asc metadata keywords <subcommand> [flags]",
+		ShortHelp:  "Optimize the keywords field offline.",
+		LongHelp: `Optimize the keywords field offline.
+
+Examples:
+  asc metadata keywords analyze --dir "./metadata" --version "1.2.3"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			MetadataKeywordsAnalyzeCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// MetadataKeywordsAnalyzeCommand returns the metadata keywords analyze subcommand.
+func MetadataKeywordsAnalyzeCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("metadata keywords analyze", flag.ExitOnError)
+
+	dir := fs.String("dir", "", "Metadata root directory (required)")
+	version := fs.String("version", "", "App version string (default: the only version in --dir)")
+	locale := fs.String("locale", "", "Only report these locale(s), comma-separated")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "analyze",
+		ShortUsage: "asc metadata keywords analyze --dir \"./metadata\" [--version \"1.2.3\"]",
+		ShortHelp:  "Find wasted characters in the keywords field.",
+		LongHelp: `Find wasted characters in the keywords field.
+
+Reads canonical metadata files (see metadata pull) and reports, per locale:
+  - keyword words already in the app name or subtitle (indexed together)
+  - keywords repeated within the field
+  - spaces after commas
+  - singular/plural pairs and stop words Apple already matches
+  - words repeated across locales indexed by the same storefront
+  - characters used and left of the 100-character budget
+
+Runs fully offline; no App Store Connect credentials are needed.
+
+Examples:
+  asc metadata keywords analyze --dir "./metadata"
+  asc metadata keywords analyze --dir "./metadata" --version "1.2.3" --locale "en-US,es-MX"
+  asc metadata keywords analyze --dir "./metadata" --output markdown`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if len(args) > 0 {
+				return shared.UsageError("metadata keywords analyze does not accept positional arguments")
+			}
+			dirValue := strings.TrimSpace(*dir)
+			if dirValue == "" {
+				return shared.UsageError("--dir is required")
+			}
+			versionValue, err := resolveKeywordsVersion(dirValue, strings.TrimSpace(*version))
+			if err != nil {
+				return err
+			}
+
+			inputs, err := loadKeywordInputs(dirValue, versionValue)
+			if err != nil {
+				return err
+			}
+
+			result := analyzeKeywords(inputs)
+			result.Dir = dirValue
+			result.Version = versionValue
+			if filter := shared.SplitCSV(*locale); len(filter) > 0 {
+				result = filterKeywordsResult(result, filter)
+			}
+
+			return shared.PrintOutputWithRenderers(
+				result,
+				*output.Output,
+				*output.Pretty,
+				func() error { return printKeywordsAnalyzeResult(result, asc.RenderTable) },
+				func() error { return printKeywordsAnalyzeResult(result, asc.RenderMarkdown) },
+			)
+		},
+	}
+}
+
+func resolveKeywordsVersion(dir, version string) (string, error) {
+	if version != "" {
+		resolved, err := validatePathSegment("version", version)
+		if err != nil {
+			return "", shared.UsageError(err.Error())
+		}
+		return resolved, nil
+	}
+	entries, err := os.ReadDir(filepath.Join(dir, versionDirName))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return "", shared.UsageErrorf("no %s directory in %s", versionDirName, dir)
+		}
+		return "", fmt.Errorf("metadata keywords analyze: %w", err)
+	}
+	var versions []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			versions = append(versions, entry.Name())
+		}
+	}
+	if len(versions) != 1 {
+		return "", shared.UsageErrorf("--version is required (found %d versions in %s)", len(versions), dir)
+	}
+	return versions[0], nil
+}
+
+// loadKeywordInputs reads name/subtitle and keywords for every explicit locale.
+func loadKeywordInputs(dir, version string) (map[string]keywordLocaleInput, error) {
+	inputs := map[string]keywordLocaleInput{}
+
+	appInfoDir := filepath.Join(dir, appInfoDirName)
+	entries, err := os.ReadDir(appInfoDir)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("metadata keywords analyze: failed to read %s: %w", appInfoDir, err)
+	}
+	for _, entry := range entries {
+		locale, ok, err := keywordFileLocale(entry)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		loc, err := ReadAppInfoLocalizationFile(filepath.Join(appInfoDir, entry.Name()))
+		if err != nil {
+			return nil, shared.UsageErrorf("invalid metadata schema in %s: %v", filepath.Join(appInfoDir, entry.Name()), err)
+		}
+		input := inputs[locale]
+		input.Name = loc.Name
+		input.Subtitle = loc.Subtitle
+		inputs[locale] = input
+	}
+
+	versionDir := filepath.Join(dir, versionDirName, version)
+	entries, err = os.ReadDir(versionDir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, shared.UsageErrorf("no metadata for version %q in %s", version, dir)
+		}
+		return nil, fmt.Errorf("metadata keywords analyze: failed to read %s: %w", versionDir, err)
+	}
+	for _, entry := range entries {
+		locale, ok, err := keywordFileLocale(entry)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		loc, err := ReadVersionLocalizationFile(filepath.Join(versionDir, entry.Name()))
+		if err != nil {
+			return nil, shared.UsageErrorf("invalid metadata schema in %s: %v", filepath.Join(versionDir, entry.Name()), err)
+		}
+		input := inputs[locale]
+		input.Keywords = loc.Keywords
+		inputs[locale] = input
+	}
+
+	for locale, input := range inputs {
+		if input.Keywords == "" {
+			delete(inputs, locale)
+		}
+	}
+	if len(inputs) == 0 {
+		return nil, shared.UsageErrorf("no keywords found for version %q in %s", version, dir)
+	}
+	return inputs, nil
+}
+
+// keywordFileLocale resolves the locale of a metadata file, skipping default.json.
+func keywordFileLocale(entry os.DirEntry) (string, bool, error) {
+	if entry.IsDir() || filepath.Ext(entry.Name()) != ".json" {
+		return "", false, nil
+	}
+	locale, err := validateLocale(strings.TrimSuffix(entry.Name(), ".json"))
+	if err != nil {
+		return "", false, shared.UsageErrorf("invalid localization file %q: %v", entry.Name(), err)
+	}
+	return locale, locale != DefaultLocale, nil
+}
+
+func analyzeKeywords(inputs map[string]keywordLocaleInput) KeywordsAnalyzeResult {
+	result := KeywordsAnalyzeResult{
+		Locales:  []KeywordLocaleBudget{},
+		Findings: []KeywordFinding{},
+	}
+	savings := map[string]int{}
+	add := func(finding KeywordFinding) {
+		result.Findings = append(result.Findings, finding)
+		savings[finding.Locale] += finding.Savings
+	}
+
+	for _, locale := range sortedKeys(inputs) {
+		for _, finding := range analyzeLocaleKeywords(locale, inputs[locale]) {
+			add(finding)
+		}
+	}
+	for _, finding := range analyzeStorefrontOverlap(inputs) {
+		add(finding)
+	}
+
+	for _, locale := range sortedKeys(inputs) {
+		used := utf8.RuneCountInString(inputs[locale].Keywords)
+		remaining := validation.LimitKeywords - used
+		result.Locales = append(result.Locales, KeywordLocaleBudget{
+			Locale:           locale,
+			Keywords:         inputs[locale].Keywords,
+			Used:             used,
+			Limit:            validation.LimitKeywords,
+			Remaining:        remaining,
+			PotentialSavings: savings[locale],
+		})
+	}
+
+	sort.SliceStable(result.Findings, func(i, j int) bool {
+		if result.Findings[i].Locale != result.Findings[j].Locale {
+			return result.Findings[i].Locale < result.Findings[j].Locale
+		}
+		return result.Findings[i].Type < result.Findings[j].Type
+	})
+	return result
+}
+
+func analyzeLocaleKeywords(locale string, input keywordLocaleInput) []KeywordFinding {
+	var findings []KeywordFinding
+
+	if spaces := commaSpaceCount(input.Keywords); spaces > 0 {
+		findings = append(findings, KeywordFinding{
+			Locale:   locale,
+			Type:     keywordFindingCommaSpace,
+			Severity: keywordSeverityWarning,
+			Message:  fmt.Sprintf("remove %d space(s) around commas", spaces),
+			Savings:  spaces,
+		})
+	}
+
+	indexed := wordSet(append(keywordWords(input.Name), keywordWords(input.Subtitle)...)...)
+	stopWords := keywordStopWords[keywordLanguage(locale)]
+	seenEntries := map[string]bool{}
+	seenWords := map[string]bool{}
+	var allWords []string
+
+	for _, entry := range splitKeywordEntries(input.Keywords) {
+		folded := strings.ToLower(entry)
+		if seenEntries[folded] {
+			findings = append(findings, KeywordFinding{
+				Locale:   locale,
+				Type:     keywordFindingDuplicate,
+				Severity: keywordSeverityWarning,
+				Term:     entry,
+				Message:  fmt.Sprintf("%q appears more than once", entry),
+				Savings:  utf8.RuneCountInString(entry) + 1,
+			})
+			continue
+		}
+		seenEntries[folded] = true
+
+		words := keywordWords(entry)
+		for _, word := range words {
+			saving := utf8.RuneCountInString(word) + 1
+			if len(words) == 1 {
+				saving = utf8.RuneCountInString(entry) + 1
+			}
+			switch {
+			case indexed[word]:
+				findings = append(findings, KeywordFinding{
+					Locale:   locale,
+					Type:     keywordFindingNameOverlap,
+					Severity: keywordSeverityWarning,
+					Term:     word,
+					Message:  fmt.Sprintf("%q is already in the app name or subtitle", word),
+					Savings:  saving,
+				})
+			case stopWords[word]:
+				findings = append(findings, KeywordFinding{
+					Locale:   locale,
+					Type:     keywordFindingStopWord,
+					Severity: keywordSeverityWarning,
+					Term:     word,
+					Message:  fmt.Sprintf("%q is a stop word that adds no search coverage", word),
+					Savings:  saving,
+				})
+			case seenWords[word]:
+				findings = append(findings, KeywordFinding{
+					Locale:   locale,
+					Type:     keywordFindingDuplicate,
+					Severity: keywordSeverityInfo,
+					Term:     word,
+					Message:  fmt.Sprintf("%q is repeated in several keywords; single words combine across the field", word),
+				})
+			}
+			if !seenWords[word] {
+				seenWords[word] = true
+				allWords = append(allWords, word)
+			}
+		}
+	}
+
+	if keywordLanguage(locale) == "en" {
+		for _, word := range allWords {
+			singular := englishSingular(word)
+			if singular == word || !(seenWords[singular] || indexed[singular]) {
+				continue
+			}
+			findings = append(findings, KeywordFinding{
+				Locale:   locale,
+				Type:     keywordFindingPlural,
+				Severity: keywordSeverityWarning,
+				Term:     word,
+				Message:  fmt.Sprintf("%q is the plural of %q; Apple matches both", word, singular),
+				Savings:  utf8.RuneCountInString(word) + 1,
+			})
+		}
+	}
+	return findings
+}
+
+// analyzeStorefrontOverlap flags keyword words repeated across locales one storefront indexes.
+func analyzeStorefrontOverlap(inputs map[string]keywordLocaleInput) []KeywordFinding {
+	reported := map[string]bool{}
+	var findings []KeywordFinding
+	for _, storefront := range sortedKeys(keywordStorefrontLocales) {
+		var present []string
+		for _, locale := range keywordStorefrontLocales[storefront] {
+			if _, ok := inputs[locale]; ok {
+				present = append(present, locale)
+			}
+		}
+		if len(present) < 2 {
+			continue
+		}
+		// The first locale in the list is the storefront's primary; later ones repeat it.
+		for i := 1; i < len(present); i++ {
+			locale := present[i]
+			earlier := map[string]string{}
+			for _, other := range present[:i] {
+				input := inputs[other]
+				for _, word := range keywordWords(input.Name + " " + input.Subtitle + " " + input.Keywords) {
+					if _, ok := earlier[word]; !ok {
+						earlier[word] = other
+					}
+				}
+			}
+			for _, word := range uniqueWords(keywordWords(inputs[locale].Keywords)) {
+				other, ok := earlier[word]
+				key := locale + "|" + word
+				if !ok || reported[key] {
+					continue
+				}
+				reported[key] = true
+				findings = append(findings, KeywordFinding{
+					Locale:   locale,
+					Type:     keywordFindingCrossLocale,
+					Severity: keywordSeverityInfo,
+					Term:     word,
+					Message:  fmt.Sprintf("%q is already indexed from %s in the %s storefront", word, other, storefront),
+					Savings:  utf8.RuneCountInString(word) + 1,
+				})
+			}
+		}
+	}
+	return findings
+}
+
+func filterKeywordsResult(result KeywordsAnalyzeResult, locales []string) KeywordsAnalyzeResult {
+	wanted := map[string]bool{}
+	for _, locale := range locales {
+		if resolved, err := validateLocale(locale); err == nil {
+			wanted[resolved] = true
+		}
+	}
+	filtered := result
+	filtered.Locales = []KeywordLocaleBudget{}
+	filtered.Findings = []KeywordFinding{}
+	for _, budget := range result.Locales {
+		if wanted[budget.Locale] {
+			filtered.Locales = append(filtered.Locales, budget)
+		}
+	}
+	for _, finding := range result.Findings {
+		if wanted[finding.Locale] {
+			filtered.Findings = append(filtered.Findings, finding)
+		}
+	}
+	return filtered
+}
+
+func splitKeywordEntries(keywords string) []string {
+	var entries []string
+	for _, entry := range strings.Split(keywords, ",") {
+		if entry = strings.TrimSpace(entry); entry != "" {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+// keywordWords lowercases text and splits it into words.
+func keywordWords(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+}
+
+func uniqueWords(words []string) []string {
+	seen := map[string]bool{}
+	unique := make([]string, 0, len(words))
+	for _, word := range words {
+		if !seen[word] {
+			seen[word] = true
+			unique = append(unique, word)
+		}
+	}
+	return unique
+}
+
+// commaSpaceCount counts whitespace adjacent to commas.
+func commaSpaceCount(keywords string) int {
+	count := 0
+	runes := []rune(keywords)
+	for i, r := range runes {
+		if !unicode.IsSpace(r) {
+			continue
+		}
+		for j := i - 1; j >= 0; j-- {
+			if runes[j] == ',' {
+				count++
+				break
+			}
+			if !unicode.IsSpace(runes[j]) {
+				break
+			}
+		}
+		for j := i + 1; j < len(runes); j++ {
+			if runes[j] == ',' {
+				if i == 0 || runes[i-1] != ',' {
+					count++
+				}
+				break
+			}
+			if !unicode.IsSpace(runes[j]) {
+				break
+			}
+		}
+	}
+	return count
+}
+
+// englishSingular returns a naive singular form for common English plurals.
+func englishSingular(word string) string {
+	switch {
+	case len(word) > 4 && strings.HasSuffix(word, "ies"):
+		return strings.TrimSuffix(word, "ies") + "y"
+	case len(word) > 4 && (strings.HasSuffix(word, "ches") || strings.HasSuffix(word, "shes") || strings.HasSuffix(word, "xes")):
+		return strings.TrimSuffix(word, "es")
+	case len(word) > 3 && strings.HasSuffix(word, "s") && !strings.HasSuffix(word, "ss"):
+		return strings.TrimSuffix(word, "s")
+	}
+	return word
+}
+
+func keywordLanguage(locale string) string {
+	language, _, _ := strings.Cut(locale, "-")
+	return strings.ToLower(language)
+}
+
+func wordSet(words ...string) map[string]bool {
+	set := make(map[string]bool, len(words))
+	for _, word := range words {
+		set[word] = true
+	}
+	return set
+}
+
+func printKeywordsAnalyzeResult(result KeywordsAnalyzeResult, render func([]string, [][]string)) error {
+	budgetRows := make([][]string, 0, len(result.Locales))
+	for _, budget := range result.Locales {
+		budgetRows = append(budgetRows, []string{
+			budget.Locale,
+			fmt.Sprintf("%d/%d", budget.Used, budget.Limit),
+			fmt.Sprintf("%d", budget.Remaining),
+			fmt.Sprintf("%d", budget.PotentialSavings),
+		})
+	}
+	render([]string{"Locale", "Used", "Remaining", "Potential Savings"}, budgetRows)
+
+	if len(result.Findings) == 0 {
+		return nil
+	}
+	fmt.Println()
+	findingRows := make([][]string, 0, len(result.Findings))
+	for _, finding := range result.Findings {
+		findingRows = append(findingRows, []string{
+			finding.Locale,
+			finding.Severity,
+			finding.Type,
+			finding.Message,
+			fmt.Sprintf("%d", finding.Savings),
+		})
+	}
+	render([]string{"Locale", "Severity", "Type", "Finding", "Saves"}, findingRows)
+	return nil
+}
@@ -0,0 +1,116 @@
+package metadata
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func findKeywordFinding(result KeywordsAnalyzeResult, locale, kind, term string) *KeywordFinding {
+	for i := range result.Findings {
+		finding := result.Findings[i]
+		if finding.Locale == locale && finding.Type == kind && finding.Term == term {
+			return &result.Findings[i]
+		}
+	}
+	return nil
+}
+
+func TestAnalyzeKeywordsPerLocale(t *testing.T) {
+	result := analyzeKeywords(map[string]keywordLocaleInput{
+		"en-US": {
+			Name:     "Acme Notes",
+			Subtitle: "Sync your ideas",
+			Keywords: "notes, todo, todos,the,journal,Journal",
+		},
+	})
+
+	if len(result.Locales) != 1 {
+		t.Fatalf("expected one locale budget, got %+v", result.Locales)
+	}
+	budget := result.Locales[0]
+	if budget.Used != 38 || budget.Remaining != 62 {
+		t.Fatalf("unexpected budget %+v", budget)
+	}
+
+	if finding := findKeywordFinding(result, "en-US", keywordFindingCommaSpace, ""); finding == nil || finding.Savings != 2 {
+		t.Fatalf("expected 2 comma spaces, got %+v", finding)
+	}
+	if finding := findKeywordFinding(result, "en-US", keywordFindingNameOverlap, "notes"); finding == nil || finding.Savings != 6 {
+		t.Fatalf("expected notes name overlap, got %+v", finding)
+	}
+	if findKeywordFinding(result, "en-US", keywordFindingPlural, "todos") == nil {
+		t.Fatalf("expected todos plural finding, got %+v", result.Findings)
+	}
+	if findKeywordFinding(result, "en-US", keywordFindingStopWord, "the") == nil {
+		t.Fatalf("expected stop word finding, got %+v", result.Findings)
+	}
+	if findKeywordFinding(result, "en-US", keywordFindingDuplicate, "Journal") == nil {
+		t.Fatalf("expected case-insensitive duplicate finding, got %+v", result.Findings)
+	}
+	if budget.PotentialSavings != 2+6+6+4+8 {
+		t.Fatalf("unexpected potential savings %d", budget.PotentialSavings)
+	}
+}
+
+func TestAnalyzeKeywordsStorefrontOverlap(t *testing.T) {
+	result := analyzeKeywords(map[string]keywordLocaleInput{
+		"en-US": {Name: "Acme", Keywords: "recipes,cooking"},
+		"es-MX": {Keywords: "recetas,cooking,acme"},
+		"de-DE": {Keywords: "rezepte,cooking"},
+	})
+
+	if findKeywordFinding(result, "es-MX", keywordFindingCrossLocale, "cooking") == nil {
+		t.Fatalf("expected es-MX overlap with en-US, got %+v", result.Findings)
+	}
+	if findKeywordFinding(result, "es-MX", keywordFindingCrossLocale, "acme") == nil {
+		t.Fatalf("expected es-MX overlap with en-US name, got %+v", result.Findings)
+	}
+	if finding := findKeywordFinding(result, "en-US", keywordFindingCrossLocale, "cooking"); finding == nil || !strings.Contains(finding.Message, "MEX") {
+		t.Fatalf("expected en-US overlap as secondary locale of MEX, got %+v", finding)
+	}
+	if findKeywordFinding(result, "de-DE", keywordFindingCrossLocale, "cooking") != nil {
+		t.Fatal("locales without a shared storefront should not be flagged")
+	}
+
+	filtered := filterKeywordsResult(result, []string{"de-DE"})
+	if len(filtered.Locales) != 1 || filtered.Locales[0].Locale != "de-DE" {
+		t.Fatalf("unexpected filtered locales %+v", filtered.Locales)
+	}
+}
+
+func TestLoadKeywordInputsReadsMetadataDir(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		filepath.Join(appInfoDirName, "en-US.json"):          `{"name":"Acme","subtitle":"Notes"}`,
+		filepath.Join(appInfoDirName, "default.json"):        `{"name":"Acme"}`,
+		filepath.Join(versionDirName, "1.0", "en-US.json"):   `{"keywords":"notes,sync"}`,
+		filepath.Join(versionDirName, "1.0", "fr-FR.json"):   `{"description":"Bonjour"}`,
+		filepath.Join(versionDirName, "1.0", "default.json"): `{"keywords":"fallback"}`,
+	}
+	for rel, content := range files {
+		path := filepath.Join(dir, rel)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("mkdir: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatalf("write: %v", err)
+		}
+	}
+
+	version, err := resolveKeywordsVersion(dir, "")
+	if err != nil || version != "1.0" {
+		t.Fatalf("expected single version 1.0, got %q (%v)", version, err)
+	}
+	inputs, err := loadKeywordInputs(dir, version)
+	if err != nil {
+		t.Fatalf("loadKeywordInputs: %v", err)
+	}
+	if len(inputs) != 1 {
+		t.Fatalf("expected only en-US with keywords, got %+v", inputs)
+	}
+	if got := inputs["en-US"]; got.Name != "Acme" || got.Subtitle != "Notes" || got.Keywords != "notes,sync" {
+		t.Fatalf("unexpected en-US input %+v", got)
+	}
+}
@@ -111,7 +111,7 @@ type versionLocalPatch struct {
 func MetadataPushCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("metadata push", flag.ExitOnError)
 
-	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (or ASC_APP_ID env)")
 	version := fs.String("version", "", "App version string (for example 1.2.3)")
 	platform := fs.String("platform", "", "Optional platform: IOS, MAC_OS, TV_OS, or VISION_OS")
 	dir := fs.String("dir", "", "Metadata root directory (required)")
@@ -119,6 +119,7 @@ func MetadataPushCommand() *ffcli.Command {
 	dryRun := fs.Bool("dry-run", false, "Preview changes without mutating App Store Connect")
 	allowDeletes := fs.Bool("allow-deletes", false, "Allow destructive delete operations when applying changes (disables default locale fallback for missing locales)")
 	confirm := fs.Bool("confirm", false, "Confirm destructive operations (required with --allow-deletes)")
+	allowUnreviewed := fs.Bool("allow-unreviewed-translations", false, "Apply machine translations that have not been approved")
 	output := shared.BindOutputFlags(fs)
 
 	return &ffcli.Command{
@@ -136,7 +137,9 @@ Examples:
 Notes:
   - default.json fallback is applied only when --allow-deletes is not set.
   - with --allow-deletes, remote locales missing locally are planned as deletes.
-  - omitted fields are treated as no-op; they do not imply deletion.`,
+  - omitted fields are treated as no-op; they do not imply deletion.
+  - unreviewed machine translations (see metadata translate) block applying
+    changes unless --allow-unreviewed-translations is set.`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -170,11 +173,6 @@ Notes:
 				return shared.UsageError(err.Error())
 			}
 
-			localBundle, err := loadLocalMetadata(dirValue, versionValue)
-			if err != nil {
-				return err
-			}
-
 			client, err := shared.GetASCClient()
 			if err != nil {
 				return fmt.Errorf("metadata push: %w", err)
@@ -183,137 +181,194 @@ Notes:
 			requestCtx, cancel := shared.ContextWithTimeout(ctx)
 			defer cancel()
 
-			appInfoIDValue, err := shared.ResolveAppInfoID(requestCtx, client, resolvedAppID, "")
-			if err != nil {
-				return fmt.Errorf("metadata push: %w", err)
+			result, err := Push(requestCtx, client, PushOptions{
+				AppID:           resolvedAppID,
+				Version:         versionValue,
+				Platform:        platformValue,
+				Dir:             dirValue,
+				Includes:        includes,
+				DryRun:          *dryRun,
+				AllowDeletes:    *allowDeletes,
+				Confirm:         *confirm,
+				AllowUnreviewed: *allowUnreviewed,
+			})
+			if errors.Is(err, errUnreviewedTranslations) {
+				return shared.UsageErrorf("%v; pass --allow-unreviewed-translations to push anyway", err)
 			}
-			versionIDValue, err := resolveVersionID(requestCtx, client, resolvedAppID, versionValue, platformValue)
 			if err != nil {
-				if errors.Is(err, flag.ErrHelp) {
-					return err
-				}
-				return fmt.Errorf("metadata push: %w", err)
+				return err
 			}
 
-			remoteAppInfoItems, err := fetchAppInfoLocalizations(requestCtx, client, appInfoIDValue)
-			if err != nil {
-				return fmt.Errorf("metadata push: %w", err)
-			}
-			remoteVersionItems, err := fetchVersionLocalizations(requestCtx, client, versionIDValue)
-			if err != nil {
-				return fmt.Errorf("metadata push: %w", err)
-			}
+			return shared.PrintOutputWithRenderers(
+				result,
+				*output.Output,
+				*output.Pretty,
+				func() error { return printPushPlanTable(*result) },
+				func() error { return printPushPlanMarkdown(*result) },
+			)
+		},
+	}
+}
 
-			remoteAppInfo := make(map[string]AppInfoLocalization, len(remoteAppInfoItems))
-			for _, item := range remoteAppInfoItems {
-				locale := strings.TrimSpace(item.Attributes.Locale)
-				if locale == "" {
-					continue
-				}
-				remoteAppInfo[locale] = NormalizeAppInfoLocalization(AppInfoLocalization{
-					Name:              item.Attributes.Name,
-					Subtitle:          item.Attributes.Subtitle,
-					PrivacyPolicyURL:  item.Attributes.PrivacyPolicyURL,
-					PrivacyChoicesURL: item.Attributes.PrivacyChoicesURL,
-					PrivacyPolicyText: item.Attributes.PrivacyPolicyText,
-				})
-			}
+// errUnreviewedTranslations reports machine translations awaiting review.
+var errUnreviewedTranslations = errors.New("unreviewed machine translations")
+
+// PushOptions configures a metadata push.
+type PushOptions struct {
+	AppID           string
+	Version         string
+	Platform        string
+	Dir             string
+	Includes        []string
+	DryRun          bool
+	AllowDeletes    bool
+	Confirm         bool
+	AllowUnreviewed bool
+}
 
-			remoteVersion := make(map[string]VersionLocalization, len(remoteVersionItems))
-			for _, item := range remoteVersionItems {
-				locale := strings.TrimSpace(item.Attributes.Locale)
-				if locale == "" {
-					continue
-				}
-				remoteVersion[locale] = NormalizeVersionLocalization(VersionLocalization{
-					Description:     item.Attributes.Description,
-					Keywords:        item.Attributes.Keywords,
-					MarketingURL:    item.Attributes.MarketingURL,
-					PromotionalText: item.Attributes.PromotionalText,
-					SupportURL:      item.Attributes.SupportURL,
-					WhatsNew:        item.Attributes.WhatsNew,
-				})
-			}
+// Push plans the metadata changes between Dir and App Store Connect and,
+// unless DryRun is set, applies them. It backs metadata push and release.
+func Push(ctx context.Context, client *asc.Client, opts PushOptions) (*PushPlanResult, error) {
+	if len(opts.Includes) == 0 {
+		opts.Includes = []string{includeLocalizations}
+	}
+	localBundle, err := loadLocalMetadata(opts.Dir, opts.Version)
+	if err != nil {
+		return nil, err
+	}
+	if !opts.DryRun && !opts.AllowUnreviewed {
+		unreviewed, err := unreviewedMachineTranslations(opts.Dir, opts.Version)
+		if err != nil {
+			return nil, fmt.Errorf("metadata push: %w", err)
+		}
+		if len(unreviewed) > 0 {
+			return nil, fmt.Errorf("metadata push: %w: %s (run asc metadata translate approve first)", errUnreviewedTranslations, strings.Join(unreviewed, ", "))
+		}
+	}
 
-			localAppInfo := applyDefaultAppInfoFallback(localBundle.appInfo, localBundle.defaultAppInfo, remoteAppInfo, *allowDeletes)
-			localVersion := applyDefaultVersionFallback(localBundle.version, localBundle.defaultVersion, remoteVersion, *allowDeletes)
+	appInfoIDValue, err := shared.ResolveAppInfoID(ctx, client, opts.AppID, "")
+	if err != nil {
+		return nil, fmt.Errorf("metadata push: %w", err)
+	}
+	versionIDValue, err := resolveVersionID(ctx, client, opts.AppID, opts.Version, opts.Platform)
+	if err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("metadata push: %w", err)
+	}
 
-			adds, updates, deletes, appInfoCalls := buildScopePlan(
-				appInfoDirName,
-				"",
-				appInfoPlanFields,
-				appInfoToPlanFields(localAppInfo),
-				appInfoToFieldMap(remoteAppInfo),
-			)
-			versionAdds, versionUpdates, versionDeletes, versionCalls := buildScopePlan(
-				versionDirName,
-				versionValue,
-				versionPlanFields,
-				versionToPlanFields(localVersion),
-				versionToFieldMap(remoteVersion),
-			)
-			adds = append(adds, versionAdds...)
-			updates = append(updates, versionUpdates...)
-			deletes = append(deletes, versionDeletes...)
-
-			sortPlanItems(adds)
-			sortPlanItems(updates)
-			sortPlanItems(deletes)
-
-			apiCalls := buildAPICallSummary(appInfoCalls, versionCalls)
-
-			result := PushPlanResult{
-				AppID:     resolvedAppID,
-				AppInfoID: appInfoIDValue,
-				Version:   versionValue,
-				VersionID: versionIDValue,
-				Dir:       dirValue,
-				DryRun:    *dryRun,
-				Includes:  includes,
-				Adds:      adds,
-				Updates:   updates,
-				Deletes:   deletes,
-				APICalls:  apiCalls,
-			}
+	remoteAppInfoItems, err := fetchAppInfoLocalizations(ctx, client, appInfoIDValue)
+	if err != nil {
+		return nil, fmt.Errorf("metadata push: %w", err)
+	}
+	remoteVersionItems, err := fetchVersionLocalizations(ctx, client, versionIDValue)
+	if err != nil {
+		return nil, fmt.Errorf("metadata push: %w", err)
+	}
 
-			if !*dryRun {
-				if len(result.Deletes) > 0 {
-					if !*allowDeletes {
-						return shared.UsageError("--allow-deletes is required to apply delete operations")
-					}
-					if !*confirm {
-						return shared.UsageError("--confirm is required when applying delete operations")
-					}
-				}
+	remoteAppInfo := make(map[string]AppInfoLocalization, len(remoteAppInfoItems))
+	for _, item := range remoteAppInfoItems {
+		locale := strings.TrimSpace(item.Attributes.Locale)
+		if locale == "" {
+			continue
+		}
+		remoteAppInfo[locale] = NormalizeAppInfoLocalization(AppInfoLocalization{
+			Name:              item.Attributes.Name,
+			Subtitle:          item.Attributes.Subtitle,
+			PrivacyPolicyURL:  item.Attributes.PrivacyPolicyURL,
+			PrivacyChoicesURL: item.Attributes.PrivacyChoicesURL,
+			PrivacyPolicyText: item.Attributes.PrivacyPolicyText,
+		})
+	}
 
-				actions, applyErr := applyMetadataPlan(
-					requestCtx,
-					client,
-					appInfoIDValue,
-					versionIDValue,
-					versionValue,
-					localAppInfo,
-					localVersion,
-					remoteAppInfoItems,
-					remoteVersionItems,
-					*allowDeletes,
-				)
-				if applyErr != nil {
-					return fmt.Errorf("metadata push: %w", applyErr)
-				}
-				result.Applied = true
-				result.Actions = actions
+	remoteVersion := make(map[string]VersionLocalization, len(remoteVersionItems))
+	for _, item := range remoteVersionItems {
+		locale := strings.TrimSpace(item.Attributes.Locale)
+		if locale == "" {
+			continue
+		}
+		remoteVersion[locale] = NormalizeVersionLocalization(VersionLocalization{
+			Description:     item.Attributes.Description,
+			Keywords:        item.Attributes.Keywords,
+			MarketingURL:    item.Attributes.MarketingURL,
+			PromotionalText: item.Attributes.PromotionalText,
+			SupportURL:      item.Attributes.SupportURL,
+			WhatsNew:        item.Attributes.WhatsNew,
+		})
+	}
+
+	localAppInfo := applyDefaultAppInfoFallback(localBundle.appInfo, localBundle.defaultAppInfo, remoteAppInfo, opts.AllowDeletes)
+	localVersion := applyDefaultVersionFallback(localBundle.version, localBundle.defaultVersion, remoteVersion, opts.AllowDeletes)
+
+	adds, updates, deletes, appInfoCalls := buildScopePlan(
+		appInfoDirName,
+		"",
+		appInfoPlanFields,
+		appInfoToPlanFields(localAppInfo),
+		appInfoToFieldMap(remoteAppInfo),
+	)
+	versionAdds, versionUpdates, versionDeletes, versionCalls := buildScopePlan(
+		versionDirName,
+		opts.Version,
+		versionPlanFields,
+		versionToPlanFields(localVersion),
+		versionToFieldMap(remoteVersion),
+	)
+	adds = append(adds, versionAdds...)
+	updates = append(updates, versionUpdates...)
+	deletes = append(deletes, versionDeletes...)
+
+	sortPlanItems(adds)
+	sortPlanItems(updates)
+	sortPlanItems(deletes)
+
+	apiCalls := buildAPICallSummary(appInfoCalls, versionCalls)
+
+	result := PushPlanResult{
+		AppID:     opts.AppID,
+		AppInfoID: appInfoIDValue,
+		Version:   opts.Version,
+		VersionID: versionIDValue,
+		Dir:       opts.Dir,
+		DryRun:    opts.DryRun,
+		Includes:  opts.Includes,
+		Adds:      adds,
+		Updates:   updates,
+		Deletes:   deletes,
+		APICalls:  apiCalls,
+	}
+
+	if !opts.DryRun {
+		if len(result.Deletes) > 0 {
+			if !opts.AllowDeletes {
+				return nil, shared.UsageError("--allow-deletes is required to apply delete operations")
+			}
+			if !opts.Confirm {
+				return nil, shared.UsageError("--confirm is required when applying delete operations")
 			}
+		}
 
-			return shared.PrintOutputWithRenderers(
-				result,
-				*output.Output,
-				*output.Pretty,
-				func() error { return printPushPlanTable(result) },
-				func() error { return printPushPlanMarkdown(result) },
-			)
-		},
+		actions, applyErr := applyMetadataPlan(
+			ctx,
+			client,
+			appInfoIDValue,
+			versionIDValue,
+			opts.Version,
+			localAppInfo,
+			localVersion,
+			remoteAppInfoItems,
+			remoteVersionItems,
+			opts.AllowDeletes,
+		)
+		if applyErr != nil {
+			return nil, fmt.Errorf("metadata push: %w", applyErr)
+		}
+		result.Applied = true
+		result.Actions = actions
 	}
+
+	return &result, nil
 }
 
 func loadLocalMetadata(dir, version string) (localMetadataBundle, error) {
@@ -0,0 +1,546 @@
+package metadata
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/validation"
+)
+
+const (
+	translateActionTranslated = "translated"
+	translateActionSkipped    = "skipped"
+)
+
+// translatableVersionFields are the version fields worth machine translating; URLs are copied as-is.
+var translatableVersionFields = []string{"description", "keywords", "promotionalText", "whatsNew"}
+
+var translateFieldLimits = map[string]int{
+	"description":     validation.LimitDescription,
+	"keywords":        validation.LimitKeywords,
+	"promotionalText": validation.LimitPromotionalText,
+	"whatsNew":        validation.LimitWhatsNew,
+}
+
+// TranslateItem is the outcome for one locale field.
+type TranslateItem struct {
+	Locale    string `json:"locale"`
+	Field     string `json:"field"`
+	Action    string `json:"action"`
+	Reason    string `json:"reason,omitempty"`
+	Length    int    `json:"length,omitempty"`
+	Limit     int    `json:"limit,omitempty"`
+	Truncated bool   `json:"truncated,omitempty"`
+	File      string `json:"file,omitempty"`
+}
+
+// TranslateResult is the structured result for metadata translate.
+type TranslateResult struct {
+	Dir      string          `json:"dir"`
+	Version  string          `json:"version"`
+	From     string          `json:"from"`
+	To       []string        `json:"to"`
+	Provider string          `json:"provider"`
+	DryRun   bool            `json:"dryRun"`
+	Items    []TranslateItem `json:"items"`
+}
+
+type translateOptions struct {
+	Dir       string
+	Version   string
+	From      string
+	To        []string
+	Fields    []string
+	Glossary  []string
+	Overwrite bool
+	DryRun    bool
+	Now       time.Time
+}
+
+// MetadataTranslateCommand returns the metadata translate subcommand.
+func MetadataTranslateCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("metadata translate", flag.ExitOnError)
+
+	dir := fs.String("dir", "", "Metadata root directory (required)")
+	version := fs.String("version", "", "App version string (for example 1.2.3)")
+	from := fs.String("from", "", "Source locale (for example en-US)")
+	to := fs.String("to", "", "Target locale(s), comma-separated")
+	provider := fs.String("provider", "", "Translation provider: deepl, google, or http")
+	endpoint := fs.String("endpoint", "", "Endpoint URL for the http provider (or ASC_TRANSLATE_URL env)")
+	fields := fs.String("fields", strings.Join(translatableVersionFields, ","), "Fields to translate (comma-separated)")
+	glossary := fs.String("glossary", "", "File of protected terms, one per line (# comments allowed)")
+	protect := fs.String("protect", "", "Protected terms, comma-separated (app name, brand words)")
+	overwrite := fs.Bool("overwrite", false, "Re-translate fields that already have a value")
+	dryRun := fs.Bool("dry-run", false, "Translate and report without writing files")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "translate",
+		ShortUsage: "asc metadata translate --dir \"./metadata\" --version \"1.2.3\" --from en-US --to de-DE,ja --provider deepl",
+		ShortHelp:  "Fill missing version locales with machine translation.",
+		LongHelp: `Fill missing version locales with machine translation.
+
+Reads version/<version>/<from>.json and writes translated fields into each
+target locale file. Only empty fields are filled unless --overwrite is set.
+Protected terms are replaced with placeholders before translation and must
+come back unchanged.
+Results that exceed the App Store character limit are shortened: keywords drop
+trailing terms, other fields are cut at the last sentence or word boundary.
+
+Translated files are recorded in machine-translations.json. Until they are
+approved with "asc metadata translate approve", metadata validate reports them
+and metadata push refuses to apply them.
+
+Providers:
+  deepl   ASC_DEEPL_API_KEY (free keys ending in :fx use api-free.deepl.com)
+  google  ASC_GOOGLE_TRANSLATE_API_KEY
+  http    POST {"source","target","texts","protectedTerms"} -> {"translations"}
+          to --endpoint (or ASC_TRANSLATE_URL), with optional ASC_TRANSLATE_TOKEN
+
+Examples:
+  asc metadata translate --dir "./metadata" --version "1.2.3" --from en-US --to de-DE,ja --provider deepl
+  asc metadata translate --dir "./metadata" --version "1.2.3" --from en-US --to fr-FR --provider google --protect "Acme,Acme Pro"
+  asc metadata translate --dir "./metadata" --version "1.2.3" --from en-US --to es-MX --provider http --endpoint "http://localhost:8080/translate" --dry-run
+  asc metadata translate approve --dir "./metadata" --locale de-DE`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			MetadataTranslateApproveCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			if len(args) > 0 {
+				return shared.UsageError("metadata translate does not accept positional arguments")
+			}
+			dirValue := strings.TrimSpace(*dir)
+			if dirValue == "" {
+				return shared.UsageError("--dir is required")
+			}
+			versionValue := strings.TrimSpace(*version)
+			if versionValue == "" {
+				return shared.UsageError("--version is required")
+			}
+			if strings.TrimSpace(*from) == "" {
+				return shared.UsageError("--from is required")
+			}
+			targets := shared.SplitCSV(*to)
+			if len(targets) == 0 {
+				return shared.UsageError("--to is required")
+			}
+			if strings.TrimSpace(*provider) == "" {
+				return shared.UsageError("--provider is required")
+			}
+			selectedFields, err := parseTranslateFields(*fields)
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
+
+			terms := shared.SplitCSV(*protect)
+			if strings.TrimSpace(*glossary) != "" {
+				fileTerms, err := readGlossaryFile(*glossary)
+				if err != nil {
+					return fmt.Errorf("metadata translate: %w", err)
+				}
+				terms = append(terms, fileTerms...)
+			}
+
+			backend, err := newTranslator(*provider, *endpoint)
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			result, err := runTranslate(requestCtx, backend, translateOptions{
+				Dir:       dirValue,
+				Version:   versionValue,
+				From:      *from,
+				To:        targets,
+				Fields:    selectedFields,
+				Glossary:  terms,
+				Overwrite: *overwrite,
+				DryRun:    *dryRun,
+				Now:       time.Now().UTC(),
+			})
+			if err != nil {
+				if errors.Is(err, flag.ErrHelp) {
+					return err
+				}
+				return fmt.Errorf("metadata translate: %w", err)
+			}
+
+			return shared.PrintOutputWithRenderers(
+				result,
+				*output.Output,
+				*output.Pretty,
+				func() error { return printTranslateResult(result, asc.RenderTable) },
+				func() error { return printTranslateResult(result, asc.RenderMarkdown) },
+			)
+		},
+	}
+}
+
+func parseTranslateFields(value string) ([]string, error) {
+	allowed := make(map[string]bool, len(translatableVersionFields))
+	for _, field := range translatableVersionFields {
+		allowed[field] = true
+	}
+	var fields []string
+	for _, field := range shared.SplitCSV(value) {
+		if !allowed[field] {
+			return nil, fmt.Errorf("--fields supports: %s", strings.Join(translatableVersionFields, ", "))
+		}
+		fields = append(fields, field)
+	}
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("--fields must include at least one field")
+	}
+	return fields, nil
+}
+
+func readGlossaryFile(path string) ([]string, error) {
+	data, err := readFileNoFollow(path)
+	if err != nil {
+		return nil, fmt.Errorf("read glossary: %w", err)
+	}
+	var terms []string
+	scanner := bufio.NewScanner(strings.NewReader(string(data)))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		terms = append(terms, line)
+	}
+	return terms, scanner.Err()
+}
+
+func runTranslate(ctx context.Context, backend translator, opts translateOptions) (TranslateResult, error) {
+	from, err := validateLocale(opts.From)
+	if err != nil || from == DefaultLocale {
+		return TranslateResult{}, shared.UsageErrorf("invalid --from locale %q", opts.From)
+	}
+	sourcePath, err := VersionLocalizationFilePath(opts.Dir, opts.Version, from)
+	if err != nil {
+		return TranslateResult{}, shared.UsageError(err.Error())
+	}
+	source, err := ReadVersionLocalizationFile(sourcePath)
+	if err != nil {
+		return TranslateResult{}, fmt.Errorf("read source %s: %w", sourcePath, err)
+	}
+	manifest, err := readMachineTranslations(opts.Dir)
+	if err != nil {
+		return TranslateResult{}, err
+	}
+
+	result := TranslateResult{
+		Dir:      opts.Dir,
+		Version:  opts.Version,
+		From:     from,
+		Provider: backend.Name(),
+		DryRun:   opts.DryRun,
+		Items:    []TranslateItem{},
+	}
+	sourceFields := versionFields(source)
+	var plans []WritePlan
+
+	for _, rawTarget := range opts.To {
+		target, err := validateLocale(rawTarget)
+		if err != nil || target == DefaultLocale {
+			return TranslateResult{}, shared.UsageErrorf("invalid --to locale %q", rawTarget)
+		}
+		if target == from {
+			return TranslateResult{}, shared.UsageErrorf("--to must not include the source locale %q", from)
+		}
+		result.To = append(result.To, target)
+
+		targetPath, err := VersionLocalizationFilePath(opts.Dir, opts.Version, target)
+		if err != nil {
+			return TranslateResult{}, shared.UsageError(err.Error())
+		}
+		existing := VersionLocalization{}
+		if _, statErr := os.Lstat(targetPath); statErr == nil {
+			existing, err = ReadVersionLocalizationFile(targetPath)
+			if err != nil {
+				return TranslateResult{}, fmt.Errorf("read %s: %w", targetPath, err)
+			}
+		}
+		existingFields := versionFields(existing)
+
+		var pending []string
+		for _, field := range opts.Fields {
+			switch {
+			case sourceFields[field] == "":
+				result.Items = append(result.Items, TranslateItem{Locale: target, Field: field, Action: translateActionSkipped, Reason: "source is empty"})
+			case existingFields[field] != "" && !opts.Overwrite:
+				result.Items = append(result.Items, TranslateItem{Locale: target, Field: field, Action: translateActionSkipped, Reason: "already translated"})
+			default:
+				pending = append(pending, field)
+			}
+		}
+		if len(pending) == 0 {
+			continue
+		}
+
+		texts := make([]string, len(pending))
+		for i, field := range pending {
+			texts[i] = sourceFields[field]
+		}
+		translated, err := translateProtected(ctx, backend, from, target, texts, opts.Glossary)
+		if err != nil {
+			return TranslateResult{}, fmt.Errorf("translate %s: %w", target, err)
+		}
+
+		for i, field := range pending {
+			value := translated[i]
+			if field == "keywords" {
+				value = normalizeTranslatedKeywords(value)
+			}
+			limit := translateFieldLimits[field]
+			value, truncated := fitToLimit(field, value, limit)
+			existingFields[field] = value
+			result.Items = append(result.Items, TranslateItem{
+				Locale:    target,
+				Field:     field,
+				Action:    translateActionTranslated,
+				Length:    utf8.RuneCountInString(value),
+				Limit:     limit,
+				Truncated: truncated,
+				File:      targetPath,
+			})
+		}
+
+		// URLs are not translated but a new locale still needs them.
+		for _, field := range []string{"marketingUrl", "supportUrl"} {
+			if existingFields[field] == "" {
+				existingFields[field] = sourceFields[field]
+			}
+		}
+		data, err := EncodeVersionLocalization(versionFromFields(existingFields))
+		if err != nil {
+			return TranslateResult{}, err
+		}
+		plans = append(plans, WritePlan{Path: targetPath, Contents: data})
+
+		key := manifestKey(opts.Dir, targetPath)
+		entry := manifest.Files[key]
+		entry.Scope = versionDirName
+		entry.Version = opts.Version
+		entry.Locale = target
+		entry.SourceLocale = from
+		entry.Provider = backend.Name()
+		entry.Fields = mergeFieldNames(entry.Fields, pending)
+		entry.TranslatedAt = opts.Now.Format(time.RFC3339)
+		entry.Reviewed = false
+		entry.ReviewedAt = ""
+		manifest.Files[key] = entry
+	}
+
+	if opts.DryRun || len(plans) == 0 {
+		return result, nil
+	}
+	for _, plan := range plans {
+		if err := os.MkdirAll(filepath.Dir(plan.Path), 0o755); err != nil {
+			return TranslateResult{}, err
+		}
+	}
+	if err := ApplyWritePlans(plans); err != nil {
+		return TranslateResult{}, err
+	}
+	if err := writeMachineTranslations(opts.Dir, manifest); err != nil {
+		return TranslateResult{}, err
+	}
+	return result, nil
+}
+
+// translateProtected swaps glossary terms for placeholders, translates, and restores them.
+func translateProtected(ctx context.Context, backend translator, from, to string, texts []string, glossary []string) ([]string, error) {
+	terms := uniqueTerms(glossary)
+	protected := make([]string, len(texts))
+	for i, text := range texts {
+		protected[i] = protectTerms(text, terms)
+	}
+
+	translated, err := backend.Translate(ctx, translationRequest{
+		Source:         from,
+		Target:         to,
+		Texts:          protected,
+		ProtectedTerms: terms,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(translated) != len(texts) {
+		return nil, fmt.Errorf("provider returned %d translations for %d texts", len(translated), len(texts))
+	}
+
+	for i := range translated {
+		restored, err := restoreTerms(protected[i], translated[i], terms)
+		if err != nil {
+			return nil, err
+		}
+		translated[i] = strings.TrimSpace(restored)
+	}
+	return translated, nil
+}
+
+// uniqueTerms returns non-empty terms, longest first so overlapping terms protect the longer one.
+func uniqueTerms(glossary []string) []string {
+	seen := map[string]bool{}
+	terms := make([]string, 0, len(glossary))
+	for _, term := range glossary {
+		term = strings.TrimSpace(term)
+		if term == "" || seen[term] {
+			continue
+		}
+		seen[term] = true
+		terms = append(terms, term)
+	}
+	sort.SliceStable(terms, func(i, j int) bool {
+		return utf8.RuneCountInString(terms[i]) > utf8.RuneCountInString(terms[j])
+	})
+	return terms
+}
+
+func termPlaceholder(index int) string {
+	return fmt.Sprintf("__ASC_TERM_%d__", index)
+}
+
+func protectTerms(text string, terms []string) string {
+	for i, term := range terms {
+		text = strings.ReplaceAll(text, term, termPlaceholder(i))
+	}
+	return text
+}
+
+// restoreTerms puts protected terms back and fails if the provider dropped one.
+func restoreTerms(sent, translated string, terms []string) (string, error) {
+	for i, term := range terms {
+		placeholder := termPlaceholder(i)
+		want := strings.Count(sent, placeholder)
+		if want == 0 {
+			continue
+		}
+		if got := strings.Count(translated, placeholder); got < want {
+			return "", fmt.Errorf("provider did not preserve protected term %q", term)
+		}
+		translated = strings.ReplaceAll(translated, placeholder, term)
+	}
+	return translated, nil
+}
+
+// normalizeTranslatedKeywords rejoins keywords with plain commas after translation.
+func normalizeTranslatedKeywords(value string) string {
+	replacer := strings.NewReplacer("、", ",", "，", ",", "،", ",", ";", ",", "；", ",")
+	seen := map[string]bool{}
+	var keywords []string
+	for _, keyword := range strings.Split(replacer.Replace(value), ",") {
+		keyword = strings.TrimSpace(keyword)
+		folded := strings.ToLower(keyword)
+		if keyword == "" || seen[folded] {
+			continue
+		}
+		seen[folded] = true
+		keywords = append(keywords, keyword)
+	}
+	return strings.Join(keywords, ",")
+}
+
+// fitToLimit shortens value to limit runes; it reports whether anything was cut.
+func fitToLimit(field, value string, limit int) (string, bool) {
+	if limit <= 0 || utf8.RuneCountInString(value) <= limit {
+		return value, false
+	}
+	if field == "keywords" {
+		var kept []string
+		length := 0
+		for _, keyword := range strings.Split(value, ",") {
+			next := utf8.RuneCountInString(keyword)
+			if len(kept) > 0 {
+				next++
+			}
+			if length+next > limit {
+				break
+			}
+			kept = append(kept, keyword)
+			length += next
+		}
+		return strings.Join(kept, ","), true
+	}
+
+	runes := []rune(value)[:limit]
+	cut := string(runes)
+	if index := lastSentenceEnd(cut); index > len(cut)/2 {
+		return strings.TrimSpace(cut[:index]), true
+	}
+	if index := strings.LastIndexAny(cut, " \n\t"); index > 0 {
+		return strings.TrimSpace(cut[:index]), true
+	}
+	return strings.TrimSpace(cut), true
+}
+
+// lastSentenceEnd returns the byte offset just after the last sentence terminator.
+func lastSentenceEnd(text string) int {
+	end := -1
+	for index, r := range text {
+		switch r {
+		case '.', '!', '?', '。', '！', '？', '\n':
+			end = index + utf8.RuneLen(r)
+		}
+	}
+	return end
+}
+
+func mergeFieldNames(existing, added []string) []string {
+	seen := map[string]bool{}
+	merged := make([]string, 0, len(existing)+len(added))
+	for _, field := range append(append([]string(nil), existing...), added...) {
+		if seen[field] {
+			continue
+		}
+		seen[field] = true
+		merged = append(merged, field)
+	}
+	sort.Strings(merged)
+	return merged
+}
+
+func versionFromFields(fields map[string]string) VersionLocalization {
+	return VersionLocalization{
+		Description:     fields["description"],
+		Keywords:        fields["keywords"],
+		MarketingURL:    fields["marketingUrl"],
+		PromotionalText: fields["promotionalText"],
+		SupportURL:      fields["supportUrl"],
+		WhatsNew:        fields["whatsNew"],
+	}
+}
+
+func printTranslateResult(result TranslateResult, render func([]string, [][]string)) error {
+	rows := make([][]string, 0, len(result.Items))
+	for _, item := range result.Items {
+		detail := item.Reason
+		if item.Action == translateActionTranslated {
+			detail = fmt.Sprintf("%d/%d chars", item.Length, item.Limit)
+			if item.Truncated {
+				detail += " (shortened)"
+			}
+		}
+		rows = append(rows, []string{item.Locale, item.Field, item.Action, detail})
+	}
+	render([]string{"Locale", "Field", "Action", "Detail"}, rows)
+	return nil
+}
@@ -0,0 +1,261 @@
+package metadata
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+const (
+	translateProviderDeepL  = "deepl"
+	translateProviderGoogle = "google"
+	translateProviderHTTP   = "http"
+
+	deepLAPIKeyEnv        = "ASC_DEEPL_API_KEY"
+	deepLAPIURLEnv        = "ASC_DEEPL_API_URL"
+	googleTranslateKeyEnv = "ASC_GOOGLE_TRANSLATE_API_KEY"
+	googleTranslateURLEnv = "ASC_GOOGLE_TRANSLATE_URL"
+	httpTranslateURLEnv   = "ASC_TRANSLATE_URL"
+	httpTranslateTokenEnv = "ASC_TRANSLATE_TOKEN"
+
+	deepLFreeURL          = "https://api-free.deepl.com/v2/translate"
+	deepLProURL           = "https://api.deepl.com/v2/translate"
+	googleTranslateURL    = "https://translation.googleapis.com/language/translate/v2"
+	translateMaxErrorBody = 4096
+)
+
+// translationRequest is one batch of texts for a single source/target pair.
+type translationRequest struct {
+	Source         string
+	Target         string
+	Texts          []string
+	ProtectedTerms []string
+}
+
+// translator is a machine-translation backend.
+type translator interface {
+	Name() string
+	Translate(ctx context.Context, req translationRequest) ([]string, error)
+}
+
+var translateHTTPClient = func() *http.Client {
+	return &http.Client{Timeout: asc.ResolveTimeout()}
+}
+
+// newTranslator builds the backend for --provider.
+func newTranslator(provider, endpoint string) (translator, error) {
+	switch strings.ToLower(strings.TrimSpace(provider)) {
+	case translateProviderDeepL:
+		key := strings.TrimSpace(os.Getenv(deepLAPIKeyEnv))
+		if key == "" {
+			return nil, fmt.Errorf("%s is required for the deepl provider", deepLAPIKeyEnv)
+		}
+		apiURL := strings.TrimSpace(os.Getenv(deepLAPIURLEnv))
+		if apiURL == "" {
+			apiURL = deepLProURL
+			if strings.HasSuffix(key, ":fx") {
+				apiURL = deepLFreeURL
+			}
+		}
+		return deepLTranslator{apiKey: key, url: apiURL}, nil
+	case translateProviderGoogle:
+		key := strings.TrimSpace(os.Getenv(googleTranslateKeyEnv))
+		if key == "" {
+			return nil, fmt.Errorf("%s is required for the google provider", googleTranslateKeyEnv)
+		}
+		apiURL := strings.TrimSpace(os.Getenv(googleTranslateURLEnv))
+		if apiURL == "" {
+			apiURL = googleTranslateURL
+		}
+		return googleTranslator{apiKey: key, url: apiURL}, nil
+	case translateProviderHTTP:
+		apiURL := strings.TrimSpace(endpoint)
+		if apiURL == "" {
+			apiURL = strings.TrimSpace(os.Getenv(httpTranslateURLEnv))
+		}
+		if apiURL == "" {
+			return nil, fmt.Errorf("--endpoint (or %s) is required for the http provider", httpTranslateURLEnv)
+		}
+		parsed, err := url.Parse(apiURL)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return nil, fmt.Errorf("invalid translation endpoint %q", apiURL)
+		}
+		return httpTranslator{url: apiURL, token: strings.TrimSpace(os.Getenv(httpTranslateTokenEnv))}, nil
+	default:
+		return nil, fmt.Errorf("--provider must be one of: %s, %s, %s", translateProviderDeepL, translateProviderGoogle, translateProviderHTTP)
+	}
+}
+
+// deepLTranslator calls the DeepL v2 translate API.
+type deepLTranslator struct {
+	apiKey string
+	url    string
+}
+
+func (t deepLTranslator) Name() string { return translateProviderDeepL }
+
+func (t deepLTranslator) Translate(ctx context.Context, req translationRequest) ([]string, error) {
+	body := map[string]any{
+		"text":        req.Texts,
+		"source_lang": deepLSourceLanguage(req.Source),
+		"target_lang": deepLTargetLanguage(req.Target),
+	}
+	var resp struct {
+		Translations []struct {
+			Text string `json:"text"`
+		} `json:"translations"`
+	}
+	headers := map[string]string{"Authorization": "DeepL-Auth-Key " + t.apiKey}
+	if err := postTranslationJSON(ctx, t.url, headers, body, &resp); err != nil {
+		return nil, fmt.Errorf("deepl: %w", err)
+	}
+	texts := make([]string, 0, len(resp.Translations))
+	for _, item := range resp.Translations {
+		texts = append(texts, item.Text)
+	}
+	return texts, nil
+}
+
+// deepLSourceLanguage returns the language-only code DeepL expects for sources.
+func deepLSourceLanguage(locale string) string {
+	language, _, _ := strings.Cut(locale, "-")
+	return strings.ToUpper(language)
+}
+
+// deepLTargetLanguage keeps the regional variants DeepL distinguishes for targets.
+func deepLTargetLanguage(locale string) string {
+	switch strings.ToLower(locale) {
+	case "en-us", "en-gb", "pt-br", "pt-pt", "zh-hans", "zh-hant":
+		return strings.ToUpper(locale)
+	case "en-au", "en-ca":
+		return "EN-GB"
+	case "no":
+		return "NB"
+	}
+	return deepLSourceLanguage(locale)
+}
+
+// googleTranslator calls the Google Cloud Translation v2 API.
+type googleTranslator struct {
+	apiKey string
+	url    string
+}
+
+func (t googleTranslator) Name() string { return translateProviderGoogle }
+
+func (t googleTranslator) Translate(ctx context.Context, req translationRequest) ([]string, error) {
+	endpoint, err := url.Parse(t.url)
+	if err != nil {
+		return nil, fmt.Errorf("google: invalid URL: %w", err)
+	}
+	query := endpoint.Query()
+	query.Set("key", t.apiKey)
+	endpoint.RawQuery = query.Encode()
+
+	body := map[string]any{
+		"q":      req.Texts,
+		"source": googleLanguage(req.Source),
+		"target": googleLanguage(req.Target),
+		"format": "text",
+	}
+	var resp struct {
+		Data struct {
+			Translations []struct {
+				TranslatedText string `json:"translatedText"`
+			} `json:"translations"`
+		} `json:"data"`
+	}
+	if err := postTranslationJSON(ctx, endpoint.String(), nil, body, &resp); err != nil {
+		return nil, fmt.Errorf("google: %w", err)
+	}
+	texts := make([]string, 0, len(resp.Data.Translations))
+	for _, item := range resp.Data.Translations {
+		texts = append(texts, item.TranslatedText)
+	}
+	return texts, nil
+}
+
+// googleLanguage maps App Store locales to Google Translate language codes.
+func googleLanguage(locale string) string {
+	switch strings.ToLower(locale) {
+	case "zh-hans":
+		return "zh-CN"
+	case "zh-hant":
+		return "zh-TW"
+	case "pt-pt":
+		return "pt-PT"
+	case "fr-ca", "fr-fr":
+		return "fr"
+	}
+	language, _, _ := strings.Cut(locale, "-")
+	return strings.ToLower(language)
+}
+
+// httpTranslator posts to a generic JSON endpoint, e.g. an internal service or local stub.
+//
+// Request:  {"source":"en-US","target":"de-DE","texts":[...],"protectedTerms":[...]}
+// Response: {"translations":[...]}
+type httpTranslator struct {
+	url   string
+	token string
+}
+
+func (t httpTranslator) Name() string { return translateProviderHTTP }
+
+func (t httpTranslator) Translate(ctx context.Context, req translationRequest) ([]string, error) {
+	body := map[string]any{
+		"source":         req.Source,
+		"target":         req.Target,
+		"texts":          req.Texts,
+		"protectedTerms": req.ProtectedTerms,
+	}
+	var headers map[string]string
+	if t.token != "" {
+		headers = map[string]string{"Authorization": "Bearer " + t.token}
+	}
+	var resp struct {
+		Translations []string `json:"translations"`
+	}
+	if err := postTranslationJSON(ctx, t.url, headers, body, &resp); err != nil {
+		return nil, fmt.Errorf("http: %w", err)
+	}
+	return resp.Translations, nil
+}
+
+func postTranslationJSON(ctx context.Context, endpoint string, headers map[string]string, body any, target any) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := translateHTTPClient().Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, translateMaxErrorBody))
+		return fmt.Errorf("unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(detail)))
+	}
+	if err := json.NewDecoder(resp.Body).Decode(target); err != nil {
+		return fmt.Errorf("decode response: %w", err)
+	}
+	return nil
+}
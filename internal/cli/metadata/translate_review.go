@@ -0,0 +1,210 @@
+package metadata
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// machineTranslationsFileName is the review manifest kept next to the metadata directories.
+const machineTranslationsFileName = "machine-translations.json"
+
+// MachineTranslationManifest records which metadata files contain machine translations.
+type MachineTranslationManifest struct {
+	Files map[string]MachineTranslationEntry `json:"files"`
+}
+
+// MachineTranslationEntry describes one machine-translated metadata file.
+type MachineTranslationEntry struct {
+	Scope        string   `json:"scope"`
+	Version      string   `json:"version,omitempty"`
+	Locale       string   `json:"locale"`
+	SourceLocale string   `json:"sourceLocale"`
+	Provider     string   `json:"provider"`
+	Fields       []string `json:"fields"`
+	TranslatedAt string   `json:"translatedAt"`
+	Reviewed     bool     `json:"reviewed"`
+	ReviewedAt   string   `json:"reviewedAt,omitempty"`
+}
+
+// TranslateApproveResult is the output of metadata translate approve.
+type TranslateApproveResult struct {
+	Dir      string   `json:"dir"`
+	Approved []string `json:"approved"`
+}
+
+func machineTranslationsPath(dir string) string {
+	return filepath.Join(dir, machineTranslationsFileName)
+}
+
+func readMachineTranslations(dir string) (MachineTranslationManifest, error) {
+	manifest := MachineTranslationManifest{Files: map[string]MachineTranslationEntry{}}
+	data, err := readFileNoFollow(machineTranslationsPath(dir))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return manifest, nil
+		}
+		return manifest, err
+	}
+	if err := decodeStrictJSON(data, &manifest); err != nil {
+		return manifest, fmt.Errorf("invalid %s: %w", machineTranslationsFileName, err)
+	}
+	if manifest.Files == nil {
+		manifest.Files = map[string]MachineTranslationEntry{}
+	}
+	return manifest, nil
+}
+
+func writeMachineTranslations(dir string, manifest MachineTranslationManifest) error {
+	data, err := encodeCanonicalJSON(manifest)
+	if err != nil {
+		return err
+	}
+	return writeFileNoFollow(machineTranslationsPath(dir), append(data, '\n'))
+}
+
+// manifestKey is the slash-separated path of a metadata file relative to dir.
+func manifestKey(dir, path string) string {
+	rel, err := filepath.Rel(dir, path)
+	if err != nil {
+		rel = path
+	}
+	return filepath.ToSlash(rel)
+}
+
+// unreviewedMachineTranslations returns unreviewed entries whose files still exist.
+// An empty version matches entries of every version.
+func unreviewedMachineTranslations(dir, version string) ([]string, error) {
+	manifest, err := readMachineTranslations(dir)
+	if err != nil {
+		return nil, err
+	}
+	var paths []string
+	for key, entry := range manifest.Files {
+		if entry.Reviewed {
+			continue
+		}
+		if version != "" && entry.Scope == versionDirName && entry.Version != version {
+			continue
+		}
+		if _, err := os.Lstat(filepath.Join(dir, filepath.FromSlash(key))); err != nil {
+			continue
+		}
+		paths = append(paths, key)
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
+
+// MetadataTranslateApproveCommand returns the metadata translate approve subcommand.
+func MetadataTranslateApproveCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("metadata translate approve", flag.ExitOnError)
+
+	dir := fs.String("dir", "", "Metadata root directory (required)")
+	locale := fs.String("locale", "", "Locale(s) to approve, comma-separated")
+	version := fs.String("version", "", "Only approve files for this app version")
+	all := fs.Bool("all", false, "Approve every unreviewed machine translation")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "approve",
+		ShortUsage: "asc metadata translate approve --dir \"./metadata\" --locale \"de-DE\" [flags]",
+		ShortHelp:  "Mark machine-translated metadata as human reviewed.",
+		LongHelp: `Mark machine-translated metadata as human reviewed.
+
+Run this after a reviewer has checked the translated files. Unreviewed machine
+translations fail metadata validate and block metadata push.
+
+Examples:
+  asc metadata translate approve --dir "./metadata" --locale "de-DE,ja"
+  asc metadata translate approve --dir "./metadata" --version "1.2.3" --all`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			dirValue := strings.TrimSpace(*dir)
+			if dirValue == "" {
+				return shared.UsageError("--dir is required")
+			}
+			locales := shared.SplitCSV(*locale)
+			if len(locales) == 0 && !*all {
+				return shared.UsageError("--locale or --all is required")
+			}
+			if len(locales) > 0 && *all {
+				return shared.UsageError("--locale and --all are mutually exclusive")
+			}
+
+			result, err := approveMachineTranslations(dirValue, strings.TrimSpace(*version), locales, time.Now().UTC())
+			if err != nil {
+				return fmt.Errorf("metadata translate approve: %w", err)
+			}
+
+			return shared.PrintOutputWithRenderers(
+				result,
+				*output.Output,
+				*output.Pretty,
+				func() error { return printApproveResult(result, asc.RenderTable) },
+				func() error { return printApproveResult(result, asc.RenderMarkdown) },
+			)
+		},
+	}
+}
+
+func approveMachineTranslations(dir, version string, locales []string, now time.Time) (TranslateApproveResult, error) {
+	manifest, err := readMachineTranslations(dir)
+	if err != nil {
+		return TranslateApproveResult{}, err
+	}
+	wanted := make(map[string]bool, len(locales))
+	for _, locale := range locales {
+		resolved, err := validateLocale(locale)
+		if err != nil {
+			return TranslateApproveResult{}, err
+		}
+		wanted[resolved] = true
+	}
+
+	result := TranslateApproveResult{Dir: dir, Approved: []string{}}
+	for _, key := range sortedKeys(manifest.Files) {
+		entry := manifest.Files[key]
+		if entry.Reviewed {
+			continue
+		}
+		if len(wanted) > 0 && !wanted[entry.Locale] {
+			continue
+		}
+		if version != "" && entry.Scope == versionDirName && entry.Version != version {
+			continue
+		}
+		entry.Reviewed = true
+		entry.ReviewedAt = now.Format(time.RFC3339)
+		manifest.Files[key] = entry
+		result.Approved = append(result.Approved, key)
+	}
+	if len(result.Approved) == 0 {
+		return result, fmt.Errorf("no unreviewed machine translations matched")
+	}
+	if err := writeMachineTranslations(dir, manifest); err != nil {
+		return result, err
+	}
+	return result, nil
+}
+
+func printApproveResult(result TranslateApproveResult, render func([]string, [][]string)) error {
+	rows := make([][]string, 0, len(result.Approved))
+	for _, path := range result.Approved {
+		rows = append(rows, []string{path, "approved"})
+	}
+	render([]string{"File", "Status"}, rows)
+	return nil
+}
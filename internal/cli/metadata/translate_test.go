@@ -0,0 +1,215 @@
+package metadata
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+// stubTranslateServer upper-cases texts and records each request body.
+func stubTranslateServer(t *testing.T, requests *[]map[string]any) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("decode request: %v", err)
+		}
+		*requests = append(*requests, body)
+		texts, _ := body["texts"].([]any)
+		translations := make([]string, 0, len(texts))
+		for _, text := range texts {
+			translations = append(translations, "["+body["target"].(string)+"] "+strings.ToUpper(text.(string)))
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"translations": translations})
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func writeTranslateSource(t *testing.T, dir string, loc VersionLocalization) {
+	t.Helper()
+	path := filepath.Join(dir, versionDirName, "1.0", "en-US.json")
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	data, err := EncodeVersionLocalization(loc)
+	if err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+}
+
+func TestRunTranslateFillsMissingFieldsAndRecordsReview(t *testing.T) {
+	var requests []map[string]any
+	server := stubTranslateServer(t, &requests)
+	backend, err := newTranslator(translateProviderHTTP, server.URL)
+	if err != nil {
+		t.Fatalf("newTranslator: %v", err)
+	}
+
+	dir := t.TempDir()
+	writeTranslateSource(t, dir, VersionLocalization{
+		Description: "Acme keeps notes in sync.",
+		Keywords:    "notes,sync",
+		SupportURL:  "https://example.com/support",
+	})
+	dePath := filepath.Join(dir, versionDirName, "1.0", "de-DE.json")
+	if err := os.WriteFile(dePath, []byte(`{"keywords":"notizen"}`), 0o644); err != nil {
+		t.Fatalf("write existing target: %v", err)
+	}
+
+	result, err := runTranslate(context.Background(), backend, translateOptions{
+		Dir:      dir,
+		Version:  "1.0",
+		From:     "en-US",
+		To:       []string{"de-DE"},
+		Fields:   translatableVersionFields,
+		Glossary: []string{"Acme"},
+		Now:      time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("runTranslate error: %v", err)
+	}
+
+	if len(requests) != 1 {
+		t.Fatalf("expected one batched request, got %d", len(requests))
+	}
+	texts := requests[0]["texts"].([]any)
+	if len(texts) != 1 || strings.Contains(texts[0].(string), "Acme") || !strings.Contains(texts[0].(string), "__ASC_TERM_0__") {
+		t.Fatalf("expected only description with protected placeholder, got %v", texts)
+	}
+
+	loc, err := ReadVersionLocalizationFile(dePath)
+	if err != nil {
+		t.Fatalf("read target: %v", err)
+	}
+	if loc.Description != "[de-DE] Acme KEEPS NOTES IN SYNC." {
+		t.Fatalf("unexpected description %q", loc.Description)
+	}
+	if loc.Keywords != "notizen" {
+		t.Fatalf("existing keywords should be kept, got %q", loc.Keywords)
+	}
+	if loc.SupportURL != "https://example.com/support" {
+		t.Fatalf("expected support URL copied, got %q", loc.SupportURL)
+	}
+
+	var skippedKeywords bool
+	for _, item := range result.Items {
+		if item.Field == "keywords" && item.Action == translateActionSkipped && item.Reason == "already translated" {
+			skippedKeywords = true
+		}
+	}
+	if !skippedKeywords {
+		t.Fatalf("expected keywords skipped, got %+v", result.Items)
+	}
+
+	unreviewed, err := unreviewedMachineTranslations(dir, "1.0")
+	if err != nil || len(unreviewed) != 1 || unreviewed[0] != "version/1.0/de-DE.json" {
+		t.Fatalf("expected de-DE pending review, got %v (%v)", unreviewed, err)
+	}
+	validated, err := validateDir(dir)
+	if err != nil {
+		t.Fatalf("validateDir: %v", err)
+	}
+	if validated.Valid {
+		t.Fatalf("expected validate to fail on unreviewed translation, got %+v", validated.Issues)
+	}
+	if _, err := Push(context.Background(), nil, PushOptions{Version: "1.0", Dir: dir}); !errors.Is(err, errUnreviewedTranslations) {
+		t.Fatalf("expected push to reject unreviewed translation, got %v", err)
+	}
+
+	if _, err := approveMachineTranslations(dir, "", []string{"de-DE"}, time.Now()); err != nil {
+		t.Fatalf("approve: %v", err)
+	}
+	if unreviewed, _ := unreviewedMachineTranslations(dir, "1.0"); len(unreviewed) != 0 {
+		t.Fatalf("expected no pending review after approve, got %v", unreviewed)
+	}
+}
+
+func TestRunTranslateDryRunWritesNothing(t *testing.T) {
+	var requests []map[string]any
+	server := stubTranslateServer(t, &requests)
+	backend, _ := newTranslator(translateProviderHTTP, server.URL)
+
+	dir := t.TempDir()
+	writeTranslateSource(t, dir, VersionLocalization{WhatsNew: "Bug fixes."})
+	_, err := runTranslate(context.Background(), backend, translateOptions{
+		Dir: dir, Version: "1.0", From: "en-US", To: []string{"ja"}, Fields: translatableVersionFields, DryRun: true, Now: time.Now(),
+	})
+	if err != nil {
+		t.Fatalf("runTranslate error: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, versionDirName, "1.0", "ja.json")); !os.IsNotExist(err) {
+		t.Fatalf("dry run should not write target, got %v", err)
+	}
+	if _, err := os.Stat(machineTranslationsPath(dir)); !os.IsNotExist(err) {
+		t.Fatalf("dry run should not write manifest, got %v", err)
+	}
+}
+
+func TestRestoreTermsRejectsDroppedPlaceholder(t *testing.T) {
+	sent := protectTerms("Acme Pro by Acme", uniqueTerms([]string{"Acme", "Acme Pro"}))
+	if sent != "__ASC_TERM_0__ by __ASC_TERM_1__" {
+		t.Fatalf("expected longest term protected first, got %q", sent)
+	}
+	if _, err := restoreTerms(sent, "__ASC_TERM_0__ von Akme", []string{"Acme Pro", "Acme"}); err == nil {
+		t.Fatal("expected error for dropped protected term")
+	}
+}
+
+func TestFitToLimit(t *testing.T) {
+	keywords, truncated := fitToLimit("keywords", "alpha,beta,gamma", 10)
+	if keywords != "alpha,beta" || !truncated {
+		t.Fatalf("unexpected keywords %q (%v)", keywords, truncated)
+	}
+
+	text := "Erster Satz ist hier. Zweiter Satz ist deutlich länger als erlaubt"
+	fitted, truncated := fitToLimit("promotionalText", text, 30)
+	if fitted != "Erster Satz ist hier." || !truncated {
+		t.Fatalf("expected sentence cut, got %q", fitted)
+	}
+	if fitted, _ := fitToLimit("promotionalText", strings.Repeat("ü", 40), 30); utf8.RuneCountInString(fitted) != 30 {
+		t.Fatalf("expected rune-safe hard cut, got %d runes", utf8.RuneCountInString(fitted))
+	}
+
+	if got := normalizeTranslatedKeywords("Notizen、 Sync ; notizen,"); got != "Notizen,Sync" {
+		t.Fatalf("unexpected normalized keywords %q", got)
+	}
+}
+
+func TestNewTranslatorRequiresCredentials(t *testing.T) {
+	t.Setenv(deepLAPIKeyEnv, "")
+	t.Setenv(httpTranslateURLEnv, "")
+	if _, err := newTranslator(translateProviderDeepL, ""); err == nil {
+		t.Fatal("expected missing DeepL key error")
+	}
+	if _, err := newTranslator(translateProviderHTTP, ""); err == nil {
+		t.Fatal("expected missing endpoint error")
+	}
+	if _, err := newTranslator("bing", ""); err == nil {
+		t.Fatal("expected unknown provider error")
+	}
+
+	t.Setenv(deepLAPIKeyEnv, "abc:fx")
+	t.Setenv(deepLAPIURLEnv, "")
+	backend, err := newTranslator(translateProviderDeepL, "")
+	if err != nil || backend.(deepLTranslator).url != deepLFreeURL {
+		t.Fatalf("expected free DeepL endpoint, got %+v (%v)", backend, err)
+	}
+	if got := deepLTargetLanguage("pt-BR"); got != "PT-BR" {
+		t.Fatalf("unexpected DeepL target %q", got)
+	}
+	if got := googleLanguage("zh-Hans"); got != "zh-CN" {
+		t.Fatalf("unexpected Google language %q", got)
+	}
+}
@@ -61,6 +61,7 @@ Checks:
   - strict JSON schema decode (unknown keys rejected)
   - required fields
   - metadata character limits
+  - machine translations awaiting review (machine-translations.json)
 
 Examples:
   asc metadata validate --dir "./metadata"
@@ -196,6 +197,20 @@ func validateDir(dir string) (ValidateResult, error) {
 		}
 	}
 
+	unreviewed, err := unreviewedMachineTranslations(dir, "")
+	if err != nil {
+		return ValidateResult{}, fmt.Errorf("metadata validate: %w", err)
+	}
+	for _, path := range unreviewed {
+		result.Issues = append(result.Issues, ValidateIssue{
+			Scope:    "translation",
+			File:     filepath.Join(dir, filepath.FromSlash(path)),
+			Field:    "review",
+			Severity: issueSeverityError,
+			Message:  "machine translation has not been reviewed (run asc metadata translate approve)",
+		})
+	}
+
 	if result.FilesScanned == 0 {
 		result.Issues = append(result.Issues, ValidateIssue{
 			Scope:    "metadata",
@@ -50,8 +50,8 @@ Examples:
 func MigrateImportCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("migrate import", flag.ExitOnError)
 
-	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID)")
-	versionID := fs.String("version-id", "", "App Store version ID (required unless Deliverfile app_version + platform)")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (or ASC_APP_ID)")
+	versionID := shared.ReferenceFlag(fs, shared.ReferenceVersionID, "", "App Store version ID or version string like 1.2.3 (required unless Deliverfile app_version + platform)")
 	fastlaneDir := fs.String("fastlane-dir", "", "Path to fastlane directory (optional)")
 	dryRun := fs.Bool("dry-run", false, "Preview changes without uploading")
 	output := shared.BindOutputFlags(fs)
@@ -269,8 +269,8 @@ Examples:
 func MigrateExportCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("migrate export", flag.ExitOnError)
 
-	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID)")
-	versionID := fs.String("version-id", "", "App Store version ID (required)")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (or ASC_APP_ID)")
+	versionID := shared.ReferenceFlag(fs, shared.ReferenceVersionID, "", "App Store version ID or version string like 1.2.3 (required)")
 	outputDir := fs.String("output-dir", "", "Output directory for fastlane structure (required)")
 	output := shared.BindOutputFlags(fs)
 
@@ -0,0 +1,266 @@
+package mock
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	mockServeDefaultHost = "127.0.0.1"
+	mockServeDefaultPort = 8788
+
+	mockModeMemory = "memory"
+	mockModeRecord = "record"
+	mockModeReplay = "replay"
+)
+
+// mockDefaultSeed gives the in-memory server one app with a build, a version,
+// a localization and a beta group so scripts have something to work against.
+const mockDefaultSeed = `{
+  "apps": [
+    {"id": "1000000001", "attributes": {"name": "Mock App", "bundleId": "com.example.mock", "sku": "MOCKAPP", "primaryLocale": "en-US"}}
+  ],
+  "builds": [
+    {"id": "mock-build-1", "attributes": {"version": "1", "processingState": "VALID", "uploadedDate": "2026-01-01T00:00:00Z", "expired": false},
+     "relationships": {"app": {"data": {"type": "apps", "id": "1000000001"}}}}
+  ],
+  "preReleaseVersions": [
+    {"id": "mock-prerelease-1", "attributes": {"version": "1.0", "platform": "IOS"},
+     "relationships": {"app": {"data": {"type": "apps", "id": "1000000001"}}}}
+  ],
+  "appStoreVersions": [
+    {"id": "mock-version-1", "attributes": {"versionString": "1.0", "platform": "IOS", "appStoreState": "PREPARE_FOR_SUBMISSION", "appVersionState": "PREPARE_FOR_SUBMISSION", "releaseType": "MANUAL"},
+     "relationships": {"app": {"data": {"type": "apps", "id": "1000000001"}}}}
+  ],
+  "appStoreVersionLocalizations": [
+    {"id": "mock-version-localization-1", "attributes": {"locale": "en-US", "description": "A mock app.", "keywords": "mock,test", "whatsNew": "Bug fixes."},
+     "relationships": {"appStoreVersion": {"data": {"type": "appStoreVersions", "id": "mock-version-1"}}}}
+  ],
+  "betaGroups": [
+    {"id": "mock-beta-group-1", "attributes": {"name": "Internal Testers", "isInternalGroup": true},
+     "relationships": {"app": {"data": {"type": "apps", "id": "1000000001"}}}}
+  ]
+}`
+
+type mockServeStartup struct {
+	URL      string `json:"url"`
+	Host     string `json:"host"`
+	Port     int    `json:"port"`
+	Mode     string `json:"mode"`
+	Dir      string `json:"dir,omitempty"`
+	Upstream string `json:"upstream,omitempty"`
+}
+
+// MockCommand returns the mock command group.
+func MockCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("mock", flag.ExitOnError)
+
+	return &ffcli.Command{
+		Name:       "mock",
+		ShortUsage: "asc mock <subcommand> [flags]",
+		ShortHelp:  "Run a local App Store Connect API for testing scripts.",
+		LongHelp: `Run a local App Store Connect API for testing scripts.
+
+Point the CLI at the server with ASC_BASE_URL to exercise release scripts end
+to end without touching a real account.
+
+Examples:
+  asc mock serve
+  ASC_BASE_URL=http://127.0.0.1:8788 asc apps list
+  asc mock serve --record ./fixtures
+  asc mock serve --replay ./fixtures`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Subcommands: []*ffcli.Command{
+			MockServeCommand(),
+		},
+		Exec: func(ctx context.Context, args []string) error {
+			return flag.ErrHelp
+		},
+	}
+}
+
+// MockServeCommand returns the mock serve subcommand.
+func MockServeCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("serve", flag.ExitOnError)
+
+	host := fs.String("host", mockServeDefaultHost, "Host to bind the mock server")
+	port := fs.Int("port", mockServeDefaultPort, "Port to bind the mock server (0-65535)")
+	seed := fs.String("seed", "", "JSON file of resources keyed by type to load instead of the default seed")
+	record := fs.String("record", "", "Proxy to the real API and record sanitized fixtures into this directory")
+	replay := fs.String("replay", "", "Serve recorded fixtures from this directory")
+	upstream := fs.String("upstream", asc.BaseURL, "API to proxy in --record mode")
+	output := fs.String("output", "text", "Output format: text (default), json")
+
+	return &ffcli.Command{
+		Name:       "serve",
+		ShortUsage: "asc mock serve [flags]",
+		ShortHelp:  "Serve a local JSON:API mock of App Store Connect.",
+		LongHelp: `Serve a local JSON:API mock of App Store Connect.
+
+Modes:
+  memory (default)  In-memory state for apps, builds, versions, localizations,
+                    beta groups, review submissions and any other resource type.
+                    Supports list filters, pagination, create/update/delete,
+                    related and relationship endpoints, and upload operations.
+  --record DIR      Proxy every request to the real API and write one fixture
+                    per request. Personal data is redacted and API URLs are
+                    replaced with a placeholder.
+  --replay DIR      Serve recorded fixtures, matched by method, path and query.
+
+The server requires a bearer token but does not verify it, so any configured
+API key works. Run commands with ASC_BASE_URL set to the printed URL.
+
+--seed takes a file like:
+
+  {
+    "apps": [{"id": "123", "attributes": {"name": "My App", "bundleId": "com.example.app"}}],
+    "builds": [{"id": "b1", "attributes": {"version": "42"},
+                "relationships": {"app": {"data": {"type": "apps", "id": "123"}}}}]
+  }
+
+Examples:
+  asc mock serve
+  asc mock serve --port 9000 --seed ./mock-seed.json
+  asc mock serve --record ./fixtures
+  asc mock serve --replay ./fixtures --output json`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if len(args) > 0 {
+				fmt.Fprintln(os.Stderr, "Error: mock serve does not accept positional arguments")
+				return flag.ErrHelp
+			}
+
+			bindHost := strings.TrimSpace(*host)
+			if bindHost == "" {
+				fmt.Fprintln(os.Stderr, "Error: --host is required")
+				return flag.ErrHelp
+			}
+			if *port < 0 || *port > 65535 {
+				fmt.Fprintln(os.Stderr, "Error: --port must be between 0 and 65535")
+				return flag.ErrHelp
+			}
+			outputFormat := strings.ToLower(strings.TrimSpace(*output))
+			if outputFormat == "" {
+				outputFormat = "text"
+			}
+			if outputFormat != "text" && outputFormat != "json" {
+				fmt.Fprintln(os.Stderr, "Error: --output must be one of: text, json")
+				return flag.ErrHelp
+			}
+
+			recordDir := strings.TrimSpace(*record)
+			replayDir := strings.TrimSpace(*replay)
+			seedPath := strings.TrimSpace(*seed)
+			if recordDir != "" && replayDir != "" {
+				return shared.UsageError("--record and --replay are mutually exclusive")
+			}
+			if seedPath != "" && (recordDir != "" || replayDir != "") {
+				return shared.UsageError("--seed is only supported in memory mode")
+			}
+
+			startup := mockServeStartup{Host: bindHost, Mode: mockModeMemory}
+			var handler http.Handler
+			switch {
+			case recordDir != "":
+				upstreamURL := strings.TrimRight(strings.TrimSpace(*upstream), "/")
+				if !strings.HasPrefix(upstreamURL, "https://") && !strings.HasPrefix(upstreamURL, "http://") {
+					return shared.UsageError("--upstream must be an http(s) URL")
+				}
+				if err := os.MkdirAll(recordDir, 0o755); err != nil {
+					return fmt.Errorf("mock serve: %w", err)
+				}
+				handler = newMockRecorder(upstreamURL, recordDir, &http.Client{Timeout: asc.ResolveTimeout()})
+				startup.Mode = mockModeRecord
+				startup.Dir = recordDir
+				startup.Upstream = upstreamURL
+			case replayDir != "":
+				replayer, err := loadMockReplayer(replayDir)
+				if err != nil {
+					return fmt.Errorf("mock serve: %w", err)
+				}
+				handler = replayer
+				startup.Mode = mockModeReplay
+				startup.Dir = replayDir
+			default:
+				store := newMockStore()
+				if seedPath != "" {
+					if err := store.loadSeedFile(seedPath); err != nil {
+						return fmt.Errorf("mock serve: %w", err)
+					}
+				} else if err := store.loadSeed([]byte(mockDefaultSeed)); err != nil {
+					return fmt.Errorf("mock serve: %w", err)
+				}
+				handler = store
+			}
+
+			listener, err := net.Listen("tcp", net.JoinHostPort(bindHost, strconv.Itoa(*port)))
+			if err != nil {
+				return fmt.Errorf("mock serve: failed to listen on %s: %w", net.JoinHostPort(bindHost, strconv.Itoa(*port)), err)
+			}
+			defer listener.Close()
+
+			tcpAddr, ok := listener.Addr().(*net.TCPAddr)
+			if !ok {
+				return fmt.Errorf("mock serve: unexpected listener address type %T", listener.Addr())
+			}
+			startup.Port = tcpAddr.Port
+			startup.URL = fmt.Sprintf("http://%s", net.JoinHostPort(bindHost, strconv.Itoa(tcpAddr.Port)))
+
+			server := &http.Server{
+				Handler:           handler,
+				ReadHeaderTimeout: 5 * time.Second,
+				IdleTimeout:       60 * time.Second,
+			}
+
+			serveErrCh := make(chan error, 1)
+			go func() {
+				err := server.Serve(listener)
+				if err != nil && !errors.Is(err, http.ErrServerClosed) {
+					serveErrCh <- err
+					return
+				}
+				serveErrCh <- nil
+			}()
+
+			if outputFormat == "json" {
+				if err := asc.PrintJSON(startup); err != nil {
+					return fmt.Errorf("mock serve: %w", err)
+				}
+			} else {
+				fmt.Fprintf(os.Stdout, "Mock App Store Connect API (%s) listening on %s\n", startup.Mode, startup.URL)
+				fmt.Fprintf(os.Stdout, "export ASC_BASE_URL=%s\n", startup.URL)
+			}
+
+			select {
+			case err := <-serveErrCh:
+				if err != nil {
+					return fmt.Errorf("mock serve: %w", err)
+				}
+				return nil
+			case <-ctx.Done():
+				shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancel()
+				_ = server.Shutdown(shutdownCtx)
+				if err := <-serveErrCh; err != nil {
+					return fmt.Errorf("mock serve: %w", err)
+				}
+				return nil
+			}
+		},
+	}
+}
@@ -0,0 +1,322 @@
+package mock
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// mockBaseURLPlaceholder stands in for the server URL inside recorded fixtures.
+const mockBaseURLPlaceholder = "{{baseURL}}"
+
+const mockRedacted = "REDACTED"
+
+// mockSensitiveKeys are attribute names whose values are redacted in fixtures.
+var mockSensitiveKeys = map[string]bool{
+	"email":                true,
+	"firstname":            true,
+	"lastname":             true,
+	"phone":                true,
+	"phonenumber":          true,
+	"password":             true,
+	"username":             true,
+	"secret":               true,
+	"contactemail":         true,
+	"contactfirstname":     true,
+	"contactlastname":      true,
+	"contactphone":         true,
+	"demoaccountname":      true,
+	"demoaccountpassword":  true,
+	"certificatecontent":   true,
+	"profilecontent":       true,
+	"secretanswer":         true,
+	"secretquestion":       true,
+	"confirmpassword":      true,
+	"privatekey":           true,
+	"authorization":        true,
+	"signeddeveloperemail": true,
+}
+
+// mockFixture is one recorded request/response pair.
+type mockFixture struct {
+	Request  mockFixtureRequest  `json:"request"`
+	Response mockFixtureResponse `json:"response"`
+}
+
+type mockFixtureRequest struct {
+	Method string          `json:"method"`
+	Path   string          `json:"path"`
+	Query  string          `json:"query,omitempty"`
+	Body   json.RawMessage `json:"body,omitempty"`
+}
+
+type mockFixtureResponse struct {
+	Status     int               `json:"status"`
+	Headers    map[string]string `json:"headers,omitempty"`
+	Body       json.RawMessage   `json:"body,omitempty"`
+	BodyBase64 string            `json:"bodyBase64,omitempty"`
+}
+
+// mockRecordedHeaders are response headers worth keeping in fixtures.
+var mockRecordedHeaders = []string{"Content-Type", "Retry-After", "Location"}
+
+// mockRecorder proxies requests to the real API and writes sanitized fixtures.
+type mockRecorder struct {
+	upstream string
+	dir      string
+	client   *http.Client
+	counter  uint64
+}
+
+func newMockRecorder(upstream, dir string, client *http.Client) *mockRecorder {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &mockRecorder{
+		upstream: strings.TrimRight(upstream, "/"),
+		dir:      dir,
+		client:   client,
+	}
+}
+
+func (r *mockRecorder) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	requestBody, err := io.ReadAll(io.LimitReader(req.Body, mockMaxBodyBytes))
+	if err != nil {
+		writeMockError(w, http.StatusBadRequest, "PARAMETER_ERROR.INVALID", "Failed to read request body", err.Error())
+		return
+	}
+
+	upstreamReq, err := http.NewRequestWithContext(req.Context(), req.Method, r.upstream+req.URL.RequestURI(), bytes.NewReader(requestBody))
+	if err != nil {
+		writeMockError(w, http.StatusBadGateway, "UPSTREAM_ERROR", "Failed to build upstream request", err.Error())
+		return
+	}
+	for _, header := range []string{"Authorization", "Content-Type", "Accept"} {
+		if value := req.Header.Get(header); value != "" {
+			upstreamReq.Header.Set(header, value)
+		}
+	}
+
+	resp, err := r.client.Do(upstreamReq)
+	if err != nil {
+		writeMockError(w, http.StatusBadGateway, "UPSTREAM_ERROR", "Upstream request failed", err.Error())
+		return
+	}
+	defer resp.Body.Close()
+	responseBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		writeMockError(w, http.StatusBadGateway, "UPSTREAM_ERROR", "Failed to read upstream response", err.Error())
+		return
+	}
+
+	fixture := mockFixture{
+		Request: mockFixtureRequest{
+			Method: req.Method,
+			Path:   req.URL.Path,
+			Query:  req.URL.Query().Encode(),
+			Body:   sanitizeMockJSON(requestBody, r.upstream),
+		},
+		Response: mockFixtureResponse{
+			Status:  resp.StatusCode,
+			Headers: map[string]string{},
+		},
+	}
+	for _, header := range mockRecordedHeaders {
+		if value := resp.Header.Get(header); value != "" {
+			fixture.Response.Headers[header] = value
+		}
+	}
+	if sanitized := sanitizeMockJSON(responseBody, r.upstream); sanitized != nil {
+		fixture.Response.Body = sanitized
+	} else if len(responseBody) > 0 {
+		fixture.Response.BodyBase64 = base64.StdEncoding.EncodeToString(responseBody)
+	}
+
+	path, err := r.writeFixture(fixture)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "mock serve: failed to record %s %s: %v\n", req.Method, req.URL.Path, err)
+	} else {
+		fmt.Fprintf(os.Stderr, "mock serve: recorded %s %s -> %d (%s)\n", req.Method, req.URL.Path, resp.StatusCode, path)
+	}
+
+	// The client sees the real response, with links pointing back at this server.
+	for header, value := range fixture.Response.Headers {
+		w.Header().Set(header, strings.ReplaceAll(value, r.upstream, mockRequestBase(req)))
+	}
+	w.WriteHeader(resp.StatusCode)
+	_, _ = w.Write(bytes.ReplaceAll(responseBody, []byte(r.upstream), []byte(mockRequestBase(req))))
+}
+
+func (r *mockRecorder) writeFixture(fixture mockFixture) (string, error) {
+	index := atomic.AddUint64(&r.counter, 1)
+	name := fmt.Sprintf("%04d-%s-%s.json", index, strings.ToLower(fixture.Request.Method), mockFixtureSlug(fixture.Request.Path))
+	data, err := json.MarshalIndent(fixture, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	path := filepath.Join(r.dir, name)
+	if _, err := shared.WriteStreamToFile(path, bytes.NewReader(append(data, '\n'))); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// mockReplayer serves recorded fixtures, in recorded order for repeated requests.
+type mockReplayer struct {
+	mu       sync.Mutex
+	fixtures map[string][]mockFixture
+	served   map[string]int
+}
+
+func loadMockReplayer(dir string) (*mockReplayer, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+
+	replayer := &mockReplayer{
+		fixtures: map[string][]mockFixture{},
+		served:   map[string]int{},
+	}
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		var fixture mockFixture
+		if err := json.Unmarshal(data, &fixture); err != nil {
+			return nil, fmt.Errorf("invalid fixture %s: %w", path, err)
+		}
+		if fixture.Request.Method == "" || fixture.Request.Path == "" {
+			return nil, fmt.Errorf("invalid fixture %s: request method and path are required", path)
+		}
+		key := mockFixtureKey(fixture.Request.Method, fixture.Request.Path, fixture.Request.Query)
+		replayer.fixtures[key] = append(replayer.fixtures[key], fixture)
+	}
+	if len(replayer.fixtures) == 0 {
+		return nil, fmt.Errorf("no fixtures found in %s", dir)
+	}
+	return replayer, nil
+}
+
+func (r *mockReplayer) count() int {
+	total := 0
+	for _, fixtures := range r.fixtures {
+		total += len(fixtures)
+	}
+	return total
+}
+
+func (r *mockReplayer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	key := mockFixtureKey(req.Method, req.URL.Path, req.URL.Query().Encode())
+
+	r.mu.Lock()
+	fixtures := r.fixtures[key]
+	index := r.served[key]
+	if len(fixtures) > 0 {
+		r.served[key]++
+	}
+	r.mu.Unlock()
+
+	if len(fixtures) == 0 {
+		writeMockError(w, http.StatusNotFound, "NOT_FOUND", "No recorded fixture", fmt.Sprintf("No fixture matches %s %s.", req.Method, req.URL.RequestURI()))
+		return
+	}
+	// Repeated requests walk through recordings and then keep serving the last one.
+	fixture := fixtures[min(index, len(fixtures)-1)]
+
+	base := mockRequestBase(req)
+	for header, value := range fixture.Response.Headers {
+		w.Header().Set(header, strings.ReplaceAll(value, mockBaseURLPlaceholder, base))
+	}
+	var body []byte
+	if len(fixture.Response.Body) > 0 {
+		body = bytes.ReplaceAll(fixture.Response.Body, []byte(mockBaseURLPlaceholder), []byte(base))
+	} else if fixture.Response.BodyBase64 != "" {
+		decoded, err := base64.StdEncoding.DecodeString(fixture.Response.BodyBase64)
+		if err != nil {
+			writeMockError(w, http.StatusInternalServerError, "INVALID_FIXTURE", "Invalid fixture body", err.Error())
+			return
+		}
+		body = decoded
+	}
+	w.WriteHeader(fixture.Response.Status)
+	_, _ = w.Write(body)
+}
+
+func mockFixtureKey(method, path, query string) string {
+	key := strings.ToUpper(method) + " " + path
+	if query != "" {
+		key += "?" + query
+	}
+	return key
+}
+
+// sanitizeMockJSON redacts personal data and replaces the upstream URL with a placeholder.
+// It returns nil when data is empty or not JSON.
+func sanitizeMockJSON(data []byte, upstream string) json.RawMessage {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil
+	}
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil
+	}
+	sanitized, err := json.Marshal(sanitizeMockValue(value, upstream))
+	if err != nil {
+		return nil
+	}
+	return sanitized
+}
+
+func sanitizeMockValue(value any, upstream string) any {
+	switch typed := value.(type) {
+	case map[string]any:
+		for key, item := range typed {
+			if _, isString := item.(string); isString && mockSensitiveKeys[strings.ToLower(key)] {
+				typed[key] = mockRedacted
+				continue
+			}
+			typed[key] = sanitizeMockValue(item, upstream)
+		}
+		return typed
+	case []any:
+		for i, item := range typed {
+			typed[i] = sanitizeMockValue(item, upstream)
+		}
+		return typed
+	case string:
+		return strings.ReplaceAll(typed, upstream, mockBaseURLPlaceholder)
+	default:
+		return value
+	}
+}
+
+// mockFixtureSlug turns a request path into a file name segment.
+func mockFixtureSlug(path string) string {
+	var b strings.Builder
+	for _, r := range strings.Trim(path, "/") {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-':
+			b.WriteRune(r)
+		default:
+			b.WriteByte('_')
+		}
+	}
+	if b.Len() == 0 {
+		return "root"
+	}
+	return b.String()
+}
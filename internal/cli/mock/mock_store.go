@@ -0,0 +1,586 @@
+package mock
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	mockDefaultLimit = 50
+	mockMaxLimit     = 200
+	mockMaxBodyBytes = 64 << 20 // 64 MiB
+)
+
+// mockIdentifier is a JSON:API resource identifier.
+type mockIdentifier struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+// mockLinkage is a to-one or to-many relationship.
+type mockLinkage struct {
+	many bool
+	ids  []mockIdentifier
+}
+
+func (l *mockLinkage) UnmarshalJSON(data []byte) error {
+	var wrapper struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(data, &wrapper); err != nil {
+		return err
+	}
+	return l.setData(wrapper.Data)
+}
+
+func (l *mockLinkage) setData(data json.RawMessage) error {
+	trimmed := strings.TrimSpace(string(data))
+	switch {
+	case trimmed == "" || trimmed == "null":
+		*l = mockLinkage{}
+	case strings.HasPrefix(trimmed, "["):
+		var ids []mockIdentifier
+		if err := json.Unmarshal(data, &ids); err != nil {
+			return err
+		}
+		*l = mockLinkage{many: true, ids: ids}
+	default:
+		var id mockIdentifier
+		if err := json.Unmarshal(data, &id); err != nil {
+			return err
+		}
+		*l = mockLinkage{ids: []mockIdentifier{id}}
+	}
+	return nil
+}
+
+func (l mockLinkage) data() any {
+	if l.many {
+		if l.ids == nil {
+			return []mockIdentifier{}
+		}
+		return l.ids
+	}
+	if len(l.ids) == 0 {
+		return nil
+	}
+	return l.ids[0]
+}
+
+func (l mockLinkage) contains(id string) bool {
+	return slices.ContainsFunc(l.ids, func(item mockIdentifier) bool { return item.ID == id })
+}
+
+// mockResource is a stored JSON:API resource.
+type mockResource struct {
+	Type          string                 `json:"type"`
+	ID            string                 `json:"id"`
+	Attributes    map[string]any         `json:"attributes,omitempty"`
+	Relationships map[string]mockLinkage `json:"relationships,omitempty"`
+}
+
+func (r *mockResource) document(base string) map[string]any {
+	doc := map[string]any{
+		"type":  r.Type,
+		"id":    r.ID,
+		"links": map[string]string{"self": fmt.Sprintf("%s/v1/%s/%s", base, r.Type, r.ID)},
+	}
+	attrs := r.Attributes
+	if attrs == nil {
+		attrs = map[string]any{}
+	}
+	doc["attributes"] = attrs
+	if len(r.Relationships) > 0 {
+		rels := make(map[string]any, len(r.Relationships))
+		for name, linkage := range r.Relationships {
+			rels[name] = map[string]any{
+				"data":  linkage.data(),
+				"links": map[string]string{"related": fmt.Sprintf("%s/v1/%s/%s/%s", base, r.Type, r.ID, name)},
+			}
+		}
+		doc["relationships"] = rels
+	}
+	return doc
+}
+
+// mockCreateDefaults are attributes the API fills in when a resource is created.
+var mockCreateDefaults = map[string]map[string]any{
+	"appStoreVersions": {
+		"appStoreState":   "PREPARE_FOR_SUBMISSION",
+		"appVersionState": "PREPARE_FOR_SUBMISSION",
+	},
+	"reviewSubmissions": {
+		"state": "READY_FOR_REVIEW",
+	},
+	"betaGroups": {
+		"isInternalGroup": false,
+	},
+}
+
+// mockStore is an in-memory App Store Connect API.
+type mockStore struct {
+	mu        sync.Mutex
+	resources map[string]map[string]*mockResource
+	order     map[string][]string
+	nextID    int
+	uploads   map[string]int64
+	now       func() time.Time
+}
+
+func newMockStore() *mockStore {
+	return &mockStore{
+		resources: map[string]map[string]*mockResource{},
+		order:     map[string][]string{},
+		uploads:   map[string]int64{},
+		now:       time.Now,
+	}
+}
+
+// loadSeed adds resources from a seed document keyed by resource type.
+func (s *mockStore) loadSeed(data []byte) error {
+	var seed map[string][]mockResource
+	if err := json.Unmarshal(data, &seed); err != nil {
+		return fmt.Errorf("invalid seed: %w", err)
+	}
+	types := make([]string, 0, len(seed))
+	for resourceType := range seed {
+		types = append(types, resourceType)
+	}
+	slices.Sort(types)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, resourceType := range types {
+		for _, resource := range seed[resourceType] {
+			if strings.TrimSpace(resource.ID) == "" {
+				return fmt.Errorf("invalid seed: %s resource without id", resourceType)
+			}
+			resource.Type = resourceType
+			s.put(&resource)
+		}
+	}
+	return nil
+}
+
+func (s *mockStore) loadSeedFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return s.loadSeed(data)
+}
+
+func (s *mockStore) put(resource *mockResource) {
+	byID := s.resources[resource.Type]
+	if byID == nil {
+		byID = map[string]*mockResource{}
+		s.resources[resource.Type] = byID
+	}
+	if _, exists := byID[resource.ID]; !exists {
+		s.order[resource.Type] = append(s.order[resource.Type], resource.ID)
+	}
+	byID[resource.ID] = resource
+}
+
+func (s *mockStore) get(resourceType, id string) *mockResource {
+	return s.resources[resourceType][id]
+}
+
+func (s *mockStore) list(resourceType string) []*mockResource {
+	items := make([]*mockResource, 0, len(s.order[resourceType]))
+	for _, id := range s.order[resourceType] {
+		if resource, ok := s.resources[resourceType][id]; ok {
+			items = append(items, resource)
+		}
+	}
+	return items
+}
+
+func (s *mockStore) remove(resourceType, id string) {
+	delete(s.resources[resourceType], id)
+	s.order[resourceType] = slices.DeleteFunc(s.order[resourceType], func(item string) bool { return item == id })
+}
+
+func (s *mockStore) newID() string {
+	s.nextID++
+	return fmt.Sprintf("mock-%d", s.nextID)
+}
+
+func (s *mockStore) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	base := mockRequestBase(req)
+	segments := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	// Upload URLs are presigned in the real API and carry no bearer token.
+	if len(segments) == 3 && segments[0] == "upload" {
+		s.handleUpload(w, req, segments[1], segments[2])
+		return
+	}
+	if !strings.HasPrefix(req.Header.Get("Authorization"), "Bearer ") {
+		writeMockError(w, http.StatusUnauthorized, "NOT_AUTHORIZED", "Authentication credentials are missing or invalid.", "Provide a properly configured and signed bearer token.")
+		return
+	}
+	if len(segments) < 2 || !strings.HasPrefix(segments[0], "v") {
+		writeMockError(w, http.StatusNotFound, "NOT_FOUND", "The specified resource does not exist", fmt.Sprintf("The path %s could not be found.", req.URL.Path))
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	resourceType := segments[1]
+	switch len(segments) {
+	case 2:
+		switch req.Method {
+		case http.MethodGet:
+			s.writeList(w, req, base, s.filter(s.list(resourceType), req.URL.Query()))
+		case http.MethodPost:
+			s.handleCreate(w, req, base, resourceType)
+		default:
+			writeMockMethodNotAllowed(w, req)
+		}
+	case 3:
+		resource := s.get(resourceType, segments[2])
+		if resource == nil {
+			writeMockNotFound(w, resourceType, segments[2])
+			return
+		}
+		switch req.Method {
+		case http.MethodGet:
+			writeMockJSON(w, http.StatusOK, map[string]any{"data": resource.document(base)})
+		case http.MethodPatch:
+			s.handleUpdate(w, req, base, resource)
+		case http.MethodDelete:
+			s.remove(resourceType, resource.ID)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			writeMockMethodNotAllowed(w, req)
+		}
+	case 4:
+		parent := s.get(resourceType, segments[2])
+		if parent == nil {
+			writeMockNotFound(w, resourceType, segments[2])
+			return
+		}
+		if req.Method != http.MethodGet {
+			writeMockMethodNotAllowed(w, req)
+			return
+		}
+		s.handleRelated(w, req, base, parent, segments[3])
+	case 5:
+		parent := s.get(resourceType, segments[2])
+		if parent == nil {
+			writeMockNotFound(w, resourceType, segments[2])
+			return
+		}
+		if segments[3] != "relationships" {
+			writeMockError(w, http.StatusNotFound, "NOT_FOUND", "The specified resource does not exist", fmt.Sprintf("The path %s could not be found.", req.URL.Path))
+			return
+		}
+		s.handleLinkage(w, req, parent, segments[4])
+	default:
+		writeMockError(w, http.StatusNotFound, "NOT_FOUND", "The specified resource does not exist", fmt.Sprintf("The path %s could not be found.", req.URL.Path))
+	}
+}
+
+func (s *mockStore) handleCreate(w http.ResponseWriter, req *http.Request, base, resourceType string) {
+	var body struct {
+		Data mockResource `json:"data"`
+	}
+	if err := decodeMockBody(req, &body); err != nil {
+		writeMockError(w, http.StatusBadRequest, "PARAMETER_ERROR.INVALID", "A parameter has an invalid value", err.Error())
+		return
+	}
+	resource := body.Data
+	if resource.Type != resourceType {
+		writeMockError(w, http.StatusConflict, "ENTITY_ERROR.INCLUDED.INVALID_TYPE", "The resource type is invalid", fmt.Sprintf("Expected type %q but got %q.", resourceType, resource.Type))
+		return
+	}
+	resource.ID = s.newID()
+	attrs := map[string]any{}
+	for key, value := range mockCreateDefaults[resourceType] {
+		attrs[key] = value
+	}
+	for key, value := range resource.Attributes {
+		attrs[key] = value
+	}
+	if _, ok := attrs["createdDate"]; !ok {
+		attrs["createdDate"] = s.now().UTC().Format(time.RFC3339)
+	}
+	if size, ok := attrs["fileSize"].(float64); ok && size > 0 {
+		// Reserve an upload the same way the real API does for assets.
+		attrs["uploadOperations"] = []map[string]any{{
+			"method": http.MethodPut,
+			"url":    fmt.Sprintf("/upload/%s/%s", resourceType, resource.ID),
+			"offset": 0,
+			"length": int64(size),
+			"requestHeaders": []map[string]string{
+				{"name": "Content-Type", "value": "application/octet-stream"},
+			},
+		}}
+		attrs["assetDeliveryState"] = map[string]any{"state": "AWAITING_UPLOAD"}
+	}
+	resource.Attributes = attrs
+	s.put(&resource)
+	writeMockJSON(w, http.StatusCreated, map[string]any{"data": resource.document(base)})
+}
+
+func (s *mockStore) handleUpdate(w http.ResponseWriter, req *http.Request, base string, resource *mockResource) {
+	var body struct {
+		Data mockResource `json:"data"`
+	}
+	if err := decodeMockBody(req, &body); err != nil {
+		writeMockError(w, http.StatusBadRequest, "PARAMETER_ERROR.INVALID", "A parameter has an invalid value", err.Error())
+		return
+	}
+	if body.Data.Type != resource.Type || body.Data.ID != resource.ID {
+		writeMockError(w, http.StatusConflict, "ENTITY_ERROR.ID.INVALID", "The provided entity id is invalid", fmt.Sprintf("Expected %s %q in the request body.", resource.Type, resource.ID))
+		return
+	}
+	if resource.Attributes == nil {
+		resource.Attributes = map[string]any{}
+	}
+	for key, value := range body.Data.Attributes {
+		if value == nil {
+			delete(resource.Attributes, key)
+			continue
+		}
+		resource.Attributes[key] = value
+	}
+	if len(body.Data.Relationships) > 0 && resource.Relationships == nil {
+		resource.Relationships = map[string]mockLinkage{}
+	}
+	for name, linkage := range body.Data.Relationships {
+		resource.Relationships[name] = linkage
+	}
+	applyMockTransitions(resource, body.Data.Attributes)
+	writeMockJSON(w, http.StatusOK, map[string]any{"data": resource.document(base)})
+}
+
+// applyMockTransitions mimics the state changes the API makes after an update.
+func applyMockTransitions(resource *mockResource, changed map[string]any) {
+	if uploaded, _ := changed["uploaded"].(bool); uploaded {
+		if _, ok := resource.Attributes["assetDeliveryState"]; ok {
+			resource.Attributes["assetDeliveryState"] = map[string]any{"state": "COMPLETE"}
+		}
+	}
+	if resource.Type == "reviewSubmissions" {
+		if submitted, _ := changed["submitted"].(bool); submitted {
+			resource.Attributes["state"] = "WAITING_FOR_REVIEW"
+		}
+		if canceled, _ := changed["canceled"].(bool); canceled {
+			resource.Attributes["state"] = "CANCELING"
+		}
+	}
+}
+
+func (s *mockStore) handleRelated(w http.ResponseWriter, req *http.Request, base string, parent *mockResource, name string) {
+	if linkage, ok := parent.Relationships[name]; ok {
+		if !linkage.many {
+			var data any
+			if len(linkage.ids) > 0 {
+				if related := s.get(linkage.ids[0].Type, linkage.ids[0].ID); related != nil {
+					data = related.document(base)
+				}
+			}
+			writeMockJSON(w, http.StatusOK, map[string]any{"data": data})
+			return
+		}
+		items := make([]*mockResource, 0, len(linkage.ids))
+		for _, id := range linkage.ids {
+			if related := s.get(id.Type, id.ID); related != nil {
+				items = append(items, related)
+			}
+		}
+		s.writeList(w, req, base, s.filter(items, req.URL.Query()))
+		return
+	}
+
+	// Children point back at their parent through a singular relationship,
+	// e.g. builds.relationships.app for /v1/apps/{id}/builds.
+	parentKey := strings.TrimSuffix(parent.Type, "s")
+	var items []*mockResource
+	for _, candidate := range s.list(name) {
+		if linkage, ok := candidate.Relationships[parentKey]; ok && linkage.contains(parent.ID) {
+			items = append(items, candidate)
+		}
+	}
+	s.writeList(w, req, base, s.filter(items, req.URL.Query()))
+}
+
+func (s *mockStore) handleLinkage(w http.ResponseWriter, req *http.Request, parent *mockResource, name string) {
+	if req.Method == http.MethodGet {
+		writeMockJSON(w, http.StatusOK, map[string]any{"data": parent.Relationships[name].data()})
+		return
+	}
+
+	var body struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err := decodeMockBody(req, &body); err != nil {
+		writeMockError(w, http.StatusBadRequest, "PARAMETER_ERROR.INVALID", "A parameter has an invalid value", err.Error())
+		return
+	}
+	var change mockLinkage
+	if err := change.setData(body.Data); err != nil {
+		writeMockError(w, http.StatusBadRequest, "PARAMETER_ERROR.INVALID", "A parameter has an invalid value", err.Error())
+		return
+	}
+	if parent.Relationships == nil {
+		parent.Relationships = map[string]mockLinkage{}
+	}
+	current := parent.Relationships[name]
+
+	switch req.Method {
+	case http.MethodPatch:
+		parent.Relationships[name] = change
+	case http.MethodPost:
+		current.many = true
+		for _, id := range change.ids {
+			if !current.contains(id.ID) {
+				current.ids = append(current.ids, id)
+			}
+		}
+		parent.Relationships[name] = current
+	case http.MethodDelete:
+		current.ids = slices.DeleteFunc(current.ids, func(item mockIdentifier) bool { return change.contains(item.ID) })
+		parent.Relationships[name] = current
+	default:
+		writeMockMethodNotAllowed(w, req)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *mockStore) handleUpload(w http.ResponseWriter, req *http.Request, resourceType, id string) {
+	if req.Method != http.MethodPut && req.Method != http.MethodPost {
+		writeMockMethodNotAllowed(w, req)
+		return
+	}
+	written, err := io.Copy(io.Discard, io.LimitReader(req.Body, mockMaxBodyBytes))
+	if err != nil {
+		writeMockError(w, http.StatusBadRequest, "PARAMETER_ERROR.INVALID", "Upload failed", err.Error())
+		return
+	}
+	s.mu.Lock()
+	s.uploads[resourceType+"/"+id] += written
+	s.mu.Unlock()
+	w.WriteHeader(http.StatusOK)
+}
+
+// filter applies filter[...] query parameters against ids, attributes and relationships.
+func (s *mockStore) filter(items []*mockResource, query url.Values) []*mockResource {
+	for key, values := range query {
+		if !strings.HasPrefix(key, "filter[") || !strings.HasSuffix(key, "]") {
+			continue
+		}
+		field := strings.TrimSuffix(strings.TrimPrefix(key, "filter["), "]")
+		allowed := map[string]bool{}
+		for _, value := range values {
+			for _, part := range strings.Split(value, ",") {
+				allowed[strings.TrimSpace(part)] = true
+			}
+		}
+		items = slices.DeleteFunc(slices.Clone(items), func(resource *mockResource) bool {
+			return !mockResourceMatches(resource, field, allowed)
+		})
+	}
+	return items
+}
+
+func mockResourceMatches(resource *mockResource, field string, allowed map[string]bool) bool {
+	if field == "id" {
+		return allowed[resource.ID]
+	}
+	if value, ok := resource.Attributes[field]; ok {
+		return allowed[fmt.Sprint(value)]
+	}
+	if linkage, ok := resource.Relationships[field]; ok {
+		for _, id := range linkage.ids {
+			if allowed[id.ID] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (s *mockStore) writeList(w http.ResponseWriter, req *http.Request, base string, items []*mockResource) {
+	query := req.URL.Query()
+	limit := mockDefaultLimit
+	if value := query.Get("limit"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 || parsed > mockMaxLimit {
+			writeMockError(w, http.StatusBadRequest, "PARAMETER_ERROR.INVALID", "A parameter has an invalid value", fmt.Sprintf("'%s' is not a valid value for limit; it must be between 1 and %d.", value, mockMaxLimit))
+			return
+		}
+		limit = parsed
+	}
+	offset, _ := strconv.Atoi(query.Get("cursor"))
+	offset = min(max(offset, 0), len(items))
+	end := min(offset+limit, len(items))
+
+	data := make([]map[string]any, 0, end-offset)
+	for _, resource := range items[offset:end] {
+		data = append(data, resource.document(base))
+	}
+	links := map[string]string{"self": base + req.URL.RequestURI()}
+	if end < len(items) {
+		query.Set("cursor", strconv.Itoa(end))
+		links["next"] = base + req.URL.Path + "?" + query.Encode()
+	}
+	writeMockJSON(w, http.StatusOK, map[string]any{
+		"data":  data,
+		"links": links,
+		"meta":  map[string]any{"paging": map[string]int{"total": len(items), "limit": limit}},
+	})
+}
+
+func decodeMockBody(req *http.Request, target any) error {
+	defer req.Body.Close()
+	decoder := json.NewDecoder(io.LimitReader(req.Body, mockMaxBodyBytes))
+	if err := decoder.Decode(target); err != nil {
+		return fmt.Errorf("invalid request body: %w", err)
+	}
+	return nil
+}
+
+func mockRequestBase(req *http.Request) string {
+	scheme := "http"
+	if req.TLS != nil {
+		scheme = "https"
+	}
+	return scheme + "://" + req.Host
+}
+
+func writeMockJSON(w http.ResponseWriter, status int, payload any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(payload)
+}
+
+func writeMockError(w http.ResponseWriter, status int, code, title, detail string) {
+	writeMockJSON(w, status, map[string]any{
+		"errors": []map[string]string{{
+			"status": strconv.Itoa(status),
+			"code":   code,
+			"title":  title,
+			"detail": detail,
+		}},
+	})
+}
+
+func writeMockNotFound(w http.ResponseWriter, resourceType, id string) {
+	writeMockError(w, http.StatusNotFound, "NOT_FOUND", "The specified resource does not exist", fmt.Sprintf("There is no resource of type '%s' with id '%s'", resourceType, id))
+}
+
+func writeMockMethodNotAllowed(w http.ResponseWriter, req *http.Request) {
+	writeMockError(w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "The request method is not allowed", fmt.Sprintf("%s is not allowed on %s.", req.Method, req.URL.Path))
+}
@@ -0,0 +1,253 @@
+package mock
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func newMockTestClient(t *testing.T, baseURL string) *asc.Client {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey() error: %v", err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("marshal key error: %v", err)
+	}
+	keyPath := filepath.Join(t.TempDir(), "AuthKey_TEST.p8")
+	if err := os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600); err != nil {
+		t.Fatalf("write key file error: %v", err)
+	}
+
+	t.Setenv("ASC_BASE_URL", baseURL)
+	t.Setenv("ASC_MAX_RETRIES", "0")
+	client, err := asc.NewClient("KEY123", "ISS456", keyPath)
+	if err != nil {
+		t.Fatalf("NewClient() error: %v", err)
+	}
+	return client
+}
+
+func mockRequest(t *testing.T, method, url, body string) (int, map[string]any) {
+	t.Helper()
+
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("new request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer test")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("%s %s: %v", method, url, err)
+	}
+	defer resp.Body.Close()
+	data, _ := io.ReadAll(resp.Body)
+	var payload map[string]any
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &payload); err != nil {
+			t.Fatalf("decode %s %s: %v (%s)", method, url, err, data)
+		}
+	}
+	return resp.StatusCode, payload
+}
+
+func TestMockStoreServesClientWithPaginationAndUploads(t *testing.T) {
+	store := newMockStore()
+	if err := store.loadSeed([]byte(mockDefaultSeed)); err != nil {
+		t.Fatalf("loadSeed: %v", err)
+	}
+	if err := store.loadSeed([]byte(`{"apps":[{"id":"2","attributes":{"name":"Second","bundleId":"com.example.second"}}]}`)); err != nil {
+		t.Fatalf("loadSeed: %v", err)
+	}
+	server := httptest.NewServer(store)
+	defer server.Close()
+	client := newMockTestClient(t, server.URL)
+	ctx := context.Background()
+
+	first, err := client.GetApps(ctx, asc.WithAppsLimit(1))
+	if err != nil {
+		t.Fatalf("GetApps error: %v", err)
+	}
+	if len(first.Data) != 1 || first.Links.Next == "" {
+		t.Fatalf("expected one app and a next link, got %+v", first)
+	}
+	second, err := client.GetApps(ctx, asc.WithAppsNextURL(first.Links.Next))
+	if err != nil {
+		t.Fatalf("GetApps next page error: %v", err)
+	}
+	if len(second.Data) != 1 || second.Data[0].Attributes.BundleID != "com.example.second" || second.Links.Next != "" {
+		t.Fatalf("unexpected second page %+v", second)
+	}
+
+	filtered, err := client.GetApps(ctx, asc.WithAppsBundleIDs([]string{"com.example.mock"}))
+	if err != nil {
+		t.Fatalf("GetApps filter error: %v", err)
+	}
+	if len(filtered.Data) != 1 || filtered.Data[0].ID != "1000000001" {
+		t.Fatalf("unexpected filtered apps %+v", filtered.Data)
+	}
+
+	status, created := mockRequest(t, http.MethodPost, server.URL+"/v1/appScreenshots",
+		`{"data":{"type":"appScreenshots","attributes":{"fileName":"shot.png","fileSize":4},"relationships":{"appScreenshotSet":{"data":{"type":"appScreenshotSets","id":"set-1"}}}}}`)
+	if status != http.StatusCreated {
+		t.Fatalf("create screenshot status %d: %v", status, created)
+	}
+	var screenshot asc.AppScreenshotResponse
+	raw, _ := json.Marshal(created)
+	if err := json.Unmarshal(raw, &screenshot); err != nil {
+		t.Fatalf("decode screenshot: %v", err)
+	}
+	operations := screenshot.Data.Attributes.UploadOperations
+	if len(operations) != 1 || !strings.HasPrefix(operations[0].URL, "/upload/appScreenshots/") {
+		t.Fatalf("unexpected upload operations %+v", operations)
+	}
+
+	filePath := filepath.Join(t.TempDir(), "shot.png")
+	if err := os.WriteFile(filePath, []byte("data"), 0o644); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+	if err := asc.ExecuteUploadOperations(ctx, filePath, operations); err != nil {
+		t.Fatalf("ExecuteUploadOperations error: %v", err)
+	}
+	if got := store.uploads["appScreenshots/"+screenshot.Data.ID]; got != 4 {
+		t.Fatalf("expected 4 uploaded bytes, got %d", got)
+	}
+
+	status, updated := mockRequest(t, http.MethodPatch, server.URL+"/v1/appScreenshots/"+screenshot.Data.ID,
+		`{"data":{"type":"appScreenshots","id":"`+screenshot.Data.ID+`","attributes":{"uploaded":true}}}`)
+	if status != http.StatusOK || !strings.Contains(mustJSON(t, updated), `"state":"COMPLETE"`) {
+		t.Fatalf("expected committed upload, got %d %v", status, updated)
+	}
+}
+
+func TestMockStoreRelatedAndRelationshipEndpoints(t *testing.T) {
+	store := newMockStore()
+	if err := store.loadSeed([]byte(mockDefaultSeed)); err != nil {
+		t.Fatalf("loadSeed: %v", err)
+	}
+	server := httptest.NewServer(store)
+	defer server.Close()
+
+	status, builds := mockRequest(t, http.MethodGet, server.URL+"/v1/apps/1000000001/builds", "")
+	if status != http.StatusOK || len(builds["data"].([]any)) != 1 {
+		t.Fatalf("expected app builds, got %d %v", status, builds)
+	}
+
+	status, _ = mockRequest(t, http.MethodPost, server.URL+"/v1/betaGroups/mock-beta-group-1/relationships/builds",
+		`{"data":[{"type":"builds","id":"mock-build-1"}]}`)
+	if status != http.StatusNoContent {
+		t.Fatalf("add build to group status %d", status)
+	}
+	status, groupBuilds := mockRequest(t, http.MethodGet, server.URL+"/v1/betaGroups/mock-beta-group-1/builds", "")
+	if status != http.StatusOK || len(groupBuilds["data"].([]any)) != 1 {
+		t.Fatalf("expected linked build, got %d %v", status, groupBuilds)
+	}
+
+	status, submission := mockRequest(t, http.MethodPost, server.URL+"/v1/reviewSubmissions",
+		`{"data":{"type":"reviewSubmissions","attributes":{"platform":"IOS"},"relationships":{"app":{"data":{"type":"apps","id":"1000000001"}}}}}`)
+	if status != http.StatusCreated || !strings.Contains(mustJSON(t, submission), `"state":"READY_FOR_REVIEW"`) {
+		t.Fatalf("unexpected submission %d %v", status, submission)
+	}
+	id := submission["data"].(map[string]any)["id"].(string)
+	_, submitted := mockRequest(t, http.MethodPatch, server.URL+"/v1/reviewSubmissions/"+id,
+		`{"data":{"type":"reviewSubmissions","id":"`+id+`","attributes":{"submitted":true}}}`)
+	if !strings.Contains(mustJSON(t, submitted), `"state":"WAITING_FOR_REVIEW"`) {
+		t.Fatalf("expected submitted state, got %v", submitted)
+	}
+	status, list := mockRequest(t, http.MethodGet, server.URL+"/v1/reviewSubmissions?filter[app]=1000000001&filter[state]=WAITING_FOR_REVIEW", "")
+	if status != http.StatusOK || len(list["data"].([]any)) != 1 {
+		t.Fatalf("expected filtered submission, got %d %v", status, list)
+	}
+
+	if status, _ := mockRequest(t, http.MethodDelete, server.URL+"/v1/reviewSubmissions/"+id, ""); status != http.StatusNoContent {
+		t.Fatalf("delete status %d", status)
+	}
+	if status, payload := mockRequest(t, http.MethodGet, server.URL+"/v1/reviewSubmissions/"+id, ""); status != http.StatusNotFound || payload["errors"] == nil {
+		t.Fatalf("expected JSON:API 404, got %d %v", status, payload)
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL+"/v1/apps", nil)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("unauthenticated request: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected 401 without a bearer token, got %d", resp.StatusCode)
+	}
+}
+
+func TestMockRecordAndReplay(t *testing.T) {
+	var upstream *httptest.Server
+	upstream = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Header.Get("Authorization") != "Bearer test" {
+			t.Errorf("expected the bearer token to be forwarded")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, `{"data":[{"type":"users","id":"u1","attributes":{"firstName":"Jane","email":"jane@example.com","roles":["ADMIN"]}}],"links":{"next":"`+upstream.URL+`/v1/users?cursor=1"}}`)
+	}))
+	defer upstream.Close()
+
+	dir := t.TempDir()
+	recorder := httptest.NewServer(newMockRecorder(upstream.URL, dir, upstream.Client()))
+	status, recorded := mockRequest(t, http.MethodGet, recorder.URL+"/v1/users?limit=1", "")
+	recorder.Close()
+	if status != http.StatusOK || recorded["links"].(map[string]any)["next"] != recorder.URL+"/v1/users?cursor=1" {
+		t.Fatalf("expected links rewritten to the recorder, got %d %v", status, recorded)
+	}
+
+	paths, _ := filepath.Glob(filepath.Join(dir, "*.json"))
+	if len(paths) != 1 || filepath.Base(paths[0]) != "0001-get-v1_users.json" {
+		t.Fatalf("unexpected fixtures %v", paths)
+	}
+	data, err := os.ReadFile(paths[0])
+	if err != nil {
+		t.Fatalf("read fixture: %v", err)
+	}
+	fixture := string(data)
+	if strings.Contains(fixture, "jane@example.com") || strings.Contains(fixture, "Jane") || strings.Contains(fixture, upstream.URL) {
+		t.Fatalf("fixture was not sanitized:\n%s", fixture)
+	}
+	if !strings.Contains(fixture, mockBaseURLPlaceholder+"/v1/users?cursor=1") {
+		t.Fatalf("expected placeholder base URL in fixture:\n%s", fixture)
+	}
+
+	replayer, err := loadMockReplayer(dir)
+	if err != nil {
+		t.Fatalf("loadMockReplayer: %v", err)
+	}
+	replay := httptest.NewServer(replayer)
+	defer replay.Close()
+	status, replayed := mockRequest(t, http.MethodGet, replay.URL+"/v1/users?limit=1", "")
+	if status != http.StatusOK || replayed["links"].(map[string]any)["next"] != replay.URL+"/v1/users?cursor=1" {
+		t.Fatalf("unexpected replay %d %v", status, replayed)
+	}
+	if status, _ := mockRequest(t, http.MethodGet, replay.URL+"/v1/users?limit=2", ""); status != http.StatusNotFound {
+		t.Fatalf("expected unmatched request to 404, got %d", status)
+	}
+}
+
+func mustJSON(t *testing.T, value any) string {
+	t.Helper()
+	data, err := json.Marshal(value)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	return string(data)
+}
@@ -0,0 +1,258 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	notifyAllowLocalEnv          = "ASC_NOTIFY_ALLOW_LOCALHOST"
+	notifyMaxResponseBodyBytes   = slackWebhookMaxResponseBodyBytes
+	notifyDefaultSuccessTitle    = "Success"
+	notifyDefaultFailureTitle    = "Failure"
+	notifySuccessColorDiscordInt = 0x2EB67D
+	notifyFailureColorDiscordInt = 0xE01E5A
+)
+
+// notification is the channel-agnostic message built from the shared flags.
+type notification struct {
+	Message string
+	Title   string
+	Payload map[string]any
+	Success bool
+}
+
+// notifier delivers a notification to one channel.
+type notifier interface {
+	Name() string
+	Send(ctx context.Context, n notification) error
+}
+
+// notificationFlagValues holds the inputs every backend accepts.
+type notificationFlagValues struct {
+	message     *string
+	title       *string
+	payloadJSON *string
+	payloadFile *string
+	success     *bool
+}
+
+func bindNotificationFlags(fs *flag.FlagSet, channel string) notificationFlagValues {
+	return notificationFlagValues{
+		message:     fs.String("message", "", "Message to send to "+channel),
+		title:       fs.String("title", "", "Optional title shown above the message"),
+		payloadJSON: fs.String("payload-json", "", "JSON object of release fields to include with the message"),
+		payloadFile: fs.String("payload-file", "", "Path to JSON object file for release payload fields"),
+		success:     fs.Bool("success", true, "Mark the notification as success (true) or failure (false)"),
+	}
+}
+
+// build validates the shared inputs and returns the notification to send.
+func (v notificationFlagValues) build() (notification, error) {
+	msg := strings.TrimSpace(*v.message)
+	if msg == "" {
+		return notification{}, shared.UsageError("--message is required")
+	}
+	payload, err := parseNotifyPayload(*v.payloadJSON, *v.payloadFile)
+	if err != nil {
+		return notification{}, shared.UsageError(err.Error())
+	}
+	return notification{
+		Message: msg,
+		Title:   strings.TrimSpace(*v.title),
+		Payload: payload,
+		Success: *v.success,
+	}, nil
+}
+
+// sendNotification delivers through a notifier and reports success on stderr.
+func sendNotification(ctx context.Context, n notifier, msg notification) error {
+	requestCtx, cancel := shared.ContextWithTimeout(ctx)
+	defer cancel()
+
+	if err := n.Send(requestCtx, msg); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "Message sent to %s successfully\n", n.Name())
+	return nil
+}
+
+// postNotificationJSON posts a JSON body and treats any status outside
+// accepted as an error. A nil accepted allows any 2xx response.
+func postNotificationJSON(ctx context.Context, command, rawURL string, body []byte, headers map[string]string, accepted []int) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, rawURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("%s: failed to create request: %w", command, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := notifyHTTPClient().Do(req)
+	if err != nil {
+		return fmt.Errorf("%s: failed to send: %w", command, err)
+	}
+	defer resp.Body.Close()
+
+	if !notificationStatusAccepted(resp.StatusCode, accepted) {
+		respBody, readErr := io.ReadAll(io.LimitReader(resp.Body, notifyMaxResponseBodyBytes))
+		if readErr != nil {
+			return fmt.Errorf("%s: failed to read response: %w", command, readErr)
+		}
+		message := strings.TrimSpace(string(respBody))
+		if message == "" {
+			return fmt.Errorf("%s: unexpected response %d", command, resp.StatusCode)
+		}
+		return fmt.Errorf("%s: unexpected response %d: %s", command, resp.StatusCode, message)
+	}
+	return nil
+}
+
+func notificationStatusAccepted(status int, accepted []int) bool {
+	if accepted == nil {
+		return status >= 200 && status <= 299
+	}
+	return slices.Contains(accepted, status)
+}
+
+// resolveNotifyValue returns the flag value, falling back to an env var.
+func resolveNotifyValue(flagValue, envVar string) string {
+	if v := strings.TrimSpace(flagValue); v != "" {
+		return v
+	}
+	return strings.TrimSpace(os.Getenv(envVar))
+}
+
+// validateHookURL checks an incoming webhook URL against the service's hosts.
+// Hosts starting with "." match any subdomain. Localhost is allowed when
+// ASC_NOTIFY_ALLOW_LOCALHOST is set, so backends can be tested locally.
+func validateHookURL(service, rawURL string, hosts []string, pathPrefix string) error {
+	parsed, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil || parsed.Scheme == "" || parsed.Host == "" || parsed.User != nil {
+		return fmt.Errorf("--webhook must be a valid %s webhook URL", service)
+	}
+	host := strings.ToLower(parsed.Hostname())
+	if allowLocalNotifyWebhook() && isLocalhost(host) {
+		if parsed.Scheme != "http" && parsed.Scheme != "https" {
+			return fmt.Errorf("--webhook must use http or https")
+		}
+		return nil
+	}
+	if parsed.Scheme != "https" {
+		return fmt.Errorf("--webhook must use https")
+	}
+	if len(hosts) == 0 {
+		return nil
+	}
+	if net.ParseIP(host) == nil {
+		for _, allowed := range hosts {
+			if host == allowed || (strings.HasPrefix(allowed, ".") && strings.HasSuffix(host, allowed)) {
+				if pathPrefix != "" && !strings.HasPrefix(parsed.Path, pathPrefix) {
+					return fmt.Errorf("--webhook must start with %s", pathPrefix)
+				}
+				return nil
+			}
+		}
+	}
+	labels := make([]string, 0, len(hosts))
+	for _, allowed := range hosts {
+		labels = append(labels, strings.TrimPrefix(allowed, "."))
+	}
+	return fmt.Errorf("--webhook must target %s", strings.Join(labels, " or "))
+}
+
+func allowLocalNotifyWebhook() bool {
+	value := strings.TrimSpace(os.Getenv(notifyAllowLocalEnv))
+	return value == "1" || strings.EqualFold(value, "true")
+}
+
+// sortedPayloadKeys returns payload keys in stable order for rendering.
+func sortedPayloadKeys(payload map[string]any) []string {
+	keys := make([]string, 0, len(payload))
+	for key := range payload {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func notificationTitle(n notification) string {
+	if n.Title != "" {
+		return n.Title
+	}
+	if n.Success {
+		return notifyDefaultSuccessTitle
+	}
+	return notifyDefaultFailureTitle
+}
+
+func parseNotifyPayload(payloadJSON string, payloadFile string) (map[string]any, error) {
+	payloadJSON = strings.TrimSpace(payloadJSON)
+	payloadFile = strings.TrimSpace(payloadFile)
+
+	if payloadJSON != "" && payloadFile != "" {
+		return nil, fmt.Errorf("only one of --payload-json or --payload-file may be set")
+	}
+	if payloadJSON == "" && payloadFile == "" {
+		return nil, nil
+	}
+
+	source := "--payload-json"
+	if payloadFile != "" {
+		data, err := os.ReadFile(payloadFile)
+		if err != nil {
+			return nil, fmt.Errorf("--payload-file must be readable: %w", err)
+		}
+		payloadJSON = strings.TrimSpace(string(data))
+		source = "--payload-file"
+	}
+	if payloadJSON == "" {
+		return nil, fmt.Errorf("%s must contain a JSON object", source)
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(payloadJSON))
+	decoder.UseNumber()
+
+	var payload map[string]any
+	if err := decoder.Decode(&payload); err != nil {
+		return nil, fmt.Errorf("%s must contain a JSON object: %w", source, err)
+	}
+	if err := decoder.Decode(&struct{}{}); err != io.EOF {
+		return nil, fmt.Errorf("%s must contain a single JSON object", source)
+	}
+	if payload == nil {
+		return nil, fmt.Errorf("%s must contain a JSON object", source)
+	}
+	return payload, nil
+}
+
+func formatPayloadValue(value any) string {
+	switch typed := value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return typed
+	case json.Number:
+		return typed.String()
+	default:
+		encoded, err := json.Marshal(typed)
+		if err != nil {
+			return fmt.Sprint(typed)
+		}
+		return string(encoded)
+	}
+}
@@ -1,12 +1,10 @@
 package notify
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"flag"
 	"fmt"
-	"io"
 	"net"
 	"net/http"
 	"net/url"
@@ -32,7 +30,10 @@ const (
 
 var slackThreadTSPattern = regexp.MustCompile(`^\d+\.\d+$`)
 
-var slackHTTPClient = func() *http.Client {
+// slackAcceptedStatuses: Slack incoming webhooks answer 200 "ok" on success.
+var slackAcceptedStatuses = []int{http.StatusOK}
+
+var notifyHTTPClient = func() *http.Client {
 	return &http.Client{Timeout: asc.ResolveTimeout()}
 }
 
@@ -70,13 +71,24 @@ func NotifyCommand() *ffcli.Command {
 		ShortHelp:  "Send notifications to external services.",
 		LongHelp: `Send notifications to external services.
 
+Every channel accepts --message and --payload-json/--payload-file, so
+workflows can switch channels without changing their inputs.
+
 Examples:
   asc notify slack --webhook $WEBHOOK --message "Build uploaded"
-  ASC_SLACK_WEBHOOK=$WEBHOOK asc notify slack --message "Done"`,
+  ASC_SLACK_WEBHOOK=$WEBHOOK asc notify slack --message "Done"
+  asc notify teams --webhook $TEAMS_WEBHOOK --message "Release 1.2.3 submitted"
+  asc notify discord --webhook $DISCORD_WEBHOOK --message "Build 42 processed"
+  asc notify webhook --url "https://ci.example.com/hooks/asc" --message "Done"
+  asc notify email --smtp-host smtp.example.com --from ci@example.com --to team@example.com --message "Done"`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			SlackCommand(),
+			TeamsCommand(),
+			DiscordCommand(),
+			WebhookCommand(),
+			EmailCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
@@ -134,7 +146,7 @@ Examples:
 				fmt.Fprintln(os.Stderr, "Error:", err.Error())
 				return flag.ErrHelp
 			}
-			releasePayload, err := parseSlackPayload(*payloadJSON, *payloadFile)
+			releasePayload, err := parseNotifyPayload(*payloadJSON, *payloadFile)
 			if err != nil {
 				fmt.Fprintln(os.Stderr, "Error:", err.Error())
 				return flag.ErrHelp
@@ -148,64 +160,24 @@ Examples:
 				return flag.ErrHelp
 			}
 
-			payload := map[string]any{}
-			payload["text"] = msg
-
-			if ch := strings.TrimSpace(*channel); ch != "" {
-				payload["channel"] = ch
-			}
-			if ts := strings.TrimSpace(*threadTS); ts != "" {
-				if !slackThreadTSPattern.MatchString(ts) {
-					fmt.Fprintln(os.Stderr, "Error: --thread-ts must be in Slack ts format (e.g. 1733977745.12345)")
-					return flag.ErrHelp
-				}
-				payload["thread_ts"] = ts
-			}
-			if blocks != nil {
-				payload["blocks"] = blocks
-			}
-			if releasePayload != nil {
-				payload["attachments"] = []map[string]any{
-					buildSlackAttachment(msg, strings.TrimSpace(*pretext), releasePayload, *success),
-				}
-			}
-
-			body, err := json.Marshal(payload)
-			if err != nil {
-				return fmt.Errorf("notify slack: failed to marshal payload: %w", err)
-			}
-
-			requestCtx, cancel := shared.ContextWithTimeout(ctx)
-			defer cancel()
-
-			req, err := http.NewRequestWithContext(requestCtx, "POST", webhookURL, bytes.NewReader(body))
-			if err != nil {
-				return fmt.Errorf("notify slack: failed to create request: %w", err)
+			ts := strings.TrimSpace(*threadTS)
+			if ts != "" && !slackThreadTSPattern.MatchString(ts) {
+				fmt.Fprintln(os.Stderr, "Error: --thread-ts must be in Slack ts format (e.g. 1733977745.12345)")
+				return flag.ErrHelp
 			}
-			req.Header.Set("Content-Type", "application/json")
 
-			client := slackHTTPClient()
-			resp, err := client.Do(req)
-			if err != nil {
-				return fmt.Errorf("notify slack: failed to send: %w", err)
+			slack := slackNotifier{
+				webhookURL: webhookURL,
+				channel:    strings.TrimSpace(*channel),
+				threadTS:   ts,
+				blocks:     blocks,
+				pretext:    strings.TrimSpace(*pretext),
 			}
-			defer resp.Body.Close()
-
-			if resp.StatusCode != http.StatusOK {
-				limited := io.LimitReader(resp.Body, slackWebhookMaxResponseBodyBytes)
-				respBody, readErr := io.ReadAll(limited)
-				if readErr != nil {
-					return fmt.Errorf("notify slack: failed to read response: %w", readErr)
-				}
-				message := strings.TrimSpace(string(respBody))
-				if message == "" {
-					return fmt.Errorf("notify slack: unexpected response %d", resp.StatusCode)
-				}
-				return fmt.Errorf("notify slack: unexpected response %d: %s", resp.StatusCode, message)
-			}
-
-			fmt.Fprintln(os.Stderr, "Message sent to Slack successfully")
-			return nil
+			return sendNotification(ctx, slack, notification{
+				Message: msg,
+				Payload: releasePayload,
+				Success: *success,
+			})
 		},
 	}
 }
@@ -220,6 +192,43 @@ func resolveWebhook(flagValue string) string {
 	return ""
 }
 
+// slackNotifier posts to a Slack incoming webhook.
+type slackNotifier struct {
+	webhookURL string
+	channel    string
+	threadTS   string
+	blocks     []json.RawMessage
+	pretext    string
+}
+
+func (s slackNotifier) Name() string {
+	return "Slack"
+}
+
+func (s slackNotifier) Send(ctx context.Context, n notification) error {
+	payload := map[string]any{"text": n.Message}
+	if s.channel != "" {
+		payload["channel"] = s.channel
+	}
+	if s.threadTS != "" {
+		payload["thread_ts"] = s.threadTS
+	}
+	if s.blocks != nil {
+		payload["blocks"] = s.blocks
+	}
+	if n.Payload != nil {
+		payload["attachments"] = []map[string]any{
+			buildSlackAttachment(n.Message, s.pretext, n.Payload, n.Success),
+		}
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("notify slack: failed to marshal payload: %w", err)
+	}
+	return postNotificationJSON(ctx, "notify slack", s.webhookURL, body, nil, slackAcceptedStatuses)
+}
+
 func parseSlackBlocks(blocksJSON string, blocksFile string) ([]json.RawMessage, error) {
 	blocksJSON = strings.TrimSpace(blocksJSON)
 	blocksFile = strings.TrimSpace(blocksFile)
@@ -252,46 +261,6 @@ func parseSlackBlocks(blocksJSON string, blocksFile string) ([]json.RawMessage,
 	return blocks, nil
 }
 
-func parseSlackPayload(payloadJSON string, payloadFile string) (map[string]any, error) {
-	payloadJSON = strings.TrimSpace(payloadJSON)
-	payloadFile = strings.TrimSpace(payloadFile)
-
-	if payloadJSON != "" && payloadFile != "" {
-		return nil, fmt.Errorf("only one of --payload-json or --payload-file may be set")
-	}
-	if payloadJSON == "" && payloadFile == "" {
-		return nil, nil
-	}
-
-	source := "--payload-json"
-	if payloadFile != "" {
-		data, err := os.ReadFile(payloadFile)
-		if err != nil {
-			return nil, fmt.Errorf("--payload-file must be readable: %w", err)
-		}
-		payloadJSON = strings.TrimSpace(string(data))
-		source = "--payload-file"
-	}
-	if payloadJSON == "" {
-		return nil, fmt.Errorf("%s must contain a JSON object", source)
-	}
-
-	decoder := json.NewDecoder(strings.NewReader(payloadJSON))
-	decoder.UseNumber()
-
-	var payload map[string]any
-	if err := decoder.Decode(&payload); err != nil {
-		return nil, fmt.Errorf("%s must contain a JSON object: %w", source, err)
-	}
-	if err := decoder.Decode(&struct{}{}); err != io.EOF {
-		return nil, fmt.Errorf("%s must contain a single JSON object", source)
-	}
-	if payload == nil {
-		return nil, fmt.Errorf("%s must contain a JSON object", source)
-	}
-	return payload, nil
-}
-
 func buildSlackAttachment(message string, pretext string, payload map[string]any, success bool) map[string]any {
 	keys := make([]string, 0, len(payload))
 	for key := range payload {
@@ -325,23 +294,6 @@ func buildSlackAttachment(message string, pretext string, payload map[string]any
 	return attachment
 }
 
-func formatPayloadValue(value any) string {
-	switch typed := value.(type) {
-	case nil:
-		return "null"
-	case string:
-		return typed
-	case json.Number:
-		return typed.String()
-	default:
-		encoded, err := json.Marshal(typed)
-		if err != nil {
-			return fmt.Sprint(typed)
-		}
-		return string(encoded)
-	}
-}
-
 func validateSlackWebhookURL(rawURL string) error {
 	rawURL = strings.TrimSpace(rawURL)
 	parsed, err := url.Parse(rawURL)
@@ -0,0 +1,382 @@
+package notify
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+type capturedRequest struct {
+	headers http.Header
+	body    map[string]any
+}
+
+func newCaptureServer(t *testing.T, status int) (*httptest.Server, *capturedRequest) {
+	t.Helper()
+	captured := &capturedRequest{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		captured.headers = r.Header.Clone()
+		data, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(data, &captured.body); err != nil {
+			t.Errorf("unmarshal body: %v (%s)", err, data)
+		}
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+	t.Setenv(notifyAllowLocalEnv, "1")
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	return server, captured
+}
+
+func TestNotifyTeamsSendsAdaptiveCard(t *testing.T) {
+	server, captured := newCaptureServer(t, http.StatusAccepted)
+
+	cmd := TeamsCommand()
+	cmd.FlagSet.SetOutput(io.Discard)
+	if err := cmd.Parse([]string{"--webhook", server.URL, "--message", "Release submitted", "--success=false", "--payload-json", `{"version":"1.2.3","build":42}`}); err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+	if err := cmd.Run(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	attachments := captured.body["attachments"].([]any)
+	attachment := attachments[0].(map[string]any)
+	if attachment["contentType"] != "application/vnd.microsoft.card.adaptive" {
+		t.Fatalf("unexpected content type: %v", attachment["contentType"])
+	}
+	body := attachment["content"].(map[string]any)["body"].([]any)
+	title := body[0].(map[string]any)
+	if title["text"] != notifyDefaultFailureTitle || title["color"] != "Attention" {
+		t.Fatalf("unexpected title block: %v", title)
+	}
+	facts := body[2].(map[string]any)["facts"].([]any)
+	first := facts[0].(map[string]any)
+	if len(facts) != 2 || first["title"] != "build" || first["value"] != "42" {
+		t.Fatalf("unexpected facts: %v", facts)
+	}
+}
+
+func TestNotifyDiscordSendsEmbed(t *testing.T) {
+	server, captured := newCaptureServer(t, http.StatusNoContent)
+
+	cmd := DiscordCommand()
+	cmd.FlagSet.SetOutput(io.Discard)
+	if err := cmd.Parse([]string{"--webhook", server.URL + "/api/webhooks/1/abc", "--title", "Release", "--message", "1.2.3 is live", "--username", "asc"}); err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+	if err := cmd.Run(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if captured.body["username"] != "asc" {
+		t.Fatalf("expected username override, got %v", captured.body["username"])
+	}
+	embed := captured.body["embeds"].([]any)[0].(map[string]any)
+	if embed["title"] != "Release" || embed["description"] != "1.2.3 is live" {
+		t.Fatalf("unexpected embed: %v", embed)
+	}
+	if embed["color"] != float64(notifySuccessColorDiscordInt) {
+		t.Fatalf("expected success color, got %v", embed["color"])
+	}
+}
+
+func TestNotifyWebhookRendersTemplateAndHeaders(t *testing.T) {
+	server, captured := newCaptureServer(t, http.StatusOK)
+
+	cmd := WebhookCommand()
+	cmd.FlagSet.SetOutput(io.Discard)
+	args := []string{
+		"--url", server.URL,
+		"--message", `Build "42" done`,
+		"--payload-json", `{"version":"1.2.3"}`,
+		"--template", `{"text":{{json .Message}},"status":"{{.Status}}","version":{{json .Payload.version}}}`,
+		"--header", "Authorization: Bearer token",
+		"--header", "X-Source: asc",
+	}
+	if err := cmd.Parse(args); err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+	if err := cmd.Run(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if captured.body["text"] != `Build "42" done` || captured.body["status"] != "success" || captured.body["version"] != "1.2.3" {
+		t.Fatalf("unexpected rendered body: %v", captured.body)
+	}
+	if captured.headers.Get("Authorization") != "Bearer token" || captured.headers.Get("X-Source") != "asc" {
+		t.Fatalf("expected custom headers, got %v", captured.headers)
+	}
+}
+
+func TestNotifyWebhookDefaultBodyAndErrors(t *testing.T) {
+	n := notification{Message: "hi", Success: false}
+	body, err := renderGenericWebhookBody(nil, n)
+	if err != nil {
+		t.Fatalf("render default body: %v", err)
+	}
+	if string(body) != `{"message":"hi","payload":{},"status":"failure","success":false,"title":""}` {
+		t.Fatalf("unexpected default body: %s", body)
+	}
+
+	tmpl, err := parseGenericWebhookTemplate(`{"text": {{.Message}}}`, "")
+	if err != nil {
+		t.Fatalf("parse template: %v", err)
+	}
+	if _, err := renderGenericWebhookBody(tmpl, n); err == nil || !strings.Contains(err.Error(), "valid JSON") {
+		t.Fatalf("expected invalid JSON error, got %v", err)
+	}
+
+	if _, err := parseGenericWebhookHeaders([]string{"NoColon"}); err == nil {
+		t.Fatal("expected header parse error")
+	}
+	if err := validateGenericWebhookURL("http://example.com/hook"); err == nil {
+		t.Fatal("expected https to be required")
+	}
+}
+
+func TestValidateHookURL(t *testing.T) {
+	t.Setenv(notifyAllowLocalEnv, "")
+	valid := map[string]string{
+		"https://contoso.webhook.office.com/webhookb2/abc":     "teams",
+		"https://prod-01.westus.logic.azure.com/workflows/abc": "teams",
+		"https://discord.com/api/webhooks/1/abc":               "discord",
+	}
+	for rawURL, service := range valid {
+		hosts, prefix := teamsWebhookHosts, ""
+		if service == "discord" {
+			hosts, prefix = discordWebhookHosts, discordWebhookPathPrefix
+		}
+		if err := validateHookURL(service, rawURL, hosts, prefix); err != nil {
+			t.Fatalf("expected %s to be valid: %v", rawURL, err)
+		}
+	}
+
+	if err := validateHookURL("Microsoft Teams", "https://webhook.office.com.evil.com/x", teamsWebhookHosts, ""); err == nil {
+		t.Fatal("expected lookalike host to be rejected")
+	}
+	if err := validateHookURL("Discord", "https://discord.com/other", discordWebhookHosts, discordWebhookPathPrefix); err == nil || !strings.Contains(err.Error(), discordWebhookPathPrefix) {
+		t.Fatalf("expected path prefix error, got %v", err)
+	}
+	if err := validateHookURL("Discord", "http://127.0.0.1:9/api/webhooks/1", discordWebhookHosts, discordWebhookPathPrefix); err == nil {
+		t.Fatal("expected localhost to be rejected without opt-in")
+	}
+}
+
+func TestNotifyChannelsRequireMessage(t *testing.T) {
+	t.Setenv(notifyAllowLocalEnv, "1")
+	commands := map[string][]string{
+		"teams":   {"--webhook", "http://127.0.0.1:9/hook"},
+		"discord": {"--webhook", "http://127.0.0.1:9/api/webhooks/1/abc"},
+		"webhook": {"--url", "http://127.0.0.1:9/hook"},
+		"email":   {"--smtp-host", "127.0.0.1", "--from", "ci@example.com", "--to", "team@example.com"},
+	}
+	for _, sub := range NotifyCommand().Subcommands {
+		args, ok := commands[sub.Name]
+		if !ok {
+			continue
+		}
+		sub.FlagSet.SetOutput(io.Discard)
+		stderr := captureOutput(t, func() {
+			if err := sub.Parse(args); err != nil {
+				t.Fatalf("%s parse error: %v", sub.Name, err)
+			}
+			if err := sub.Run(context.Background()); !errors.Is(err, flag.ErrHelp) {
+				t.Fatalf("%s: expected flag.ErrHelp, got %v", sub.Name, err)
+			}
+		})
+		if !strings.Contains(stderr, "--message is required") {
+			t.Fatalf("%s: expected message error, got %q", sub.Name, stderr)
+		}
+	}
+}
+
+// startFakeSMTPServer accepts one message and returns what was received.
+func startFakeSMTPServer(t *testing.T) (string, <-chan []string) {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	t.Cleanup(func() { _ = listener.Close() })
+
+	received := make(chan []string, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		reader := bufio.NewReader(conn)
+		reply := func(line string) { _, _ = conn.Write([]byte(line + "\r\n")) }
+
+		var lines []string
+		reply("220 localhost ESMTP")
+		inData := false
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				received <- lines
+				return
+			}
+			line = strings.TrimRight(line, "\r\n")
+			if inData {
+				if line == "." {
+					inData = false
+					reply("250 queued")
+					continue
+				}
+				lines = append(lines, "DATA:"+line)
+				continue
+			}
+			lines = append(lines, line)
+			switch command := strings.ToUpper(strings.SplitN(line, " ", 2)[0]); command {
+			case "EHLO", "HELO":
+				reply("250 localhost")
+			case "DATA":
+				inData = true
+				reply("354 go ahead")
+			case "QUIT":
+				reply("221 bye")
+				received <- lines
+				return
+			default:
+				reply("250 ok")
+			}
+		}
+	}()
+	return listener.Addr().String(), received
+}
+
+func TestNotifyEmailSendsOverSMTP(t *testing.T) {
+	address, received := startFakeSMTPServer(t)
+	host, port, _ := net.SplitHostPort(address)
+	t.Setenv("ASC_CONFIG_PATH", filepath.Join(t.TempDir(), "nonexistent.json"))
+	t.Setenv(smtpPortEnvVar, port)
+	t.Setenv(smtpUsernameEnvVar, "")
+
+	cmd := EmailCommand()
+	cmd.FlagSet.SetOutput(io.Discard)
+	args := []string{
+		"--smtp-host", host,
+		"--from", "CI <ci@example.com>",
+		"--to", "a@example.com, b@example.com",
+		"--message", "Release submitted\nAll good",
+		"--payload-json", `{"version":"1.2.3"}`,
+	}
+	if err := cmd.Parse(args); err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+	if err := cmd.Run(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	transcript := strings.Join(<-received, "\n")
+	for _, want := range []string{
+		"MAIL FROM:<ci@example.com>",
+		"RCPT TO:<a@example.com>",
+		"RCPT TO:<b@example.com>",
+		"DATA:Subject: Release submitted",
+		"DATA:To: a@example.com, b@example.com",
+		"DATA:All good",
+		"DATA:version: 1.2.3",
+	} {
+		if !strings.Contains(transcript, want) {
+			t.Fatalf("expected %q in SMTP transcript:\n%s", want, transcript)
+		}
+	}
+}
+
+func TestResolveSMTPPort(t *testing.T) {
+	t.Setenv(smtpPortEnvVar, "")
+	if port, err := resolveSMTPPort(0); err != nil || port != smtpDefaultPort {
+		t.Fatalf("expected default port, got %d (%v)", port, err)
+	}
+	t.Setenv(smtpPortEnvVar, "2525")
+	if port, err := resolveSMTPPort(0); err != nil || port != 2525 {
+		t.Fatalf("expected env port, got %d (%v)", port, err)
+	}
+	if port, err := resolveSMTPPort(465); err != nil || port != 465 {
+		t.Fatalf("expected flag port to win, got %d (%v)", port, err)
+	}
+	t.Setenv(smtpPortEnvVar, "nope")
+	if _, err := resolveSMTPPort(0); err == nil {
+		t.Fatal("expected invalid env port error")
+	}
+}
+
+func TestSendUsesChannelEnvConfiguration(t *testing.T) {
+	server, captured := newCaptureServer(t, http.StatusOK)
+	t.Setenv(genericWebhookEnvVar, server.URL)
+
+	err := Send(context.Background(), "webhook", Message{
+		Title:   "Phased release paused",
+		Text:    "Crash threshold exceeded",
+		Payload: map[string]any{"crashes": 12},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if captured.body["title"] != "Phased release paused" || captured.body["status"] != "failure" {
+		t.Fatalf("unexpected body: %v", captured.body)
+	}
+
+	t.Setenv(slackWebhookEnvVar, "")
+	if err := ValidateChannel("slack"); err == nil || !strings.Contains(err.Error(), slackWebhookEnvVar) {
+		t.Fatalf("expected missing slack webhook error, got %v", err)
+	}
+
+	t.Setenv(smtpHostEnvVar, "smtp.example.com")
+	t.Setenv(smtpPortEnvVar, "")
+	t.Setenv(smtpFromEnvVar, "ci@example.com")
+	t.Setenv(smtpToEnvVar, "")
+	if err := ValidateChannel("email"); err == nil || !strings.Contains(err.Error(), smtpToEnvVar) {
+		t.Fatalf("expected missing email recipients error, got %v", err)
+	}
+	t.Setenv(smtpToEnvVar, "a@example.com, b@example.com")
+	n, err := channelNotifier("email")
+	if err != nil {
+		t.Fatalf("unexpected email channel error: %v", err)
+	}
+	if email, ok := n.(emailNotifier); !ok || email.port != smtpDefaultPort || len(email.to) != 2 {
+		t.Fatalf("unexpected email notifier %#v", n)
+	}
+	if err := ValidateChannel("pager"); err == nil || !strings.Contains(err.Error(), "unsupported notify channel") {
+		t.Fatalf("expected unsupported channel error, got %v", err)
+	}
+}
+
+func TestPostNotificationJSONAcceptedStatuses(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   int
+		accepted []int
+		wantErr  bool
+	}{
+		{name: "slack ok", status: http.StatusOK, accepted: slackAcceptedStatuses},
+		{name: "slack rejects accepted", status: http.StatusAccepted, accepted: slackAcceptedStatuses, wantErr: true},
+		{name: "slack rejects no content", status: http.StatusNoContent, accepted: slackAcceptedStatuses, wantErr: true},
+		{name: "teams workflow accepted", status: http.StatusAccepted, accepted: teamsAcceptedStatuses},
+		{name: "discord no content", status: http.StatusNoContent, accepted: discordAcceptedStatuses},
+		{name: "webhook any 2xx", status: http.StatusCreated},
+		{name: "webhook rejects redirect", status: http.StatusNotModified, wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server, _ := newCaptureServer(t, test.status)
+			err := postNotificationJSON(context.Background(), "notify test", server.URL, []byte(`{}`), nil, test.accepted)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("postNotificationJSON() error = %v, wantErr %v", err, test.wantErr)
+			}
+		})
+	}
+}
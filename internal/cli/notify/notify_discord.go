@@ -0,0 +1,110 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/http"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	discordWebhookEnvVar     = "ASC_DISCORD_WEBHOOK"
+	discordWebhookPathPrefix = "/api/webhooks/"
+	discordMaxEmbedFields    = 25
+)
+
+var discordWebhookHosts = []string{"discord.com", "discordapp.com", "ptb.discord.com", "canary.discord.com"}
+
+// discordAcceptedStatuses: Discord answers 204, or 200 when ?wait=true is set.
+var discordAcceptedStatuses = []int{http.StatusOK, http.StatusNoContent}
+
+// discordNotifier posts an embed to a Discord webhook.
+type discordNotifier struct {
+	webhookURL string
+	username   string
+}
+
+// DiscordCommand returns the notify discord subcommand.
+func DiscordCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("notify discord", flag.ExitOnError)
+
+	webhook := fs.String("webhook", "", "Discord webhook URL (https://discord.com/api/webhooks/...; or set "+discordWebhookEnvVar+" env var)")
+	username := fs.String("username", "", "Override the webhook's display name")
+	inputs := bindNotificationFlags(fs, "Discord")
+
+	return &ffcli.Command{
+		Name:       "discord",
+		ShortUsage: "asc notify discord --webhook URL --message TEXT [flags]",
+		ShortHelp:  "Send a message to Discord via webhook.",
+		LongHelp: `Send a message to Discord as an embed.
+
+The message becomes the embed description and payload fields become embed
+fields (Discord keeps at most 25).
+
+Examples:
+  asc notify discord --webhook "https://discord.com/api/webhooks/..." --message "Build uploaded"
+  ASC_DISCORD_WEBHOOK=$WEBHOOK asc notify discord --title "Release" --message "1.2.3 is live"
+  asc notify discord --message "Release failed" --success=false --payload-file ./release.json`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			webhookURL := resolveNotifyValue(*webhook, discordWebhookEnvVar)
+			if webhookURL == "" {
+				return shared.UsageErrorf("--webhook is required or set %s env var", discordWebhookEnvVar)
+			}
+			if err := validateHookURL("Discord", webhookURL, discordWebhookHosts, discordWebhookPathPrefix); err != nil {
+				return shared.UsageError(err.Error())
+			}
+			msg, err := inputs.build()
+			if err != nil {
+				return err
+			}
+			return sendNotification(ctx, discordNotifier{webhookURL: webhookURL, username: *username}, msg)
+		},
+	}
+}
+
+func (d discordNotifier) Name() string {
+	return "Discord"
+}
+
+func (d discordNotifier) Send(ctx context.Context, n notification) error {
+	body, err := json.Marshal(buildDiscordMessage(n, d.username))
+	if err != nil {
+		return fmt.Errorf("notify discord: failed to marshal payload: %w", err)
+	}
+	return postNotificationJSON(ctx, "notify discord", d.webhookURL, body, nil, discordAcceptedStatuses)
+}
+
+func buildDiscordMessage(n notification, username string) map[string]any {
+	color := notifySuccessColorDiscordInt
+	if !n.Success {
+		color = notifyFailureColorDiscordInt
+	}
+	embed := map[string]any{
+		"title":       notificationTitle(n),
+		"description": n.Message,
+		"color":       color,
+	}
+	if len(n.Payload) > 0 {
+		fields := make([]map[string]any, 0, len(n.Payload))
+		for _, key := range sortedPayloadKeys(n.Payload) {
+			if len(fields) == discordMaxEmbedFields {
+				break
+			}
+			fields = append(fields, map[string]any{"name": key, "value": formatPayloadValue(n.Payload[key]), "inline": false})
+		}
+		embed["fields"] = fields
+	}
+
+	message := map[string]any{"embeds": []map[string]any{embed}}
+	if username != "" {
+		message["username"] = username
+	}
+	return message
+}
@@ -0,0 +1,264 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"flag"
+	"fmt"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	smtpHostEnvVar        = "ASC_SMTP_HOST"
+	smtpPortEnvVar        = "ASC_SMTP_PORT"
+	smtpUsernameEnvVar    = "ASC_SMTP_USERNAME"
+	smtpPasswordEnvVar    = "ASC_SMTP_PASSWORD"
+	smtpFromEnvVar        = "ASC_SMTP_FROM"
+	smtpToEnvVar          = "ASC_SMTP_TO"
+	smtpDefaultPort       = 587
+	smtpImplicitTLSPort   = 465
+	emailSubjectMaxLength = 120
+)
+
+// emailNotifier sends a plain-text email over SMTP.
+type emailNotifier struct {
+	host     string
+	port     int
+	username string
+	password string
+	from     string
+	to       []string
+	subject  string
+}
+
+// EmailCommand returns the notify email subcommand.
+func EmailCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("notify email", flag.ExitOnError)
+
+	host := fs.String("smtp-host", "", "SMTP server host (or set "+smtpHostEnvVar+" env var)")
+	port := fs.Int("smtp-port", 0, "SMTP server port (default 587; or set "+smtpPortEnvVar+" env var; 465 uses implicit TLS)")
+	username := fs.String("smtp-username", "", "SMTP username (or set "+smtpUsernameEnvVar+" env var; password from "+smtpPasswordEnvVar+")")
+	from := fs.String("from", "", "Sender address (or set "+smtpFromEnvVar+" env var)")
+	to := fs.String("to", "", "Recipient addresses (comma-separated; or set "+smtpToEnvVar+" env var)")
+	subject := fs.String("subject", "", "Email subject (default: --title or the first line of --message)")
+	inputs := bindNotificationFlags(fs, "the recipients")
+
+	return &ffcli.Command{
+		Name:       "email",
+		ShortUsage: "asc notify email --smtp-host HOST --from ADDRESS --to ADDRESS --message TEXT [flags]",
+		ShortHelp:  "Send a message by email over SMTP.",
+		LongHelp: `Send a plain-text email over SMTP.
+
+STARTTLS is used when the server offers it; port 465 connects with implicit
+TLS. Authentication uses PLAIN and is only attempted over TLS (or to
+localhost). The password is read from ASC_SMTP_PASSWORD.
+
+Examples:
+  asc notify email --smtp-host smtp.example.com --from ci@example.com --to team@example.com --message "Build uploaded"
+  ASC_SMTP_HOST=smtp.example.com ASC_SMTP_USERNAME=ci ASC_SMTP_PASSWORD=$PASS asc notify email --from ci@example.com --to "a@example.com,b@example.com" --message "Done"
+  asc notify email --smtp-host smtp.example.com --smtp-port 465 --from ci@example.com --to team@example.com --subject "Release 1.2.3" --message "Submitted" --payload-json '{"build":"42"}'`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			resolvedHost := resolveNotifyValue(*host, smtpHostEnvVar)
+			if resolvedHost == "" {
+				return shared.UsageErrorf("--smtp-host is required or set %s env var", smtpHostEnvVar)
+			}
+			resolvedPort, err := resolveSMTPPort(*port)
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
+			resolvedFrom, recipients, err := resolveEmailAddresses(*from, *to)
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
+			if strings.ContainsAny(*subject, "\r\n") {
+				return shared.UsageError("--subject must be a single line")
+			}
+			msg, err := inputs.build()
+			if err != nil {
+				return err
+			}
+
+			notifier := emailNotifier{
+				host:     resolvedHost,
+				port:     resolvedPort,
+				username: resolveNotifyValue(*username, smtpUsernameEnvVar),
+				password: os.Getenv(smtpPasswordEnvVar),
+				from:     resolvedFrom,
+				to:       recipients,
+				subject:  strings.TrimSpace(*subject),
+			}
+			return sendNotification(ctx, notifier, msg)
+		},
+	}
+}
+
+// resolveEmailAddresses resolves and validates the sender and recipients,
+// falling back to ASC_SMTP_FROM and ASC_SMTP_TO.
+func resolveEmailAddresses(fromFlag, toFlag string) (string, []string, error) {
+	from := resolveNotifyValue(fromFlag, smtpFromEnvVar)
+	if from == "" {
+		return "", nil, fmt.Errorf("--from is required or set %s env var", smtpFromEnvVar)
+	}
+	if _, err := mail.ParseAddress(from); err != nil {
+		return "", nil, fmt.Errorf("--from must be a valid email address: %v", err)
+	}
+	recipients := shared.SplitCSV(resolveNotifyValue(toFlag, smtpToEnvVar))
+	if len(recipients) == 0 {
+		return "", nil, fmt.Errorf("--to is required or set %s env var", smtpToEnvVar)
+	}
+	for _, recipient := range recipients {
+		if _, err := mail.ParseAddress(recipient); err != nil {
+			return "", nil, fmt.Errorf("--to contains an invalid address %q", recipient)
+		}
+	}
+	return from, recipients, nil
+}
+
+func resolveSMTPPort(flagValue int) (int, error) {
+	if flagValue != 0 {
+		if flagValue < 1 || flagValue > 65535 {
+			return 0, fmt.Errorf("--smtp-port must be between 1 and 65535")
+		}
+		return flagValue, nil
+	}
+	value := strings.TrimSpace(os.Getenv(smtpPortEnvVar))
+	if value == "" {
+		return smtpDefaultPort, nil
+	}
+	port, err := strconv.Atoi(value)
+	if err != nil || port < 1 || port > 65535 {
+		return 0, fmt.Errorf("%s must be a port number, got %q", smtpPortEnvVar, value)
+	}
+	return port, nil
+}
+
+func (e emailNotifier) Name() string {
+	return "email"
+}
+
+func (e emailNotifier) Send(ctx context.Context, n notification) error {
+	address := net.JoinHostPort(e.host, strconv.Itoa(e.port))
+	dialer := &net.Dialer{}
+	var (
+		conn net.Conn
+		err  error
+	)
+	if e.port == smtpImplicitTLSPort {
+		conn, err = (&tls.Dialer{NetDialer: dialer, Config: &tls.Config{ServerName: e.host}}).DialContext(ctx, "tcp", address)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", address)
+	}
+	if err != nil {
+		return fmt.Errorf("notify email: failed to connect: %w", err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, e.host)
+	if err != nil {
+		_ = conn.Close()
+		return fmt.Errorf("notify email: %w", err)
+	}
+	defer client.Close()
+
+	if e.port != smtpImplicitTLSPort {
+		if ok, _ := client.Extension("STARTTLS"); ok {
+			if err := client.StartTLS(&tls.Config{ServerName: e.host}); err != nil {
+				return fmt.Errorf("notify email: starttls: %w", err)
+			}
+		}
+	}
+	if e.username != "" {
+		if ok, _ := client.Extension("AUTH"); !ok {
+			return fmt.Errorf("notify email: server does not support authentication")
+		}
+		if err := client.Auth(smtp.PlainAuth("", e.username, e.password, e.host)); err != nil {
+			return fmt.Errorf("notify email: auth: %w", err)
+		}
+	}
+
+	if err := client.Mail(envelopeAddress(e.from)); err != nil {
+		return fmt.Errorf("notify email: %w", err)
+	}
+	for _, recipient := range e.to {
+		if err := client.Rcpt(envelopeAddress(recipient)); err != nil {
+			return fmt.Errorf("notify email: recipient %s: %w", recipient, err)
+		}
+	}
+	writer, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("notify email: %w", err)
+	}
+	if _, err := writer.Write(e.buildMessage(n, time.Now())); err != nil {
+		return fmt.Errorf("notify email: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("notify email: %w", err)
+	}
+	return client.Quit()
+}
+
+func (e emailNotifier) buildMessage(n notification, now time.Time) []byte {
+	var buf bytes.Buffer
+	writeHeader := func(name, value string) {
+		fmt.Fprintf(&buf, "%s: %s\r\n", name, value)
+	}
+	writeHeader("From", e.from)
+	writeHeader("To", strings.Join(e.to, ", "))
+	writeHeader("Subject", mime.QEncoding.Encode("utf-8", emailSubject(e.subject, n)))
+	writeHeader("Date", now.Format(time.RFC1123Z))
+	writeHeader("MIME-Version", "1.0")
+	writeHeader("Content-Type", "text/plain; charset=UTF-8")
+	writeHeader("Content-Transfer-Encoding", "8bit")
+	buf.WriteString("\r\n")
+
+	body := n.Message
+	if len(n.Payload) > 0 {
+		lines := make([]string, 0, len(n.Payload))
+		for _, key := range sortedPayloadKeys(n.Payload) {
+			lines = append(lines, key+": "+formatPayloadValue(n.Payload[key]))
+		}
+		body += "\n\n" + strings.Join(lines, "\n")
+	}
+	body = strings.ReplaceAll(body, "\r\n", "\n")
+	buf.WriteString(strings.ReplaceAll(body, "\n", "\r\n"))
+	buf.WriteString("\r\n")
+	return buf.Bytes()
+}
+
+// envelopeAddress strips any display name for the SMTP MAIL/RCPT commands.
+func envelopeAddress(value string) string {
+	if parsed, err := mail.ParseAddress(value); err == nil {
+		return parsed.Address
+	}
+	return value
+}
+
+func emailSubject(subject string, n notification) string {
+	if subject == "" {
+		subject = n.Title
+	}
+	if subject == "" {
+		subject, _, _ = strings.Cut(n.Message, "\n")
+		subject = strings.TrimSpace(subject)
+	}
+	if runes := []rune(subject); len(runes) > emailSubjectMaxLength {
+		subject = string(runes[:emailSubjectMaxLength])
+	}
+	return subject
+}
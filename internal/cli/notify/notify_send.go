@@ -0,0 +1,105 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Channels are the notify channels other commands can send through. Each is
+// configured from the same environment variable its subcommand falls back to.
+var Channels = []string{"slack", "teams", "discord", "webhook", "email"}
+
+// Message is a notification sent by another command through Send.
+type Message struct {
+	Title   string
+	Text    string
+	Payload map[string]any
+	Success bool
+}
+
+// ValidateChannel checks that channel is supported and configured.
+func ValidateChannel(channel string) error {
+	_, err := channelNotifier(channel)
+	return err
+}
+
+// Send delivers msg through channel, exactly as the matching
+// "asc notify <channel>" subcommand would.
+func Send(ctx context.Context, channel string, msg Message) error {
+	n, err := channelNotifier(channel)
+	if err != nil {
+		return err
+	}
+	return sendNotification(ctx, n, notification{
+		Message: msg.Text,
+		Title:   msg.Title,
+		Payload: msg.Payload,
+		Success: msg.Success,
+	})
+}
+
+func channelNotifier(channel string) (notifier, error) {
+	switch strings.ToLower(strings.TrimSpace(channel)) {
+	case "slack":
+		webhookURL := resolveWebhook("")
+		if webhookURL == "" {
+			return nil, fmt.Errorf("notify slack: set %s", slackWebhookEnvVar)
+		}
+		if err := validateSlackWebhookURL(webhookURL); err != nil {
+			return nil, fmt.Errorf("notify slack: %w", err)
+		}
+		return slackNotifier{webhookURL: webhookURL}, nil
+	case "teams":
+		webhookURL := resolveNotifyValue("", teamsWebhookEnvVar)
+		if webhookURL == "" {
+			return nil, fmt.Errorf("notify teams: set %s", teamsWebhookEnvVar)
+		}
+		if err := validateHookURL("Microsoft Teams", webhookURL, teamsWebhookHosts, ""); err != nil {
+			return nil, fmt.Errorf("notify teams: %w", err)
+		}
+		return teamsNotifier{webhookURL: webhookURL}, nil
+	case "discord":
+		webhookURL := resolveNotifyValue("", discordWebhookEnvVar)
+		if webhookURL == "" {
+			return nil, fmt.Errorf("notify discord: set %s", discordWebhookEnvVar)
+		}
+		if err := validateHookURL("Discord", webhookURL, discordWebhookHosts, discordWebhookPathPrefix); err != nil {
+			return nil, fmt.Errorf("notify discord: %w", err)
+		}
+		return discordNotifier{webhookURL: webhookURL}, nil
+	case "webhook":
+		targetURL := resolveNotifyValue("", genericWebhookEnvVar)
+		if targetURL == "" {
+			return nil, fmt.Errorf("notify webhook: set %s", genericWebhookEnvVar)
+		}
+		if err := validateGenericWebhookURL(targetURL); err != nil {
+			return nil, fmt.Errorf("notify webhook: %w", err)
+		}
+		return genericWebhookNotifier{url: targetURL}, nil
+	case "email":
+		host := resolveNotifyValue("", smtpHostEnvVar)
+		if host == "" {
+			return nil, fmt.Errorf("notify email: set %s", smtpHostEnvVar)
+		}
+		port, err := resolveSMTPPort(0)
+		if err != nil {
+			return nil, fmt.Errorf("notify email: %w", err)
+		}
+		from, recipients, err := resolveEmailAddresses("", "")
+		if err != nil {
+			return nil, fmt.Errorf("notify email: %w", err)
+		}
+		return emailNotifier{
+			host:     host,
+			port:     port,
+			username: resolveNotifyValue("", smtpUsernameEnvVar),
+			password: os.Getenv(smtpPasswordEnvVar),
+			from:     from,
+			to:       recipients,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported notify channel %q (allowed: %s)", channel, strings.Join(Channels, ", "))
+	}
+}
@@ -0,0 +1,110 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/http"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const teamsWebhookEnvVar = "ASC_TEAMS_WEBHOOK"
+
+// teamsWebhookHosts covers legacy Office 365 connectors and Workflows (Power Automate) URLs.
+var teamsWebhookHosts = []string{".webhook.office.com", ".logic.azure.com", ".api.powerplatform.com"}
+
+// teamsAcceptedStatuses: connectors answer 200 and Workflows answer 202.
+var teamsAcceptedStatuses = []int{http.StatusOK, http.StatusAccepted}
+
+// teamsNotifier posts an Adaptive Card to a Microsoft Teams incoming webhook.
+type teamsNotifier struct {
+	webhookURL string
+}
+
+// TeamsCommand returns the notify teams subcommand.
+func TeamsCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("notify teams", flag.ExitOnError)
+
+	webhook := fs.String("webhook", "", "Microsoft Teams webhook URL (or set "+teamsWebhookEnvVar+" env var)")
+	inputs := bindNotificationFlags(fs, "Microsoft Teams")
+
+	return &ffcli.Command{
+		Name:       "teams",
+		ShortUsage: "asc notify teams --webhook URL --message TEXT [flags]",
+		ShortHelp:  "Send a message to Microsoft Teams via webhook.",
+		LongHelp: `Send a message to Microsoft Teams as an Adaptive Card.
+
+Works with Teams Workflows webhooks and legacy incoming webhook connectors.
+Payload fields are rendered as a fact set below the message.
+
+Examples:
+  asc notify teams --webhook "https://example.webhook.office.com/..." --message "Build uploaded"
+  ASC_TEAMS_WEBHOOK=$WEBHOOK asc notify teams --title "Release" --message "1.2.3 submitted"
+  asc notify teams --message "Release failed" --success=false --payload-json '{"version":"1.2.3"}'`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			webhookURL := resolveNotifyValue(*webhook, teamsWebhookEnvVar)
+			if webhookURL == "" {
+				return shared.UsageErrorf("--webhook is required or set %s env var", teamsWebhookEnvVar)
+			}
+			if err := validateHookURL("Microsoft Teams", webhookURL, teamsWebhookHosts, ""); err != nil {
+				return shared.UsageError(err.Error())
+			}
+			msg, err := inputs.build()
+			if err != nil {
+				return err
+			}
+			return sendNotification(ctx, teamsNotifier{webhookURL: webhookURL}, msg)
+		},
+	}
+}
+
+func (t teamsNotifier) Name() string {
+	return "Microsoft Teams"
+}
+
+func (t teamsNotifier) Send(ctx context.Context, n notification) error {
+	body, err := json.Marshal(buildTeamsMessage(n))
+	if err != nil {
+		return fmt.Errorf("notify teams: failed to marshal payload: %w", err)
+	}
+	return postNotificationJSON(ctx, "notify teams", t.webhookURL, body, nil, teamsAcceptedStatuses)
+}
+
+func buildTeamsMessage(n notification) map[string]any {
+	color := "Good"
+	if !n.Success {
+		color = "Attention"
+	}
+	cardBody := []map[string]any{
+		{"type": "TextBlock", "text": notificationTitle(n), "weight": "Bolder", "size": "Medium", "color": color, "wrap": true},
+		{"type": "TextBlock", "text": n.Message, "wrap": true},
+	}
+	if len(n.Payload) > 0 {
+		facts := make([]map[string]string, 0, len(n.Payload))
+		for _, key := range sortedPayloadKeys(n.Payload) {
+			facts = append(facts, map[string]string{"title": key, "value": formatPayloadValue(n.Payload[key])})
+		}
+		cardBody = append(cardBody, map[string]any{"type": "FactSet", "facts": facts})
+	}
+
+	return map[string]any{
+		"type": "message",
+		"attachments": []map[string]any{
+			{
+				"contentType": "application/vnd.microsoft.card.adaptive",
+				"content": map[string]any{
+					"$schema": "http://adaptivecards.io/schemas/adaptive-card.json",
+					"type":    "AdaptiveCard",
+					"version": "1.4",
+					"body":    cardBody,
+				},
+			},
+		},
+	}
+}
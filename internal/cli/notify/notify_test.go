@@ -543,9 +543,9 @@ func TestNotifySlackPayloadValidationErrors(t *testing.T) {
 }
 
 func TestParseSlackPayloadUsesJSONNumber(t *testing.T) {
-	payload, err := parseSlackPayload(`{"release_id":123456789012345678901234567890}`, "")
+	payload, err := parseNotifyPayload(`{"release_id":123456789012345678901234567890}`, "")
 	if err != nil {
-		t.Fatalf("parseSlackPayload returned error: %v", err)
+		t.Fatalf("parseNotifyPayload returned error: %v", err)
 	}
 	value, ok := payload["release_id"].(json.Number)
 	if !ok {
@@ -0,0 +1,204 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"text/template"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const genericWebhookEnvVar = "ASC_NOTIFY_WEBHOOK_URL"
+
+// genericWebhookData is the data passed to --template.
+type genericWebhookData struct {
+	Message string
+	Title   string
+	Success bool
+	Status  string
+	Payload map[string]any
+}
+
+// headerFlag collects repeated --header "Name: value" values.
+type headerFlag []string
+
+func (h *headerFlag) String() string {
+	return strings.Join(*h, ", ")
+}
+
+func (h *headerFlag) Set(value string) error {
+	*h = append(*h, value)
+	return nil
+}
+
+// genericWebhookNotifier posts a JSON body rendered from a Go template.
+type genericWebhookNotifier struct {
+	url      string
+	template *template.Template
+	headers  map[string]string
+}
+
+// WebhookCommand returns the notify webhook subcommand.
+func WebhookCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("notify webhook", flag.ExitOnError)
+
+	targetURL := fs.String("url", "", "Webhook URL (https; or set "+genericWebhookEnvVar+" env var)")
+	templateText := fs.String("template", "", "Go template rendering the JSON request body")
+	templateFile := fs.String("template-file", "", "Path to a Go template file rendering the JSON request body")
+	var headers headerFlag
+	fs.Var(&headers, "header", `Extra request header as "Name: value" (repeatable)`)
+	inputs := bindNotificationFlags(fs, "the webhook")
+
+	return &ffcli.Command{
+		Name:       "webhook",
+		ShortUsage: "asc notify webhook --url URL --message TEXT [flags]",
+		ShortHelp:  "Send a templated JSON message to any webhook.",
+		LongHelp: `Send a JSON message to any HTTP endpoint.
+
+Without a template the body is {"title","message","success","status","payload"}.
+With --template or --template-file the body is rendered from a Go template
+and must be valid JSON. The template receives .Message, .Title, .Success,
+.Status ("success" or "failure") and .Payload, plus a "json" function that
+encodes a value as a JSON literal.
+
+Examples:
+  asc notify webhook --url "https://ci.example.com/hooks/asc" --message "Build uploaded"
+  asc notify webhook --url "$URL" --message "Done" --template '{"text":{{json .Message}},"ok":{{.Success}}}'
+  asc notify webhook --url "$URL" --message "Release" --payload-json '{"version":"1.2.3"}' --template-file ./body.tmpl
+  asc notify webhook --url "$URL" --message "Done" --header "Authorization: Bearer $TOKEN"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			resolvedURL := resolveNotifyValue(*targetURL, genericWebhookEnvVar)
+			if resolvedURL == "" {
+				return shared.UsageErrorf("--url is required or set %s env var", genericWebhookEnvVar)
+			}
+			if err := validateGenericWebhookURL(resolvedURL); err != nil {
+				return shared.UsageError(err.Error())
+			}
+			tmpl, err := parseGenericWebhookTemplate(*templateText, *templateFile)
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
+			parsedHeaders, err := parseGenericWebhookHeaders(headers)
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
+			msg, err := inputs.build()
+			if err != nil {
+				return err
+			}
+			return sendNotification(ctx, genericWebhookNotifier{url: resolvedURL, template: tmpl, headers: parsedHeaders}, msg)
+		},
+	}
+}
+
+func (g genericWebhookNotifier) Name() string {
+	return "webhook"
+}
+
+func (g genericWebhookNotifier) Send(ctx context.Context, n notification) error {
+	body, err := renderGenericWebhookBody(g.template, n)
+	if err != nil {
+		return fmt.Errorf("notify webhook: %w", err)
+	}
+	return postNotificationJSON(ctx, "notify webhook", g.url, body, g.headers, nil)
+}
+
+func renderGenericWebhookBody(tmpl *template.Template, n notification) ([]byte, error) {
+	status := "success"
+	if !n.Success {
+		status = "failure"
+	}
+	if tmpl == nil {
+		payload := n.Payload
+		if payload == nil {
+			payload = map[string]any{}
+		}
+		return json.Marshal(map[string]any{
+			"title":   n.Title,
+			"message": n.Message,
+			"success": n.Success,
+			"status":  status,
+			"payload": payload,
+		})
+	}
+
+	var buf bytes.Buffer
+	data := genericWebhookData{Message: n.Message, Title: n.Title, Success: n.Success, Status: status, Payload: n.Payload}
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, fmt.Errorf("failed to render template: %w", err)
+	}
+	body := bytes.TrimSpace(buf.Bytes())
+	if !json.Valid(body) {
+		return nil, fmt.Errorf("template did not render valid JSON: %s", body)
+	}
+	return body, nil
+}
+
+func parseGenericWebhookTemplate(templateText, templateFile string) (*template.Template, error) {
+	templateText = strings.TrimSpace(templateText)
+	templateFile = strings.TrimSpace(templateFile)
+	if templateText != "" && templateFile != "" {
+		return nil, fmt.Errorf("only one of --template or --template-file may be set")
+	}
+	if templateFile != "" {
+		data, err := os.ReadFile(templateFile)
+		if err != nil {
+			return nil, fmt.Errorf("--template-file must be readable: %w", err)
+		}
+		templateText = strings.TrimSpace(string(data))
+	}
+	if templateText == "" {
+		return nil, nil
+	}
+
+	tmpl, err := template.New("body").Option("missingkey=error").Funcs(template.FuncMap{
+		"json": func(value any) (string, error) {
+			encoded, err := json.Marshal(value)
+			return string(encoded), err
+		},
+	}).Parse(templateText)
+	if err != nil {
+		return nil, fmt.Errorf("invalid template: %w", err)
+	}
+	return tmpl, nil
+}
+
+func parseGenericWebhookHeaders(values []string) (map[string]string, error) {
+	headers := make(map[string]string, len(values))
+	for _, value := range values {
+		name, headerValue, ok := strings.Cut(value, ":")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" || strings.ContainsAny(name, " \t") {
+			return nil, fmt.Errorf(`--header must be "Name: value", got %q`, value)
+		}
+		headers[name] = strings.TrimSpace(headerValue)
+	}
+	return headers, nil
+}
+
+func validateGenericWebhookURL(rawURL string) error {
+	parsed, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil || parsed.Scheme == "" || parsed.Host == "" || parsed.User != nil {
+		return fmt.Errorf("--url must be a valid URL")
+	}
+	if allowLocalNotifyWebhook() && isLocalhost(strings.ToLower(parsed.Hostname())) {
+		if parsed.Scheme != "http" && parsed.Scheme != "https" {
+			return fmt.Errorf("--url must use http or https")
+		}
+		return nil
+	}
+	if parsed.Scheme != "https" {
+		return fmt.Errorf("--url must use https")
+	}
+	return nil
+}
@@ -0,0 +1,173 @@
+// Package plugins discovers and runs external asc-<name> executables as
+// subcommands, in the style of git and kubectl plugins.
+package plugins
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// Prefix is the executable name prefix that marks an asc plugin.
+const Prefix = "asc-"
+
+// Plugin is an external subcommand found on disk.
+type Plugin struct {
+	Name string
+	Path string
+}
+
+// Dirs returns the plugin search path: ~/.asc/plugins first, then PATH.
+func Dirs() []string {
+	var dirs []string
+	if home, err := os.UserHomeDir(); err == nil {
+		dirs = append(dirs, filepath.Join(home, ".asc", "plugins"))
+	}
+	return append(dirs, filepath.SplitList(os.Getenv("PATH"))...)
+}
+
+// Discover lists plugins in dirs, sorted by name. When the same name appears
+// in several directories the first one wins.
+func Discover(dirs []string) []Plugin {
+	seen := map[string]bool{}
+	var found []Plugin
+	for _, dir := range dirs {
+		if strings.TrimSpace(dir) == "" {
+			continue
+		}
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		for _, entry := range entries {
+			name, ok := pluginName(entry.Name())
+			if !ok || seen[name] {
+				continue
+			}
+			path := filepath.Join(dir, entry.Name())
+			if !isExecutable(path) {
+				continue
+			}
+			seen[name] = true
+			found = append(found, Plugin{Name: name, Path: path})
+		}
+	}
+	sort.Slice(found, func(i, j int) bool { return found[i].Name < found[j].Name })
+	return found
+}
+
+// Names returns the names of the plugins in dirs.
+func Names(dirs []string) []string {
+	plugins := Discover(dirs)
+	names := make([]string, 0, len(plugins))
+	for _, plugin := range plugins {
+		names = append(names, plugin.Name)
+	}
+	return names
+}
+
+// Find looks up the plugin for a subcommand name in dirs.
+func Find(dirs []string, name string) (Plugin, bool) {
+	if !validName(name) {
+		return Plugin{}, false
+	}
+	for _, dir := range dirs {
+		if strings.TrimSpace(dir) == "" {
+			continue
+		}
+		for _, candidate := range executableNames(Prefix + name) {
+			path := filepath.Join(dir, candidate)
+			if isExecutable(path) {
+				return Plugin{Name: name, Path: path}, true
+			}
+		}
+	}
+	return Plugin{}, false
+}
+
+// Run executes plugin with args, passing through stdio. A non-zero exit is
+// returned as *shared.ExitCodeError so asc exits with the plugin's status.
+func Run(ctx context.Context, plugin Plugin, args []string) error {
+	cmd := exec.CommandContext(ctx, plugin.Path, args...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.Env = append(os.Environ(), Env()...)
+
+	if err := cmd.Run(); err != nil {
+		if exitErr, ok := errors.AsType[*exec.ExitError](err); ok {
+			return &shared.ExitCodeError{Code: exitErr.ExitCode()}
+		}
+		return fmt.Errorf("plugin %s: %w", plugin.Name, err)
+	}
+	return nil
+}
+
+// Env returns the variables handed to plugins: the resolved profile, app ID,
+// output format, API base URL and, when credentials are available, a
+// short-lived API token. An invalid ASC_BASE_URL is passed through unchanged
+// so the plugin rejects it too.
+func Env() []string {
+	env := []string{
+		"ASC_DEFAULT_OUTPUT=" + shared.DefaultOutputFormat(),
+	}
+	if baseURL, err := asc.ResolveBaseURL(); err == nil {
+		env = append(env, "ASC_BASE_URL="+baseURL)
+	}
+	if profile := shared.SelectedProfile(); profile != "" {
+		env = append(env, "ASC_PROFILE="+profile)
+	}
+	if appID := shared.ResolveAppID(""); appID != "" {
+		env = append(env, "ASC_APP_ID="+appID)
+	}
+	// Plugins that work offline should still run without credentials.
+	if client, err := shared.GetASCClient(); err == nil {
+		if token, err := client.BearerToken(); err == nil {
+			env = append(env, "ASC_TOKEN="+token)
+		}
+	}
+	return env
+}
+
+func pluginName(fileName string) (string, bool) {
+	if !strings.HasPrefix(fileName, Prefix) {
+		return "", false
+	}
+	name := strings.TrimPrefix(fileName, Prefix)
+	if runtime.GOOS == "windows" {
+		name = strings.TrimSuffix(name, filepath.Ext(name))
+	}
+	return name, validName(name)
+}
+
+// validName rejects names that could not have come from a command line word.
+func validName(name string) bool {
+	return name != "" && !strings.HasPrefix(name, "-") && !strings.ContainsAny(name, `/\. `)
+}
+
+func executableNames(base string) []string {
+	if runtime.GOOS != "windows" {
+		return []string{base}
+	}
+	return []string{base + ".exe", base + ".bat", base + ".cmd"}
+}
+
+func isExecutable(path string) bool {
+	info, err := os.Stat(path)
+	if err != nil || info.IsDir() {
+		return false
+	}
+	if runtime.GOOS == "windows" {
+		return true
+	}
+	return info.Mode().Perm()&0o111 != 0
+}
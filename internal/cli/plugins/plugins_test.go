@@ -0,0 +1,52 @@
+package plugins
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"testing"
+)
+
+func writePlugin(t *testing.T, dir, name string, mode os.FileMode) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(dir, name), []byte("#!/bin/sh\n"), mode); err != nil {
+		t.Fatalf("WriteFile() error: %v", err)
+	}
+}
+
+func TestDiscoverAndFind(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("executable bits are not used on Windows")
+	}
+
+	first := t.TempDir()
+	second := t.TempDir()
+	writePlugin(t, first, "asc-deploy", 0o755)
+	writePlugin(t, second, "asc-deploy", 0o755)
+	writePlugin(t, second, "asc-audit", 0o755)
+	writePlugin(t, second, "asc-notes", 0o644)
+	writePlugin(t, second, "other-tool", 0o755)
+	if err := os.Mkdir(filepath.Join(second, "asc-dir"), 0o755); err != nil {
+		t.Fatalf("Mkdir() error: %v", err)
+	}
+
+	dirs := []string{first, "", second}
+	found := Discover(dirs)
+	if names := Names(dirs); !slices.Equal(names, []string{"audit", "deploy"}) {
+		t.Fatalf("Names() = %v", names)
+	}
+	if found[1].Path != filepath.Join(first, "asc-deploy") {
+		t.Fatalf("expected first directory to win, got %s", found[1].Path)
+	}
+
+	plugin, ok := Find(dirs, "audit")
+	if !ok || plugin.Path != filepath.Join(second, "asc-audit") {
+		t.Fatalf("Find(audit) = %+v, %v", plugin, ok)
+	}
+	for _, name := range []string{"notes", "dir", "../audit", "-audit", ""} {
+		if _, ok := Find(dirs, name); ok {
+			t.Fatalf("expected Find(%q) to fail", name)
+		}
+	}
+}
@@ -42,7 +42,7 @@ Examples:
 func PreReleaseVersionsListCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("pre-release-versions list", flag.ExitOnError)
 
-	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID)")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (or ASC_APP_ID)")
 	platform := fs.String("platform", "", "Filter by platform: IOS, MAC_OS, TV_OS, VISION_OS")
 	version := fs.String("version", "", "Filter by version string")
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
@@ -32,6 +32,7 @@ Examples:
   asc pricing schedule create --app "123456789" --price-point "PRICE_POINT_ID" --base-territory "USA" --start-date "2024-03-01"
   asc pricing schedule manual-prices --schedule "SCHEDULE_ID"
   asc pricing schedule automatic-prices --schedule "SCHEDULE_ID"
+  asc pricing equalize --subscription-id "SUB_ID" --base-territory "USA" --base-price "9.99"
   asc pricing availability get --app "123456789"
   asc pricing availability get --id "AVAILABILITY_ID"
   asc pricing availability set --app "123456789" --territory "USA,GBR,DEU" --available true
@@ -41,6 +42,7 @@ Examples:
 			PricingTerritoriesCommand(),
 			PricingPricePointsCommand(),
 			PricingScheduleCommand(),
+			PricingEqualizeCommand(),
 			PricingAvailabilityCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
@@ -141,7 +143,7 @@ Examples:
 func PricingPricePointsCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("pricing price-points", flag.ExitOnError)
 
-	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID)")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (or ASC_APP_ID)")
 	territory := fs.String("territory", "", "Filter by territory (e.g., USA)")
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Next page URL from a previous response")
@@ -336,7 +338,7 @@ Examples:
 func PricingScheduleGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("pricing schedule get", flag.ExitOnError)
 
-	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID)")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (or ASC_APP_ID)")
 	id := fs.String("id", "", "App price schedule ID")
 	output := shared.BindOutputFlags(fs)
 
@@ -519,7 +521,7 @@ Examples:
 func PricingAvailabilityGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("pricing availability get", flag.ExitOnError)
 
-	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID)")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (or ASC_APP_ID)")
 	id := fs.String("id", "", "App availability ID")
 	output := shared.BindOutputFlags(fs)
 
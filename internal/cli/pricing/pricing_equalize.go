@@ -0,0 +1,807 @@
+package pricing
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	equalizeSourceApple  = "apple"
+	equalizeSourceFXFile = "fx-file"
+
+	equalizeStatusNew       = "new"
+	equalizeStatusChanged   = "changed"
+	equalizeStatusUnchanged = "unchanged"
+)
+
+// equalizeClient is the subset of the App Store Connect client used by pricing equalize.
+type equalizeClient interface {
+	GetInAppPurchasePricePoints(ctx context.Context, iapID string, opts ...asc.IAPPricePointsOption) (*asc.InAppPurchasePricePointsResponse, error)
+	GetInAppPurchasePricePointEqualizations(ctx context.Context, pricePointID string, opts ...asc.IAPPricePointsOption) (*asc.InAppPurchasePricePointsResponse, error)
+	GetInAppPurchasePriceSchedule(ctx context.Context, iapID string, opts ...asc.IAPPriceScheduleOption) (*asc.InAppPurchasePriceScheduleResponse, error)
+	GetInAppPurchasePriceScheduleManualPrices(ctx context.Context, scheduleID string, opts ...asc.IAPPriceSchedulePricesOption) (*asc.InAppPurchasePricesResponse, error)
+	CreateInAppPurchasePriceSchedule(ctx context.Context, iapID string, attrs asc.InAppPurchasePriceScheduleCreateAttributes) (*asc.InAppPurchasePriceScheduleResponse, error)
+	GetSubscriptionPricePoints(ctx context.Context, subscriptionID string, opts ...asc.SubscriptionPricePointsOption) (*asc.SubscriptionPricePointsResponse, error)
+	GetSubscriptionPricePointEqualizations(ctx context.Context, pricePointID string, opts ...asc.SubscriptionPricePointsOption) (*asc.SubscriptionPricePointsResponse, error)
+	GetSubscriptionPrices(ctx context.Context, subscriptionID string, opts ...asc.SubscriptionPricesOption) (*asc.SubscriptionPricesResponse, error)
+	CreateSubscriptionPrice(ctx context.Context, subID, pricePointID, territoryID string, attrs asc.SubscriptionPriceCreateAttributes) (*asc.SubscriptionPriceResponse, error)
+}
+
+// equalizeResult is the preview (or applied result) of a price equalization.
+type equalizeResult struct {
+	ProductType      string        `json:"productType"`
+	ProductID        string        `json:"productId"`
+	Source           string        `json:"source"`
+	BaseTerritory    string        `json:"baseTerritory"`
+	BasePrice        string        `json:"basePrice"`
+	BasePricePointID string        `json:"basePricePointId"`
+	StartDate        string        `json:"startDate,omitempty"`
+	Applied          bool          `json:"applied"`
+	Changes          int           `json:"changes"`
+	Territories      []equalizeRow `json:"territories"`
+}
+
+// equalizeRow is the old vs. new price for one territory.
+type equalizeRow struct {
+	Territory      string `json:"territory"`
+	CurrentPrice   string `json:"currentPrice,omitempty"`
+	NewPrice       string `json:"newPrice"`
+	TargetPrice    string `json:"targetPrice,omitempty"`
+	ChangePercent  string `json:"changePercent,omitempty"`
+	Status         string `json:"status"`
+	PricePointID   string `json:"pricePointId"`
+	currentPointID string
+}
+
+type equalizePricePoint struct {
+	ID            string
+	Territory     string
+	CustomerPrice string
+}
+
+type equalizeOptions struct {
+	kind          string
+	productID     string
+	baseTerritory string
+	basePrice     string
+	fxRates       map[string]float64
+	territories   []string
+	startDate     string
+	apply         bool
+}
+
+// PricingEqualizeCommand returns the pricing equalize subcommand.
+func PricingEqualizeCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("pricing equalize", flag.ExitOnError)
+
+	subscriptionID := fs.String("subscription-id", "", "Subscription ID to equalize")
+	iapID := fs.String("iap-id", "", "In-app purchase ID to equalize")
+	baseTerritory := fs.String("base-territory", "USA", "Base territory (e.g., USA)")
+	basePrice := fs.String("base-price", "", "Customer price in the base territory (e.g., 9.99)")
+	fxFile := fs.String("fx-file", "", "CSV of territory,rate multipliers (FX or PPP index) instead of Apple's equalization")
+	territories := fs.String("territories", "", "Comma-separated territories to include (default: all)")
+	startDate := fs.String("start-date", "", "Start date for the new prices (YYYY-MM-DD)")
+	apply := fs.Bool("apply", false, "Apply the new prices (default: preview only)")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "equalize",
+		ShortUsage: "asc pricing equalize (--subscription-id ID | --iap-id ID) --base-price PRICE [flags]",
+		ShortHelp:  "Equalize subscription or IAP prices across territories.",
+		LongHelp: `Equalize subscription or in-app purchase prices across territories.
+
+Starting from a base territory price, picks a price point for every other
+territory and previews the current price, the new price and the change.
+By default Apple's equalized price points are used. With --fx-file, each
+territory's target is base price x rate and the nearest price point wins.
+
+The --fx-file CSV needs a "territory,rate" header. Rates are local units per
+unit of the base currency; a PPP index can be folded into the rate.
+
+Examples:
+  asc pricing equalize --subscription-id "SUB_ID" --base-territory "USA" --base-price "9.99"
+  asc pricing equalize --iap-id "IAP_ID" --base-price "4.99" --territories "GBR,DEU,JPN"
+  asc pricing equalize --subscription-id "SUB_ID" --base-price "9.99" --fx-file "./ppp.csv" --output table
+  asc pricing equalize --subscription-id "SUB_ID" --base-price "9.99" --start-date "2026-03-01" --apply
+
+Notes:
+  Subscription prices are added per changed territory with --start-date.
+  In-app purchase prices replace the manual price schedule; with
+  --start-date, current prices stay in effect until that date.`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			subValue := strings.TrimSpace(*subscriptionID)
+			iapValue := strings.TrimSpace(*iapID)
+			if subValue == "" && iapValue == "" {
+				return shared.UsageError("--subscription-id or --iap-id is required")
+			}
+			if subValue != "" && iapValue != "" {
+				return shared.UsageError("--subscription-id and --iap-id are mutually exclusive")
+			}
+
+			opts := equalizeOptions{
+				kind:          "subscription",
+				productID:     subValue,
+				baseTerritory: strings.ToUpper(strings.TrimSpace(*baseTerritory)),
+				territories:   shared.SplitCSVUpper(*territories),
+				apply:         *apply,
+			}
+			if iapValue != "" {
+				opts.kind = "iap"
+				opts.productID = iapValue
+			}
+			if opts.baseTerritory == "" {
+				return shared.UsageError("--base-territory is required")
+			}
+
+			priceValue := strings.TrimSpace(*basePrice)
+			if priceValue == "" {
+				return shared.UsageError("--base-price is required")
+			}
+			if value, err := strconv.ParseFloat(priceValue, 64); err != nil || value <= 0 {
+				return shared.UsageError("--base-price must be a positive number")
+			}
+			opts.basePrice = priceValue
+
+			if strings.TrimSpace(*startDate) != "" {
+				normalized, err := shared.NormalizeDate(*startDate, "--start-date")
+				if err != nil {
+					return shared.UsageError(err.Error())
+				}
+				opts.startDate = normalized
+			}
+
+			if strings.TrimSpace(*fxFile) != "" {
+				rates, err := readEqualizeFXFile(*fxFile)
+				if err != nil {
+					return fmt.Errorf("pricing equalize: %w", err)
+				}
+				opts.fxRates = rates
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("pricing equalize: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			result, err := runEqualize(requestCtx, client, opts)
+			if err != nil {
+				return fmt.Errorf("pricing equalize: %w", err)
+			}
+
+			return shared.PrintOutputWithRenderers(
+				result,
+				*output.Output,
+				*output.Pretty,
+				func() error { return printEqualizeTable(result) },
+				func() error { return printEqualizeMarkdown(result) },
+			)
+		},
+	}
+}
+
+func runEqualize(ctx context.Context, client equalizeClient, opts equalizeOptions) (*equalizeResult, error) {
+	result := &equalizeResult{
+		ProductType:   opts.kind,
+		ProductID:     opts.productID,
+		Source:        equalizeSourceApple,
+		BaseTerritory: opts.baseTerritory,
+		BasePrice:     opts.basePrice,
+		StartDate:     opts.startDate,
+		Territories:   []equalizeRow{},
+	}
+	if opts.fxRates != nil {
+		result.Source = equalizeSourceFXFile
+	}
+
+	basePoints, err := listEqualizePricePoints(ctx, client, opts.kind, opts.productID, opts.baseTerritory)
+	if err != nil {
+		return nil, fmt.Errorf("fetch %s price points: %w", opts.baseTerritory, err)
+	}
+	basePoint, ok := exactEqualizePricePoint(basePoints, opts.basePrice)
+	if !ok {
+		target, _ := strconv.ParseFloat(opts.basePrice, 64)
+		if nearest, found := nearestEqualizePricePoint(basePoints, target); found {
+			return nil, fmt.Errorf("no %s price point at %s (nearest is %s)", opts.baseTerritory, opts.basePrice, nearest.CustomerPrice)
+		}
+		return nil, fmt.Errorf("no %s price points found", opts.baseTerritory)
+	}
+	basePoint.Territory = opts.baseTerritory
+	result.BasePricePointID = basePoint.ID
+
+	targets, err := equalizeTargets(ctx, client, opts, basePoint)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := currentEqualizePrices(ctx, client, opts.kind, opts.productID)
+	if err != nil {
+		return nil, fmt.Errorf("fetch current prices: %w", err)
+	}
+
+	for _, target := range targets {
+		row := equalizeRow{
+			Territory:    target.point.Territory,
+			NewPrice:     target.point.CustomerPrice,
+			TargetPrice:  target.target,
+			PricePointID: target.point.ID,
+			Status:       equalizeStatusNew,
+		}
+		if existing, ok := current[row.Territory]; ok {
+			row.CurrentPrice = existing.CustomerPrice
+			row.currentPointID = existing.ID
+			row.ChangePercent = equalizeChangePercent(existing.CustomerPrice, row.NewPrice)
+			row.Status = equalizeStatusChanged
+			if existing.ID == row.PricePointID || equalizePriceEqual(existing.CustomerPrice, row.NewPrice) {
+				row.Status = equalizeStatusUnchanged
+			}
+		}
+		if row.Status != equalizeStatusUnchanged {
+			result.Changes++
+		}
+		result.Territories = append(result.Territories, row)
+	}
+
+	if !opts.apply || result.Changes == 0 {
+		return result, nil
+	}
+	if err := applyEqualize(ctx, client, opts, result); err != nil {
+		return nil, err
+	}
+	result.Applied = true
+	return result, nil
+}
+
+type equalizeTarget struct {
+	point  equalizePricePoint
+	target string
+}
+
+// equalizeTargets returns one price point per territory, sorted with the base territory first.
+func equalizeTargets(ctx context.Context, client equalizeClient, opts equalizeOptions, basePoint equalizePricePoint) ([]equalizeTarget, error) {
+	include := make(map[string]bool, len(opts.territories))
+	for _, territory := range opts.territories {
+		include[territory] = true
+	}
+	wanted := func(territory string) bool {
+		return len(include) == 0 || include[territory]
+	}
+
+	targets := []equalizeTarget{{point: basePoint}}
+	if opts.fxRates == nil {
+		points, err := listEqualizeEqualizations(ctx, client, opts.kind, basePoint.ID)
+		if err != nil {
+			return nil, fmt.Errorf("fetch equalizations: %w", err)
+		}
+		for _, point := range points {
+			if point.Territory == "" || point.Territory == opts.baseTerritory || !wanted(point.Territory) {
+				continue
+			}
+			targets = append(targets, equalizeTarget{point: point})
+		}
+	} else {
+		base, _ := strconv.ParseFloat(opts.basePrice, 64)
+		territories := make([]string, 0, len(opts.fxRates))
+		for territory := range opts.fxRates {
+			if territory != opts.baseTerritory && wanted(territory) {
+				territories = append(territories, territory)
+			}
+		}
+		sort.Strings(territories)
+		for _, territory := range territories {
+			value := base * opts.fxRates[territory]
+			points, err := listEqualizePricePoints(ctx, client, opts.kind, opts.productID, territory)
+			if err != nil {
+				return nil, fmt.Errorf("fetch %s price points: %w", territory, err)
+			}
+			point, ok := nearestEqualizePricePoint(points, value)
+			if !ok {
+				return nil, fmt.Errorf("no %s price points found", territory)
+			}
+			point.Territory = territory
+			targets = append(targets, equalizeTarget{point: point, target: strconv.FormatFloat(value, 'f', 2, 64)})
+		}
+	}
+
+	sort.SliceStable(targets[1:], func(i, j int) bool {
+		return targets[i+1].point.Territory < targets[j+1].point.Territory
+	})
+	return targets, nil
+}
+
+func applyEqualize(ctx context.Context, client equalizeClient, opts equalizeOptions, result *equalizeResult) error {
+	if opts.kind == "subscription" {
+		for _, row := range result.Territories {
+			if row.Status == equalizeStatusUnchanged {
+				continue
+			}
+			attrs := asc.SubscriptionPriceCreateAttributes{StartDate: opts.startDate}
+			if _, err := client.CreateSubscriptionPrice(ctx, opts.productID, row.PricePointID, row.Territory, attrs); err != nil {
+				return fmt.Errorf("set %s price: %w", row.Territory, err)
+			}
+		}
+		return nil
+	}
+
+	attrs := asc.InAppPurchasePriceScheduleCreateAttributes{BaseTerritoryID: opts.baseTerritory}
+	for _, row := range result.Territories {
+		if row.Status == equalizeStatusUnchanged {
+			attrs.Prices = append(attrs.Prices, asc.InAppPurchasePriceSchedulePrice{PricePointID: row.PricePointID})
+			continue
+		}
+		if opts.startDate == "" {
+			attrs.Prices = append(attrs.Prices, asc.InAppPurchasePriceSchedulePrice{PricePointID: row.PricePointID})
+			continue
+		}
+		if row.currentPointID != "" {
+			attrs.Prices = append(attrs.Prices,
+				asc.InAppPurchasePriceSchedulePrice{PricePointID: row.currentPointID, EndDate: opts.startDate},
+				asc.InAppPurchasePriceSchedulePrice{PricePointID: row.PricePointID, StartDate: opts.startDate},
+			)
+			continue
+		}
+		// A territory without a price today starts immediately so the base territory is never unpriced.
+		attrs.Prices = append(attrs.Prices, asc.InAppPurchasePriceSchedulePrice{PricePointID: row.PricePointID})
+	}
+
+	// Creating a schedule replaces every manual price, so territories left
+	// out by --territories keep their current and upcoming prices.
+	retained, err := retainedEqualizeIAPPrices(ctx, client, opts.productID, result.Territories)
+	if err != nil {
+		return fmt.Errorf("fetch current price schedule: %w", err)
+	}
+	attrs.Prices = append(attrs.Prices, retained...)
+	if _, err := client.CreateInAppPurchasePriceSchedule(ctx, opts.productID, attrs); err != nil {
+		return fmt.Errorf("create price schedule: %w", err)
+	}
+	return nil
+}
+
+// retainedEqualizeIAPPrices returns the unexpired manual prices of every
+// territory not covered by rows. Prices already in effect start immediately.
+func retainedEqualizeIAPPrices(ctx context.Context, client equalizeClient, productID string, rows []equalizeRow) ([]asc.InAppPurchasePriceSchedulePrice, error) {
+	covered := make(map[string]bool, len(rows))
+	for _, row := range rows {
+		covered[row.Territory] = true
+	}
+	scheduled, err := listEqualizeIAPManualPrices(ctx, client, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	today := time.Now().UTC().Format("2006-01-02")
+	var retained []asc.InAppPurchasePriceSchedulePrice
+	for _, price := range scheduled {
+		if price.Territory == "" || price.PricePointID == "" || covered[price.Territory] {
+			continue
+		}
+		if price.EndDate != "" && price.EndDate <= today {
+			continue
+		}
+		entry := asc.InAppPurchasePriceSchedulePrice{PricePointID: price.PricePointID, EndDate: price.EndDate}
+		if price.StartDate > today {
+			entry.StartDate = price.StartDate
+		}
+		retained = append(retained, entry)
+	}
+	return retained, nil
+}
+
+func listEqualizePricePoints(ctx context.Context, client equalizeClient, kind, productID, territory string) ([]equalizePricePoint, error) {
+	var points []equalizePricePoint
+	if kind == "iap" {
+		opts := []asc.IAPPricePointsOption{
+			asc.WithIAPPricePointsTerritory(territory),
+			asc.WithIAPPricePointsFields([]string{"customerPrice"}),
+			asc.WithIAPPricePointsLimit(8000),
+		}
+		for {
+			resp, err := client.GetInAppPurchasePricePoints(ctx, productID, opts...)
+			if err != nil {
+				return nil, err
+			}
+			for _, item := range resp.Data {
+				points = append(points, equalizePricePoint{ID: item.ID, Territory: territory, CustomerPrice: item.Attributes.CustomerPrice})
+			}
+			if strings.TrimSpace(resp.Links.Next) == "" {
+				return points, nil
+			}
+			opts = []asc.IAPPricePointsOption{asc.WithIAPPricePointsNextURL(resp.Links.Next)}
+		}
+	}
+
+	opts := []asc.SubscriptionPricePointsOption{
+		asc.WithSubscriptionPricePointsTerritory(territory),
+		asc.WithSubscriptionPricePointsLimit(200),
+	}
+	for {
+		resp, err := client.GetSubscriptionPricePoints(ctx, productID, opts...)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range resp.Data {
+			points = append(points, equalizePricePoint{ID: item.ID, Territory: territory, CustomerPrice: item.Attributes.CustomerPrice})
+		}
+		if strings.TrimSpace(resp.Links.Next) == "" {
+			return points, nil
+		}
+		opts = []asc.SubscriptionPricePointsOption{asc.WithSubscriptionPricePointsNextURL(resp.Links.Next)}
+	}
+}
+
+func listEqualizeEqualizations(ctx context.Context, client equalizeClient, kind, pricePointID string) ([]equalizePricePoint, error) {
+	var points []equalizePricePoint
+	if kind == "iap" {
+		opts := []asc.IAPPricePointsOption{
+			asc.WithIAPPricePointsFields([]string{"customerPrice", "territory"}),
+			asc.WithIAPPricePointsLimit(200),
+		}
+		for {
+			resp, err := client.GetInAppPurchasePricePointEqualizations(ctx, pricePointID, opts...)
+			if err != nil {
+				return nil, err
+			}
+			for _, item := range resp.Data {
+				points = append(points, equalizePricePoint{
+					ID:            item.ID,
+					Territory:     equalizePricePointTerritory(item.ID, item.Relationships),
+					CustomerPrice: item.Attributes.CustomerPrice,
+				})
+			}
+			if strings.TrimSpace(resp.Links.Next) == "" {
+				return points, nil
+			}
+			opts = []asc.IAPPricePointsOption{asc.WithIAPPricePointsNextURL(resp.Links.Next)}
+		}
+	}
+
+	opts := []asc.SubscriptionPricePointsOption{asc.WithSubscriptionPricePointsLimit(200)}
+	for {
+		resp, err := client.GetSubscriptionPricePointEqualizations(ctx, pricePointID, opts...)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range resp.Data {
+			points = append(points, equalizePricePoint{
+				ID:            item.ID,
+				Territory:     equalizePricePointTerritory(item.ID, item.Relationships),
+				CustomerPrice: item.Attributes.CustomerPrice,
+			})
+		}
+		if strings.TrimSpace(resp.Links.Next) == "" {
+			return points, nil
+		}
+		opts = []asc.SubscriptionPricePointsOption{asc.WithSubscriptionPricePointsNextURL(resp.Links.Next)}
+	}
+}
+
+// equalizeScheduledPrice is one manual price in an IAP price schedule.
+type equalizeScheduledPrice struct {
+	Territory     string
+	PricePointID  string
+	CustomerPrice string
+	StartDate     string
+	EndDate       string
+}
+
+// listEqualizeIAPManualPrices returns every manual price in the IAP's price
+// schedule, including past and future entries.
+func listEqualizeIAPManualPrices(ctx context.Context, client equalizeClient, productID string) ([]equalizeScheduledPrice, error) {
+	schedule, err := client.GetInAppPurchasePriceSchedule(ctx, productID)
+	if err != nil {
+		if asc.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	opts := []asc.IAPPriceSchedulePricesOption{
+		asc.WithIAPPriceSchedulePricesInclude([]string{"inAppPurchasePricePoint", "territory"}),
+		asc.WithIAPPriceSchedulePricesPricePointFields([]string{"customerPrice"}),
+		asc.WithIAPPriceSchedulePricesLimit(200),
+	}
+	var prices []equalizeScheduledPrice
+	for {
+		resp, err := client.GetInAppPurchasePriceScheduleManualPrices(ctx, schedule.Data.ID, opts...)
+		if err != nil {
+			return nil, err
+		}
+		values := equalizeIncludedPrices(resp.Included, string(asc.ResourceTypeInAppPurchasePricePoints))
+		for _, item := range resp.Data {
+			pointID := equalizeRelationshipID(item.Relationships, "inAppPurchasePricePoint")
+			prices = append(prices, equalizeScheduledPrice{
+				Territory:     strings.ToUpper(equalizeRelationshipID(item.Relationships, "territory")),
+				PricePointID:  pointID,
+				CustomerPrice: values[pointID],
+				StartDate:     strings.TrimSpace(item.Attributes.StartDate),
+				EndDate:       strings.TrimSpace(item.Attributes.EndDate),
+			})
+		}
+		if strings.TrimSpace(resp.Links.Next) == "" {
+			return prices, nil
+		}
+		opts = []asc.IAPPriceSchedulePricesOption{asc.WithIAPPriceSchedulePricesNextURL(resp.Links.Next)}
+	}
+}
+
+// currentEqualizePrices returns the price in effect today for each territory.
+func currentEqualizePrices(ctx context.Context, client equalizeClient, kind, productID string) (map[string]equalizePricePoint, error) {
+	prices := make(map[string]equalizePricePoint)
+	starts := make(map[string]string)
+	today := time.Now().UTC().Format("2006-01-02")
+	record := func(territory, pointID, price, start string) {
+		territory = strings.ToUpper(territory)
+		if territory == "" || pointID == "" {
+			return
+		}
+		if seen, ok := starts[territory]; ok && seen > start {
+			return
+		}
+		starts[territory] = start
+		prices[territory] = equalizePricePoint{ID: pointID, Territory: territory, CustomerPrice: price}
+	}
+
+	if kind == "iap" {
+		scheduled, err := listEqualizeIAPManualPrices(ctx, client, productID)
+		if err != nil {
+			return nil, err
+		}
+		for _, price := range scheduled {
+			if price.StartDate > today || (price.EndDate != "" && price.EndDate <= today) {
+				continue
+			}
+			record(price.Territory, price.PricePointID, price.CustomerPrice, price.StartDate)
+		}
+		return prices, nil
+	}
+
+	opts := []asc.SubscriptionPricesOption{
+		asc.WithSubscriptionPricesInclude([]string{"subscriptionPricePoint", "territory"}),
+		asc.WithSubscriptionPricesPricePointFields([]string{"customerPrice"}),
+		asc.WithSubscriptionPricesLimit(200),
+	}
+	for {
+		resp, err := client.GetSubscriptionPrices(ctx, productID, opts...)
+		if err != nil {
+			return nil, err
+		}
+		values := equalizeIncludedPrices(resp.Included, string(asc.ResourceTypeSubscriptionPricePoints))
+		for _, item := range resp.Data {
+			start := strings.TrimSpace(item.Attributes.StartDate)
+			if start > today {
+				continue
+			}
+			pointID := equalizeRelationshipID(item.Relationships, "subscriptionPricePoint")
+			record(equalizeRelationshipID(item.Relationships, "territory"), pointID, values[pointID], start)
+		}
+		if strings.TrimSpace(resp.Links.Next) == "" {
+			return prices, nil
+		}
+		opts = []asc.SubscriptionPricesOption{asc.WithSubscriptionPricesNextURL(resp.Links.Next)}
+	}
+}
+
+func readEqualizeFXFile(path string) (map[string]float64, error) {
+	file, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return nil, fmt.Errorf("read fx file: %w", err)
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.Comment = '#'
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("fx file %s is empty", path)
+		}
+		return nil, fmt.Errorf("parse fx file: %w", err)
+	}
+	territoryIdx, rateIdx := -1, -1
+	for i, column := range header {
+		switch strings.ToLower(strings.TrimSpace(column)) {
+		case "territory":
+			territoryIdx = i
+		case "rate":
+			rateIdx = i
+		}
+	}
+	if territoryIdx < 0 || rateIdx < 0 {
+		return nil, fmt.Errorf("fx file %s must have territory and rate columns", path)
+	}
+
+	rates := make(map[string]float64)
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parse fx file: %w", err)
+		}
+		territory := strings.ToUpper(strings.TrimSpace(record[territoryIdx]))
+		if len(territory) != 3 {
+			return nil, fmt.Errorf("fx file line %d: invalid territory %q", line, record[territoryIdx])
+		}
+		rate, err := strconv.ParseFloat(strings.TrimSpace(record[rateIdx]), 64)
+		if err != nil || rate <= 0 {
+			return nil, fmt.Errorf("fx file line %d: rate must be a positive number", line)
+		}
+		if _, exists := rates[territory]; exists {
+			return nil, fmt.Errorf("fx file line %d: duplicate territory %s", line, territory)
+		}
+		rates[territory] = rate
+	}
+	if len(rates) == 0 {
+		return nil, fmt.Errorf("fx file %s has no rates", path)
+	}
+	return rates, nil
+}
+
+func exactEqualizePricePoint(points []equalizePricePoint, price string) (equalizePricePoint, bool) {
+	for _, point := range points {
+		if equalizePriceEqual(point.CustomerPrice, price) {
+			return point, true
+		}
+	}
+	return equalizePricePoint{}, false
+}
+
+// nearestEqualizePricePoint picks the price point closest to target, preferring the lower one on ties.
+func nearestEqualizePricePoint(points []equalizePricePoint, target float64) (equalizePricePoint, bool) {
+	var best equalizePricePoint
+	bestDistance, bestValue := math.Inf(1), math.Inf(1)
+	for _, point := range points {
+		value, err := strconv.ParseFloat(strings.TrimSpace(point.CustomerPrice), 64)
+		if err != nil {
+			continue
+		}
+		distance := math.Abs(value - target)
+		if distance < bestDistance || (distance == bestDistance && value < bestValue) {
+			best, bestDistance, bestValue = point, distance, value
+		}
+	}
+	return best, !math.IsInf(bestDistance, 1)
+}
+
+func equalizePriceEqual(a, b string) bool {
+	left, errLeft := strconv.ParseFloat(strings.TrimSpace(a), 64)
+	right, errRight := strconv.ParseFloat(strings.TrimSpace(b), 64)
+	if errLeft != nil || errRight != nil {
+		return strings.TrimSpace(a) == strings.TrimSpace(b)
+	}
+	return math.Abs(left-right) < 0.0001
+}
+
+func equalizeChangePercent(current, next string) string {
+	from, errFrom := strconv.ParseFloat(strings.TrimSpace(current), 64)
+	to, errTo := strconv.ParseFloat(strings.TrimSpace(next), 64)
+	if errFrom != nil || errTo != nil || from == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%+.1f%%", (to-from)/from*100)
+}
+
+// equalizePricePointTerritory reads the territory from the relationship or, failing that, the encoded price point ID.
+func equalizePricePointTerritory(id string, relationships json.RawMessage) string {
+	if territory := equalizeRelationshipID(relationships, "territory"); territory != "" {
+		return strings.ToUpper(territory)
+	}
+	decoded, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(strings.TrimSpace(id), "="))
+	if err != nil {
+		return ""
+	}
+	var payload struct {
+		Territory string `json:"t"`
+	}
+	if err := json.Unmarshal(decoded, &payload); err != nil {
+		return ""
+	}
+	return strings.ToUpper(strings.TrimSpace(payload.Territory))
+}
+
+func equalizeRelationshipID(raw json.RawMessage, key string) string {
+	if len(raw) == 0 {
+		return ""
+	}
+	var relationships map[string]struct {
+		Data *asc.ResourceData `json:"data"`
+	}
+	if err := json.Unmarshal(raw, &relationships); err != nil {
+		return ""
+	}
+	if rel, ok := relationships[key]; ok && rel.Data != nil {
+		return strings.TrimSpace(rel.Data.ID)
+	}
+	return ""
+}
+
+func equalizeIncludedPrices(raw json.RawMessage, resourceType string) map[string]string {
+	values := make(map[string]string)
+	if len(raw) == 0 {
+		return values
+	}
+	var included []struct {
+		Type       string `json:"type"`
+		ID         string `json:"id"`
+		Attributes struct {
+			CustomerPrice string `json:"customerPrice"`
+		} `json:"attributes"`
+	}
+	if err := json.Unmarshal(raw, &included); err != nil {
+		return values
+	}
+	for _, item := range included {
+		if item.Type == resourceType {
+			values[item.ID] = strings.TrimSpace(item.Attributes.CustomerPrice)
+		}
+	}
+	return values
+}
+
+func equalizeStatusLine(result *equalizeResult) string {
+	switch {
+	case result.Applied:
+		return fmt.Sprintf("applied %d change(s)", result.Changes)
+	case result.Changes == 0:
+		return "no changes"
+	default:
+		return fmt.Sprintf("%d change(s) (preview, use --apply)", result.Changes)
+	}
+}
+
+func equalizeRows(result *equalizeResult) [][]string {
+	rows := make([][]string, 0, len(result.Territories))
+	for _, row := range result.Territories {
+		rows = append(rows, []string{
+			row.Territory,
+			shared.OrNA(row.CurrentPrice),
+			row.NewPrice,
+			shared.OrNA(row.ChangePercent),
+			shared.OrNA(row.TargetPrice),
+			row.Status,
+		})
+	}
+	return rows
+}
+
+func printEqualizeTable(result *equalizeResult) error {
+	fmt.Printf("Product: %s %s\n", result.ProductType, result.ProductID)
+	fmt.Printf("Base: %s %s (%s)\n", result.BaseTerritory, result.BasePrice, result.Source)
+	fmt.Printf("Start Date: %s\n", shared.OrNA(result.StartDate))
+	fmt.Printf("Status: %s\n\n", equalizeStatusLine(result))
+	asc.RenderTable([]string{"Territory", "Current", "New", "Change", "Target", "Status"}, equalizeRows(result))
+	return nil
+}
+
+func printEqualizeMarkdown(result *equalizeResult) error {
+	fmt.Printf("**Product:** %s %s\n\n", result.ProductType, result.ProductID)
+	fmt.Printf("**Base:** %s %s (%s)\n\n", result.BaseTerritory, result.BasePrice, result.Source)
+	fmt.Printf("**Start Date:** %s\n\n", shared.OrNA(result.StartDate))
+	fmt.Printf("**Status:** %s\n\n", equalizeStatusLine(result))
+	asc.RenderMarkdown([]string{"Territory", "Current", "New", "Change", "Target", "Status"}, equalizeRows(result))
+	return nil
+}
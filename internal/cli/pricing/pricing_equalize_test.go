@@ -0,0 +1,370 @@
+package pricing
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+type equalizeStub struct {
+	// pointPages is returned in order, one page per price point list call.
+	pointPages   [][]equalizePricePoint
+	equalized    []equalizePricePoint
+	subPrices    *asc.SubscriptionPricesResponse
+	iapPrices    *asc.InAppPurchasePricesResponse
+	calls        []string
+	scheduleCall *asc.InAppPurchasePriceScheduleCreateAttributes
+}
+
+func (s *equalizeStub) nextPage() []equalizePricePoint {
+	if len(s.pointPages) == 0 {
+		return nil
+	}
+	page := s.pointPages[0]
+	s.pointPages = s.pointPages[1:]
+	return page
+}
+
+func (s *equalizeStub) GetInAppPurchasePricePoints(ctx context.Context, iapID string, opts ...asc.IAPPricePointsOption) (*asc.InAppPurchasePricePointsResponse, error) {
+	resp := &asc.InAppPurchasePricePointsResponse{}
+	for _, point := range s.nextPage() {
+		resp.Data = append(resp.Data, asc.Resource[asc.InAppPurchasePricePointAttributes]{ID: point.ID, Attributes: asc.InAppPurchasePricePointAttributes{CustomerPrice: point.CustomerPrice}})
+	}
+	return resp, nil
+}
+
+func (s *equalizeStub) GetInAppPurchasePricePointEqualizations(ctx context.Context, pricePointID string, opts ...asc.IAPPricePointsOption) (*asc.InAppPurchasePricePointsResponse, error) {
+	resp := &asc.InAppPurchasePricePointsResponse{}
+	for _, point := range s.equalized {
+		resp.Data = append(resp.Data, asc.Resource[asc.InAppPurchasePricePointAttributes]{ID: point.ID, Attributes: asc.InAppPurchasePricePointAttributes{CustomerPrice: point.CustomerPrice}})
+	}
+	return resp, nil
+}
+
+func (s *equalizeStub) GetInAppPurchasePriceSchedule(ctx context.Context, iapID string, opts ...asc.IAPPriceScheduleOption) (*asc.InAppPurchasePriceScheduleResponse, error) {
+	if s.iapPrices == nil {
+		return nil, asc.ErrNotFound
+	}
+	return &asc.InAppPurchasePriceScheduleResponse{Data: asc.Resource[asc.InAppPurchasePriceScheduleAttributes]{ID: "schedule-1"}}, nil
+}
+
+func (s *equalizeStub) GetInAppPurchasePriceScheduleManualPrices(ctx context.Context, scheduleID string, opts ...asc.IAPPriceSchedulePricesOption) (*asc.InAppPurchasePricesResponse, error) {
+	return s.iapPrices, nil
+}
+
+func (s *equalizeStub) CreateInAppPurchasePriceSchedule(ctx context.Context, iapID string, attrs asc.InAppPurchasePriceScheduleCreateAttributes) (*asc.InAppPurchasePriceScheduleResponse, error) {
+	s.scheduleCall = &attrs
+	return &asc.InAppPurchasePriceScheduleResponse{}, nil
+}
+
+func (s *equalizeStub) GetSubscriptionPricePoints(ctx context.Context, subscriptionID string, opts ...asc.SubscriptionPricePointsOption) (*asc.SubscriptionPricePointsResponse, error) {
+	resp := &asc.SubscriptionPricePointsResponse{}
+	for _, point := range s.nextPage() {
+		resp.Data = append(resp.Data, asc.Resource[asc.SubscriptionPricePointAttributes]{ID: point.ID, Attributes: asc.SubscriptionPricePointAttributes{CustomerPrice: point.CustomerPrice}})
+	}
+	return resp, nil
+}
+
+func (s *equalizeStub) GetSubscriptionPricePointEqualizations(ctx context.Context, pricePointID string, opts ...asc.SubscriptionPricePointsOption) (*asc.SubscriptionPricePointsResponse, error) {
+	resp := &asc.SubscriptionPricePointsResponse{}
+	for _, point := range s.equalized {
+		resp.Data = append(resp.Data, asc.Resource[asc.SubscriptionPricePointAttributes]{ID: point.ID, Attributes: asc.SubscriptionPricePointAttributes{CustomerPrice: point.CustomerPrice}})
+	}
+	return resp, nil
+}
+
+func (s *equalizeStub) GetSubscriptionPrices(ctx context.Context, subscriptionID string, opts ...asc.SubscriptionPricesOption) (*asc.SubscriptionPricesResponse, error) {
+	if s.subPrices == nil {
+		return &asc.SubscriptionPricesResponse{}, nil
+	}
+	return s.subPrices, nil
+}
+
+func (s *equalizeStub) CreateSubscriptionPrice(ctx context.Context, subID, pricePointID, territoryID string, attrs asc.SubscriptionPriceCreateAttributes) (*asc.SubscriptionPriceResponse, error) {
+	s.calls = append(s.calls, fmt.Sprintf("%s %s %s", territoryID, pricePointID, attrs.StartDate))
+	return &asc.SubscriptionPriceResponse{}, nil
+}
+
+// testPricePointID builds an ID shaped like App Store Connect's encoded price point IDs.
+func testPricePointID(territory, tier string) string {
+	payload, _ := json.Marshal(map[string]string{"s": "product", "t": territory, "p": tier})
+	return base64.RawURLEncoding.EncodeToString(payload)
+}
+
+func testPricePoint(territory, tier, price string) equalizePricePoint {
+	return equalizePricePoint{ID: testPricePointID(territory, tier), Territory: territory, CustomerPrice: price}
+}
+
+func testRelationships(t *testing.T, refs map[string]string) json.RawMessage {
+	t.Helper()
+	relationships := make(map[string]any, len(refs))
+	for key, id := range refs {
+		relationships[key] = map[string]any{"data": map[string]string{"type": key, "id": id}}
+	}
+	data, err := json.Marshal(relationships)
+	if err != nil {
+		t.Fatalf("marshal relationships: %v", err)
+	}
+	return data
+}
+
+func TestPricingEqualizeCommand_MissingFlags(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+	}{
+		{name: "missing product", args: []string{"--base-price", "9.99"}},
+		{name: "both products", args: []string{"--subscription-id", "sub-1", "--iap-id", "iap-1", "--base-price", "9.99"}},
+		{name: "missing base price", args: []string{"--subscription-id", "sub-1"}},
+		{name: "invalid base price", args: []string{"--subscription-id", "sub-1", "--base-price", "free"}},
+		{name: "invalid start date", args: []string{"--subscription-id", "sub-1", "--base-price", "9.99", "--start-date", "03/01/2026"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cmd := PricingEqualizeCommand()
+			if err := cmd.FlagSet.Parse(test.args); err != nil {
+				t.Fatalf("failed to parse flags: %v", err)
+			}
+			if err := cmd.Exec(context.Background(), []string{}); !errors.Is(err, flag.ErrHelp) {
+				t.Fatalf("expected flag.ErrHelp, got %v", err)
+			}
+		})
+	}
+}
+
+func TestRunEqualize_SubscriptionAppleEqualization(t *testing.T) {
+	usa := testPricePoint("USA", "10099", "9.99")
+	gbrOld := testPricePoint("GBR", "10080", "7.99")
+	gbr := testPricePoint("GBR", "10099", "9.99")
+	jpn := testPricePoint("JPN", "10099", "1500")
+	stub := &equalizeStub{
+		pointPages: [][]equalizePricePoint{{testPricePoint("USA", "10089", "8.99"), usa}},
+		equalized:  []equalizePricePoint{jpn, gbr},
+		subPrices: &asc.SubscriptionPricesResponse{
+			Data: []asc.Resource[asc.SubscriptionPriceAttributes]{
+				{ID: "price-usa", Attributes: asc.SubscriptionPriceAttributes{StartDate: "2024-01-01"}, Relationships: testRelationships(t, map[string]string{"territory": "USA", "subscriptionPricePoint": usa.ID})},
+				{ID: "price-gbr", Attributes: asc.SubscriptionPriceAttributes{StartDate: "2024-01-01"}, Relationships: testRelationships(t, map[string]string{"territory": "GBR", "subscriptionPricePoint": gbrOld.ID})},
+			},
+			Included: json.RawMessage(fmt.Sprintf(`[{"type":"subscriptionPricePoints","id":%q,"attributes":{"customerPrice":"9.99"}},{"type":"subscriptionPricePoints","id":%q,"attributes":{"customerPrice":"7.99"}}]`, usa.ID, gbrOld.ID)),
+		},
+	}
+
+	result, err := runEqualize(context.Background(), stub, equalizeOptions{
+		kind:          "subscription",
+		productID:     "sub-1",
+		baseTerritory: "USA",
+		basePrice:     "9.99",
+		startDate:     "2026-03-01",
+		apply:         true,
+	})
+	if err != nil {
+		t.Fatalf("runEqualize() error: %v", err)
+	}
+
+	var got []string
+	for _, row := range result.Territories {
+		got = append(got, strings.Join([]string{row.Territory, row.CurrentPrice, row.NewPrice, row.ChangePercent, row.Status}, "|"))
+	}
+	want := []string{
+		"USA|9.99|9.99|+0.0%|unchanged",
+		"GBR|7.99|9.99|+25.0%|changed",
+		"JPN||1500||new",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("rows = %v, want %v", got, want)
+	}
+	if result.Changes != 2 || !result.Applied || result.BasePricePointID != usa.ID {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+
+	wantCalls := []string{
+		"GBR " + gbr.ID + " 2026-03-01",
+		"JPN " + jpn.ID + " 2026-03-01",
+	}
+	if !reflect.DeepEqual(stub.calls, wantCalls) {
+		t.Fatalf("calls = %v, want %v", stub.calls, wantCalls)
+	}
+}
+
+func TestRunEqualize_FXFilePicksNearestPricePoint(t *testing.T) {
+	stub := &equalizeStub{
+		pointPages: [][]equalizePricePoint{
+			{testPricePoint("USA", "10099", "9.99")},
+			{testPricePoint("IND", "1", "399"), testPricePoint("IND", "2", "449"), testPricePoint("IND", "3", "499")},
+			{testPricePoint("TUR", "1", "99.99"), testPricePoint("TUR", "2", "149.99")},
+		},
+	}
+
+	result, err := runEqualize(context.Background(), stub, equalizeOptions{
+		kind:          "iap",
+		productID:     "iap-1",
+		baseTerritory: "USA",
+		basePrice:     "9.99",
+		fxRates:       map[string]float64{"USA": 1, "IND": 42, "TUR": 15},
+	})
+	if err != nil {
+		t.Fatalf("runEqualize() error: %v", err)
+	}
+
+	var got []string
+	for _, row := range result.Territories {
+		got = append(got, row.Territory+"|"+row.TargetPrice+"|"+row.NewPrice)
+	}
+	want := []string{"USA||9.99", "IND|419.58|399", "TUR|149.85|149.99"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("rows = %v, want %v", got, want)
+	}
+	if result.Source != equalizeSourceFXFile || result.Applied || stub.scheduleCall != nil {
+		t.Fatalf("expected preview only, got %+v", result)
+	}
+}
+
+func TestRunEqualize_IAPApplyKeepsCurrentPriceUntilStartDate(t *testing.T) {
+	usaOld := testPricePoint("USA", "10049", "4.99")
+	usa := testPricePoint("USA", "10099", "9.99")
+	gbr := testPricePoint("GBR", "10099", "9.99")
+	stub := &equalizeStub{
+		pointPages: [][]equalizePricePoint{{usaOld, usa}},
+		equalized:  []equalizePricePoint{gbr},
+		iapPrices: &asc.InAppPurchasePricesResponse{
+			Data: []asc.Resource[asc.InAppPurchasePriceAttributes]{
+				{ID: "price-usa", Attributes: asc.InAppPurchasePriceAttributes{Manual: true}, Relationships: testRelationships(t, map[string]string{"territory": "USA", "inAppPurchasePricePoint": usaOld.ID})},
+			},
+			Included: json.RawMessage(fmt.Sprintf(`[{"type":"inAppPurchasePricePoints","id":%q,"attributes":{"customerPrice":"4.99"}}]`, usaOld.ID)),
+		},
+	}
+
+	result, err := runEqualize(context.Background(), stub, equalizeOptions{
+		kind:          "iap",
+		productID:     "iap-1",
+		baseTerritory: "USA",
+		basePrice:     "9.99",
+		startDate:     "2026-03-01",
+		apply:         true,
+	})
+	if err != nil {
+		t.Fatalf("runEqualize() error: %v", err)
+	}
+	if !result.Applied || stub.scheduleCall == nil {
+		t.Fatalf("expected schedule to be created, got %+v", result)
+	}
+
+	want := asc.InAppPurchasePriceScheduleCreateAttributes{
+		BaseTerritoryID: "USA",
+		Prices: []asc.InAppPurchasePriceSchedulePrice{
+			{PricePointID: usaOld.ID, EndDate: "2026-03-01"},
+			{PricePointID: usa.ID, StartDate: "2026-03-01"},
+			{PricePointID: gbr.ID},
+		},
+	}
+	if !reflect.DeepEqual(*stub.scheduleCall, want) {
+		t.Fatalf("schedule = %+v, want %+v", *stub.scheduleCall, want)
+	}
+}
+
+func TestRunEqualize_IAPApplyWithTerritoriesKeepsUnlistedTerritories(t *testing.T) {
+	usa := testPricePoint("USA", "10099", "9.99")
+	gbr := testPricePoint("GBR", "10099", "9.99")
+	gbrOld := testPricePoint("GBR", "10049", "4.99")
+	jpn := testPricePoint("JPN", "10049", "700")
+	fra := testPricePoint("FRA", "10049", "5.99")
+	deu := testPricePoint("DEU", "10049", "5.99")
+	stub := &equalizeStub{
+		pointPages: [][]equalizePricePoint{{usa}},
+		equalized:  []equalizePricePoint{gbr},
+		iapPrices: &asc.InAppPurchasePricesResponse{
+			Data: []asc.Resource[asc.InAppPurchasePriceAttributes]{
+				{ID: "price-usa", Attributes: asc.InAppPurchasePriceAttributes{Manual: true}, Relationships: testRelationships(t, map[string]string{"territory": "USA", "inAppPurchasePricePoint": usa.ID})},
+				{ID: "price-gbr", Attributes: asc.InAppPurchasePriceAttributes{Manual: true}, Relationships: testRelationships(t, map[string]string{"territory": "GBR", "inAppPurchasePricePoint": gbrOld.ID})},
+				{ID: "price-jpn", Attributes: asc.InAppPurchasePriceAttributes{Manual: true, StartDate: "2020-01-01"}, Relationships: testRelationships(t, map[string]string{"territory": "JPN", "inAppPurchasePricePoint": jpn.ID})},
+				{ID: "price-fra", Attributes: asc.InAppPurchasePriceAttributes{Manual: true, StartDate: "2999-01-01"}, Relationships: testRelationships(t, map[string]string{"territory": "FRA", "inAppPurchasePricePoint": fra.ID})},
+				{ID: "price-deu", Attributes: asc.InAppPurchasePriceAttributes{Manual: true, EndDate: "2020-01-01"}, Relationships: testRelationships(t, map[string]string{"territory": "DEU", "inAppPurchasePricePoint": deu.ID})},
+			},
+		},
+	}
+
+	_, err := runEqualize(context.Background(), stub, equalizeOptions{
+		kind:          "iap",
+		productID:     "iap-1",
+		baseTerritory: "USA",
+		basePrice:     "9.99",
+		territories:   []string{"GBR"},
+		apply:         true,
+	})
+	if err != nil {
+		t.Fatalf("runEqualize() error: %v", err)
+	}
+	if stub.scheduleCall == nil {
+		t.Fatal("expected schedule to be created")
+	}
+
+	want := asc.InAppPurchasePriceScheduleCreateAttributes{
+		BaseTerritoryID: "USA",
+		Prices: []asc.InAppPurchasePriceSchedulePrice{
+			{PricePointID: usa.ID},
+			{PricePointID: gbr.ID},
+			{PricePointID: jpn.ID},
+			{PricePointID: fra.ID, StartDate: "2999-01-01"},
+		},
+	}
+	if !reflect.DeepEqual(*stub.scheduleCall, want) {
+		t.Fatalf("schedule = %+v, want %+v", *stub.scheduleCall, want)
+	}
+}
+
+func TestRunEqualize_BasePriceMustMatchPricePoint(t *testing.T) {
+	stub := &equalizeStub{
+		pointPages: [][]equalizePricePoint{{testPricePoint("USA", "1", "9.99"), testPricePoint("USA", "2", "10.99")}},
+	}
+
+	_, err := runEqualize(context.Background(), stub, equalizeOptions{
+		kind:          "subscription",
+		productID:     "sub-1",
+		baseTerritory: "USA",
+		basePrice:     "10.49",
+	})
+	if err == nil || !strings.Contains(err.Error(), "nearest is 9.99") {
+		t.Fatalf("expected nearest price hint, got %v", err)
+	}
+}
+
+func TestReadEqualizeFXFile(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatalf("write fx file: %v", err)
+		}
+		return path
+	}
+
+	rates, err := readEqualizeFXFile(write("ok.csv", "# PPP index\nterritory,rate\nind, 42\ngbr,0.79\n"))
+	if err != nil {
+		t.Fatalf("readEqualizeFXFile() error: %v", err)
+	}
+	if !reflect.DeepEqual(rates, map[string]float64{"IND": 42, "GBR": 0.79}) {
+		t.Fatalf("unexpected rates: %v", rates)
+	}
+
+	for name, content := range map[string]string{
+		"header.csv":    "country,value\nIND,42\n",
+		"rate.csv":      "territory,rate\nIND,-1\n",
+		"duplicate.csv": "territory,rate\nIND,42\nind,40\n",
+		"empty.csv":     "",
+	} {
+		if _, err := readEqualizeFXFile(write(name, content)); err == nil {
+			t.Fatalf("expected error for %s", name)
+		}
+	}
+}
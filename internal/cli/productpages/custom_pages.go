@@ -50,7 +50,7 @@ Examples:
 func CustomPagesListCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("custom-pages list", flag.ExitOnError)
 
-	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID)")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (or ASC_APP_ID)")
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate := fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
@@ -168,7 +168,7 @@ Examples:
 func CustomPagesCreateCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("custom-pages create", flag.ExitOnError)
 
-	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID)")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (or ASC_APP_ID)")
 	name := fs.String("name", "", "Custom product page name")
 	output := shared.BindOutputFlags(fs)
 
@@ -28,7 +28,9 @@ Examples:
   asc product-pages experiments list --version-id "VERSION_ID"
   asc product-pages experiments list --v2 --app "APP_ID"
   asc product-pages experiments create --version-id "VERSION_ID" --name "Icon Test" --traffic-proportion 25
-  asc product-pages experiments create --v2 --app "APP_ID" --platform IOS --name "Icon Test" --traffic-proportion 25`,
+  asc product-pages experiments create --v2 --app "APP_ID" --platform IOS --name "Icon Test" --traffic-proportion 25
+  asc product-pages experiments report --experiment-id "EXPERIMENT_ID" --app "APP_ID"
+  asc product-pages experiments conclude --experiment-id "EXPERIMENT_ID" --app "APP_ID" --apply-winner --version-id "VERSION_ID" --confirm`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
@@ -37,6 +39,8 @@ Examples:
 			ExperimentsCreateCommand(),
 			ExperimentsUpdateCommand(),
 			ExperimentsDeleteCommand(),
+			ExperimentsReportCommand(),
+			ExperimentsConcludeCommand(),
 			ExperimentTreatmentsCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
@@ -49,8 +53,8 @@ Examples:
 func ExperimentsListCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("experiments list", flag.ExitOnError)
 
-	versionID := fs.String("version-id", "", "App Store version ID (v1 experiments)")
-	appID := fs.String("app", "", "App Store Connect app ID (v2 experiments)")
+	versionID := shared.ReferenceFlag(fs, shared.ReferenceVersionID, "", "App Store version ID or version string like 1.2.3 (v1 experiments)")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (v2 experiments)")
 	state := fs.String("state", "", "Filter by state(s), comma-separated")
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
@@ -234,8 +238,8 @@ Examples:
 func ExperimentsCreateCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("experiments create", flag.ExitOnError)
 
-	versionID := fs.String("version-id", "", "App Store version ID (v1 experiments)")
-	appID := fs.String("app", "", "App Store Connect app ID (v2 experiments)")
+	versionID := shared.ReferenceFlag(fs, shared.ReferenceVersionID, "", "App Store version ID or version string like 1.2.3 (v1 experiments)")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (v2 experiments)")
 	platform := fs.String("platform", "", "Platform: IOS, MAC_OS, TV_OS, VISION_OS (v2 experiments)")
 	name := fs.String("name", "", "Experiment name")
 	trafficProportion := fs.String("traffic-proportion", "", "Traffic proportion (integer)")
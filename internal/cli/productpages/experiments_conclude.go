@@ -0,0 +1,547 @@
+package productpages
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/assets"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	experimentMediaScreenshots = "screenshots"
+	experimentMediaPreviews    = "previews"
+
+	experimentDefaultMinConfidence = 95.0
+)
+
+// experimentFinishedStates are experiment states that no longer need stopping.
+var experimentFinishedStates = map[string]struct{}{
+	"COMPLETED": {},
+	"STOPPED":   {},
+}
+
+type experimentConclusion struct {
+	ExperimentID  string                `json:"experimentId"`
+	WinnerID      string                `json:"winnerTreatmentId,omitempty"`
+	WinnerName    string                `json:"winnerName,omitempty"`
+	Improvement   *float64              `json:"improvement,omitempty"`
+	Confidence    *float64              `json:"confidence,omitempty"`
+	VersionID     string                `json:"versionId,omitempty"`
+	Stopped       bool                  `json:"stopped"`
+	Copied        []experimentMediaCopy `json:"copied,omitempty"`
+	Failed        *experimentMediaCopy  `json:"failed,omitempty"`
+	Error         string                `json:"error,omitempty"`
+	SkippedLocale []string              `json:"skippedLocales,omitempty"`
+	Report        *experimentReport     `json:"report,omitempty"`
+}
+
+// experimentMediaCopy records one media set copied from the winning
+// treatment onto the default product page.
+type experimentMediaCopy struct {
+	Locale      string `json:"locale"`
+	Media       string `json:"media"`
+	Type        string `json:"type"`
+	SourceSetID string `json:"sourceSetId"`
+	TargetSetID string `json:"targetSetId"`
+	Count       int    `json:"count"`
+}
+
+// ExperimentsConcludeCommand returns the experiments conclude subcommand.
+func ExperimentsConcludeCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("experiments conclude", flag.ExitOnError)
+
+	experimentID := fs.String("experiment-id", "", "Experiment ID")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name for analytics (or ASC_APP_ID env)")
+	versionID := shared.ReferenceFlag(fs, shared.ReferenceVersionID, "", "App Store version ID or version string like 1.2.3 whose default product page receives the winner (required with --apply-winner)")
+	treatmentID := fs.String("treatment-id", "", "Winning treatment ID (skips analytics-based selection)")
+	minConfidence := fs.Float64("min-confidence", experimentDefaultMinConfidence, "Minimum confidence percentage for an analytics-selected winner")
+	applyWinner := fs.Bool("apply-winner", false, "Copy the winning treatment's screenshots and previews onto the default product page")
+	confirm := fs.Bool("confirm", false, "Confirm concluding the experiment")
+	v2 := fs.Bool("v2", false, "Use v2 experiments endpoint")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "conclude",
+		ShortUsage: "asc product-pages experiments conclude --experiment-id \"EXPERIMENT_ID\" [--apply-winner --version-id \"VERSION_ID\"] --confirm [flags]",
+		ShortHelp:  "Stop an experiment and optionally promote the winning treatment.",
+		LongHelp: `Stop an experiment and optionally promote the winning treatment.
+
+The winner is the treatment with the highest improvement over the original
+product page whose confidence reaches --min-confidence, as computed by
+"asc product-pages experiments report". Pass --treatment-id to choose it
+yourself.
+
+With --apply-winner, each of the winner's localized screenshot and preview
+sets replaces the matching set on the App Store version's default product
+page. Locales without a version localization are skipped. The experiment is
+stopped after the media is copied. If a set fails to copy, the sets already
+replaced and the one that failed are reported, and the experiment keeps
+running.
+
+Examples:
+  asc product-pages experiments conclude --experiment-id "EXPERIMENT_ID" --app "APP_ID" --confirm
+  asc product-pages experiments conclude --experiment-id "EXPERIMENT_ID" --app "APP_ID" --apply-winner --version-id "VERSION_ID" --confirm
+  asc product-pages experiments conclude --experiment-id "EXPERIMENT_ID" --treatment-id "TREATMENT_ID" --apply-winner --version-id "VERSION_ID" --confirm --v2`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			trimmedID := strings.TrimSpace(*experimentID)
+			if trimmedID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --experiment-id is required")
+				return flag.ErrHelp
+			}
+			trimmedTreatmentID := strings.TrimSpace(*treatmentID)
+			resolvedAppID := shared.ResolveAppID(*appID)
+			if trimmedTreatmentID == "" && resolvedAppID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --app is required to select a winner from analytics (or pass --treatment-id)")
+				return flag.ErrHelp
+			}
+			trimmedVersionID := strings.TrimSpace(*versionID)
+			if *applyWinner && trimmedVersionID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --version-id is required with --apply-winner")
+				return flag.ErrHelp
+			}
+			if *minConfidence < 0 || *minConfidence > 100 {
+				fmt.Fprintln(os.Stderr, "Error: --min-confidence must be between 0 and 100")
+				return flag.ErrHelp
+			}
+			if !*confirm {
+				fmt.Fprintln(os.Stderr, "Error: --confirm is required")
+				return flag.ErrHelp
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("experiments conclude: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			report, err := collectExperimentReport(requestCtx, client, resolvedAppID, trimmedID, *v2)
+			cancel()
+			if err != nil {
+				return fmt.Errorf("experiments conclude: %w", err)
+			}
+
+			winner, err := selectExperimentWinner(report, trimmedTreatmentID, *minConfidence)
+			if err != nil {
+				return fmt.Errorf("experiments conclude: %w", err)
+			}
+
+			result := &experimentConclusion{
+				ExperimentID: report.ExperimentID,
+				WinnerID:     winner.ID,
+				WinnerName:   winner.Name,
+				Improvement:  winner.Improvement,
+				Confidence:   winner.Confidence,
+				Report:       report,
+			}
+
+			if *applyWinner {
+				result.VersionID = trimmedVersionID
+				if err := applyExperimentWinner(ctx, client, winner.ID, trimmedVersionID, result); err != nil {
+					if result.Failed == nil {
+						return fmt.Errorf("experiments conclude: %w", err)
+					}
+					// The live page was partially replaced; show exactly which
+					// sets changed before failing. The experiment keeps running.
+					result.Error = err.Error()
+					if printErr := printExperimentConclusion(result, *output.Output, *output.Pretty); printErr != nil {
+						return printErr
+					}
+					return shared.NewReportedError(fmt.Errorf("experiments conclude: %w", err))
+				}
+			}
+
+			if _, finished := experimentFinishedStates[strings.ToUpper(report.State)]; !finished {
+				requestCtx, cancel := shared.ContextWithTimeout(ctx)
+				err := stopExperiment(requestCtx, client, trimmedID, *v2)
+				cancel()
+				if err != nil {
+					err = fmt.Errorf("failed to stop experiment: %w", err)
+					if !*applyWinner {
+						return fmt.Errorf("experiments conclude: %w", err)
+					}
+					// The winner is already live; report what was replaced
+					// so a retry only needs to stop the experiment.
+					result.Error = err.Error()
+					if printErr := printExperimentConclusion(result, *output.Output, *output.Pretty); printErr != nil {
+						return printErr
+					}
+					return shared.NewReportedError(fmt.Errorf("experiments conclude: %w", err))
+				}
+				result.Stopped = true
+			}
+
+			return printExperimentConclusion(result, *output.Output, *output.Pretty)
+		},
+	}
+}
+
+func printExperimentConclusion(result *experimentConclusion, format string, pretty bool) error {
+	return shared.PrintOutputWithRenderers(
+		result,
+		format,
+		pretty,
+		func() error { return renderExperimentConclusion(result, asc.RenderTable) },
+		func() error { return renderExperimentConclusion(result, asc.RenderMarkdown) },
+	)
+}
+
+// selectExperimentWinner returns the treatment named by treatmentID, or else
+// the treatment with the highest improvement that reaches minConfidence.
+func selectExperimentWinner(report *experimentReport, treatmentID string, minConfidence float64) (experimentTreatmentResult, error) {
+	if treatmentID != "" {
+		for _, treatment := range report.Treatments {
+			if treatment.ID == treatmentID {
+				return treatment, nil
+			}
+		}
+		return experimentTreatmentResult{}, fmt.Errorf("treatment %q is not part of experiment %q", treatmentID, report.ExperimentID)
+	}
+
+	if report.Analytics.Status != experimentAnalyticsStatusOK {
+		return experimentTreatmentResult{}, fmt.Errorf("cannot select a winner: %s (pass --treatment-id)", report.Analytics.Reason)
+	}
+
+	var (
+		winner experimentTreatmentResult
+		found  bool
+	)
+	for _, treatment := range report.Treatments {
+		if treatment.Improvement == nil || treatment.Confidence == nil {
+			continue
+		}
+		if *treatment.Improvement <= 0 || *treatment.Confidence < minConfidence {
+			continue
+		}
+		if !found || *treatment.Improvement > *winner.Improvement {
+			winner = treatment
+			found = true
+		}
+	}
+	if !found {
+		return experimentTreatmentResult{}, fmt.Errorf("no treatment outperforms the original with %.1f%% confidence (pass --treatment-id)", minConfidence)
+	}
+	return winner, nil
+}
+
+// applyExperimentWinner copies every localized screenshot and preview set of
+// the treatment onto the version localization with the same locale. All media
+// is downloaded first; the version's sets are only replaced once that succeeds.
+// If a replacement fails, result.Failed names the set that failed and
+// result.Copied lists the sets already replaced on the live page.
+func applyExperimentWinner(ctx context.Context, client *asc.Client, treatmentID, versionID string, result *experimentConclusion) error {
+	requestCtx, cancel := shared.ContextWithTimeout(ctx)
+	treatmentLocs, err := client.GetAppStoreVersionExperimentTreatmentLocalizations(
+		requestCtx,
+		treatmentID,
+		asc.WithAppStoreVersionExperimentTreatmentLocalizationsLimit(productPagesMaxLimit),
+	)
+	if err != nil {
+		cancel()
+		return fmt.Errorf("failed to fetch treatment localizations: %w", err)
+	}
+	versionLocs, err := client.GetAppStoreVersionLocalizations(requestCtx, versionID, asc.WithAppStoreVersionLocalizationsLimit(productPagesMaxLimit))
+	cancel()
+	if err != nil {
+		return fmt.Errorf("failed to fetch version localizations: %w", err)
+	}
+
+	versionLocByLocale := make(map[string]string, len(versionLocs.Data))
+	for _, loc := range versionLocs.Data {
+		versionLocByLocale[strings.ToLower(strings.TrimSpace(loc.Attributes.Locale))] = loc.ID
+	}
+
+	workDir, err := os.MkdirTemp("", "asc-experiment-winner-*")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(workDir)
+
+	treatments := append([]asc.Resource[asc.AppStoreVersionExperimentTreatmentLocalizationAttributes](nil), treatmentLocs.Data...)
+	sort.Slice(treatments, func(i, j int) bool {
+		return treatments[i].Attributes.Locale < treatments[j].Attributes.Locale
+	})
+
+	// Download every locale's media before touching the live page, so a
+	// download failure can't leave it half-promoted.
+	var downloads []experimentMediaDownload
+	for _, treatmentLoc := range treatments {
+		locale := strings.TrimSpace(treatmentLoc.Attributes.Locale)
+		targetLocID, ok := versionLocByLocale[strings.ToLower(locale)]
+		if !ok {
+			result.SkippedLocale = append(result.SkippedLocale, locale)
+			continue
+		}
+
+		screenshots, err := downloadExperimentScreenshotSets(ctx, client, treatmentLoc.ID, filepath.Join(workDir, treatmentLoc.ID, experimentMediaScreenshots))
+		if err != nil {
+			return fmt.Errorf("failed to download %s screenshots: %w", locale, err)
+		}
+		previews, err := downloadExperimentPreviewSets(ctx, client, treatmentLoc.ID, filepath.Join(workDir, treatmentLoc.ID, experimentMediaPreviews))
+		if err != nil {
+			return fmt.Errorf("failed to download %s previews: %w", locale, err)
+		}
+		for _, download := range append(screenshots, previews...) {
+			download.copy.Locale = locale
+			download.targetLocID = targetLocID
+			downloads = append(downloads, download)
+		}
+	}
+
+	for _, download := range downloads {
+		uploadCtx, cancel := contextWithCustomPageMediaUploadTimeout(ctx)
+		var targetSetID string
+		if download.copy.Media == experimentMediaScreenshots {
+			targetSetID, err = replaceExperimentScreenshotSet(uploadCtx, client, download.targetLocID, download.copy.Type, download.files)
+		} else {
+			targetSetID, err = replaceExperimentPreviewSet(uploadCtx, client, download.targetLocID, download.copy.Type, download.files)
+		}
+		cancel()
+		if err != nil {
+			failed := download.copy
+			result.Failed = &failed
+			return fmt.Errorf("failed to copy %s %s: %w", download.copy.Locale, download.copy.Media, err)
+		}
+		download.copy.TargetSetID = targetSetID
+		result.Copied = append(result.Copied, download.copy)
+	}
+	return nil
+}
+
+// experimentMediaDownload is one treatment media set downloaded to disk and
+// waiting to replace the matching set on the version localization.
+type experimentMediaDownload struct {
+	copy        experimentMediaCopy
+	targetLocID string
+	files       []string
+}
+
+func downloadExperimentScreenshotSets(ctx context.Context, client *asc.Client, treatmentLocID, workDir string) ([]experimentMediaDownload, error) {
+	requestCtx, cancel := shared.ContextWithTimeout(ctx)
+	setsResp, err := client.GetAppStoreVersionExperimentTreatmentLocalizationScreenshotSets(
+		requestCtx,
+		treatmentLocID,
+		asc.WithAppStoreVersionExperimentTreatmentLocalizationScreenshotSetsLimit(productPagesMaxLimit),
+	)
+	cancel()
+	if err != nil {
+		return nil, err
+	}
+
+	downloads := make([]experimentMediaDownload, 0, len(setsResp.Data))
+	for _, set := range setsResp.Data {
+		displayType := set.Attributes.ScreenshotDisplayType
+
+		requestCtx, cancel := shared.ContextWithTimeout(ctx)
+		shotsResp, err := client.GetAppScreenshots(requestCtx, set.ID)
+		cancel()
+		if err != nil {
+			return nil, err
+		}
+		if len(shotsResp.Data) == 0 {
+			continue
+		}
+
+		files := make([]string, 0, len(shotsResp.Data))
+		for index, shot := range shotsResp.Data {
+			downloadURL, err := assets.ResolveImageAssetDownloadURL(shot.Attributes.ImageAsset, shot.Attributes.FileName)
+			if err != nil {
+				return nil, fmt.Errorf("screenshot %s: %w", shot.ID, err)
+			}
+			path := filepath.Join(workDir, displayType, experimentMediaFileName(index, shot.Attributes.FileName, ".png"))
+			if err := downloadExperimentMedia(ctx, downloadURL, path); err != nil {
+				return nil, fmt.Errorf("screenshot %s: %w", shot.ID, err)
+			}
+			files = append(files, path)
+		}
+
+		downloads = append(downloads, experimentMediaDownload{
+			copy: experimentMediaCopy{
+				Media:       experimentMediaScreenshots,
+				Type:        displayType,
+				SourceSetID: set.ID,
+				Count:       len(files),
+			},
+			files: files,
+		})
+	}
+	return downloads, nil
+}
+
+func downloadExperimentPreviewSets(ctx context.Context, client *asc.Client, treatmentLocID, workDir string) ([]experimentMediaDownload, error) {
+	requestCtx, cancel := shared.ContextWithTimeout(ctx)
+	setsResp, err := client.GetAppStoreVersionExperimentTreatmentLocalizationPreviewSets(
+		requestCtx,
+		treatmentLocID,
+		asc.WithAppStoreVersionExperimentTreatmentLocalizationPreviewSetsLimit(productPagesMaxLimit),
+	)
+	cancel()
+	if err != nil {
+		return nil, err
+	}
+
+	downloads := make([]experimentMediaDownload, 0, len(setsResp.Data))
+	for _, set := range setsResp.Data {
+		previewType := set.Attributes.PreviewType
+
+		requestCtx, cancel := shared.ContextWithTimeout(ctx)
+		previewsResp, err := client.GetAppPreviews(requestCtx, set.ID)
+		cancel()
+		if err != nil {
+			return nil, err
+		}
+		if len(previewsResp.Data) == 0 {
+			continue
+		}
+
+		files := make([]string, 0, len(previewsResp.Data))
+		for index, preview := range previewsResp.Data {
+			downloadURL := strings.TrimSpace(preview.Attributes.VideoURL)
+			if downloadURL == "" {
+				// List responses can omit videoUrl; the detail endpoint has it.
+				requestCtx, cancel := shared.ContextWithTimeout(ctx)
+				full, err := client.GetAppPreview(requestCtx, preview.ID)
+				cancel()
+				if err != nil {
+					return nil, fmt.Errorf("preview %s: %w", preview.ID, err)
+				}
+				downloadURL = strings.TrimSpace(full.Data.Attributes.VideoURL)
+			}
+			if downloadURL == "" {
+				return nil, fmt.Errorf("preview %s has no videoUrl (it may still be processing)", preview.ID)
+			}
+			path := filepath.Join(workDir, previewType, experimentMediaFileName(index, preview.Attributes.FileName, ".mov"))
+			if err := downloadExperimentMedia(ctx, downloadURL, path); err != nil {
+				return nil, fmt.Errorf("preview %s: %w", preview.ID, err)
+			}
+			files = append(files, path)
+		}
+
+		downloads = append(downloads, experimentMediaDownload{
+			copy: experimentMediaCopy{
+				Media:       experimentMediaPreviews,
+				Type:        previewType,
+				SourceSetID: set.ID,
+				Count:       len(files),
+			},
+			files: files,
+		})
+	}
+	return downloads, nil
+}
+
+// replaceExperimentScreenshotSet swaps the localization's screenshots of one
+// display type for the given files.
+func replaceExperimentScreenshotSet(ctx context.Context, client *asc.Client, localizationID, displayType string, files []string) (string, error) {
+	set, err := assets.EnsureScreenshotSet(ctx, client, localizationID, displayType)
+	if err != nil {
+		return "", err
+	}
+	if err := deleteAllScreenshotsInSet(ctx, client, set.ID); err != nil {
+		return "", err
+	}
+	for _, path := range files {
+		if _, err := assets.UploadScreenshotAsset(ctx, client, set.ID, path); err != nil {
+			return "", err
+		}
+	}
+	return set.ID, nil
+}
+
+// replaceExperimentPreviewSet swaps the localization's previews of one
+// preview type for the given files.
+func replaceExperimentPreviewSet(ctx context.Context, client *asc.Client, localizationID, previewType string, files []string) (string, error) {
+	set, err := assets.EnsurePreviewSet(ctx, client, localizationID, previewType)
+	if err != nil {
+		return "", err
+	}
+	if err := deleteAllPreviewsInSet(ctx, client, set.ID); err != nil {
+		return "", err
+	}
+	for _, path := range files {
+		if _, err := assets.UploadPreviewAsset(ctx, client, set.ID, path); err != nil {
+			return "", err
+		}
+	}
+	return set.ID, nil
+}
+
+func downloadExperimentMedia(ctx context.Context, downloadURL, path string) error {
+	downloadCtx, cancel := shared.ContextWithTimeout(ctx)
+	defer cancel()
+	_, _, err := assets.DownloadURLToFile(downloadCtx, downloadURL, path, false)
+	return err
+}
+
+// experimentMediaFileName keeps set order and the original extension, which
+// the upload helpers use to validate and detect the media type.
+func experimentMediaFileName(index int, fileName, fallbackExt string) string {
+	ext := strings.ToLower(filepath.Ext(strings.TrimSpace(fileName)))
+	if ext == "" {
+		ext = fallbackExt
+	}
+	return fmt.Sprintf("%02d%s", index+1, ext)
+}
+
+func stopExperiment(ctx context.Context, client *asc.Client, experimentID string, v2 bool) error {
+	started := false
+	if v2 {
+		_, err := client.UpdateAppStoreVersionExperimentV2(ctx, experimentID, asc.AppStoreVersionExperimentV2UpdateAttributes{Started: &started})
+		return err
+	}
+	_, err := client.UpdateAppStoreVersionExperiment(ctx, experimentID, asc.AppStoreVersionExperimentUpdateAttributes{Started: &started})
+	return err
+}
+
+func renderExperimentConclusion(result *experimentConclusion, render func([]string, [][]string)) error {
+	render([]string{"Experiment", "Winner ID", "Winner", "Improvement", "Confidence", "Stopped"}, [][]string{{
+		result.ExperimentID,
+		result.WinnerID,
+		result.WinnerName,
+		formatExperimentValue(result.Improvement, "%+.2f%%"),
+		formatExperimentValue(result.Confidence, "%.1f%%"),
+		fmt.Sprintf("%t", result.Stopped),
+	}})
+
+	if len(result.Copied) > 0 {
+		rows := make([][]string, 0, len(result.Copied))
+		for _, copied := range result.Copied {
+			rows = append(rows, []string{
+				copied.Locale,
+				copied.Media,
+				copied.Type,
+				copied.TargetSetID,
+				fmt.Sprintf("%d", copied.Count),
+			})
+		}
+		fmt.Println()
+		render([]string{"Locale", "Media", "Type", "Target Set", "Count"}, rows)
+	}
+
+	if result.Failed != nil {
+		fmt.Println()
+		fmt.Printf("Failed to replace %s %s (%s); sets listed above were already replaced and the experiment was not stopped: %s\n",
+			result.Failed.Locale, result.Failed.Media, result.Failed.Type, result.Error)
+	}
+
+	if result.Failed == nil && result.Error != "" {
+		fmt.Println()
+		fmt.Printf("The winner was applied but the experiment was not stopped: %s\n", result.Error)
+	}
+
+	if len(result.SkippedLocale) > 0 {
+		fmt.Println()
+		fmt.Printf("Skipped locales without a version localization: %s\n", strings.Join(result.SkippedLocale, ", "))
+	}
+	return nil
+}
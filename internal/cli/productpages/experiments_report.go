@@ -0,0 +1,661 @@
+package productpages
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/csv"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	experimentAnalyticsStatusOK          = "ok"
+	experimentAnalyticsStatusUnavailable = "unavailable"
+
+	// experimentBaselineKey keys analytics totals for the original product page.
+	experimentBaselineKey  = "original"
+	experimentBaselineName = "Original"
+
+	experimentAnalyticsCategory = "APP_STORE_ENGAGEMENT"
+)
+
+// Analytics report columns vary between report versions, so each value is
+// looked up by the first matching header.
+var (
+	experimentTreatmentColumns     = []string{"Treatment ID", "Treatment", "Treatment Name"}
+	experimentExperimentColumns    = []string{"Experiment ID", "Experiment", "Experiment Name"}
+	experimentImpressionColumns    = []string{"Impressions Unique Device", "Unique Impressions", "Impressions"}
+	experimentConversionColumns    = []string{"Conversions", "Total Downloads", "Downloads"}
+	experimentBaselineLabels       = []string{"original", "control", "baseline", "default"}
+	experimentAnalyticsReportNames = []string{"product page optimization", "experiment"}
+)
+
+type experimentReport struct {
+	ExperimentID      string                      `json:"experimentId"`
+	Name              string                      `json:"name,omitempty"`
+	State             string                      `json:"state,omitempty"`
+	TrafficProportion *int                        `json:"trafficProportion,omitempty"`
+	StartDate         string                      `json:"startDate,omitempty"`
+	EndDate           string                      `json:"endDate,omitempty"`
+	Analytics         experimentAnalyticsSummary  `json:"analytics"`
+	Baseline          experimentTreatmentResult   `json:"baseline"`
+	Treatments        []experimentTreatmentResult `json:"treatments"`
+}
+
+type experimentAnalyticsSummary struct {
+	Status    string   `json:"status"`
+	Reason    string   `json:"reason,omitempty"`
+	Reports   []string `json:"reports,omitempty"`
+	Instances int      `json:"instances"`
+}
+
+// experimentTreatmentResult holds per-treatment totals. Rates, improvement
+// and confidence are percentages and are omitted when analytics are missing.
+type experimentTreatmentResult struct {
+	ID             string   `json:"id,omitempty"`
+	Name           string   `json:"name"`
+	PromotedDate   string   `json:"promotedDate,omitempty"`
+	Impressions    *float64 `json:"impressions,omitempty"`
+	Conversions    *float64 `json:"conversions,omitempty"`
+	ConversionRate *float64 `json:"conversionRate,omitempty"`
+	Improvement    *float64 `json:"improvement,omitempty"`
+	Confidence     *float64 `json:"confidence,omitempty"`
+}
+
+type experimentInfo struct {
+	ID                string
+	Name              string
+	State             string
+	TrafficProportion *int
+	StartDate         string
+	EndDate           string
+}
+
+type experimentCounts struct {
+	Impressions float64
+	Conversions float64
+}
+
+// ExperimentsReportCommand returns the experiments report subcommand.
+func ExperimentsReportCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("experiments report", flag.ExitOnError)
+
+	experimentID := fs.String("experiment-id", "", "Experiment ID")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name for analytics (or ASC_APP_ID env)")
+	v2 := fs.Bool("v2", false, "Use v2 experiments endpoint")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "report",
+		ShortUsage: "asc product-pages experiments report --experiment-id \"EXPERIMENT_ID\" --app \"APP_ID\" [--v2] [flags]",
+		ShortHelp:  "Report per-treatment results for an experiment.",
+		LongHelp: `Report per-treatment results for an experiment.
+
+Combines the experiment's treatments with any product page optimization
+analytics report instances available for the app. Each treatment lists
+impressions, conversions, conversion rate, improvement over the original
+product page and the confidence that it outperforms the original.
+
+Values show as n/a until analytics are available. Start an ongoing analytics
+report request with "asc analytics request" to collect them.
+
+Examples:
+  asc product-pages experiments report --experiment-id "EXPERIMENT_ID" --app "APP_ID"
+  asc product-pages experiments report --experiment-id "EXPERIMENT_ID" --app "APP_ID" --v2 --output table`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			trimmedID := strings.TrimSpace(*experimentID)
+			if trimmedID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --experiment-id is required")
+				return flag.ErrHelp
+			}
+			resolvedAppID := shared.ResolveAppID(*appID)
+			if resolvedAppID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
+				return flag.ErrHelp
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("experiments report: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			report, err := collectExperimentReport(requestCtx, client, resolvedAppID, trimmedID, *v2)
+			if err != nil {
+				return fmt.Errorf("experiments report: %w", err)
+			}
+
+			return printExperimentReport(report, *output.Output, *output.Pretty)
+		},
+	}
+}
+
+// collectExperimentReport builds the per-treatment report. Analytics are
+// skipped when appID is empty.
+func collectExperimentReport(ctx context.Context, client *asc.Client, appID, experimentID string, v2 bool) (*experimentReport, error) {
+	info, err := fetchExperimentInfo(ctx, client, experimentID, v2)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch experiment: %w", err)
+	}
+	treatments, err := fetchExperimentTreatments(ctx, client, experimentID, v2)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch treatments: %w", err)
+	}
+
+	if appID == "" {
+		summary := unavailableExperimentAnalytics("no app ID given for analytics")
+		return buildExperimentReport(info, treatments, summary, nil), nil
+	}
+	summary, counts, err := collectExperimentAnalytics(ctx, client, appID, info, treatments)
+	if err != nil {
+		return nil, err
+	}
+	return buildExperimentReport(info, treatments, summary, counts), nil
+}
+
+func fetchExperimentInfo(ctx context.Context, client *asc.Client, experimentID string, v2 bool) (experimentInfo, error) {
+	if v2 {
+		resp, err := client.GetAppStoreVersionExperimentV2(ctx, experimentID)
+		if err != nil {
+			return experimentInfo{}, err
+		}
+		attrs := resp.Data.Attributes
+		return experimentInfo{
+			ID:                resp.Data.ID,
+			Name:              attrs.Name,
+			State:             attrs.State,
+			TrafficProportion: attrs.TrafficProportion,
+			StartDate:         attrs.StartDate,
+			EndDate:           attrs.EndDate,
+		}, nil
+	}
+
+	resp, err := client.GetAppStoreVersionExperiment(ctx, experimentID)
+	if err != nil {
+		return experimentInfo{}, err
+	}
+	attrs := resp.Data.Attributes
+	return experimentInfo{
+		ID:                resp.Data.ID,
+		Name:              attrs.Name,
+		State:             attrs.State,
+		TrafficProportion: attrs.TrafficProportion,
+		StartDate:         attrs.StartDate,
+		EndDate:           attrs.EndDate,
+	}, nil
+}
+
+func fetchExperimentTreatments(ctx context.Context, client *asc.Client, experimentID string, v2 bool) ([]asc.Resource[asc.AppStoreVersionExperimentTreatmentAttributes], error) {
+	limit := asc.WithAppStoreVersionExperimentTreatmentsLimit(productPagesMaxLimit)
+	var (
+		resp *asc.AppStoreVersionExperimentTreatmentsResponse
+		err  error
+	)
+	if v2 {
+		resp, err = client.GetAppStoreVersionExperimentTreatmentsV2(ctx, experimentID, limit)
+	} else {
+		resp, err = client.GetAppStoreVersionExperimentTreatments(ctx, experimentID, limit)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return resp.Data, nil
+}
+
+// collectExperimentAnalytics sums impressions and conversions per treatment
+// from daily product page optimization report instances. Missing or
+// forbidden analytics are reported as unavailable rather than as errors.
+func collectExperimentAnalytics(
+	ctx context.Context,
+	client *asc.Client,
+	appID string,
+	info experimentInfo,
+	treatments []asc.Resource[asc.AppStoreVersionExperimentTreatmentAttributes],
+) (experimentAnalyticsSummary, map[string]*experimentCounts, error) {
+	counts := make(map[string]*experimentCounts)
+
+	requestsResp, err := client.GetAnalyticsReportRequests(ctx, appID, asc.WithAnalyticsReportRequestsLimit(200))
+	if err != nil {
+		if reason, ok := experimentAnalyticsUnavailableReason(err); ok {
+			return unavailableExperimentAnalytics(reason), counts, nil
+		}
+		return experimentAnalyticsSummary{}, nil, fmt.Errorf("failed to fetch analytics requests: %w", err)
+	}
+
+	summary := experimentAnalyticsSummary{Status: experimentAnalyticsStatusOK}
+	startDate := experimentDatePrefix(info.StartDate)
+	endDate := experimentDatePrefix(info.EndDate)
+
+	// ONGOING and ONE_TIME_SNAPSHOT requests produce the same reports for
+	// overlapping dates; count each (report name, report date) only once.
+	seenReports := make(map[string]bool)
+	seenInstances := make(map[string]bool)
+
+	for _, request := range requestsResp.Data {
+		if request.Attributes.State == asc.AnalyticsReportRequestStateFailed {
+			continue
+		}
+
+		reportsResp, err := client.GetAnalyticsReports(
+			ctx,
+			request.ID,
+			asc.WithAnalyticsReportsLimit(200),
+			asc.WithAnalyticsReportsCategory(experimentAnalyticsCategory),
+		)
+		if err != nil {
+			if reason, ok := experimentAnalyticsUnavailableReason(err); ok {
+				return unavailableExperimentAnalytics(reason), counts, nil
+			}
+			return experimentAnalyticsSummary{}, nil, fmt.Errorf("failed to fetch analytics reports: %w", err)
+		}
+
+		for _, report := range reportsResp.Data {
+			if !isExperimentAnalyticsReport(report.Attributes.Name) {
+				continue
+			}
+			if !seenReports[report.Attributes.Name] {
+				seenReports[report.Attributes.Name] = true
+				summary.Reports = append(summary.Reports, report.Attributes.Name)
+			}
+
+			instancesResp, err := client.GetAnalyticsReportInstances(ctx, report.ID, asc.WithAnalyticsReportInstancesLimit(200))
+			if err != nil {
+				return experimentAnalyticsSummary{}, nil, fmt.Errorf("failed to fetch analytics report instances: %w", err)
+			}
+
+			for _, instance := range instancesResp.Data {
+				granularity := strings.ToUpper(strings.TrimSpace(instance.Attributes.Granularity))
+				if granularity != "" && granularity != "DAILY" {
+					continue
+				}
+				reportDate := experimentDatePrefix(instance.Attributes.ReportDate)
+				if reportDate == "" {
+					reportDate = experimentDatePrefix(instance.Attributes.ProcessingDate)
+				}
+				if startDate != "" && reportDate != "" && reportDate < startDate {
+					continue
+				}
+				if endDate != "" && reportDate != "" && reportDate > endDate {
+					continue
+				}
+				instanceKey := instance.ID
+				if reportDate != "" {
+					instanceKey = report.Attributes.Name + "|" + reportDate
+				}
+				if seenInstances[instanceKey] {
+					continue
+				}
+				seenInstances[instanceKey] = true
+
+				segmentsResp, err := client.GetAnalyticsReportSegments(ctx, instance.ID, asc.WithAnalyticsReportSegmentsLimit(200))
+				if err != nil {
+					return experimentAnalyticsSummary{}, nil, fmt.Errorf("failed to fetch analytics report segments: %w", err)
+				}
+				for _, segment := range segmentsResp.Data {
+					downloadURL := strings.TrimSpace(segment.Attributes.URL)
+					if downloadURL == "" {
+						continue
+					}
+					if err := downloadExperimentAnalyticsSegment(ctx, client, downloadURL, info, treatments, counts); err != nil {
+						return experimentAnalyticsSummary{}, nil, err
+					}
+				}
+				summary.Instances++
+			}
+		}
+	}
+
+	switch {
+	case len(summary.Reports) == 0:
+		summary.Status = experimentAnalyticsStatusUnavailable
+		summary.Reason = "no product page optimization analytics reports found for the app"
+	case summary.Instances == 0:
+		summary.Status = experimentAnalyticsStatusUnavailable
+		summary.Reason = "no analytics report instances cover the experiment yet"
+	case len(counts) == 0:
+		summary.Status = experimentAnalyticsStatusUnavailable
+		summary.Reason = "analytics reports contain no rows for this experiment"
+	}
+	return summary, counts, nil
+}
+
+func downloadExperimentAnalyticsSegment(
+	ctx context.Context,
+	client *asc.Client,
+	downloadURL string,
+	info experimentInfo,
+	treatments []asc.Resource[asc.AppStoreVersionExperimentTreatmentAttributes],
+	counts map[string]*experimentCounts,
+) error {
+	download, err := client.DownloadAnalyticsReport(ctx, downloadURL)
+	if err != nil {
+		return fmt.Errorf("failed to download analytics report: %w", err)
+	}
+	defer download.Body.Close()
+
+	if err := parseExperimentAnalytics(download.Body, info, treatments, counts); err != nil {
+		return fmt.Errorf("failed to parse analytics report: %w", err)
+	}
+	return nil
+}
+
+// parseExperimentAnalytics adds a gzip TSV analytics segment's rows to counts,
+// keyed by treatment ID or experimentBaselineKey. Rows for other experiments
+// or unknown treatments are ignored.
+func parseExperimentAnalytics(
+	reader io.Reader,
+	info experimentInfo,
+	treatments []asc.Resource[asc.AppStoreVersionExperimentTreatmentAttributes],
+	counts map[string]*experimentCounts,
+) error {
+	gzipReader, err := gzip.NewReader(reader)
+	if err != nil {
+		return fmt.Errorf("read gzip report: %w", err)
+	}
+	defer gzipReader.Close()
+
+	tsvReader := csv.NewReader(gzipReader)
+	tsvReader.Comma = '\t'
+	tsvReader.FieldsPerRecord = -1
+	tsvReader.LazyQuotes = true
+
+	headers, err := tsvReader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		return err
+	}
+
+	treatmentIdx := findExperimentColumn(headers, experimentTreatmentColumns)
+	impressionsIdx := findExperimentColumn(headers, experimentImpressionColumns)
+	conversionsIdx := findExperimentColumn(headers, experimentConversionColumns)
+	if treatmentIdx < 0 || impressionsIdx < 0 || conversionsIdx < 0 {
+		return nil
+	}
+	experimentIdx := findExperimentColumn(headers, experimentExperimentColumns)
+
+	for {
+		row, err := tsvReader.Read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if experimentIdx >= 0 {
+			experiment := experimentColumnValue(row, experimentIdx)
+			if experiment != info.ID && !strings.EqualFold(experiment, info.Name) {
+				continue
+			}
+		}
+
+		key, ok := matchExperimentTreatment(experimentColumnValue(row, treatmentIdx), treatments)
+		if !ok {
+			continue
+		}
+		impressions, ok := parseExperimentNumber(experimentColumnValue(row, impressionsIdx))
+		if !ok {
+			continue
+		}
+		conversions, ok := parseExperimentNumber(experimentColumnValue(row, conversionsIdx))
+		if !ok {
+			continue
+		}
+
+		total, exists := counts[key]
+		if !exists {
+			total = &experimentCounts{}
+			counts[key] = total
+		}
+		total.Impressions += impressions
+		total.Conversions += conversions
+	}
+}
+
+func matchExperimentTreatment(value string, treatments []asc.Resource[asc.AppStoreVersionExperimentTreatmentAttributes]) (string, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return "", false
+	}
+	for _, label := range experimentBaselineLabels {
+		if strings.EqualFold(value, label) {
+			return experimentBaselineKey, true
+		}
+	}
+	for _, treatment := range treatments {
+		if value == treatment.ID || strings.EqualFold(value, strings.TrimSpace(treatment.Attributes.Name)) {
+			return treatment.ID, true
+		}
+	}
+	return "", false
+}
+
+func buildExperimentReport(
+	info experimentInfo,
+	treatments []asc.Resource[asc.AppStoreVersionExperimentTreatmentAttributes],
+	summary experimentAnalyticsSummary,
+	counts map[string]*experimentCounts,
+) *experimentReport {
+	report := &experimentReport{
+		ExperimentID:      info.ID,
+		Name:              info.Name,
+		State:             info.State,
+		TrafficProportion: info.TrafficProportion,
+		StartDate:         info.StartDate,
+		EndDate:           info.EndDate,
+		Analytics:         summary,
+		Baseline:          experimentTreatmentResult{Name: experimentBaselineName},
+		Treatments:        make([]experimentTreatmentResult, 0, len(treatments)),
+	}
+
+	baseline := counts[experimentBaselineKey]
+	applyExperimentCounts(&report.Baseline, baseline)
+
+	for _, treatment := range treatments {
+		result := experimentTreatmentResult{
+			ID:           treatment.ID,
+			Name:         treatment.Attributes.Name,
+			PromotedDate: treatment.Attributes.PromotedDate,
+		}
+		current := counts[treatment.ID]
+		applyExperimentCounts(&result, current)
+
+		if current != nil && baseline != nil && current.Impressions > 0 && baseline.Impressions > 0 {
+			baseRate := baseline.Conversions / baseline.Impressions
+			rate := current.Conversions / current.Impressions
+			if baseRate > 0 {
+				improvement := (rate - baseRate) / baseRate * 100
+				result.Improvement = &improvement
+			}
+			if confidence, ok := experimentConfidence(baseline.Conversions, baseline.Impressions, current.Conversions, current.Impressions); ok {
+				confidence *= 100
+				result.Confidence = &confidence
+			}
+		}
+		report.Treatments = append(report.Treatments, result)
+	}
+	return report
+}
+
+func applyExperimentCounts(result *experimentTreatmentResult, counts *experimentCounts) {
+	if counts == nil {
+		return
+	}
+	impressions := counts.Impressions
+	conversions := counts.Conversions
+	result.Impressions = &impressions
+	result.Conversions = &conversions
+	if impressions > 0 {
+		rate := conversions / impressions * 100
+		result.ConversionRate = &rate
+	}
+}
+
+// experimentConfidence returns the probability that the treatment's
+// conversion rate exceeds the baseline's, using a pooled two-proportion z-test.
+func experimentConfidence(baseConversions, baseImpressions, conversions, impressions float64) (float64, bool) {
+	if baseImpressions <= 0 || impressions <= 0 {
+		return 0, false
+	}
+	pooled := (baseConversions + conversions) / (baseImpressions + impressions)
+	standardError := math.Sqrt(pooled * (1 - pooled) * (1/baseImpressions + 1/impressions))
+	if standardError == 0 || math.IsNaN(standardError) {
+		return 0, false
+	}
+	z := (conversions/impressions - baseConversions/baseImpressions) / standardError
+	return 0.5 * (1 + math.Erf(z/math.Sqrt2)), true
+}
+
+func experimentAnalyticsUnavailableReason(err error) (string, bool) {
+	if errors.Is(err, asc.ErrForbidden) {
+		return "analytics reports are not permitted for the current API key", true
+	}
+	if asc.IsNotFound(err) {
+		return "analytics data is unavailable for this app", true
+	}
+	return "", false
+}
+
+func unavailableExperimentAnalytics(reason string) experimentAnalyticsSummary {
+	return experimentAnalyticsSummary{
+		Status: experimentAnalyticsStatusUnavailable,
+		Reason: reason,
+	}
+}
+
+func isExperimentAnalyticsReport(name string) bool {
+	normalized := strings.ToLower(strings.TrimSpace(name))
+	for _, key := range experimentAnalyticsReportNames {
+		if strings.Contains(normalized, key) {
+			return true
+		}
+	}
+	return false
+}
+
+func findExperimentColumn(headers []string, candidates []string) int {
+	for _, candidate := range candidates {
+		target := normalizeExperimentColumn(candidate)
+		for index, header := range headers {
+			if normalizeExperimentColumn(header) == target {
+				return index
+			}
+		}
+	}
+	return -1
+}
+
+func normalizeExperimentColumn(value string) string {
+	normalized := strings.ToLower(strings.TrimSpace(value))
+	for _, token := range []string{" ", "_", "-", "/"} {
+		normalized = strings.ReplaceAll(normalized, token, "")
+	}
+	return normalized
+}
+
+func experimentColumnValue(row []string, index int) string {
+	if index < 0 || index >= len(row) {
+		return ""
+	}
+	return strings.TrimSpace(row[index])
+}
+
+func parseExperimentNumber(value string) (float64, bool) {
+	value = strings.ReplaceAll(strings.TrimSpace(value), ",", "")
+	if value == "" {
+		return 0, false
+	}
+	parsed, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, false
+	}
+	return parsed, true
+}
+
+// experimentDatePrefix trims an ISO 8601 timestamp to its YYYY-MM-DD date so
+// dates compare lexically.
+func experimentDatePrefix(value string) string {
+	value = strings.TrimSpace(value)
+	if len(value) > 10 {
+		return value[:10]
+	}
+	return value
+}
+
+func printExperimentReport(report *experimentReport, format string, pretty bool) error {
+	return shared.PrintOutputWithRenderers(
+		report,
+		format,
+		pretty,
+		func() error { return renderExperimentReport(report, asc.RenderTable) },
+		func() error { return renderExperimentReport(report, asc.RenderMarkdown) },
+	)
+}
+
+func renderExperimentReport(report *experimentReport, render func([]string, [][]string)) error {
+	traffic := ""
+	if report.TrafficProportion != nil {
+		traffic = fmt.Sprintf("%d%%", *report.TrafficProportion)
+	}
+	render([]string{"Experiment", "Name", "State", "Traffic", "Start", "End", "Analytics"}, [][]string{{
+		report.ExperimentID,
+		report.Name,
+		report.State,
+		shared.OrNA(traffic),
+		shared.OrNA(report.StartDate),
+		shared.OrNA(report.EndDate),
+		formatExperimentAnalytics(report.Analytics),
+	}})
+
+	rows := make([][]string, 0, len(report.Treatments)+1)
+	rows = append(rows, experimentTreatmentRow(report.Baseline))
+	for _, treatment := range report.Treatments {
+		rows = append(rows, experimentTreatmentRow(treatment))
+	}
+	fmt.Println()
+	render([]string{"Treatment ID", "Name", "Impressions", "Conversions", "Conversion Rate", "Improvement", "Confidence"}, rows)
+	return nil
+}
+
+func experimentTreatmentRow(result experimentTreatmentResult) []string {
+	return []string{
+		shared.OrNA(result.ID),
+		result.Name,
+		formatExperimentValue(result.Impressions, "%.0f"),
+		formatExperimentValue(result.Conversions, "%.0f"),
+		formatExperimentValue(result.ConversionRate, "%.2f%%"),
+		formatExperimentValue(result.Improvement, "%+.2f%%"),
+		formatExperimentValue(result.Confidence, "%.1f%%"),
+	}
+}
+
+func formatExperimentAnalytics(summary experimentAnalyticsSummary) string {
+	if summary.Status != experimentAnalyticsStatusOK {
+		return fmt.Sprintf("%s: %s", summary.Status, summary.Reason)
+	}
+	return fmt.Sprintf("%d instance(s)", summary.Instances)
+}
+
+func formatExperimentValue(value *float64, format string) string {
+	if value == nil {
+		return "n/a"
+	}
+	return fmt.Sprintf(format, *value)
+}
@@ -0,0 +1,175 @@
+package productpages
+
+import (
+	"bytes"
+	"compress/gzip"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+func testExperimentTreatments() []asc.Resource[asc.AppStoreVersionExperimentTreatmentAttributes] {
+	return []asc.Resource[asc.AppStoreVersionExperimentTreatmentAttributes]{
+		{ID: "treat-a", Attributes: asc.AppStoreVersionExperimentTreatmentAttributes{Name: "Bold Icon"}},
+		{ID: "treat-b", Attributes: asc.AppStoreVersionExperimentTreatmentAttributes{Name: "Dark Screens"}},
+	}
+}
+
+func gzipExperimentRows(t *testing.T, rows ...string) *bytes.Buffer {
+	t.Helper()
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	if _, err := writer.Write([]byte(strings.Join(rows, "\n") + "\n")); err != nil {
+		t.Fatalf("gzip write: %v", err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("gzip close: %v", err)
+	}
+	return &buf
+}
+
+func TestParseExperimentAnalytics(t *testing.T) {
+	info := experimentInfo{ID: "exp-1", Name: "Icon Test"}
+	counts := make(map[string]*experimentCounts)
+
+	body := gzipExperimentRows(t,
+		"Date\tExperiment ID\tTreatment\tImpressions Unique Device\tConversions",
+		"2026-10-01\texp-1\tOriginal\t1,000\t100",
+		"2026-10-01\texp-1\ttreat-a\t1000\t150",
+		"2026-10-02\texp-1\tdark screens\t500\t40",
+		"2026-10-02\texp-1\tOriginal\t1000\t100",
+		"2026-10-02\texp-2\ttreat-a\t9999\t9999",
+		"2026-10-02\texp-1\tunknown\t10\t10",
+	)
+	if err := parseExperimentAnalytics(body, info, testExperimentTreatments(), counts); err != nil {
+		t.Fatalf("parseExperimentAnalytics() error: %v", err)
+	}
+
+	want := map[string]experimentCounts{
+		experimentBaselineKey: {Impressions: 2000, Conversions: 200},
+		"treat-a":             {Impressions: 1000, Conversions: 150},
+		"treat-b":             {Impressions: 500, Conversions: 40},
+	}
+	if len(counts) != len(want) {
+		t.Fatalf("expected %d keys, got %d: %+v", len(want), len(counts), counts)
+	}
+	for key, expected := range want {
+		got, ok := counts[key]
+		if !ok {
+			t.Fatalf("missing counts for %q", key)
+		}
+		if *got != expected {
+			t.Fatalf("counts[%q] = %+v, want %+v", key, *got, expected)
+		}
+	}
+}
+
+func TestParseExperimentAnalyticsIgnoresReportsWithoutTreatments(t *testing.T) {
+	counts := make(map[string]*experimentCounts)
+	body := gzipExperimentRows(t,
+		"Date\tPage Type\tImpressions",
+		"2026-10-01\tProduct Page\t100",
+	)
+	if err := parseExperimentAnalytics(body, experimentInfo{ID: "exp-1"}, testExperimentTreatments(), counts); err != nil {
+		t.Fatalf("parseExperimentAnalytics() error: %v", err)
+	}
+	if len(counts) != 0 {
+		t.Fatalf("expected no counts, got %+v", counts)
+	}
+}
+
+func TestBuildExperimentReport(t *testing.T) {
+	counts := map[string]*experimentCounts{
+		experimentBaselineKey: {Impressions: 2000, Conversions: 200},
+		"treat-a":             {Impressions: 2000, Conversions: 260},
+	}
+	report := buildExperimentReport(
+		experimentInfo{ID: "exp-1", Name: "Icon Test", State: "ACCEPTED"},
+		testExperimentTreatments(),
+		experimentAnalyticsSummary{Status: experimentAnalyticsStatusOK, Instances: 2},
+		counts,
+	)
+
+	if report.Baseline.ConversionRate == nil || *report.Baseline.ConversionRate != 10 {
+		t.Fatalf("expected baseline conversion rate 10, got %v", report.Baseline.ConversionRate)
+	}
+	if len(report.Treatments) != 2 {
+		t.Fatalf("expected 2 treatments, got %d", len(report.Treatments))
+	}
+
+	winner := report.Treatments[0]
+	if winner.Improvement == nil || math.Abs(*winner.Improvement-30) > 1e-9 {
+		t.Fatalf("expected 30%% improvement, got %v", winner.Improvement)
+	}
+	if winner.Confidence == nil || *winner.Confidence < 99 {
+		t.Fatalf("expected confidence above 99%%, got %v", winner.Confidence)
+	}
+
+	missing := report.Treatments[1]
+	if missing.Impressions != nil || missing.Improvement != nil || missing.Confidence != nil {
+		t.Fatalf("expected treatment without analytics to have no values, got %+v", missing)
+	}
+}
+
+func TestExperimentConfidence(t *testing.T) {
+	if _, ok := experimentConfidence(0, 0, 10, 100); ok {
+		t.Fatal("expected no confidence without baseline impressions")
+	}
+	equal, ok := experimentConfidence(100, 1000, 100, 1000)
+	if !ok || math.Abs(equal-0.5) > 1e-9 {
+		t.Fatalf("expected 0.5 for equal rates, got %v (ok=%v)", equal, ok)
+	}
+	worse, ok := experimentConfidence(100, 1000, 50, 1000)
+	if !ok || worse > 0.01 {
+		t.Fatalf("expected near-zero confidence for a worse treatment, got %v", worse)
+	}
+}
+
+func TestSelectExperimentWinner(t *testing.T) {
+	ptr := func(v float64) *float64 { return &v }
+	report := &experimentReport{
+		ExperimentID: "exp-1",
+		Analytics:    experimentAnalyticsSummary{Status: experimentAnalyticsStatusOK},
+		Treatments: []experimentTreatmentResult{
+			{ID: "treat-a", Improvement: ptr(12), Confidence: ptr(97)},
+			{ID: "treat-b", Improvement: ptr(20), Confidence: ptr(80)},
+			{ID: "treat-c", Improvement: ptr(15), Confidence: ptr(99)},
+		},
+	}
+
+	winner, err := selectExperimentWinner(report, "", 95)
+	if err != nil {
+		t.Fatalf("selectExperimentWinner() error: %v", err)
+	}
+	if winner.ID != "treat-c" {
+		t.Fatalf("expected treat-c, got %q", winner.ID)
+	}
+
+	winner, err = selectExperimentWinner(report, "treat-b", 95)
+	if err != nil || winner.ID != "treat-b" {
+		t.Fatalf("expected explicit treat-b, got %q (err=%v)", winner.ID, err)
+	}
+
+	if _, err := selectExperimentWinner(report, "treat-z", 95); err == nil {
+		t.Fatal("expected error for unknown treatment")
+	}
+	if _, err := selectExperimentWinner(report, "", 99.5); err == nil || !strings.Contains(err.Error(), "--treatment-id") {
+		t.Fatalf("expected no-winner error, got %v", err)
+	}
+
+	report.Analytics = unavailableExperimentAnalytics("no reports")
+	if _, err := selectExperimentWinner(report, "", 95); err == nil || !strings.Contains(err.Error(), "no reports") {
+		t.Fatalf("expected unavailable analytics error, got %v", err)
+	}
+}
+
+func TestExperimentMediaFileName(t *testing.T) {
+	if got := experimentMediaFileName(0, "Hero.PNG", ".png"); got != "01.png" {
+		t.Fatalf("expected 01.png, got %q", got)
+	}
+	if got := experimentMediaFileName(9, "", ".mov"); got != "10.mov" {
+		t.Fatalf("expected 10.mov, got %q", got)
+	}
+}
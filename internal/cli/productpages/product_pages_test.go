@@ -23,6 +23,8 @@ func TestProductPagesCommandConstructors(t *testing.T) {
 		func() any { return CustomPageLocalizationsCommand() },
 		func() any { return CustomPageVersionsCommand() },
 		func() any { return ExperimentsCommand() },
+		func() any { return ExperimentsReportCommand() },
+		func() any { return ExperimentsConcludeCommand() },
 		func() any { return ExperimentTreatmentsCommand() },
 	}
 	for _, ctor := range constructors {
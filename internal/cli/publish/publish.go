@@ -46,12 +46,12 @@ Examples:
 func PublishTestFlightCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("publish testflight", flag.ExitOnError)
 
-	appID := fs.String("app", "", "App Store Connect app ID (required, or ASC_APP_ID env)")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (required, or ASC_APP_ID env)")
 	ipaPath := fs.String("ipa", "", "Path to .ipa file (required)")
 	version := fs.String("version", "", "CFBundleShortVersionString (auto-extracted from IPA if not provided)")
 	buildNumber := fs.String("build-number", "", "CFBundleVersion (auto-extracted from IPA if not provided)")
 	platform := fs.String("platform", "IOS", "Platform: IOS, MAC_OS, TV_OS, VISION_OS")
-	groupIDs := fs.String("group", "", "Beta group ID(s) or name(s), comma-separated")
+	groupIDs := shared.ReferenceListFlag(fs, shared.ReferenceBetaGroup, "", "Beta group ID(s) or name(s) or name(s), comma-separated")
 	notify := fs.Bool("notify", false, "Notify testers after adding to groups")
 	wait := fs.Bool("wait", false, "Wait for build processing to complete")
 	pollInterval := fs.Duration("poll-interval", shared.PublishDefaultPollInterval, "Polling interval for --wait and build discovery")
@@ -143,7 +143,7 @@ Examples:
 			requestCtx, cancel := shared.ContextWithTimeoutDuration(ctx, timeoutValue)
 			defer cancel()
 
-			resolvedGroupIDs, err := resolvePublishBetaGroupIDs(requestCtx, client, resolvedAppID, parsedGroupIDs)
+			resolvedGroupIDs, err := shared.ResolveBetaGroupIDs(requestCtx, client, resolvedAppID, parsedGroupIDs)
 			if err != nil {
 				return fmt.Errorf("publish testflight: %w", err)
 			}
@@ -192,7 +192,7 @@ Examples:
 func PublishAppStoreCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("publish appstore", flag.ExitOnError)
 
-	appID := fs.String("app", "", "App Store Connect app ID (required, or ASC_APP_ID env)")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (required, or ASC_APP_ID env)")
 	ipaPath := fs.String("ipa", "", "Path to .ipa file (required)")
 	version := fs.String("version", "", "App Store version string (defaults to IPA version)")
 	buildNumber := fs.String("build-number", "", "CFBundleVersion (auto-extracted from IPA if not provided)")
@@ -324,6 +324,26 @@ Examples:
 	}
 }
 
+// UploadBuild uploads an IPA and waits until App Store Connect lists the
+// matching build. It backs the upload step of publish and release.
+func UploadBuild(ctx context.Context, client *asc.Client, appID, ipaPath, version, buildNumber string, platform asc.Platform, pollInterval time.Duration) (*asc.BuildResponse, error) {
+	fileInfo, err := validateIPAPath(ipaPath)
+	if err != nil {
+		return nil, err
+	}
+	result, err := uploadBuildAndWaitForID(ctx, client, appID, ipaPath, fileInfo, version, buildNumber, platform, pollInterval, resolvePublishTimeout(0), false)
+	if err != nil {
+		return nil, err
+	}
+	return result.Build, nil
+}
+
+// ResolveBundleInfo returns the version and build number for an IPA,
+// extracting whichever of them is empty from its Info.plist.
+func ResolveBundleInfo(ipaPath, version, buildNumber string) (string, string, error) {
+	return resolveBundleInfoForIPA(ipaPath, version, buildNumber)
+}
+
 type publishUploadResult struct {
 	Build       *asc.BuildResponse
 	Version     string
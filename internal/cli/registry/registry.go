@@ -14,6 +14,7 @@ import (
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/alternativedistribution"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/analytics"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/androidiosmapping"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/api"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/app_events"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/appclips"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/apps"
@@ -25,6 +26,7 @@ import (
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/buildlocalizations"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/builds"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/bundleids"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/catalog"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/categories"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/certificates"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/completion"
@@ -43,9 +45,11 @@ import (
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/install"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/localizations"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/marketplace"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/mcp"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/merchantids"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/metadata"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/migrate"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/mock"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/nominations"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/notarization"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/notify"
@@ -59,6 +63,7 @@ import (
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/profiles"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/promotedpurchases"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/publish"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/release"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/releasenotes"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/reviews"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/routingcoverage"
@@ -74,6 +79,7 @@ import (
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/validate"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/versions"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/videopreviews"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/watch"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/webhooks"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/winbackoffers"
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/workflow"
@@ -105,6 +111,7 @@ func Subcommands(version string) []*ffcli.Command {
 		docs.DocsCommand(),
 		diffcmd.DiffCommand(),
 		status.StatusCommand(),
+		status.TUICommand(),
 		insights.InsightsCommand(),
 		releasenotes.ReleaseNotesCommand(),
 		feedback.FeedbackCommand(),
@@ -122,6 +129,7 @@ func Subcommands(version string) []*ffcli.Command {
 		marketplace.MarketplaceCommand(),
 		alternativedistribution.Command(),
 		webhooks.WebhooksCommand(),
+		watch.WatchCommand(),
 		nominations.NominationsCommand(),
 		bundleids.BundleIDsCommand(),
 		merchantids.MerchantIDsCommand(),
@@ -137,6 +145,7 @@ func Subcommands(version string) []*ffcli.Command {
 		builds.BuildsCommand(),
 		buildbundles.BuildBundlesCommand(),
 		publish.PublishCommand(),
+		release.ReleaseCommand(),
 		workflow.WorkflowCommand(),
 		versions.VersionsCommand(),
 		productpages.ProductPagesCommand(),
@@ -162,6 +171,7 @@ func Subcommands(version string) []*ffcli.Command {
 		iap.IAPCommand(),
 		app_events.Command(),
 		subscriptions.SubscriptionsCommand(),
+		catalog.CatalogCommand(),
 		submit.SubmitCommand(),
 		validate.ValidateCommand(),
 		xcodecloud.XcodeCloudCommand(),
@@ -172,10 +182,13 @@ func Subcommands(version string) []*ffcli.Command {
 		promotedpurchases.PromotedPurchasesCommand(),
 		migrate.MigrateCommand(),
 		notify.NotifyCommand(),
+		mock.MockCommand(),
+		api.APICommand(),
 		gamecenter.GameCenterCommand(),
 		VersionCommand(version),
 	}
 
-	subs = append(subs, completion.CompletionCommand(subs))
+	subs = append(subs, mcp.MCPCommand(version, subs))
+	subs = append(subs, completion.CompletionCommand())
 	return subs
 }
@@ -0,0 +1,237 @@
+package release
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+	"gopkg.in/yaml.v3"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/publish"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const releaseOutputJUnit = "junit"
+
+var releaseOutputFormats = []string{"json", "table", "markdown", releaseOutputJUnit}
+
+// releaseDefaultTimeout bounds a whole release run, including build
+// processing, unless --timeout overrides it.
+const releaseDefaultTimeout = 2 * time.Hour
+
+// ReleaseConfig is the release.yaml schema.
+type ReleaseConfig struct {
+	App              string `yaml:"app" json:"app"`
+	Platform         string `yaml:"platform,omitempty" json:"platform,omitempty"`
+	Version          string `yaml:"version,omitempty" json:"version,omitempty"`
+	BuildNumber      string `yaml:"buildNumber,omitempty" json:"buildNumber,omitempty"`
+	IPA              string `yaml:"ipa,omitempty" json:"ipa,omitempty"`
+	MetadataDir      string `yaml:"metadataDir,omitempty" json:"metadataDir,omitempty"`
+	StrictValidation bool   `yaml:"strictValidation,omitempty" json:"strictValidation,omitempty"`
+	Submit           bool   `yaml:"submit,omitempty" json:"submit,omitempty"`
+	PhasedRelease    bool   `yaml:"phasedRelease,omitempty" json:"phasedRelease,omitempty"`
+}
+
+var newReleaseBackend = func() (releaseBackend, error) {
+	client, err := shared.GetASCClient()
+	if err != nil {
+		return nil, err
+	}
+	return &clientReleaseBackend{client: client}, nil
+}
+
+// ReleaseCommand returns the asc release command.
+func ReleaseCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("release", flag.ExitOnError)
+
+	configPath := fs.String("config", "", "Path to release.yaml (required)")
+	plan := fs.Bool("plan", false, "Print the release plan without changing anything")
+	confirm := fs.Bool("confirm", false, "Confirm executing the release (required unless --plan)")
+	stateFile := fs.String("state-file", "", "Progress file (default: .asc/release/APP_ID-VERSION-BUILD.json)")
+	pollInterval := fs.Duration("poll-interval", shared.PublishDefaultPollInterval, "Polling interval for build discovery and processing")
+	timeout := fs.Duration("timeout", 0, "Override the overall release timeout (default: 2h)")
+	output := shared.BindOutputFlagsWith(fs, "output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, junit")
+
+	return &ffcli.Command{
+		Name:       "release",
+		ShortUsage: "asc release --config release.yaml [--plan | --confirm] [flags]",
+		ShortHelp:  "Run an end-to-end App Store release from a config file.",
+		LongHelp: `Run an end-to-end App Store release from a config file.
+
+Stages run in order, and each one is skipped when App Store Connect already
+reflects it:
+  upload          Upload the IPA (skipped when the build number exists)
+  process         Wait for build processing
+  version         Find or create the App Store version
+  metadata        Push metadata from metadataDir (when configured)
+  attach          Attach the build to the version
+  validate        Run asc validate checks; blocking issues stop the release
+  submit          Submit for App Store review (when submit: true)
+  phased-release  Create a phased release (when phasedRelease: true)
+
+Progress is saved to --state-file after every stage, so rerunning the same
+command resumes where a failed or interrupted run stopped.
+
+Config (release.yaml, paths are relative to the file):
+  app: "123456789"
+  platform: IOS
+  ipa: build/App.ipa          # version/buildNumber default to the IPA's
+  version: "1.2.3"
+  buildNumber: "42"
+  metadataDir: metadata
+  strictValidation: true
+  submit: true
+  phasedRelease: true
+
+Examples:
+  asc release --config release.yaml --plan
+  asc release --config release.yaml --confirm
+  asc release --config release.yaml --confirm --output markdown
+  asc release --config release.yaml --confirm --output junit > release.xml`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if len(args) > 0 {
+				return shared.UsageError("release does not accept positional arguments")
+			}
+			if strings.TrimSpace(*configPath) == "" {
+				return shared.UsageError("--config is required")
+			}
+			if !*plan && !*confirm {
+				return shared.UsageError("--confirm is required to execute a release (use --plan to preview)")
+			}
+			if *pollInterval <= 0 {
+				return shared.UsageError("--poll-interval must be greater than 0")
+			}
+			if *timeout < 0 {
+				return shared.UsageError("--timeout must be greater than 0")
+			}
+			format, err := shared.ValidateOutputFormatAllowed(*output.Output, *output.Pretty, releaseOutputFormats...)
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
+
+			config, err := loadReleaseConfig(*configPath)
+			if err != nil {
+				return fmt.Errorf("release: %w", err)
+			}
+
+			backend, err := newReleaseBackend()
+			if err != nil {
+				return fmt.Errorf("release: %w", err)
+			}
+
+			timeoutValue := *timeout
+			if timeoutValue == 0 {
+				timeoutValue = releaseDefaultTimeout
+			}
+			requestCtx, cancel := shared.ContextWithTimeoutDuration(ctx, timeoutValue)
+			defer cancel()
+
+			runner := &releaseRunner{
+				config:       config,
+				backend:      backend,
+				statePath:    resolveReleaseStatePath(*stateFile, config),
+				pollInterval: *pollInterval,
+				log:          os.Stderr,
+				now:          time.Now,
+			}
+
+			var result *releaseResult
+			var runErr error
+			if *plan {
+				result, runErr = runner.plan(requestCtx)
+				if runErr != nil {
+					return fmt.Errorf("release: %w", runErr)
+				}
+			} else {
+				result, runErr = runner.run(requestCtx)
+				if result == nil {
+					return fmt.Errorf("release: %w", runErr)
+				}
+			}
+
+			if err := printReleaseResult(result, format, *output.Pretty); err != nil {
+				return err
+			}
+			if runErr != nil {
+				return shared.NewReportedError(fmt.Errorf("release: %w", runErr))
+			}
+			return nil
+		},
+	}
+}
+
+// loadReleaseConfig reads release.yaml, resolves paths relative to it and
+// fills version/build number from the IPA when they are omitted.
+func loadReleaseConfig(path string) (ReleaseConfig, error) {
+	data, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return ReleaseConfig{}, fmt.Errorf("read config: %w", err)
+	}
+
+	var config ReleaseConfig
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&config); err != nil {
+		if errors.Is(err, io.EOF) {
+			return ReleaseConfig{}, fmt.Errorf("config %s is empty", path)
+		}
+		return ReleaseConfig{}, fmt.Errorf("parse config %s: %w", path, err)
+	}
+
+	config.App = shared.ResolveAppID(strings.TrimSpace(config.App))
+	if config.App == "" {
+		return ReleaseConfig{}, fmt.Errorf("config %s: app is required (or set ASC_APP_ID)", path)
+	}
+	if strings.TrimSpace(config.Platform) == "" {
+		config.Platform = string(asc.PlatformIOS)
+	}
+	platform, err := shared.NormalizeAppStoreVersionPlatform(config.Platform)
+	if err != nil {
+		return ReleaseConfig{}, fmt.Errorf("config %s: %w", path, err)
+	}
+	config.Platform = platform
+
+	baseDir := filepath.Dir(path)
+	config.IPA = resolveReleaseConfigPath(baseDir, config.IPA)
+	config.MetadataDir = resolveReleaseConfigPath(baseDir, config.MetadataDir)
+	config.Version = strings.TrimSpace(config.Version)
+	config.BuildNumber = strings.TrimSpace(config.BuildNumber)
+
+	if config.IPA != "" {
+		config.Version, config.BuildNumber, err = publish.ResolveBundleInfo(config.IPA, config.Version, config.BuildNumber)
+		if err != nil {
+			return ReleaseConfig{}, fmt.Errorf("config %s: %w", path, err)
+		}
+	}
+	if config.Version == "" || config.BuildNumber == "" {
+		return ReleaseConfig{}, fmt.Errorf("config %s: version and buildNumber are required without ipa", path)
+	}
+	return config, nil
+}
+
+func resolveReleaseConfigPath(baseDir, value string) string {
+	value = strings.TrimSpace(value)
+	if value == "" || filepath.IsAbs(value) {
+		return value
+	}
+	return filepath.Join(baseDir, value)
+}
+
+func resolveReleaseStatePath(value string, config ReleaseConfig) string {
+	if trimmed := strings.TrimSpace(value); trimmed != "" {
+		return filepath.Clean(trimmed)
+	}
+	name := fmt.Sprintf("%s-%s-%s.json", config.App, config.Version, config.BuildNumber)
+	return filepath.Join(".asc", "release", name)
+}
@@ -0,0 +1,175 @@
+package release
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/metadata"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/publish"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/submit"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/validate"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/validation"
+)
+
+// releaseBackend is the App Store Connect surface the release stages use.
+// Lookups return an empty ID, not an error, when the resource is missing.
+type releaseBackend interface {
+	FindBuild(ctx context.Context, config ReleaseConfig) (string, error)
+	UploadBuild(ctx context.Context, config ReleaseConfig, pollInterval time.Duration) (string, error)
+	BuildProcessingState(ctx context.Context, buildID string) (string, error)
+	WaitForBuildProcessing(ctx context.Context, buildID string, pollInterval time.Duration) error
+	FindVersion(ctx context.Context, config ReleaseConfig) (string, error)
+	CreateVersion(ctx context.Context, config ReleaseConfig) (string, error)
+	PushMetadata(ctx context.Context, config ReleaseConfig, dryRun bool) (*metadata.PushPlanResult, error)
+	AttachedBuildID(ctx context.Context, versionID string) (string, error)
+	AttachBuild(ctx context.Context, versionID, buildID string) error
+	Validate(ctx context.Context, config ReleaseConfig, versionID string) (*validation.Report, error)
+	VersionState(ctx context.Context, versionID string) (string, error)
+	Submit(ctx context.Context, config ReleaseConfig, versionID, buildID string) (string, error)
+	FindPhasedRelease(ctx context.Context, versionID string) (string, error)
+	CreatePhasedRelease(ctx context.Context, versionID string) (string, error)
+}
+
+type clientReleaseBackend struct {
+	client *asc.Client
+}
+
+func (b *clientReleaseBackend) FindBuild(ctx context.Context, config ReleaseConfig) (string, error) {
+	requestCtx, cancel := shared.ContextWithTimeout(ctx)
+	defer cancel()
+	build, err := shared.FindBuildByNumber(requestCtx, b.client, config.App, config.Version, config.BuildNumber, config.Platform)
+	if err != nil || build == nil {
+		return "", err
+	}
+	return build.Data.ID, nil
+}
+
+func (b *clientReleaseBackend) UploadBuild(ctx context.Context, config ReleaseConfig, pollInterval time.Duration) (string, error) {
+	build, err := publish.UploadBuild(ctx, b.client, config.App, config.IPA, config.Version, config.BuildNumber, asc.Platform(config.Platform), pollInterval)
+	if err != nil {
+		return "", err
+	}
+	return build.Data.ID, nil
+}
+
+func (b *clientReleaseBackend) BuildProcessingState(ctx context.Context, buildID string) (string, error) {
+	requestCtx, cancel := shared.ContextWithTimeout(ctx)
+	defer cancel()
+	build, err := b.client.GetBuild(requestCtx, buildID)
+	if err != nil {
+		return "", err
+	}
+	return strings.ToUpper(strings.TrimSpace(build.Data.Attributes.ProcessingState)), nil
+}
+
+func (b *clientReleaseBackend) WaitForBuildProcessing(ctx context.Context, buildID string, pollInterval time.Duration) error {
+	_, err := b.client.WaitForBuildProcessing(ctx, buildID, pollInterval)
+	return err
+}
+
+func (b *clientReleaseBackend) FindVersion(ctx context.Context, config ReleaseConfig) (string, error) {
+	requestCtx, cancel := shared.ContextWithTimeout(ctx)
+	defer cancel()
+	resp, err := b.client.GetAppStoreVersions(requestCtx, config.App,
+		asc.WithAppStoreVersionsVersionStrings([]string{config.Version}),
+		asc.WithAppStoreVersionsPlatforms([]string{config.Platform}),
+		asc.WithAppStoreVersionsLimit(10),
+	)
+	if err != nil || len(resp.Data) == 0 {
+		return "", err
+	}
+	return resp.Data[0].ID, nil
+}
+
+func (b *clientReleaseBackend) CreateVersion(ctx context.Context, config ReleaseConfig) (string, error) {
+	requestCtx, cancel := shared.ContextWithTimeout(ctx)
+	defer cancel()
+	resp, err := b.client.FindOrCreateAppStoreVersion(requestCtx, config.App, config.Version, asc.Platform(config.Platform))
+	if err != nil {
+		return "", err
+	}
+	return resp.Data.ID, nil
+}
+
+func (b *clientReleaseBackend) PushMetadata(ctx context.Context, config ReleaseConfig, dryRun bool) (*metadata.PushPlanResult, error) {
+	requestCtx, cancel := shared.ContextWithTimeout(ctx)
+	defer cancel()
+	return metadata.Push(requestCtx, b.client, metadata.PushOptions{
+		AppID:    config.App,
+		Version:  config.Version,
+		Platform: config.Platform,
+		Dir:      config.MetadataDir,
+		DryRun:   dryRun,
+	})
+}
+
+func (b *clientReleaseBackend) AttachedBuildID(ctx context.Context, versionID string) (string, error) {
+	requestCtx, cancel := shared.ContextWithTimeout(ctx)
+	defer cancel()
+	resp, err := b.client.GetAppStoreVersionBuild(requestCtx, versionID)
+	if err != nil {
+		if asc.IsNotFound(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	return strings.TrimSpace(resp.Data.ID), nil
+}
+
+func (b *clientReleaseBackend) AttachBuild(ctx context.Context, versionID, buildID string) error {
+	requestCtx, cancel := shared.ContextWithTimeout(ctx)
+	defer cancel()
+	return b.client.AttachBuildToVersion(requestCtx, versionID, buildID)
+}
+
+func (b *clientReleaseBackend) Validate(ctx context.Context, config ReleaseConfig, versionID string) (*validation.Report, error) {
+	requestCtx, cancel := shared.ContextWithTimeout(ctx)
+	defer cancel()
+	return validate.BuildReport(requestCtx, b.client, config.App, versionID, config.Platform, config.StrictValidation)
+}
+
+func (b *clientReleaseBackend) VersionState(ctx context.Context, versionID string) (string, error) {
+	requestCtx, cancel := shared.ContextWithTimeout(ctx)
+	defer cancel()
+	resp, err := b.client.GetAppStoreVersion(requestCtx, versionID)
+	if err != nil {
+		return "", err
+	}
+	return strings.ToUpper(shared.ResolveAppStoreVersionState(resp.Data.Attributes)), nil
+}
+
+func (b *clientReleaseBackend) Submit(ctx context.Context, config ReleaseConfig, versionID, buildID string) (string, error) {
+	requestCtx, cancel := shared.ContextWithTimeout(ctx)
+	defer cancel()
+	result, err := submit.SubmitForReview(requestCtx, b.client, config.App, versionID, buildID, asc.Platform(config.Platform))
+	if err != nil {
+		return "", err
+	}
+	return result.SubmissionID, nil
+}
+
+func (b *clientReleaseBackend) FindPhasedRelease(ctx context.Context, versionID string) (string, error) {
+	requestCtx, cancel := shared.ContextWithTimeout(ctx)
+	defer cancel()
+	resp, err := b.client.GetAppStoreVersionPhasedRelease(requestCtx, versionID)
+	if err != nil {
+		if asc.IsNotFound(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	return strings.TrimSpace(resp.Data.ID), nil
+}
+
+func (b *clientReleaseBackend) CreatePhasedRelease(ctx context.Context, versionID string) (string, error) {
+	requestCtx, cancel := shared.ContextWithTimeout(ctx)
+	defer cancel()
+	resp, err := b.client.CreateAppStoreVersionPhasedRelease(requestCtx, versionID, asc.PhasedReleaseStateInactive)
+	if err != nil {
+		return "", err
+	}
+	return resp.Data.ID, nil
+}
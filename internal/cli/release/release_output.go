@@ -0,0 +1,75 @@
+package release
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// printReleaseResult writes the result in a format validated against releaseOutputFormats.
+func printReleaseResult(result *releaseResult, format string, pretty bool) error {
+	if format == releaseOutputJUnit {
+		report := releaseJUnitReport(result, time.Now())
+		if _, err := report.WriteTo(os.Stdout); err != nil {
+			return err
+		}
+		fmt.Println()
+		return nil
+	}
+	return shared.PrintOutputWithRenderers(
+		result,
+		format,
+		pretty,
+		func() error { return renderReleaseResult(result, asc.RenderTable) },
+		func() error { return renderReleaseResult(result, asc.RenderMarkdown) },
+	)
+}
+
+func renderReleaseResult(result *releaseResult, render func([]string, [][]string)) error {
+	render([]string{"App", "Version", "Build", "Platform", "Build ID", "Version ID"}, [][]string{{
+		result.AppID,
+		result.Version,
+		result.BuildNumber,
+		result.Platform,
+		shared.OrNA(result.BuildID),
+		shared.OrNA(result.VersionID),
+	}})
+
+	rows := make([][]string, 0, len(result.Stages))
+	for _, stage := range result.Stages {
+		rows = append(rows, []string{stage.Name, stage.Status, stage.Detail})
+	}
+	fmt.Println()
+	render([]string{"Stage", "Status", "Detail"}, rows)
+	return nil
+}
+
+// releaseJUnitReport maps each stage to a test case. Stages that did not
+// run (disabled, or pending after a failure) are reported as skipped.
+func releaseJUnitReport(result *releaseResult, now time.Time) shared.JUnitReport {
+	report := shared.JUnitReport{
+		Name:      fmt.Sprintf("asc release %s (%s)", result.Version, result.BuildNumber),
+		Timestamp: now,
+	}
+	for _, stage := range result.Stages {
+		testCase := shared.JUnitTestCase{
+			Name:      stage.Name,
+			Classname: "release",
+			Time:      stage.Duration,
+			SystemOut: stage.Detail,
+		}
+		switch stage.Status {
+		case releaseStatusFailed:
+			testCase.Failure = "FAILED"
+			testCase.Message = stage.Detail
+			testCase.SystemOut = ""
+		case releaseStatusDisabled, releaseStatusPending:
+			testCase.Skipped = true
+		}
+		report.Tests = append(report.Tests, testCase)
+	}
+	return report
+}
@@ -0,0 +1,452 @@
+package release
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+const (
+	releaseStatusPending   = "pending"
+	releaseStatusSatisfied = "satisfied"
+	releaseStatusCompleted = "completed"
+	releaseStatusResumed   = "resumed"
+	releaseStatusDisabled  = "disabled"
+	releaseStatusFailed    = "failed"
+)
+
+// releaseSubmittedStates are version states that mean the version has
+// already been submitted for review (or gone past it).
+var releaseSubmittedStates = map[string]bool{
+	"WAITING_FOR_REVIEW":          true,
+	"IN_REVIEW":                   true,
+	"PENDING_APPLE_RELEASE":       true,
+	"PENDING_DEVELOPER_RELEASE":   true,
+	"PROCESSING_FOR_DISTRIBUTION": true,
+	"READY_FOR_DISTRIBUTION":      true,
+	"READY_FOR_SALE":              true,
+	"ACCEPTED":                    true,
+}
+
+// releaseStageResult is one stage of the plan or of a finished run.
+type releaseStageResult struct {
+	Name     string        `json:"name"`
+	Status   string        `json:"status"`
+	Detail   string        `json:"detail,omitempty"`
+	Duration time.Duration `json:"-"`
+}
+
+// releaseResult is the release command output.
+type releaseResult struct {
+	AppID           string               `json:"appId"`
+	Version         string               `json:"version"`
+	BuildNumber     string               `json:"buildNumber"`
+	Platform        string               `json:"platform"`
+	BuildID         string               `json:"buildId,omitempty"`
+	VersionID       string               `json:"versionId,omitempty"`
+	SubmissionID    string               `json:"submissionId,omitempty"`
+	PhasedReleaseID string               `json:"phasedReleaseId,omitempty"`
+	StateFile       string               `json:"stateFile"`
+	Plan            bool                 `json:"plan,omitempty"`
+	Stages          []releaseStageResult `json:"stages"`
+}
+
+// releaseState is persisted after every stage so reruns resume.
+type releaseState struct {
+	AppID           string            `json:"appId"`
+	Version         string            `json:"version"`
+	BuildNumber     string            `json:"buildNumber"`
+	Platform        string            `json:"platform"`
+	BuildID         string            `json:"buildId,omitempty"`
+	VersionID       string            `json:"versionId,omitempty"`
+	SubmissionID    string            `json:"submissionId,omitempty"`
+	PhasedReleaseID string            `json:"phasedReleaseId,omitempty"`
+	Completed       map[string]string `json:"completed"`
+	UpdatedAt       string            `json:"updatedAt,omitempty"`
+}
+
+// releaseStage checks whether App Store Connect already reflects a stage
+// and runs it when it does not. Checks that depend on an earlier pending
+// stage report "not satisfied" instead of failing.
+type releaseStage struct {
+	name    string
+	enabled func(config ReleaseConfig) bool
+	check   func(ctx context.Context, r *releaseRunner) (string, bool, error)
+	run     func(ctx context.Context, r *releaseRunner) (string, error)
+}
+
+var releaseStages = []releaseStage{
+	{name: "upload", check: checkUploadStage, run: runUploadStage},
+	{name: "process", check: checkProcessStage, run: runProcessStage},
+	{name: "version", check: checkVersionStage, run: runVersionStage},
+	{
+		name:    "metadata",
+		enabled: func(config ReleaseConfig) bool { return config.MetadataDir != "" },
+		check:   checkMetadataStage,
+		run:     runMetadataStage,
+	},
+	{name: "attach", check: checkAttachStage, run: runAttachStage},
+	{name: "validate", check: checkValidateStage, run: runValidateStage},
+	{
+		name:    "submit",
+		enabled: func(config ReleaseConfig) bool { return config.Submit },
+		check:   checkSubmitStage,
+		run:     runSubmitStage,
+	},
+	{
+		name:    "phased-release",
+		enabled: func(config ReleaseConfig) bool { return config.PhasedRelease },
+		check:   checkPhasedReleaseStage,
+		run:     runPhasedReleaseStage,
+	},
+}
+
+type releaseRunner struct {
+	config       ReleaseConfig
+	backend      releaseBackend
+	statePath    string
+	pollInterval time.Duration
+	log          io.Writer
+	now          func() time.Time
+	state        releaseState
+}
+
+// plan reports what a run would do without changing anything.
+func (r *releaseRunner) plan(ctx context.Context) (*releaseResult, error) {
+	if err := r.loadState(); err != nil {
+		return nil, err
+	}
+	result := r.newResult()
+	result.Plan = true
+	for _, stage := range releaseStages {
+		if status, detail, skip := r.skipStatus(stage); skip {
+			result.Stages = append(result.Stages, releaseStageResult{Name: stage.name, Status: status, Detail: detail})
+			continue
+		}
+		detail, satisfied, err := stage.check(ctx, r)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", stage.name, err)
+		}
+		status := releaseStatusPending
+		if satisfied {
+			status = releaseStatusSatisfied
+		}
+		result.Stages = append(result.Stages, releaseStageResult{Name: stage.name, Status: status, Detail: detail})
+	}
+	r.fillResultIDs(result)
+	return result, nil
+}
+
+// run executes every enabled stage in order, saving progress after each
+// one. On failure it returns the partial result along with the error.
+func (r *releaseRunner) run(ctx context.Context) (*releaseResult, error) {
+	if err := r.loadState(); err != nil {
+		return nil, err
+	}
+	result := r.newResult()
+	var runErr error
+	for _, stage := range releaseStages {
+		if status, detail, skip := r.skipStatus(stage); skip {
+			result.Stages = append(result.Stages, releaseStageResult{Name: stage.name, Status: status, Detail: detail})
+			continue
+		}
+		if runErr != nil {
+			result.Stages = append(result.Stages, releaseStageResult{Name: stage.name, Status: releaseStatusPending})
+			continue
+		}
+
+		started := r.now()
+		stageResult := releaseStageResult{Name: stage.name}
+		detail, satisfied, err := stage.check(ctx, r)
+		switch {
+		case err != nil:
+		case satisfied:
+			stageResult.Status = releaseStatusSatisfied
+		default:
+			fmt.Fprintf(r.log, "release: %s: %s\n", stage.name, detail)
+			detail, err = stage.run(ctx, r)
+			stageResult.Status = releaseStatusCompleted
+		}
+		stageResult.Duration = r.now().Sub(started)
+
+		if err != nil {
+			stageResult.Status = releaseStatusFailed
+			stageResult.Detail = err.Error()
+			runErr = fmt.Errorf("%s: %w", stage.name, err)
+		} else {
+			stageResult.Detail = detail
+			r.state.Completed[stage.name] = detail
+		}
+		fmt.Fprintf(r.log, "release: %s %s: %s\n", stage.name, stageResult.Status, stageResult.Detail)
+		result.Stages = append(result.Stages, stageResult)
+
+		if err := r.saveState(); err != nil {
+			return nil, err
+		}
+	}
+	r.fillResultIDs(result)
+	return result, runErr
+}
+
+func (r *releaseRunner) skipStatus(stage releaseStage) (string, string, bool) {
+	if stage.enabled != nil && !stage.enabled(r.config) {
+		return releaseStatusDisabled, "", true
+	}
+	if detail, ok := r.state.Completed[stage.name]; ok {
+		return releaseStatusResumed, detail, true
+	}
+	return "", "", false
+}
+
+func (r *releaseRunner) newResult() *releaseResult {
+	return &releaseResult{
+		AppID:       r.config.App,
+		Version:     r.config.Version,
+		BuildNumber: r.config.BuildNumber,
+		Platform:    r.config.Platform,
+		StateFile:   r.statePath,
+		Stages:      []releaseStageResult{},
+	}
+}
+
+func (r *releaseRunner) fillResultIDs(result *releaseResult) {
+	result.BuildID = r.state.BuildID
+	result.VersionID = r.state.VersionID
+	result.SubmissionID = r.state.SubmissionID
+	result.PhasedReleaseID = r.state.PhasedReleaseID
+}
+
+func (r *releaseRunner) loadState() error {
+	r.state = releaseState{
+		AppID:       r.config.App,
+		Version:     r.config.Version,
+		BuildNumber: r.config.BuildNumber,
+		Platform:    r.config.Platform,
+		Completed:   map[string]string{},
+	}
+	data, err := os.ReadFile(r.statePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("read state: %w", err)
+	}
+	var state releaseState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return fmt.Errorf("parse state %s: %w", r.statePath, err)
+	}
+	if state.AppID != r.config.App || state.Version != r.config.Version || state.BuildNumber != r.config.BuildNumber || state.Platform != r.config.Platform {
+		return fmt.Errorf("state file %s belongs to app %s version %s (%s) on %s; remove it or pass --state-file", r.statePath, state.AppID, state.Version, state.BuildNumber, state.Platform)
+	}
+	if state.Completed == nil {
+		state.Completed = map[string]string{}
+	}
+	r.state = state
+	return nil
+}
+
+func (r *releaseRunner) saveState() error {
+	r.state.UpdatedAt = r.now().UTC().Format(time.RFC3339)
+	data, err := json.MarshalIndent(r.state, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(r.statePath), 0o755); err != nil {
+		return fmt.Errorf("write state: %w", err)
+	}
+	tmp := r.statePath + ".tmp"
+	if err := os.WriteFile(tmp, append(data, '\n'), 0o600); err != nil {
+		return fmt.Errorf("write state: %w", err)
+	}
+	if err := os.Rename(tmp, r.statePath); err != nil {
+		return fmt.Errorf("write state: %w", err)
+	}
+	return nil
+}
+
+func checkUploadStage(ctx context.Context, r *releaseRunner) (string, bool, error) {
+	buildID, err := r.backend.FindBuild(ctx, r.config)
+	if err != nil {
+		return "", false, err
+	}
+	if buildID != "" {
+		r.state.BuildID = buildID
+		return fmt.Sprintf("build %s already uploaded (%s)", r.config.BuildNumber, buildID), true, nil
+	}
+	if r.config.IPA == "" {
+		return fmt.Sprintf("build %s not found and no ipa configured", r.config.BuildNumber), false, nil
+	}
+	return fmt.Sprintf("upload %s as build %s", filepath.Base(r.config.IPA), r.config.BuildNumber), false, nil
+}
+
+func runUploadStage(ctx context.Context, r *releaseRunner) (string, error) {
+	if r.config.IPA == "" {
+		return "", fmt.Errorf("build %s for version %s not found and no ipa is configured", r.config.BuildNumber, r.config.Version)
+	}
+	buildID, err := r.backend.UploadBuild(ctx, r.config, r.pollInterval)
+	if err != nil {
+		return "", err
+	}
+	r.state.BuildID = buildID
+	return fmt.Sprintf("uploaded build %s (%s)", r.config.BuildNumber, buildID), nil
+}
+
+func checkProcessStage(ctx context.Context, r *releaseRunner) (string, bool, error) {
+	if r.state.BuildID == "" {
+		return "wait for the uploaded build to process", false, nil
+	}
+	state, err := r.backend.BuildProcessingState(ctx, r.state.BuildID)
+	if err != nil {
+		return "", false, err
+	}
+	switch state {
+	case asc.BuildProcessingStateValid:
+		return fmt.Sprintf("build %s is processed", r.state.BuildID), true, nil
+	case asc.BuildProcessingStateInvalid:
+		return "", false, fmt.Errorf("build %s processing failed: %s", r.state.BuildID, state)
+	}
+	return fmt.Sprintf("wait for build %s to process (%s)", r.state.BuildID, state), false, nil
+}
+
+func runProcessStage(ctx context.Context, r *releaseRunner) (string, error) {
+	if err := r.backend.WaitForBuildProcessing(ctx, r.state.BuildID, r.pollInterval); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("build %s finished processing", r.state.BuildID), nil
+}
+
+func checkVersionStage(ctx context.Context, r *releaseRunner) (string, bool, error) {
+	versionID, err := r.backend.FindVersion(ctx, r.config)
+	if err != nil {
+		return "", false, err
+	}
+	if versionID != "" {
+		r.state.VersionID = versionID
+		return fmt.Sprintf("version %s exists (%s)", r.config.Version, versionID), true, nil
+	}
+	return fmt.Sprintf("create version %s for %s", r.config.Version, r.config.Platform), false, nil
+}
+
+func runVersionStage(ctx context.Context, r *releaseRunner) (string, error) {
+	versionID, err := r.backend.CreateVersion(ctx, r.config)
+	if err != nil {
+		return "", err
+	}
+	r.state.VersionID = versionID
+	return fmt.Sprintf("created version %s (%s)", r.config.Version, versionID), nil
+}
+
+func checkMetadataStage(ctx context.Context, r *releaseRunner) (string, bool, error) {
+	if r.state.VersionID == "" {
+		return fmt.Sprintf("push metadata from %s", r.config.MetadataDir), false, nil
+	}
+	plan, err := r.backend.PushMetadata(ctx, r.config, true)
+	if err != nil {
+		return "", false, err
+	}
+	changes := len(plan.Adds) + len(plan.Updates) + len(plan.Deletes)
+	if changes == 0 {
+		return "metadata is up to date", true, nil
+	}
+	return fmt.Sprintf("apply %d metadata change(s) from %s", changes, r.config.MetadataDir), false, nil
+}
+
+func runMetadataStage(ctx context.Context, r *releaseRunner) (string, error) {
+	result, err := r.backend.PushMetadata(ctx, r.config, false)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("applied %d metadata action(s)", len(result.Actions)), nil
+}
+
+func checkAttachStage(ctx context.Context, r *releaseRunner) (string, bool, error) {
+	if r.state.VersionID == "" || r.state.BuildID == "" {
+		return "attach the build to the version", false, nil
+	}
+	attached, err := r.backend.AttachedBuildID(ctx, r.state.VersionID)
+	if err != nil {
+		return "", false, err
+	}
+	if attached == r.state.BuildID {
+		return fmt.Sprintf("build %s already attached", r.state.BuildID), true, nil
+	}
+	return fmt.Sprintf("attach build %s to version %s", r.state.BuildID, r.state.VersionID), false, nil
+}
+
+func runAttachStage(ctx context.Context, r *releaseRunner) (string, error) {
+	if err := r.backend.AttachBuild(ctx, r.state.VersionID, r.state.BuildID); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("attached build %s to version %s", r.state.BuildID, r.state.VersionID), nil
+}
+
+func checkValidateStage(_ context.Context, _ *releaseRunner) (string, bool, error) {
+	// Validation is read-only, so it always runs rather than being
+	// inferred from remote state.
+	return "run submission readiness checks", false, nil
+}
+
+func runValidateStage(ctx context.Context, r *releaseRunner) (string, error) {
+	report, err := r.backend.Validate(ctx, r.config, r.state.VersionID)
+	if err != nil {
+		return "", err
+	}
+	if report.Summary.Blocking > 0 {
+		return "", fmt.Errorf("found %d blocking issue(s); run asc validate --app %q --version-id %q for details", report.Summary.Blocking, r.config.App, r.state.VersionID)
+	}
+	return fmt.Sprintf("%d error(s), %d warning(s)", report.Summary.Errors, report.Summary.Warnings), nil
+}
+
+func checkSubmitStage(ctx context.Context, r *releaseRunner) (string, bool, error) {
+	if r.state.VersionID == "" {
+		return "submit the version for review", false, nil
+	}
+	state, err := r.backend.VersionState(ctx, r.state.VersionID)
+	if err != nil {
+		return "", false, err
+	}
+	if releaseSubmittedStates[state] {
+		return fmt.Sprintf("version is already %s", state), true, nil
+	}
+	return fmt.Sprintf("submit version %s for review", r.config.Version), false, nil
+}
+
+func runSubmitStage(ctx context.Context, r *releaseRunner) (string, error) {
+	submissionID, err := r.backend.Submit(ctx, r.config, r.state.VersionID, r.state.BuildID)
+	if err != nil {
+		return "", err
+	}
+	r.state.SubmissionID = submissionID
+	return fmt.Sprintf("submitted for review (%s)", submissionID), nil
+}
+
+func checkPhasedReleaseStage(ctx context.Context, r *releaseRunner) (string, bool, error) {
+	if r.state.VersionID == "" {
+		return "create a phased release", false, nil
+	}
+	phasedReleaseID, err := r.backend.FindPhasedRelease(ctx, r.state.VersionID)
+	if err != nil {
+		return "", false, err
+	}
+	if phasedReleaseID != "" {
+		r.state.PhasedReleaseID = phasedReleaseID
+		return fmt.Sprintf("phased release %s exists", phasedReleaseID), true, nil
+	}
+	return "create a phased release", false, nil
+}
+
+func runPhasedReleaseStage(ctx context.Context, r *releaseRunner) (string, error) {
+	phasedReleaseID, err := r.backend.CreatePhasedRelease(ctx, r.state.VersionID)
+	if err != nil {
+		return "", err
+	}
+	r.state.PhasedReleaseID = phasedReleaseID
+	return fmt.Sprintf("created phased release %s", phasedReleaseID), nil
+}
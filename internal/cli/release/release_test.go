@@ -0,0 +1,355 @@
+package release
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/metadata"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/validation"
+)
+
+type fakeReleaseBackend struct {
+	buildID         string
+	processingState string
+	versionID       string
+	metadataChanges int
+	attachedBuildID string
+	blockingIssues  int
+	versionState    string
+	phasedReleaseID string
+
+	calls []string
+}
+
+func (f *fakeReleaseBackend) record(call string) {
+	f.calls = append(f.calls, call)
+}
+
+func (f *fakeReleaseBackend) FindBuild(context.Context, ReleaseConfig) (string, error) {
+	f.record("FindBuild")
+	return f.buildID, nil
+}
+
+func (f *fakeReleaseBackend) UploadBuild(context.Context, ReleaseConfig, time.Duration) (string, error) {
+	f.record("UploadBuild")
+	f.buildID = "build-1"
+	f.processingState = "PROCESSING"
+	return f.buildID, nil
+}
+
+func (f *fakeReleaseBackend) BuildProcessingState(context.Context, string) (string, error) {
+	f.record("BuildProcessingState")
+	return f.processingState, nil
+}
+
+func (f *fakeReleaseBackend) WaitForBuildProcessing(context.Context, string, time.Duration) error {
+	f.record("WaitForBuildProcessing")
+	f.processingState = "VALID"
+	return nil
+}
+
+func (f *fakeReleaseBackend) FindVersion(context.Context, ReleaseConfig) (string, error) {
+	f.record("FindVersion")
+	return f.versionID, nil
+}
+
+func (f *fakeReleaseBackend) CreateVersion(context.Context, ReleaseConfig) (string, error) {
+	f.record("CreateVersion")
+	f.versionID = "version-1"
+	return f.versionID, nil
+}
+
+func (f *fakeReleaseBackend) PushMetadata(_ context.Context, _ ReleaseConfig, dryRun bool) (*metadata.PushPlanResult, error) {
+	if dryRun {
+		f.record("PlanMetadata")
+	} else {
+		f.record("PushMetadata")
+	}
+	result := &metadata.PushPlanResult{DryRun: dryRun}
+	for range f.metadataChanges {
+		result.Updates = append(result.Updates, metadata.PlanItem{Field: "whatsNew"})
+		if !dryRun {
+			result.Actions = append(result.Actions, metadata.ApplyAction{Action: "update"})
+		}
+	}
+	if !dryRun {
+		f.metadataChanges = 0
+	}
+	return result, nil
+}
+
+func (f *fakeReleaseBackend) AttachedBuildID(context.Context, string) (string, error) {
+	f.record("AttachedBuildID")
+	return f.attachedBuildID, nil
+}
+
+func (f *fakeReleaseBackend) AttachBuild(_ context.Context, _ string, buildID string) error {
+	f.record("AttachBuild")
+	f.attachedBuildID = buildID
+	return nil
+}
+
+func (f *fakeReleaseBackend) Validate(context.Context, ReleaseConfig, string) (*validation.Report, error) {
+	f.record("Validate")
+	return &validation.Report{Summary: validation.Summary{Errors: f.blockingIssues, Blocking: f.blockingIssues, Warnings: 1}}, nil
+}
+
+func (f *fakeReleaseBackend) VersionState(context.Context, string) (string, error) {
+	f.record("VersionState")
+	return f.versionState, nil
+}
+
+func (f *fakeReleaseBackend) Submit(context.Context, ReleaseConfig, string, string) (string, error) {
+	f.record("Submit")
+	f.versionState = "WAITING_FOR_REVIEW"
+	return "submission-1", nil
+}
+
+func (f *fakeReleaseBackend) FindPhasedRelease(context.Context, string) (string, error) {
+	f.record("FindPhasedRelease")
+	return f.phasedReleaseID, nil
+}
+
+func (f *fakeReleaseBackend) CreatePhasedRelease(context.Context, string) (string, error) {
+	f.record("CreatePhasedRelease")
+	f.phasedReleaseID = "phased-1"
+	return f.phasedReleaseID, nil
+}
+
+func testReleaseConfig() ReleaseConfig {
+	return ReleaseConfig{
+		App:           "123",
+		Platform:      "IOS",
+		Version:       "1.2.3",
+		BuildNumber:   "42",
+		IPA:           "App.ipa",
+		MetadataDir:   "metadata",
+		Submit:        true,
+		PhasedRelease: true,
+	}
+}
+
+func newTestReleaseRunner(t *testing.T, backend releaseBackend, config ReleaseConfig) *releaseRunner {
+	t.Helper()
+	return &releaseRunner{
+		config:       config,
+		backend:      backend,
+		statePath:    filepath.Join(t.TempDir(), "release", "state.json"),
+		pollInterval: time.Second,
+		log:          io.Discard,
+		now:          func() time.Time { return time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC) },
+	}
+}
+
+func stageStatuses(result *releaseResult) map[string]string {
+	statuses := make(map[string]string, len(result.Stages))
+	for _, stage := range result.Stages {
+		statuses[stage.Name] = stage.Status
+	}
+	return statuses
+}
+
+func TestReleasePlanReportsPendingStagesWithoutMutating(t *testing.T) {
+	backend := &fakeReleaseBackend{}
+	config := testReleaseConfig()
+	config.PhasedRelease = false
+	runner := newTestReleaseRunner(t, backend, config)
+
+	result, err := runner.plan(context.Background())
+	if err != nil {
+		t.Fatalf("plan error: %v", err)
+	}
+
+	want := map[string]string{
+		"upload":         releaseStatusPending,
+		"process":        releaseStatusPending,
+		"version":        releaseStatusPending,
+		"metadata":       releaseStatusPending,
+		"attach":         releaseStatusPending,
+		"validate":       releaseStatusPending,
+		"submit":         releaseStatusPending,
+		"phased-release": releaseStatusDisabled,
+	}
+	if got := stageStatuses(result); !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected plan statuses %v", got)
+	}
+	if !reflect.DeepEqual(backend.calls, []string{"FindBuild", "FindVersion"}) {
+		t.Fatalf("expected only read-only lookups, got %v", backend.calls)
+	}
+	if _, err := os.Stat(runner.statePath); !os.IsNotExist(err) {
+		t.Fatalf("expected plan not to write state, stat err %v", err)
+	}
+}
+
+func TestReleaseRunSkipsStagesSatisfiedRemotely(t *testing.T) {
+	backend := &fakeReleaseBackend{
+		buildID:         "build-9",
+		processingState: "VALID",
+		versionID:       "version-9",
+		attachedBuildID: "build-9",
+		versionState:    "WAITING_FOR_REVIEW",
+		phasedReleaseID: "phased-9",
+	}
+	runner := newTestReleaseRunner(t, backend, testReleaseConfig())
+
+	result, err := runner.run(context.Background())
+	if err != nil {
+		t.Fatalf("run error: %v", err)
+	}
+
+	for _, stage := range result.Stages {
+		want := releaseStatusSatisfied
+		if stage.Name == "validate" {
+			want = releaseStatusCompleted
+		}
+		if stage.Status != want {
+			t.Fatalf("stage %s: expected %s, got %s (%s)", stage.Name, want, stage.Status, stage.Detail)
+		}
+	}
+	for _, call := range backend.calls {
+		switch call {
+		case "UploadBuild", "WaitForBuildProcessing", "CreateVersion", "PushMetadata", "AttachBuild", "Submit", "CreatePhasedRelease":
+			t.Fatalf("unexpected mutating call %s in %v", call, backend.calls)
+		}
+	}
+	if result.BuildID != "build-9" || result.VersionID != "version-9" || result.PhasedReleaseID != "phased-9" {
+		t.Fatalf("unexpected result IDs %+v", result)
+	}
+}
+
+func TestReleaseRunPersistsProgressAndResumesAfterFailure(t *testing.T) {
+	backend := &fakeReleaseBackend{metadataChanges: 2, blockingIssues: 1, versionState: "PREPARE_FOR_SUBMISSION"}
+	runner := newTestReleaseRunner(t, backend, testReleaseConfig())
+
+	result, err := runner.run(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "validate: found 1 blocking issue(s)") {
+		t.Fatalf("expected validate failure, got %v", err)
+	}
+	want := map[string]string{
+		"upload":         releaseStatusCompleted,
+		"process":        releaseStatusCompleted,
+		"version":        releaseStatusCompleted,
+		"metadata":       releaseStatusCompleted,
+		"attach":         releaseStatusCompleted,
+		"validate":       releaseStatusFailed,
+		"submit":         releaseStatusPending,
+		"phased-release": releaseStatusPending,
+	}
+	if got := stageStatuses(result); !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected first run statuses %v", got)
+	}
+
+	data, err := os.ReadFile(runner.statePath)
+	if err != nil {
+		t.Fatalf("read state: %v", err)
+	}
+	var state releaseState
+	if err := json.Unmarshal(data, &state); err != nil {
+		t.Fatalf("parse state: %v", err)
+	}
+	if state.BuildID != "build-1" || state.VersionID != "version-1" || len(state.Completed) != 5 {
+		t.Fatalf("unexpected saved state %+v", state)
+	}
+
+	backend.blockingIssues = 0
+	backend.calls = nil
+	result, err = runner.run(context.Background())
+	if err != nil {
+		t.Fatalf("resume error: %v", err)
+	}
+	if result.Stages[0].Status != releaseStatusResumed || result.Stages[4].Status != releaseStatusResumed {
+		t.Fatalf("expected earlier stages to resume, got %+v", result.Stages)
+	}
+	wantCalls := []string{"Validate", "VersionState", "Submit", "FindPhasedRelease", "CreatePhasedRelease"}
+	if !reflect.DeepEqual(backend.calls, wantCalls) {
+		t.Fatalf("expected resume to start at validate, got %v", backend.calls)
+	}
+	if result.SubmissionID != "submission-1" || result.PhasedReleaseID != "phased-1" {
+		t.Fatalf("unexpected result IDs %+v", result)
+	}
+}
+
+func TestReleaseRunRejectsStateForAnotherRelease(t *testing.T) {
+	runner := newTestReleaseRunner(t, &fakeReleaseBackend{}, testReleaseConfig())
+	if err := os.MkdirAll(filepath.Dir(runner.statePath), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	state := `{"appId":"123","version":"1.2.3","buildNumber":"41","platform":"IOS","completed":{}}`
+	if err := os.WriteFile(runner.statePath, []byte(state), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := runner.run(context.Background()); err == nil || !strings.Contains(err.Error(), "belongs to app 123 version 1.2.3 (41)") {
+		t.Fatalf("expected state mismatch error, got %v", err)
+	}
+}
+
+func TestLoadReleaseConfig(t *testing.T) {
+	t.Setenv("ASC_APP_ID", "")
+	dir := t.TempDir()
+	path := filepath.Join(dir, "release.yaml")
+	writeConfig := func(t *testing.T, body string) {
+		t.Helper()
+		if err := os.WriteFile(path, []byte(body), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	writeConfig(t, "app: \"123\"\nplatform: ios\nversion: 1.2.3\nbuildNumber: \"42\"\nmetadataDir: metadata\nsubmit: true\n")
+	config, err := loadReleaseConfig(path)
+	if err != nil {
+		t.Fatalf("load error: %v", err)
+	}
+	if config.Platform != "IOS" || config.MetadataDir != filepath.Join(dir, "metadata") || !config.Submit {
+		t.Fatalf("unexpected config %+v", config)
+	}
+
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{name: "missing app", body: "version: 1.0\nbuildNumber: \"1\"\n", want: "app is required"},
+		{name: "missing build number", body: "app: \"123\"\nversion: 1.0\n", want: "version and buildNumber are required"},
+		{name: "unknown field", body: "app: \"123\"\nsubmitt: true\n", want: "field submitt not found"},
+		{name: "empty", body: "", want: "is empty"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			writeConfig(t, test.body)
+			if _, err := loadReleaseConfig(path); err == nil || !strings.Contains(err.Error(), test.want) {
+				t.Fatalf("expected error containing %q, got %v", test.want, err)
+			}
+		})
+	}
+}
+
+func TestReleaseJUnitReport(t *testing.T) {
+	result := &releaseResult{
+		Version:     "1.2.3",
+		BuildNumber: "42",
+		Stages: []releaseStageResult{
+			{Name: "upload", Status: releaseStatusSatisfied, Detail: "already uploaded"},
+			{Name: "validate", Status: releaseStatusFailed, Detail: "found 1 blocking issue(s)"},
+			{Name: "submit", Status: releaseStatusPending},
+		},
+	}
+
+	report := releaseJUnitReport(result, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	if report.Name != "asc release 1.2.3 (42)" || len(report.Tests) != 3 {
+		t.Fatalf("unexpected report %+v", report)
+	}
+	if report.Tests[1].Failure != "FAILED" || report.Tests[1].Message != "found 1 blocking issue(s)" {
+		t.Fatalf("expected failed validate case, got %+v", report.Tests[1])
+	}
+	if !report.Tests[2].Skipped || report.Tests[0].Skipped {
+		t.Fatalf("expected only pending stage skipped, got %+v", report.Tests)
+	}
+}
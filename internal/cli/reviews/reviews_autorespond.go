@@ -0,0 +1,714 @@
+package reviews
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+	"gopkg.in/yaml.v3"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	autorespondDefaultLookback  = 7 * 24 * time.Hour
+	autorespondDefaultMax       = 10
+	autorespondMaxResponseChars = 5970
+	autorespondPageLimit        = 200
+	autorespondDefaultTemplate  = "default"
+)
+
+var autorespondPlaceholderPattern = regexp.MustCompile(`\{\{\s*([A-Za-z_]+)\s*\}\}`)
+
+var autorespondPlaceholders = map[string]bool{
+	"nickname":  true,
+	"app_name":  true,
+	"version":   true,
+	"territory": true,
+	"rating":    true,
+}
+
+// Autorespond decision actions.
+const (
+	autorespondActionResponded    = "responded"
+	autorespondActionWouldRespond = "would-respond"
+	autorespondActionSkipped      = "skipped"
+	autorespondActionFailed       = "failed"
+)
+
+// AutorespondRules is the on-disk rules file for reviews autorespond.
+type AutorespondRules struct {
+	AppName       string            `yaml:"appName,omitempty" json:"appName,omitempty"`
+	Version       string            `yaml:"version,omitempty" json:"version,omitempty"`
+	DefaultLocale string            `yaml:"defaultLocale,omitempty" json:"defaultLocale,omitempty"`
+	MaxResponses  int               `yaml:"maxResponses,omitempty" json:"maxResponses,omitempty"`
+	Rules         []AutorespondRule `yaml:"rules" json:"rules"`
+}
+
+// AutorespondRule matches reviews and renders a response from per-locale templates.
+// Empty conditions match any review; the first matching rule wins.
+type AutorespondRule struct {
+	Name            string            `yaml:"name" json:"name"`
+	Stars           []int             `yaml:"stars,omitempty" json:"stars,omitempty"`
+	Territories     []string          `yaml:"territories,omitempty" json:"territories,omitempty"`
+	Languages       []string          `yaml:"languages,omitempty" json:"languages,omitempty"`
+	Keywords        []string          `yaml:"keywords,omitempty" json:"keywords,omitempty"`
+	ExcludeKeywords []string          `yaml:"excludeKeywords,omitempty" json:"excludeKeywords,omitempty"`
+	AppVersions     []string          `yaml:"appVersions,omitempty" json:"appVersions,omitempty"`
+	Templates       map[string]string `yaml:"templates" json:"templates"`
+}
+
+// autorespondCursor is the stored position between runs.
+type autorespondCursor struct {
+	AppID       string `json:"appId"`
+	CreatedDate string `json:"createdDate"`
+	UpdatedAt   string `json:"updatedAt"`
+}
+
+// AutorespondDecision records what happened to one review.
+type AutorespondDecision struct {
+	ReviewID    string `json:"reviewId"`
+	CreatedDate string `json:"createdDate"`
+	Rating      int    `json:"rating"`
+	Territory   string `json:"territory"`
+	Locale      string `json:"locale,omitempty"`
+	Rule        string `json:"rule,omitempty"`
+	Action      string `json:"action"`
+	Reason      string `json:"reason,omitempty"`
+	Response    string `json:"response,omitempty"`
+	ResponseID  string `json:"responseId,omitempty"`
+}
+
+// AutorespondResult summarizes an autorespond run.
+type AutorespondResult struct {
+	AppID     string                `json:"appId"`
+	DryRun    bool                  `json:"dryRun"`
+	Since     string                `json:"since"`
+	Cursor    string                `json:"cursor,omitempty"`
+	Max       int                   `json:"max"`
+	Responded int                   `json:"responded"`
+	Skipped   int                   `json:"skipped"`
+	Failed    int                   `json:"failed"`
+	Decisions []AutorespondDecision `json:"decisions"`
+}
+
+type autorespondClient interface {
+	GetApp(ctx context.Context, appID string) (*asc.AppResponse, error)
+	GetAppStoreVersions(ctx context.Context, appID string, opts ...asc.AppStoreVersionsOption) (*asc.AppStoreVersionsResponse, error)
+	GetReviews(ctx context.Context, appID string, opts ...asc.ReviewOption) (*asc.ReviewsResponse, error)
+	GetCustomerReviewResponseForReview(ctx context.Context, reviewID string) (*asc.CustomerReviewResponseResponse, error)
+	CreateCustomerReviewResponse(ctx context.Context, reviewID, responseBody string) (*asc.CustomerReviewResponseResponse, error)
+}
+
+type autorespondOptions struct {
+	AppID  string
+	Rules  *AutorespondRules
+	Since  time.Time
+	Max    int
+	DryRun bool
+}
+
+// ReviewsAutorespondCommand returns the reviews autorespond subcommand.
+func ReviewsAutorespondCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("autorespond", flag.ExitOnError)
+
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (or ASC_APP_ID env)")
+	rulesPath := fs.String("rules", "", "Path to rules YAML file (required)")
+	stateFile := fs.String("state-file", "", "Path to the cursor file (default: .asc/reviews-autorespond/APP_ID.json)")
+	since := fs.String("since", "", "Only consider reviews created on or after YYYY-MM-DD when no cursor is stored (default: last 7 days)")
+	maxResponses := fs.Int("max", 0, "Maximum responses per run (overrides maxResponses in the rules file; default 10)")
+	dryRun := fs.Bool("dry-run", false, "Preview responses without posting or advancing the cursor")
+	logFile := fs.String("log-file", "", "Append every decision as a JSON line to this file")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "autorespond",
+		ShortUsage: "asc reviews autorespond --app APP_ID --rules rules.yaml [flags]",
+		ShortHelp:  "Respond to new reviews from rule-matched templates.",
+		LongHelp: `Respond to new customer reviews using rules and per-locale templates.
+
+Reviews created since the stored cursor are matched against rules in order;
+the first matching rule renders a response from its templates. Reviews that
+already have a response are skipped, and at most --max responses are posted
+per run. The cursor only advances past reviews that were fully decided.
+
+Rules file:
+  appName: My App              # default: app name from App Store Connect
+  defaultLocale: en-US         # default: app primary locale
+  maxResponses: 10
+  rules:
+    - name: happy
+      stars: [4, 5]
+      territories: [USA, GBR]
+      languages: [en]
+      keywords: [love, great]
+      excludeKeywords: [refund]
+      templates:
+        en-US: "Thanks {{nickname}}! Glad you enjoy {{app_name}} {{version}}."
+        default: "Thank you for your review, {{nickname}}!"
+
+Placeholders: {{nickname}}, {{app_name}}, {{version}}, {{territory}}, {{rating}}.
+Review language is derived from the territory. Reviews do not carry an app
+version, so appVersions and {{version}} use the version live on the App Store.
+
+Examples:
+  asc reviews autorespond --app "123456789" --rules ./review-rules.yaml --dry-run
+  asc reviews autorespond --app "123456789" --rules ./review-rules.yaml --max 5
+  asc reviews autorespond --app "123456789" --rules ./review-rules.yaml --since 2026-01-01 --log-file ./autorespond.log`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			resolvedAppID := shared.ResolveAppID(*appID)
+			if resolvedAppID == "" {
+				return shared.UsageError("--app is required (or set ASC_APP_ID)")
+			}
+			if strings.TrimSpace(*rulesPath) == "" {
+				return shared.UsageError("--rules is required")
+			}
+			if *maxResponses < 0 {
+				return shared.UsageError("--max must be zero or greater")
+			}
+			var sinceTime time.Time
+			if strings.TrimSpace(*since) != "" {
+				normalized, err := shared.NormalizeDate(*since, "--since")
+				if err != nil {
+					return shared.UsageError(err.Error())
+				}
+				sinceTime, _ = time.Parse("2006-01-02", normalized)
+			}
+
+			rules, err := loadAutorespondRules(*rulesPath)
+			if err != nil {
+				return fmt.Errorf("reviews autorespond: %w", err)
+			}
+
+			statePath := resolveAutorespondStatePath(*stateFile, resolvedAppID)
+			cursor, err := readAutorespondCursor(statePath, resolvedAppID)
+			if err != nil {
+				return fmt.Errorf("reviews autorespond: %w", err)
+			}
+			if !cursor.IsZero() {
+				sinceTime = cursor
+			} else if sinceTime.IsZero() {
+				sinceTime = time.Now().UTC().Add(-autorespondDefaultLookback)
+			}
+
+			limit := autorespondDefaultMax
+			if rules.MaxResponses > 0 {
+				limit = rules.MaxResponses
+			}
+			if *maxResponses > 0 {
+				limit = *maxResponses
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("reviews autorespond: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			result, err := runAutorespond(requestCtx, client, autorespondOptions{
+				AppID:  resolvedAppID,
+				Rules:  rules,
+				Since:  sinceTime,
+				Max:    limit,
+				DryRun: *dryRun,
+			})
+			if err != nil {
+				return fmt.Errorf("reviews autorespond: %w", err)
+			}
+
+			if path := strings.TrimSpace(*logFile); path != "" {
+				if err := appendAutorespondLog(path, result.Decisions); err != nil {
+					return fmt.Errorf("reviews autorespond: %w", err)
+				}
+			}
+			if !*dryRun && result.Cursor != "" {
+				if err := writeAutorespondCursor(statePath, resolvedAppID, result.Cursor); err != nil {
+					return fmt.Errorf("reviews autorespond: %w", err)
+				}
+			}
+
+			if err := shared.PrintOutputWithRenderers(
+				result,
+				*output.Output,
+				*output.Pretty,
+				func() error { return printAutorespondTable(result) },
+				func() error { return printAutorespondMarkdown(result) },
+			); err != nil {
+				return err
+			}
+			if result.Failed > 0 {
+				return shared.NewReportedError(fmt.Errorf("reviews autorespond: %d response(s) failed", result.Failed))
+			}
+			return nil
+		},
+	}
+}
+
+func loadAutorespondRules(path string) (*AutorespondRules, error) {
+	data, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, fmt.Errorf("read rules: %w", err)
+	}
+	var rules AutorespondRules
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&rules); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("rules file %s is empty", path)
+		}
+		return nil, fmt.Errorf("parse rules: %w", err)
+	}
+	if err := validateAutorespondRules(&rules); err != nil {
+		return nil, fmt.Errorf("rules file %s: %w", path, err)
+	}
+	return &rules, nil
+}
+
+func validateAutorespondRules(rules *AutorespondRules) error {
+	if len(rules.Rules) == 0 {
+		return fmt.Errorf("no rules declared")
+	}
+	if rules.MaxResponses < 0 {
+		return fmt.Errorf("maxResponses must be zero or greater")
+	}
+	seen := make(map[string]bool, len(rules.Rules))
+	for i := range rules.Rules {
+		rule := &rules.Rules[i]
+		rule.Name = strings.TrimSpace(rule.Name)
+		if rule.Name == "" {
+			rule.Name = fmt.Sprintf("rule-%d", i+1)
+		}
+		if seen[rule.Name] {
+			return fmt.Errorf("duplicate rule name %q", rule.Name)
+		}
+		seen[rule.Name] = true
+		for _, star := range rule.Stars {
+			if star < 1 || star > 5 {
+				return fmt.Errorf("rule %s: stars must be between 1 and 5", rule.Name)
+			}
+		}
+		for j, territory := range rule.Territories {
+			rule.Territories[j] = strings.ToUpper(strings.TrimSpace(territory))
+		}
+		if len(rule.Templates) == 0 {
+			return fmt.Errorf("rule %s: at least one template is required", rule.Name)
+		}
+		for locale, text := range rule.Templates {
+			if strings.TrimSpace(text) == "" {
+				return fmt.Errorf("rule %s: template %s is empty", rule.Name, locale)
+			}
+			for _, match := range autorespondPlaceholderPattern.FindAllStringSubmatch(text, -1) {
+				if !autorespondPlaceholders[match[1]] {
+					return fmt.Errorf("rule %s: template %s uses unknown placeholder {{%s}}", rule.Name, locale, match[1])
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func runAutorespond(ctx context.Context, client autorespondClient, opts autorespondOptions) (*AutorespondResult, error) {
+	appResp, err := client.GetApp(ctx, opts.AppID)
+	if err != nil {
+		return nil, fmt.Errorf("fetch app: %w", err)
+	}
+	appName := strings.TrimSpace(opts.Rules.AppName)
+	if appName == "" {
+		appName = appResp.Data.Attributes.Name
+	}
+	defaultLocale := strings.TrimSpace(opts.Rules.DefaultLocale)
+	if defaultLocale == "" {
+		defaultLocale = appResp.Data.Attributes.PrimaryLocale
+	}
+	version := strings.TrimSpace(opts.Rules.Version)
+	if version == "" {
+		version, err = liveAppStoreVersion(ctx, client, opts.AppID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	reviews, err := fetchReviewsSince(ctx, client, opts.AppID, opts.Since)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &AutorespondResult{
+		AppID:     opts.AppID,
+		DryRun:    opts.DryRun,
+		Since:     opts.Since.UTC().Format(time.RFC3339),
+		Max:       opts.Max,
+		Decisions: []AutorespondDecision{},
+	}
+	// The cursor stops before the first failure so it is retried next run.
+	cursorBlocked := false
+	for _, review := range reviews {
+		attrs := review.Attributes
+		if result.Responded >= opts.Max {
+			// Leave the rest for the next run; the cursor stays before them.
+			break
+		}
+		if !cursorBlocked {
+			result.Cursor = attrs.CreatedDate
+		}
+
+		locale := territoryLocale(attrs.Territory, defaultLocale)
+		decision := AutorespondDecision{
+			ReviewID:    review.ID,
+			CreatedDate: attrs.CreatedDate,
+			Rating:      attrs.Rating,
+			Territory:   attrs.Territory,
+			Locale:      locale,
+			Action:      autorespondActionSkipped,
+		}
+
+		rule, reason := matchAutorespondRule(opts.Rules.Rules, attrs, locale, version)
+		if rule == nil {
+			decision.Reason = reason
+			result.add(decision)
+			continue
+		}
+		decision.Rule = rule.Name
+
+		template, ok := selectAutorespondTemplate(rule.Templates, locale, defaultLocale)
+		if !ok {
+			decision.Reason = "no template for locale " + locale
+			result.add(decision)
+			continue
+		}
+		response := renderAutorespondTemplate(template, map[string]string{
+			"nickname":  attrs.ReviewerNickname,
+			"app_name":  appName,
+			"version":   version,
+			"territory": attrs.Territory,
+			"rating":    fmt.Sprintf("%d", attrs.Rating),
+		})
+		if len([]rune(response)) > autorespondMaxResponseChars {
+			decision.Reason = fmt.Sprintf("response exceeds %d characters", autorespondMaxResponseChars)
+			result.add(decision)
+			continue
+		}
+
+		existing, err := client.GetCustomerReviewResponseForReview(ctx, review.ID)
+		if err != nil && !asc.IsNotFound(err) {
+			decision.Action = autorespondActionFailed
+			decision.Reason = "check existing response: " + err.Error()
+			result.add(decision)
+			cursorBlocked = true
+			continue
+		}
+		if err == nil && existing != nil && existing.Data.ID != "" {
+			decision.Reason = "already has a response"
+			decision.ResponseID = existing.Data.ID
+			result.add(decision)
+			continue
+		}
+
+		decision.Response = response
+		if opts.DryRun {
+			decision.Action = autorespondActionWouldRespond
+			result.add(decision)
+			continue
+		}
+		created, err := client.CreateCustomerReviewResponse(ctx, review.ID, response)
+		if err != nil {
+			decision.Action = autorespondActionFailed
+			decision.Reason = err.Error()
+			result.add(decision)
+			cursorBlocked = true
+			continue
+		}
+		decision.Action = autorespondActionResponded
+		decision.ResponseID = created.Data.ID
+		result.add(decision)
+	}
+	return result, nil
+}
+
+func (r *AutorespondResult) add(decision AutorespondDecision) {
+	switch decision.Action {
+	case autorespondActionResponded, autorespondActionWouldRespond:
+		r.Responded++
+	case autorespondActionFailed:
+		r.Failed++
+	default:
+		r.Skipped++
+	}
+	r.Decisions = append(r.Decisions, decision)
+}
+
+// fetchReviewsSince pages newest-first until reviews are older than since, then returns them oldest-first.
+func fetchReviewsSince(ctx context.Context, client autorespondClient, appID string, since time.Time) ([]asc.Resource[asc.ReviewAttributes], error) {
+	type datedReview struct {
+		review  asc.Resource[asc.ReviewAttributes]
+		created time.Time
+	}
+	var dated []datedReview
+
+	resp, err := client.GetReviews(ctx, appID, asc.WithReviewSort("-createdDate"), asc.WithLimit(autorespondPageLimit))
+	for {
+		if err != nil {
+			return nil, fmt.Errorf("fetch reviews: %w", err)
+		}
+		reachedCursor := false
+		for _, review := range resp.Data {
+			created, parseErr := time.Parse(time.RFC3339, review.Attributes.CreatedDate)
+			if parseErr != nil {
+				return nil, fmt.Errorf("review %s has invalid createdDate %q", review.ID, review.Attributes.CreatedDate)
+			}
+			// Reviews at exactly the cursor are revisited; already-answered ones are skipped.
+			if created.Before(since) {
+				reachedCursor = true
+				break
+			}
+			dated = append(dated, datedReview{review: review, created: created})
+		}
+		if reachedCursor || strings.TrimSpace(resp.Links.Next) == "" {
+			break
+		}
+		resp, err = client.GetReviews(ctx, appID, asc.WithNextURL(resp.Links.Next))
+	}
+
+	sort.SliceStable(dated, func(i, j int) bool {
+		return dated[i].created.Before(dated[j].created)
+	})
+	reviews := make([]asc.Resource[asc.ReviewAttributes], 0, len(dated))
+	for _, item := range dated {
+		reviews = append(reviews, item.review)
+	}
+	return reviews, nil
+}
+
+func liveAppStoreVersion(ctx context.Context, client autorespondClient, appID string) (string, error) {
+	resp, err := client.GetAppStoreVersions(ctx, appID,
+		asc.WithAppStoreVersionsStates([]string{"READY_FOR_SALE"}),
+		asc.WithAppStoreVersionsLimit(10),
+	)
+	if err != nil {
+		return "", fmt.Errorf("fetch live version: %w", err)
+	}
+	for _, version := range resp.Data {
+		if value := strings.TrimSpace(version.Attributes.VersionString); value != "" {
+			return value, nil
+		}
+	}
+	return "", nil
+}
+
+// matchAutorespondRule returns the first matching rule, or the reason nothing matched.
+func matchAutorespondRule(rules []AutorespondRule, review asc.ReviewAttributes, locale, version string) (*AutorespondRule, string) {
+	text := strings.ToLower(review.Title + "\n" + review.Body)
+	language := strings.ToLower(strings.SplitN(locale, "-", 2)[0])
+	for i := range rules {
+		rule := &rules[i]
+		if len(rule.Stars) > 0 && !containsInt(rule.Stars, review.Rating) {
+			continue
+		}
+		if len(rule.Territories) > 0 && !containsFold(rule.Territories, review.Territory) {
+			continue
+		}
+		if len(rule.Languages) > 0 && !containsFold(rule.Languages, language) && !containsFold(rule.Languages, locale) {
+			continue
+		}
+		if len(rule.AppVersions) > 0 && !containsFold(rule.AppVersions, version) {
+			continue
+		}
+		if len(rule.Keywords) > 0 && !containsAnyKeyword(text, rule.Keywords) {
+			continue
+		}
+		if containsAnyKeyword(text, rule.ExcludeKeywords) {
+			continue
+		}
+		return rule, ""
+	}
+	return nil, "no rule matched"
+}
+
+// selectAutorespondTemplate prefers the exact locale, then the same language, then "default", then the default locale.
+func selectAutorespondTemplate(templates map[string]string, locale, defaultLocale string) (string, bool) {
+	if text, ok := lookupFold(templates, locale); ok {
+		return text, true
+	}
+	language := strings.SplitN(locale, "-", 2)[0]
+	keys := make([]string, 0, len(templates))
+	for key := range templates {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if strings.EqualFold(strings.SplitN(key, "-", 2)[0], language) {
+			return templates[key], true
+		}
+	}
+	if text, ok := lookupFold(templates, autorespondDefaultTemplate); ok {
+		return text, true
+	}
+	if defaultLocale != "" {
+		return lookupFold(templates, defaultLocale)
+	}
+	return "", false
+}
+
+func renderAutorespondTemplate(template string, values map[string]string) string {
+	rendered := autorespondPlaceholderPattern.ReplaceAllStringFunc(template, func(match string) string {
+		name := autorespondPlaceholderPattern.FindStringSubmatch(match)[1]
+		return values[name]
+	})
+	return strings.TrimSpace(rendered)
+}
+
+func lookupFold(values map[string]string, key string) (string, bool) {
+	for candidate, value := range values {
+		if strings.EqualFold(candidate, key) {
+			return value, true
+		}
+	}
+	return "", false
+}
+
+func containsInt(values []int, target int) bool {
+	for _, value := range values {
+		if value == target {
+			return true
+		}
+	}
+	return false
+}
+
+func containsFold(values []string, target string) bool {
+	for _, value := range values {
+		if strings.EqualFold(strings.TrimSpace(value), target) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsAnyKeyword(text string, keywords []string) bool {
+	for _, keyword := range keywords {
+		keyword = strings.ToLower(strings.TrimSpace(keyword))
+		if keyword != "" && strings.Contains(text, keyword) {
+			return true
+		}
+	}
+	return false
+}
+
+func resolveAutorespondStatePath(value, appID string) string {
+	if trimmed := strings.TrimSpace(value); trimmed != "" {
+		return filepath.Clean(trimmed)
+	}
+	return filepath.Join(".asc", "reviews-autorespond", appID+".json")
+}
+
+func readAutorespondCursor(path, appID string) (time.Time, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return time.Time{}, nil
+		}
+		return time.Time{}, fmt.Errorf("read cursor: %w", err)
+	}
+	var cursor autorespondCursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return time.Time{}, fmt.Errorf("parse cursor %s: %w", path, err)
+	}
+	if cursor.AppID != appID {
+		return time.Time{}, fmt.Errorf("cursor file %s belongs to app %s", path, cursor.AppID)
+	}
+	created, err := time.Parse(time.RFC3339, cursor.CreatedDate)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("cursor file %s has invalid createdDate %q", path, cursor.CreatedDate)
+	}
+	return created, nil
+}
+
+func writeAutorespondCursor(path, appID, createdDate string) error {
+	data, err := json.MarshalIndent(autorespondCursor{
+		AppID:       appID,
+		CreatedDate: createdDate,
+		UpdatedAt:   time.Now().UTC().Format(time.RFC3339),
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("write cursor: %w", err)
+	}
+	if _, err := shared.WriteFileNoSymlinkOverwrite(path, bytes.NewReader(append(data, '\n')), 0o600, ".asc-autorespond-*", ".asc-autorespond-backup-*"); err != nil {
+		return fmt.Errorf("write cursor: %w", err)
+	}
+	return nil
+}
+
+func appendAutorespondLog(path string, decisions []AutorespondDecision) error {
+	file, err := os.OpenFile(filepath.Clean(path), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("open log: %w", err)
+	}
+	defer file.Close()
+
+	loggedAt := time.Now().UTC().Format(time.RFC3339)
+	encoder := json.NewEncoder(file)
+	for _, decision := range decisions {
+		entry := struct {
+			LoggedAt string `json:"loggedAt"`
+			AutorespondDecision
+		}{LoggedAt: loggedAt, AutorespondDecision: decision}
+		if err := encoder.Encode(entry); err != nil {
+			return fmt.Errorf("write log: %w", err)
+		}
+	}
+	return nil
+}
+
+func autorespondRows(result *AutorespondResult) [][]string {
+	rows := make([][]string, 0, len(result.Decisions))
+	for _, decision := range result.Decisions {
+		rows = append(rows, []string{
+			decision.ReviewID,
+			decision.CreatedDate,
+			fmt.Sprintf("%d", decision.Rating),
+			decision.Territory,
+			shared.OrNA(decision.Rule),
+			decision.Action,
+			decision.Reason,
+			decision.Response,
+		})
+	}
+	return rows
+}
+
+func printAutorespondTable(result *AutorespondResult) error {
+	fmt.Printf("App: %s\n", result.AppID)
+	fmt.Printf("Since: %s\n", result.Since)
+	fmt.Printf("Dry Run: %t\n", result.DryRun)
+	fmt.Printf("Responded: %d/%d (skipped %d, failed %d)\n\n", result.Responded, result.Max, result.Skipped, result.Failed)
+	asc.RenderTable([]string{"Review", "Created", "Stars", "Territory", "Rule", "Action", "Reason", "Response"}, autorespondRows(result))
+	return nil
+}
+
+func printAutorespondMarkdown(result *AutorespondResult) error {
+	fmt.Printf("**App:** %s\n\n", result.AppID)
+	fmt.Printf("**Since:** %s\n\n", result.Since)
+	fmt.Printf("**Dry Run:** %t\n\n", result.DryRun)
+	fmt.Printf("**Responded:** %d/%d (skipped %d, failed %d)\n\n", result.Responded, result.Max, result.Skipped, result.Failed)
+	asc.RenderMarkdown([]string{"Review", "Created", "Stars", "Territory", "Rule", "Action", "Reason", "Response"}, autorespondRows(result))
+	return nil
+}
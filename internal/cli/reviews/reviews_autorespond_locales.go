@@ -0,0 +1,68 @@
+package reviews
+
+import "strings"
+
+// territoryLocales maps App Store territory codes to their primary App Store locale.
+// Territories not listed fall back to the rules file (or app) default locale.
+var territoryLocales = map[string]string{
+	"ARE": "ar-SA",
+	"ARG": "es-MX",
+	"AUS": "en-AU",
+	"AUT": "de-DE",
+	"BEL": "nl-NL",
+	"BRA": "pt-BR",
+	"CAN": "en-CA",
+	"CHE": "de-DE",
+	"CHL": "es-MX",
+	"CHN": "zh-Hans",
+	"COL": "es-MX",
+	"CZE": "cs",
+	"DEU": "de-DE",
+	"DNK": "da",
+	"EGY": "ar-SA",
+	"ESP": "es-ES",
+	"FIN": "fi",
+	"FRA": "fr-FR",
+	"GBR": "en-GB",
+	"GRC": "el",
+	"HKG": "zh-Hant",
+	"HRV": "hr",
+	"HUN": "hu",
+	"IDN": "id",
+	"IND": "en-GB",
+	"IRL": "en-GB",
+	"ISR": "he",
+	"ITA": "it",
+	"JPN": "ja",
+	"KOR": "ko",
+	"MEX": "es-MX",
+	"MYS": "ms",
+	"NLD": "nl-NL",
+	"NOR": "no",
+	"NZL": "en-AU",
+	"PER": "es-MX",
+	"PHL": "en-US",
+	"POL": "pl",
+	"PRT": "pt-PT",
+	"ROU": "ro",
+	"RUS": "ru",
+	"SAU": "ar-SA",
+	"SGP": "en-GB",
+	"SVK": "sk",
+	"SWE": "sv",
+	"THA": "th",
+	"TUR": "tr",
+	"TWN": "zh-Hant",
+	"UKR": "uk",
+	"USA": "en-US",
+	"VNM": "vi",
+	"ZAF": "en-GB",
+}
+
+// territoryLocale returns the locale used to pick a template for a review's territory.
+func territoryLocale(territory, fallback string) string {
+	if locale, ok := territoryLocales[strings.ToUpper(strings.TrimSpace(territory))]; ok {
+		return locale
+	}
+	return fallback
+}
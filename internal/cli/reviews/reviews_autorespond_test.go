@@ -0,0 +1,251 @@
+package reviews
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+type autorespondStub struct {
+	pages     []*asc.ReviewsResponse
+	responded map[string]string
+	existing  map[string]string
+	failOn    string
+	created   map[string]string
+	pageCalls int
+}
+
+func (s *autorespondStub) GetApp(ctx context.Context, appID string) (*asc.AppResponse, error) {
+	resp := &asc.AppResponse{}
+	resp.Data.ID = appID
+	resp.Data.Attributes = asc.AppAttributes{Name: "Demo", PrimaryLocale: "en-US"}
+	return resp, nil
+}
+
+func (s *autorespondStub) GetAppStoreVersions(ctx context.Context, appID string, opts ...asc.AppStoreVersionsOption) (*asc.AppStoreVersionsResponse, error) {
+	return &asc.AppStoreVersionsResponse{Data: []asc.Resource[asc.AppStoreVersionAttributes]{
+		{ID: "ver-1", Attributes: asc.AppStoreVersionAttributes{VersionString: "2.3"}},
+	}}, nil
+}
+
+func (s *autorespondStub) GetReviews(ctx context.Context, appID string, opts ...asc.ReviewOption) (*asc.ReviewsResponse, error) {
+	page := s.pages[s.pageCalls]
+	s.pageCalls++
+	return page, nil
+}
+
+func (s *autorespondStub) GetCustomerReviewResponseForReview(ctx context.Context, reviewID string) (*asc.CustomerReviewResponseResponse, error) {
+	if id, ok := s.existing[reviewID]; ok {
+		resp := &asc.CustomerReviewResponseResponse{}
+		resp.Data.ID = id
+		return resp, nil
+	}
+	return nil, asc.ErrNotFound
+}
+
+func (s *autorespondStub) CreateCustomerReviewResponse(ctx context.Context, reviewID, responseBody string) (*asc.CustomerReviewResponseResponse, error) {
+	if reviewID == s.failOn {
+		return nil, errors.New("boom")
+	}
+	if s.created == nil {
+		s.created = map[string]string{}
+	}
+	s.created[reviewID] = responseBody
+	resp := &asc.CustomerReviewResponseResponse{}
+	resp.Data.ID = "resp-" + reviewID
+	return resp, nil
+}
+
+func review(id, created string, rating int, territory, nickname, body string) asc.Resource[asc.ReviewAttributes] {
+	return asc.Resource[asc.ReviewAttributes]{ID: id, Attributes: asc.ReviewAttributes{
+		Rating: rating, Territory: territory, ReviewerNickname: nickname, Body: body, CreatedDate: created,
+	}}
+}
+
+func writeAutorespondRules(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "rules.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("write rules: %v", err)
+	}
+	return path
+}
+
+const autorespondTestRules = `
+maxResponses: 5
+rules:
+  - name: angry
+    stars: [1, 2]
+    excludeKeywords: [refund]
+    templates:
+      en-US: "Sorry {{nickname}}, we're fixing {{app_name}} {{version}}."
+  - name: happy
+    stars: [5]
+    languages: [en, de]
+    keywords: [love]
+    templates:
+      de-DE: "Danke {{nickname}}!"
+      default: "Thanks {{nickname}} from {{territory}}!"
+`
+
+func TestLoadAutorespondRulesValidates(t *testing.T) {
+	if _, err := loadAutorespondRules(writeAutorespondRules(t, autorespondTestRules)); err != nil {
+		t.Fatalf("expected valid rules, got %v", err)
+	}
+
+	invalid := map[string]string{
+		"no rules":          "rules: []\n",
+		"bad stars":         "rules:\n  - name: a\n    stars: [6]\n    templates: {default: hi}\n",
+		"no templates":      "rules:\n  - name: a\n",
+		"bad placeholder":   "rules:\n  - name: a\n    templates: {default: \"hi {{email}}\"}\n",
+		"duplicate names":   "rules:\n  - name: a\n    templates: {default: hi}\n  - name: a\n    templates: {default: hi}\n",
+		"unknown field":     "rules:\n  - name: a\n    stars_typo: [1]\n    templates: {default: hi}\n",
+		"negative max caps": "maxResponses: -1\nrules:\n  - name: a\n    templates: {default: hi}\n",
+	}
+	for name, content := range invalid {
+		t.Run(name, func(t *testing.T) {
+			if _, err := loadAutorespondRules(writeAutorespondRules(t, content)); err == nil {
+				t.Fatal("expected rules error")
+			}
+		})
+	}
+}
+
+func TestRunAutorespondMatchesRendersAndSkips(t *testing.T) {
+	rules, err := loadAutorespondRules(writeAutorespondRules(t, autorespondTestRules))
+	if err != nil {
+		t.Fatalf("load rules: %v", err)
+	}
+	stub := &autorespondStub{
+		pages: []*asc.ReviewsResponse{
+			{
+				Data: []asc.Resource[asc.ReviewAttributes]{
+					review("r5", "2026-03-05T10:00:00Z", 5, "DEU", "Hans", "I love it"),
+					review("r4", "2026-03-04T10:00:00Z", 1, "USA", "Ann", "I want a refund"),
+					review("r3", "2026-03-03T10:00:00Z", 5, "GBR", "Bob", "Love this"),
+				},
+				Links: asc.Links{Next: "https://api.appstoreconnect.apple.com/v1/apps/app-1/customerReviews?cursor=2"},
+			},
+			{
+				Data: []asc.Resource[asc.ReviewAttributes]{
+					review("r2", "2026-03-02T10:00:00Z", 2, "USA", "Cat", "Crashes"),
+					review("r1", "2026-03-01T10:00:00Z", 1, "USA", "Dan", "Old review"),
+				},
+			},
+		},
+		existing: map[string]string{"r3": "resp-old"},
+	}
+
+	result, err := runAutorespond(context.Background(), stub, autorespondOptions{
+		AppID: "app-1",
+		Rules: rules,
+		Since: time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC),
+		Max:   5,
+	})
+	if err != nil {
+		t.Fatalf("runAutorespond error: %v", err)
+	}
+
+	if len(result.Decisions) != 4 {
+		t.Fatalf("expected 4 decisions (r1 is before since), got %+v", result.Decisions)
+	}
+	got := map[string]AutorespondDecision{}
+	for _, decision := range result.Decisions {
+		got[decision.ReviewID] = decision
+	}
+	if got["r2"].Action != autorespondActionResponded || stub.created["r2"] != "Sorry Cat, we're fixing Demo 2.3." {
+		t.Fatalf("unexpected r2 decision: %+v (%q)", got["r2"], stub.created["r2"])
+	}
+	if got["r3"].Action != autorespondActionSkipped || got["r3"].Reason != "already has a response" {
+		t.Fatalf("expected r3 skipped as answered, got %+v", got["r3"])
+	}
+	if got["r4"].Action != autorespondActionSkipped || got["r4"].Reason != "no rule matched" {
+		t.Fatalf("expected r4 excluded by keyword, got %+v", got["r4"])
+	}
+	if stub.created["r5"] != "Danke Hans!" || got["r5"].Locale != "de-DE" {
+		t.Fatalf("expected German template for r5, got %+v (%q)", got["r5"], stub.created["r5"])
+	}
+	if result.Responded != 2 || result.Skipped != 2 || result.Cursor != "2026-03-05T10:00:00Z" {
+		t.Fatalf("unexpected summary: %+v", result)
+	}
+	if result.Decisions[0].ReviewID != "r2" {
+		t.Fatalf("expected oldest-first processing, got %s first", result.Decisions[0].ReviewID)
+	}
+}
+
+func TestRunAutorespondCapAndDryRun(t *testing.T) {
+	rules, err := loadAutorespondRules(writeAutorespondRules(t, "rules:\n  - name: all\n    templates: {default: \"Thanks {{nickname}}\"}\n"))
+	if err != nil {
+		t.Fatalf("load rules: %v", err)
+	}
+	stub := &autorespondStub{pages: []*asc.ReviewsResponse{{Data: []asc.Resource[asc.ReviewAttributes]{
+		review("b", "2026-03-02T10:00:00Z", 4, "FRA", "Zoe", ""),
+		review("a", "2026-03-01T10:00:00Z", 3, "USA", "Yan", ""),
+	}}}}
+
+	result, err := runAutorespond(context.Background(), stub, autorespondOptions{
+		AppID: "app-1", Rules: rules, Since: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), Max: 1, DryRun: true,
+	})
+	if err != nil {
+		t.Fatalf("runAutorespond error: %v", err)
+	}
+	if len(stub.created) != 0 {
+		t.Fatalf("dry run should not post responses, got %v", stub.created)
+	}
+	if len(result.Decisions) != 1 || result.Decisions[0].Action != autorespondActionWouldRespond || result.Decisions[0].Response != "Thanks Yan" {
+		t.Fatalf("unexpected dry-run decisions: %+v", result.Decisions)
+	}
+	if result.Cursor != "2026-03-01T10:00:00Z" {
+		t.Fatalf("cursor should stop at the capped review, got %q", result.Cursor)
+	}
+}
+
+func TestRunAutorespondCursorStopsAtFailure(t *testing.T) {
+	rules, err := loadAutorespondRules(writeAutorespondRules(t, "rules:\n  - name: all\n    templates: {default: hi}\n"))
+	if err != nil {
+		t.Fatalf("load rules: %v", err)
+	}
+	stub := &autorespondStub{
+		failOn: "b",
+		pages: []*asc.ReviewsResponse{{Data: []asc.Resource[asc.ReviewAttributes]{
+			review("c", "2026-03-03T10:00:00Z", 4, "USA", "C", ""),
+			review("b", "2026-03-02T10:00:00Z", 4, "USA", "B", ""),
+			review("a", "2026-03-01T10:00:00Z", 4, "USA", "A", ""),
+		}}},
+	}
+	result, err := runAutorespond(context.Background(), stub, autorespondOptions{
+		AppID: "app-1", Rules: rules, Since: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), Max: 10,
+	})
+	if err != nil {
+		t.Fatalf("runAutorespond error: %v", err)
+	}
+	if result.Failed != 1 || result.Responded != 2 {
+		t.Fatalf("unexpected summary: %+v", result)
+	}
+	if result.Cursor != "2026-03-02T10:00:00Z" {
+		t.Fatalf("cursor should not pass the failed review, got %q", result.Cursor)
+	}
+}
+
+func TestAutorespondCursorRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state", "cursor.json")
+	if cursor, err := readAutorespondCursor(path, "app-1"); err != nil || !cursor.IsZero() {
+		t.Fatalf("expected empty cursor, got %v (%v)", cursor, err)
+	}
+	if err := writeAutorespondCursor(path, "app-1", "2026-03-02T10:00:00Z"); err != nil {
+		t.Fatalf("write cursor: %v", err)
+	}
+	cursor, err := readAutorespondCursor(path, "app-1")
+	if err != nil || !cursor.Equal(time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected cursor %v (%v)", cursor, err)
+	}
+	if _, err := readAutorespondCursor(path, "app-2"); err == nil || !strings.Contains(err.Error(), "belongs to app app-1") {
+		t.Fatalf("expected app mismatch error, got %v", err)
+	}
+}
@@ -19,7 +19,7 @@ import (
 func ReviewsRatingsCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("ratings", flag.ExitOnError)
 
-	appID := fs.String("app", "", "App Store app ID (required)")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store app ID, bundle ID or app name (required)")
 	country := fs.String("country", "us", "Country code (e.g., us, gb, de)")
 	all := fs.Bool("all", false, "Fetch ratings from all countries")
 	workers := fs.Int("workers", 10, "Number of parallel workers for --all")
@@ -0,0 +1,667 @@
+package reviews
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	reportDefaultDays     = 7
+	reportDefaultTop      = 10
+	reportUnknownVersion  = "unknown"
+	reportUnknownLocale   = "unknown"
+	reportMinTermOccurred = 2
+	reportPageLimit       = 200
+)
+
+// reportReleasedStates are version states that mean the version reached customers.
+var reportReleasedStates = map[string]bool{
+	"READY_FOR_SALE":              true,
+	"READY_FOR_DISTRIBUTION":      true,
+	"REPLACED_WITH_NEW_VERSION":   true,
+	"REMOVED_FROM_SALE":           true,
+	"DEVELOPER_REMOVED_FROM_SALE": true,
+}
+
+// ReviewsReport is the aggregated review digest for a date range.
+type ReviewsReport struct {
+	AppID          string                 `json:"appId"`
+	From           string                 `json:"from"`
+	To             string                 `json:"to"`
+	Total          int                    `json:"total"`
+	AverageRating  float64                `json:"averageRating"`
+	Distribution   map[int]int            `json:"distribution"`
+	Sentiment      ReviewsSentiment       `json:"sentiment"`
+	Territories    []ReviewsGroupStats    `json:"territories"`
+	Versions       []ReviewsGroupStats    `json:"versions"`
+	VersionChanges []ReviewsVersionChange `json:"versionChanges"`
+	Locales        []ReviewsLocaleTerms   `json:"locales"`
+}
+
+// ReviewsSentiment counts reviews by lexicon sentiment.
+type ReviewsSentiment struct {
+	Positive     int     `json:"positive"`
+	Neutral      int     `json:"neutral"`
+	Negative     int     `json:"negative"`
+	AverageScore float64 `json:"averageScore"`
+}
+
+// ReviewsGroupStats aggregates reviews for one territory or version.
+type ReviewsGroupStats struct {
+	Key           string           `json:"key"`
+	Count         int              `json:"count"`
+	AverageRating float64          `json:"averageRating"`
+	Distribution  map[int]int      `json:"distribution"`
+	Sentiment     ReviewsSentiment `json:"sentiment"`
+}
+
+// ReviewsVersionChange compares the rating distribution of consecutive versions.
+type ReviewsVersionChange struct {
+	From         string          `json:"from"`
+	To           string          `json:"to"`
+	AverageDelta float64         `json:"averageDelta"`
+	ShareDelta   map[int]float64 `json:"shareDelta"`
+}
+
+// ReviewsLocaleTerms lists recurring keywords and phrases for one locale.
+type ReviewsLocaleTerms struct {
+	Locale   string             `json:"locale"`
+	Reviews  int                `json:"reviews"`
+	Keywords []ReviewsTermCount `json:"keywords"`
+	Phrases  []ReviewsTermCount `json:"phrases"`
+}
+
+// ReviewsTermCount is a term and the number of reviews mentioning it.
+type ReviewsTermCount struct {
+	Term          string  `json:"term"`
+	Count         int     `json:"count"`
+	AverageRating float64 `json:"averageRating"`
+}
+
+type reportClient interface {
+	GetReviews(ctx context.Context, appID string, opts ...asc.ReviewOption) (*asc.ReviewsResponse, error)
+	GetAppStoreVersions(ctx context.Context, appID string, opts ...asc.AppStoreVersionsOption) (*asc.AppStoreVersionsResponse, error)
+	GetAppStoreVersionPhasedRelease(ctx context.Context, versionID string) (*asc.AppStoreVersionPhasedReleaseResponse, error)
+}
+
+// reportVersionStart is when a released version started receiving reviews.
+type reportVersionStart struct {
+	Version string
+	Start   time.Time
+}
+
+// ReviewsReportCommand returns the reviews report subcommand.
+func ReviewsReportCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("report", flag.ExitOnError)
+
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (or ASC_APP_ID env)")
+	from := fs.String("from", "", "Start date YYYY-MM-DD (default: 7 days before --to)")
+	to := fs.String("to", "", "End date YYYY-MM-DD, inclusive (default: today)")
+	top := fs.Int("top", reportDefaultTop, "Keywords and phrases to show per locale")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "report",
+		ShortUsage: "asc reviews report --app APP_ID [flags]",
+		ShortHelp:  "Summarize reviews by rating, territory, version and keywords.",
+		LongHelp: `Summarize customer reviews for a date range.
+
+Aggregates reviews by star rating, territory and app version, scores each
+review with a built-in sentiment lexicon (no external service), extracts the
+top recurring keywords and phrases per locale, and compares the rating
+distribution between consecutive versions. Markdown output is suited to a
+weekly digest.
+
+Reviews do not carry an app version; each review is attributed to the most
+recent App Store version released before it. The release date is the
+version's earliestReleaseDate, then its phased release start date, and
+finally its creation date when neither is available. Locale is derived from
+the review territory.
+
+Examples:
+  asc reviews report --app "123456789" --output markdown
+  asc reviews report --app "123456789" --from 2026-01-01 --to 2026-01-31
+  asc reviews report --app "123456789" --top 5 --output json --pretty`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			resolvedAppID := shared.ResolveAppID(*appID)
+			if resolvedAppID == "" {
+				return shared.UsageError("--app is required (or set ASC_APP_ID)")
+			}
+			if *top < 1 {
+				return shared.UsageError("--top must be at least 1")
+			}
+			fromTime, toTime, err := resolveReportRange(*from, *to, time.Now().UTC())
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("reviews report: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			report, err := buildReviewsReport(requestCtx, client, resolvedAppID, fromTime, toTime, *top)
+			if err != nil {
+				return fmt.Errorf("reviews report: %w", err)
+			}
+
+			return shared.PrintOutputWithRenderers(
+				report,
+				*output.Output,
+				*output.Pretty,
+				func() error { return printReviewsReportTable(report) },
+				func() error { return printReviewsReportMarkdown(report) },
+			)
+		},
+	}
+}
+
+// resolveReportRange returns [from, to+1day) for inclusive YYYY-MM-DD bounds.
+func resolveReportRange(fromValue, toValue string, now time.Time) (time.Time, time.Time, error) {
+	toDay := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	if strings.TrimSpace(toValue) != "" {
+		normalized, err := shared.NormalizeDate(toValue, "--to")
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		toDay, _ = time.Parse("2006-01-02", normalized)
+	}
+	fromDay := toDay.AddDate(0, 0, -(reportDefaultDays - 1))
+	if strings.TrimSpace(fromValue) != "" {
+		normalized, err := shared.NormalizeDate(fromValue, "--from")
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		fromDay, _ = time.Parse("2006-01-02", normalized)
+	}
+	if fromDay.After(toDay) {
+		return time.Time{}, time.Time{}, fmt.Errorf("--from must be on or before --to")
+	}
+	return fromDay, toDay.AddDate(0, 0, 1), nil
+}
+
+func buildReviewsReport(ctx context.Context, client reportClient, appID string, from, to time.Time, top int) (*ReviewsReport, error) {
+	versions, err := fetchReportVersionStarts(ctx, client, appID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	type reportReview struct {
+		attrs   asc.ReviewAttributes
+		created time.Time
+		tokens  []string
+		score   int
+	}
+	var reviews []reportReview
+
+	resp, err := client.GetReviews(ctx, appID, asc.WithReviewSort("-createdDate"), asc.WithLimit(reportPageLimit))
+	for {
+		if err != nil {
+			return nil, fmt.Errorf("fetch reviews: %w", err)
+		}
+		reachedStart := false
+		for _, review := range resp.Data {
+			created, parseErr := time.Parse(time.RFC3339, review.Attributes.CreatedDate)
+			if parseErr != nil {
+				return nil, fmt.Errorf("review %s has invalid createdDate %q", review.ID, review.Attributes.CreatedDate)
+			}
+			if created.Before(from) {
+				reachedStart = true
+				break
+			}
+			if !created.Before(to) {
+				continue
+			}
+			tokens := tokenizeReview(review.Attributes.Title + " " + review.Attributes.Body)
+			reviews = append(reviews, reportReview{attrs: review.Attributes, created: created, tokens: tokens, score: scoreSentiment(tokens)})
+		}
+		if reachedStart || strings.TrimSpace(resp.Links.Next) == "" {
+			break
+		}
+		resp, err = client.GetReviews(ctx, appID, asc.WithNextURL(resp.Links.Next))
+	}
+
+	report := &ReviewsReport{
+		AppID:          appID,
+		From:           from.Format("2006-01-02"),
+		To:             to.AddDate(0, 0, -1).Format("2006-01-02"),
+		Distribution:   emptyDistribution(),
+		Territories:    []ReviewsGroupStats{},
+		Versions:       []ReviewsGroupStats{},
+		VersionChanges: []ReviewsVersionChange{},
+		Locales:        []ReviewsLocaleTerms{},
+	}
+	overall := &reportAccumulator{}
+	territories := map[string]*reportAccumulator{}
+	versionStats := map[string]*reportAccumulator{}
+	locales := map[string]*reportTermAccumulator{}
+
+	for _, review := range reviews {
+		overall.add(review.attrs.Rating, review.score)
+		accumulatorFor(territories, strings.ToUpper(review.attrs.Territory)).add(review.attrs.Rating, review.score)
+		accumulatorFor(versionStats, versionForReview(versions, review.created)).add(review.attrs.Rating, review.score)
+
+		locale := territoryLocale(review.attrs.Territory, reportUnknownLocale)
+		terms, ok := locales[locale]
+		if !ok {
+			terms = newReportTermAccumulator()
+			locales[locale] = terms
+		}
+		keywords, phrases := reviewTerms(review.tokens)
+		terms.add(review.attrs.Rating, keywords, phrases)
+	}
+
+	report.Total = overall.count
+	report.AverageRating = overall.average()
+	report.Distribution = overall.distribution
+	report.Sentiment = overall.sentiment()
+
+	for key, acc := range territories {
+		report.Territories = append(report.Territories, acc.stats(key))
+	}
+	sort.Slice(report.Territories, func(i, j int) bool {
+		if report.Territories[i].Count != report.Territories[j].Count {
+			return report.Territories[i].Count > report.Territories[j].Count
+		}
+		return report.Territories[i].Key < report.Territories[j].Key
+	})
+
+	// Versions follow release order; unattributed reviews come first.
+	order := map[string]int{reportUnknownVersion: -1}
+	for i, version := range versions {
+		order[version.Version] = i
+	}
+	for key, acc := range versionStats {
+		report.Versions = append(report.Versions, acc.stats(key))
+	}
+	sort.Slice(report.Versions, func(i, j int) bool {
+		return order[report.Versions[i].Key] < order[report.Versions[j].Key]
+	})
+	report.VersionChanges = compareVersionStats(report.Versions)
+
+	for locale, terms := range locales {
+		report.Locales = append(report.Locales, ReviewsLocaleTerms{
+			Locale:   locale,
+			Reviews:  terms.reviews,
+			Keywords: topTerms(terms.keywords, terms.ratings, top),
+			Phrases:  topTerms(terms.phrases, terms.ratings, top),
+		})
+	}
+	sort.Slice(report.Locales, func(i, j int) bool {
+		if report.Locales[i].Reviews != report.Locales[j].Reviews {
+			return report.Locales[i].Reviews > report.Locales[j].Reviews
+		}
+		return report.Locales[i].Locale < report.Locales[j].Locale
+	})
+
+	return report, nil
+}
+
+// fetchReportVersionStarts returns released versions ordered by release date.
+func fetchReportVersionStarts(ctx context.Context, client reportClient, appID string, from, to time.Time) ([]reportVersionStart, error) {
+	type releasedVersion struct {
+		id       string
+		version  string
+		created  time.Time
+		earliest string
+	}
+	var released []releasedVersion
+	resp, err := client.GetAppStoreVersions(ctx, appID, asc.WithAppStoreVersionsLimit(reportPageLimit))
+	for {
+		if err != nil {
+			return nil, fmt.Errorf("fetch app store versions: %w", err)
+		}
+		for _, version := range resp.Data {
+			if !reportReleasedStates[shared.ResolveAppStoreVersionState(version.Attributes)] {
+				continue
+			}
+			created, parseErr := time.Parse(time.RFC3339, version.Attributes.CreatedDate)
+			if parseErr != nil || strings.TrimSpace(version.Attributes.VersionString) == "" {
+				continue
+			}
+			released = append(released, releasedVersion{
+				id:       version.ID,
+				version:  version.Attributes.VersionString,
+				created:  created,
+				earliest: version.Attributes.EarliestReleaseDate,
+			})
+		}
+		if strings.TrimSpace(resp.Links.Next) == "" {
+			break
+		}
+		resp, err = client.GetAppStoreVersions(ctx, appID, asc.WithAppStoreVersionsNextURL(resp.Links.Next))
+	}
+	sort.SliceStable(released, func(i, j int) bool {
+		return released[i].created.After(released[j].created)
+	})
+
+	// Versions release in creation order, so walking back from the newest one
+	// created before the range can stop at the first version released before it.
+	var starts []reportVersionStart
+	for _, version := range released {
+		if !version.created.Before(to) {
+			continue
+		}
+		start, err := reportReleaseDate(ctx, client, version.id, version.created, version.earliest)
+		if err != nil {
+			return nil, err
+		}
+		starts = append(starts, reportVersionStart{Version: version.version, Start: start})
+		if !start.After(from) {
+			break
+		}
+	}
+	sort.SliceStable(starts, func(i, j int) bool {
+		return starts[i].Start.Before(starts[j].Start)
+	})
+	return starts, nil
+}
+
+// reportReleaseDate resolves when a released version reached customers.
+func reportReleaseDate(ctx context.Context, client reportClient, versionID string, created time.Time, earliest string) (time.Time, error) {
+	if parsed, err := time.Parse(time.RFC3339, strings.TrimSpace(earliest)); err == nil {
+		return parsed, nil
+	}
+	phased, err := client.GetAppStoreVersionPhasedRelease(ctx, versionID)
+	if err != nil {
+		if asc.IsNotFound(err) {
+			return created, nil
+		}
+		return time.Time{}, fmt.Errorf("fetch phased release for version %s: %w", versionID, err)
+	}
+	if phased != nil {
+		if parsed, err := time.Parse(time.RFC3339, strings.TrimSpace(phased.Data.Attributes.StartDate)); err == nil {
+			return parsed, nil
+		}
+	}
+	return created, nil
+}
+
+func versionForReview(versions []reportVersionStart, created time.Time) string {
+	result := reportUnknownVersion
+	for _, version := range versions {
+		if version.Start.After(created) {
+			break
+		}
+		result = version.Version
+	}
+	return result
+}
+
+func compareVersionStats(versions []ReviewsGroupStats) []ReviewsVersionChange {
+	changes := []ReviewsVersionChange{}
+	var previous *ReviewsGroupStats
+	for i := range versions {
+		current := &versions[i]
+		if current.Key == reportUnknownVersion || current.Count == 0 {
+			continue
+		}
+		if previous != nil {
+			change := ReviewsVersionChange{
+				From:         previous.Key,
+				To:           current.Key,
+				AverageDelta: roundReport(current.AverageRating - previous.AverageRating),
+				ShareDelta:   map[int]float64{},
+			}
+			for star := 1; star <= 5; star++ {
+				before := float64(previous.Distribution[star]) / float64(previous.Count) * 100
+				after := float64(current.Distribution[star]) / float64(current.Count) * 100
+				change.ShareDelta[star] = roundReport(after - before)
+			}
+			changes = append(changes, change)
+		}
+		previous = current
+	}
+	return changes
+}
+
+type reportAccumulator struct {
+	count        int
+	ratingSum    int
+	scoreSum     int
+	distribution map[int]int
+	positive     int
+	neutral      int
+	negative     int
+}
+
+func accumulatorFor(groups map[string]*reportAccumulator, key string) *reportAccumulator {
+	if strings.TrimSpace(key) == "" {
+		key = reportUnknownVersion
+	}
+	acc, ok := groups[key]
+	if !ok {
+		acc = &reportAccumulator{}
+		groups[key] = acc
+	}
+	return acc
+}
+
+func (a *reportAccumulator) add(rating, score int) {
+	if a.distribution == nil {
+		a.distribution = emptyDistribution()
+	}
+	a.count++
+	a.ratingSum += rating
+	a.scoreSum += score
+	if rating >= 1 && rating <= 5 {
+		a.distribution[rating]++
+	}
+	switch sentimentLabel(score) {
+	case "positive":
+		a.positive++
+	case "negative":
+		a.negative++
+	default:
+		a.neutral++
+	}
+}
+
+func (a *reportAccumulator) average() float64 {
+	if a.count == 0 {
+		return 0
+	}
+	return roundReport(float64(a.ratingSum) / float64(a.count))
+}
+
+func (a *reportAccumulator) sentiment() ReviewsSentiment {
+	sentiment := ReviewsSentiment{Positive: a.positive, Neutral: a.neutral, Negative: a.negative}
+	if a.count > 0 {
+		sentiment.AverageScore = roundReport(float64(a.scoreSum) / float64(a.count))
+	}
+	return sentiment
+}
+
+func (a *reportAccumulator) stats(key string) ReviewsGroupStats {
+	distribution := a.distribution
+	if distribution == nil {
+		distribution = emptyDistribution()
+	}
+	return ReviewsGroupStats{
+		Key:           key,
+		Count:         a.count,
+		AverageRating: a.average(),
+		Distribution:  distribution,
+		Sentiment:     a.sentiment(),
+	}
+}
+
+type reportTermAccumulator struct {
+	reviews  int
+	keywords map[string]int
+	phrases  map[string]int
+	ratings  map[string]int
+}
+
+func newReportTermAccumulator() *reportTermAccumulator {
+	return &reportTermAccumulator{keywords: map[string]int{}, phrases: map[string]int{}, ratings: map[string]int{}}
+}
+
+func (t *reportTermAccumulator) add(rating int, keywords, phrases []string) {
+	t.reviews++
+	for _, keyword := range keywords {
+		t.keywords[keyword]++
+		t.ratings[keyword] += rating
+	}
+	for _, phrase := range phrases {
+		t.phrases[phrase]++
+		t.ratings[phrase] += rating
+	}
+}
+
+// topTerms returns terms seen in at least two reviews, most frequent first.
+func topTerms(counts map[string]int, ratingSums map[string]int, top int) []ReviewsTermCount {
+	terms := make([]ReviewsTermCount, 0, len(counts))
+	for term, count := range counts {
+		if count < reportMinTermOccurred {
+			continue
+		}
+		terms = append(terms, ReviewsTermCount{
+			Term:          term,
+			Count:         count,
+			AverageRating: roundReport(float64(ratingSums[term]) / float64(count)),
+		})
+	}
+	sort.Slice(terms, func(i, j int) bool {
+		if terms[i].Count != terms[j].Count {
+			return terms[i].Count > terms[j].Count
+		}
+		return terms[i].Term < terms[j].Term
+	})
+	if len(terms) > top {
+		terms = terms[:top]
+	}
+	return terms
+}
+
+func emptyDistribution() map[int]int {
+	return map[int]int{1: 0, 2: 0, 3: 0, 4: 0, 5: 0}
+}
+
+func roundReport(value float64) float64 {
+	return math.Round(value*100) / 100
+}
+
+func formatReportDistribution(distribution map[int]int) string {
+	parts := make([]string, 0, 5)
+	for star := 5; star >= 1; star-- {
+		parts = append(parts, fmt.Sprintf("%d★ %d", star, distribution[star]))
+	}
+	return strings.Join(parts, " / ")
+}
+
+func formatReportShareDelta(delta map[int]float64) string {
+	parts := make([]string, 0, 5)
+	for star := 5; star >= 1; star-- {
+		parts = append(parts, fmt.Sprintf("%d★ %+.1fpp", star, delta[star]))
+	}
+	return strings.Join(parts, " / ")
+}
+
+func formatReportSentiment(sentiment ReviewsSentiment) string {
+	return fmt.Sprintf("+%d / =%d / -%d", sentiment.Positive, sentiment.Neutral, sentiment.Negative)
+}
+
+func reviewsReportGroupRows(groups []ReviewsGroupStats) [][]string {
+	rows := make([][]string, 0, len(groups))
+	for _, group := range groups {
+		rows = append(rows, []string{
+			group.Key,
+			fmt.Sprintf("%d", group.Count),
+			fmt.Sprintf("%.2f", group.AverageRating),
+			formatReportDistribution(group.Distribution),
+			formatReportSentiment(group.Sentiment),
+		})
+	}
+	return rows
+}
+
+func reviewsReportChangeRows(changes []ReviewsVersionChange) [][]string {
+	rows := make([][]string, 0, len(changes))
+	for _, change := range changes {
+		rows = append(rows, []string{
+			change.From + " → " + change.To,
+			fmt.Sprintf("%+.2f", change.AverageDelta),
+			formatReportShareDelta(change.ShareDelta),
+		})
+	}
+	return rows
+}
+
+func reviewsReportTermRows(locales []ReviewsLocaleTerms) [][]string {
+	var rows [][]string
+	for _, locale := range locales {
+		for _, term := range locale.Keywords {
+			rows = append(rows, []string{locale.Locale, "keyword", term.Term, fmt.Sprintf("%d", term.Count), fmt.Sprintf("%.2f", term.AverageRating)})
+		}
+		for _, term := range locale.Phrases {
+			rows = append(rows, []string{locale.Locale, "phrase", term.Term, fmt.Sprintf("%d", term.Count), fmt.Sprintf("%.2f", term.AverageRating)})
+		}
+	}
+	return rows
+}
+
+var (
+	reviewsReportGroupHeaders  = []string{"Key", "Reviews", "Avg", "Distribution", "Sentiment (+/=/-)"}
+	reviewsReportChangeHeaders = []string{"Versions", "Avg Δ", "Share Δ"}
+	reviewsReportTermHeaders   = []string{"Locale", "Kind", "Term", "Reviews", "Avg"}
+)
+
+func printReviewsReportTable(report *ReviewsReport) error {
+	fmt.Printf("App: %s\n", report.AppID)
+	fmt.Printf("Range: %s to %s\n", report.From, report.To)
+	fmt.Printf("Reviews: %d (avg %.2f)\n", report.Total, report.AverageRating)
+	fmt.Printf("Distribution: %s\n", formatReportDistribution(report.Distribution))
+	fmt.Printf("Sentiment: %s (avg score %.2f)\n\n", formatReportSentiment(report.Sentiment), report.Sentiment.AverageScore)
+
+	fmt.Println("Versions")
+	asc.RenderTable(reviewsReportGroupHeaders, reviewsReportGroupRows(report.Versions))
+	if len(report.VersionChanges) > 0 {
+		fmt.Println("\nVersion Changes")
+		asc.RenderTable(reviewsReportChangeHeaders, reviewsReportChangeRows(report.VersionChanges))
+	}
+	fmt.Println("\nTerritories")
+	asc.RenderTable(reviewsReportGroupHeaders, reviewsReportGroupRows(report.Territories))
+	if rows := reviewsReportTermRows(report.Locales); len(rows) > 0 {
+		fmt.Println("\nTop Terms")
+		asc.RenderTable(reviewsReportTermHeaders, rows)
+	}
+	return nil
+}
+
+func printReviewsReportMarkdown(report *ReviewsReport) error {
+	fmt.Printf("**App:** %s\n\n", report.AppID)
+	fmt.Printf("**Range:** %s to %s\n\n", report.From, report.To)
+	fmt.Printf("**Reviews:** %d (avg %.2f)\n\n", report.Total, report.AverageRating)
+	fmt.Printf("**Distribution:** %s\n\n", formatReportDistribution(report.Distribution))
+	fmt.Printf("**Sentiment:** %s (avg score %.2f)\n\n", formatReportSentiment(report.Sentiment), report.Sentiment.AverageScore)
+
+	fmt.Print("### Versions\n\n")
+	asc.RenderMarkdown(reviewsReportGroupHeaders, reviewsReportGroupRows(report.Versions))
+	if len(report.VersionChanges) > 0 {
+		fmt.Print("\n### Version Changes\n\n")
+		asc.RenderMarkdown(reviewsReportChangeHeaders, reviewsReportChangeRows(report.VersionChanges))
+	}
+	fmt.Print("\n### Territories\n\n")
+	asc.RenderMarkdown(reviewsReportGroupHeaders, reviewsReportGroupRows(report.Territories))
+	if rows := reviewsReportTermRows(report.Locales); len(rows) > 0 {
+		fmt.Print("\n### Top Terms\n\n")
+		asc.RenderMarkdown(reviewsReportTermHeaders, rows)
+	}
+	return nil
+}
@@ -0,0 +1,213 @@
+package reviews
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+type reportStub struct {
+	pages     []*asc.ReviewsResponse
+	versions  []asc.Resource[asc.AppStoreVersionAttributes]
+	phased    map[string]string
+	pageCalls int
+	phaseIDs  []string
+}
+
+func (s *reportStub) GetReviews(ctx context.Context, appID string, opts ...asc.ReviewOption) (*asc.ReviewsResponse, error) {
+	page := s.pages[s.pageCalls]
+	s.pageCalls++
+	return page, nil
+}
+
+func (s *reportStub) GetAppStoreVersions(ctx context.Context, appID string, opts ...asc.AppStoreVersionsOption) (*asc.AppStoreVersionsResponse, error) {
+	return &asc.AppStoreVersionsResponse{Data: s.versions}, nil
+}
+
+func (s *reportStub) GetAppStoreVersionPhasedRelease(ctx context.Context, versionID string) (*asc.AppStoreVersionPhasedReleaseResponse, error) {
+	s.phaseIDs = append(s.phaseIDs, versionID)
+	start, ok := s.phased[versionID]
+	if !ok {
+		return nil, asc.ErrNotFound
+	}
+	return &asc.AppStoreVersionPhasedReleaseResponse{Data: asc.Resource[asc.AppStoreVersionPhasedReleaseAttributes]{
+		ID: "phase-" + versionID, Attributes: asc.AppStoreVersionPhasedReleaseAttributes{StartDate: start},
+	}}, nil
+}
+
+func reportVersion(version, state, created string) asc.Resource[asc.AppStoreVersionAttributes] {
+	return asc.Resource[asc.AppStoreVersionAttributes]{ID: "ver-" + version, Attributes: asc.AppStoreVersionAttributes{
+		VersionString: version, AppStoreState: state, CreatedDate: created,
+	}}
+}
+
+func TestBuildReviewsReportAggregates(t *testing.T) {
+	stub := &reportStub{
+		versions: []asc.Resource[asc.AppStoreVersionAttributes]{
+			reportVersion("2.1", "PREPARE_FOR_SUBMISSION", "2026-03-06T00:00:00Z"),
+			reportVersion("2.0", "READY_FOR_SALE", "2026-03-03T00:00:00Z"),
+			reportVersion("1.9", "REPLACED_WITH_NEW_VERSION", "2026-02-01T00:00:00Z"),
+		},
+		pages: []*asc.ReviewsResponse{
+			{
+				Data: []asc.Resource[asc.ReviewAttributes]{
+					review("r6", "2026-03-09T10:00:00Z", 5, "USA", "F", "Outside the range"),
+					review("r5", "2026-03-07T10:00:00Z", 5, "USA", "E", "Love the dark mode"),
+					review("r4", "2026-03-05T10:00:00Z", 4, "DEU", "D", "Dark mode is great"),
+				},
+				Links: asc.Links{Next: "https://api.appstoreconnect.apple.com/v1/apps/app-1/customerReviews?cursor=2"},
+			},
+			{
+				Data: []asc.Resource[asc.ReviewAttributes]{
+					review("r3", "2026-03-02T10:00:00Z", 1, "USA", "C", "Sync crashes constantly"),
+					review("r2", "2026-03-01T10:00:00Z", 2, "USA", "B", "Not good, sync crashes"),
+					review("r0", "2026-02-20T10:00:00Z", 1, "USA", "Z", "Before the range"),
+				},
+				Links: asc.Links{Next: "https://api.appstoreconnect.apple.com/v1/apps/app-1/customerReviews?cursor=3"},
+			},
+		},
+	}
+
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC)
+	report, err := buildReviewsReport(context.Background(), stub, "app-1", from, to, 5)
+	if err != nil {
+		t.Fatalf("buildReviewsReport error: %v", err)
+	}
+
+	if stub.pageCalls != 2 {
+		t.Fatalf("expected pagination to stop at --from, got %d page calls", stub.pageCalls)
+	}
+	if report.Total != 4 || report.AverageRating != 3 {
+		t.Fatalf("unexpected totals: %d reviews, avg %.2f", report.Total, report.AverageRating)
+	}
+	if want := map[int]int{1: 1, 2: 1, 3: 0, 4: 1, 5: 1}; !reflect.DeepEqual(report.Distribution, want) {
+		t.Fatalf("unexpected distribution %v", report.Distribution)
+	}
+	if report.Sentiment.Positive != 2 || report.Sentiment.Negative != 2 {
+		t.Fatalf("unexpected sentiment %+v", report.Sentiment)
+	}
+	if report.From != "2026-03-01" || report.To != "2026-03-07" {
+		t.Fatalf("unexpected range %s..%s", report.From, report.To)
+	}
+
+	if len(report.Territories) != 2 || report.Territories[0].Key != "USA" || report.Territories[0].Count != 3 {
+		t.Fatalf("unexpected territories %+v", report.Territories)
+	}
+
+	if len(report.Versions) != 2 || report.Versions[0].Key != "1.9" || report.Versions[1].Key != "2.0" {
+		t.Fatalf("unexpected versions %+v", report.Versions)
+	}
+	if len(report.VersionChanges) != 1 {
+		t.Fatalf("expected one version change, got %+v", report.VersionChanges)
+	}
+	change := report.VersionChanges[0]
+	if change.From != "1.9" || change.To != "2.0" || change.AverageDelta != 3 {
+		t.Fatalf("unexpected version change %+v", change)
+	}
+	if change.ShareDelta[5] != 50 || change.ShareDelta[1] != -50 {
+		t.Fatalf("unexpected share delta %v", change.ShareDelta)
+	}
+
+	var english *ReviewsLocaleTerms
+	for i := range report.Locales {
+		if report.Locales[i].Locale == "en-US" {
+			english = &report.Locales[i]
+		}
+	}
+	if english == nil || english.Reviews != 3 {
+		t.Fatalf("expected en-US locale with 3 reviews, got %+v", report.Locales)
+	}
+	if len(english.Phrases) != 1 || english.Phrases[0].Term != "sync crashes" || english.Phrases[0].AverageRating != 1.5 {
+		t.Fatalf("unexpected en-US phrases %+v", english.Phrases)
+	}
+}
+
+func TestBuildReviewsReportAttributesByReleaseDate(t *testing.T) {
+	scheduled := reportVersion("3.0", "READY_FOR_SALE", "2026-03-01T00:00:00Z")
+	scheduled.Attributes.EarliestReleaseDate = "2026-03-06T00:00:00Z"
+	stub := &reportStub{
+		versions: []asc.Resource[asc.AppStoreVersionAttributes]{
+			scheduled,
+			reportVersion("2.0", "REPLACED_WITH_NEW_VERSION", "2026-02-20T00:00:00Z"),
+			reportVersion("1.9", "REPLACED_WITH_NEW_VERSION", "2026-01-10T00:00:00Z"),
+			reportVersion("1.8", "REPLACED_WITH_NEW_VERSION", "2025-12-01T00:00:00Z"),
+		},
+		phased: map[string]string{"ver-2.0": "2026-03-03T00:00:00Z"},
+		pages: []*asc.ReviewsResponse{{
+			Data: []asc.Resource[asc.ReviewAttributes]{
+				review("r3", "2026-03-07T10:00:00Z", 5, "USA", "C", "Great update"),
+				review("r2", "2026-03-04T10:00:00Z", 4, "USA", "B", "Better"),
+				review("r1", "2026-03-02T10:00:00Z", 2, "XKX", "A", "Slow"),
+			},
+		}},
+	}
+
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC)
+	report, err := buildReviewsReport(context.Background(), stub, "app-1", from, to, 5)
+	if err != nil {
+		t.Fatalf("buildReviewsReport error: %v", err)
+	}
+
+	got := map[string]int{}
+	for _, version := range report.Versions {
+		got[version.Key] = version.Count
+	}
+	if want := map[string]int{"1.9": 1, "2.0": 1, "3.0": 1}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected version attribution %v", got)
+	}
+	if want := []string{"ver-2.0", "ver-1.9"}; !reflect.DeepEqual(stub.phaseIDs, want) {
+		t.Fatalf("expected phased release lookups to stop at the version released before --from, got %v", stub.phaseIDs)
+	}
+
+	locales := map[string]int{}
+	for _, locale := range report.Locales {
+		locales[locale.Locale] = locale.Reviews
+	}
+	if want := map[string]int{"en-US": 2, reportUnknownLocale: 1}; !reflect.DeepEqual(locales, want) {
+		t.Fatalf("unexpected locales %v", locales)
+	}
+}
+
+func TestScoreSentimentHandlesNegation(t *testing.T) {
+	tests := map[string]string{
+		"I love this app":            "positive",
+		"Not good at all":            "negative",
+		"It crashes on launch":       "negative",
+		"Never crashes, works great": "positive",
+		"Opened it yesterday":        "neutral",
+	}
+	for text, want := range tests {
+		if got := sentimentLabel(scoreSentiment(tokenizeReview(text))); got != want {
+			t.Errorf("%q: expected %s, got %s", text, want, got)
+		}
+	}
+}
+
+func TestReviewTermsSkipsStopwordsAndDuplicates(t *testing.T) {
+	keywords, phrases := reviewTerms(tokenizeReview("The sync is broken. Sync broken again!"))
+	if want := []string{"sync", "broken", "again"}; !reflect.DeepEqual(keywords, want) {
+		t.Fatalf("unexpected keywords %v", keywords)
+	}
+	if want := []string{"broken sync", "sync broken", "broken again"}; !reflect.DeepEqual(phrases, want) {
+		t.Fatalf("unexpected phrases %v", phrases)
+	}
+}
+
+func TestResolveReportRange(t *testing.T) {
+	now := time.Date(2026, 3, 10, 15, 0, 0, 0, time.UTC)
+	from, to, err := resolveReportRange("", "", now)
+	if err != nil {
+		t.Fatalf("resolveReportRange error: %v", err)
+	}
+	if !from.Equal(time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC)) || !to.Equal(time.Date(2026, 3, 11, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected default range %v..%v", from, to)
+	}
+	if _, _, err := resolveReportRange("2026-03-05", "2026-03-01", now); err == nil {
+		t.Fatal("expected error when --from is after --to")
+	}
+}
@@ -0,0 +1,130 @@
+package reviews
+
+import (
+	"strings"
+	"unicode"
+)
+
+// sentimentLexicon scores common review words from -3 (very negative) to 3 (very positive).
+// It is English-first with a few frequent words from other App Store languages;
+// text in other languages mostly scores neutral.
+var sentimentLexicon = map[string]int{
+	// positive
+	"love": 3, "loved": 3, "loving": 3, "amazing": 3, "awesome": 3, "excellent": 3, "perfect": 3,
+	"fantastic": 3, "outstanding": 3, "brilliant": 3, "best": 3, "wonderful": 3, "superb": 3,
+	"great": 2, "good": 2, "nice": 2, "useful": 2, "helpful": 2, "easy": 2, "beautiful": 2,
+	"recommend": 2, "favorite": 2, "favourite": 2, "enjoy": 2, "enjoyed": 2, "fun": 2, "happy": 2,
+	"intuitive": 2, "reliable": 2, "smooth": 2, "fast": 1, "clean": 1, "simple": 1, "better": 1,
+	"thanks": 1, "thank": 1, "works": 1, "fixed": 1, "improved": 1, "like": 1, "cool": 1,
+	"genial": 3, "génial": 3, "super": 2, "excelente": 3, "gut": 2, "toll": 2, "bueno": 2,
+	"bien": 1, "ottimo": 3, "buono": 2, "parfait": 3,
+	// negative
+	"hate": -3, "terrible": -3, "awful": -3, "horrible": -3, "worst": -3, "useless": -3,
+	"scam": -3, "garbage": -3, "trash": -3, "broken": -3, "unusable": -3,
+	"bad": -2, "poor": -2, "crash": -2, "crashes": -2, "crashing": -2, "crashed": -2,
+	"bug": -2, "bugs": -2, "buggy": -2, "slow": -2, "annoying": -2, "frustrating": -2,
+	"disappointed": -2, "disappointing": -2, "waste": -2, "refund": -2, "freeze": -2, "freezes": -2,
+	"fails": -2, "failed": -2, "error": -2, "errors": -2, "laggy": -2, "expensive": -1,
+	"confusing": -1, "difficult": -1, "ads": -1, "problem": -1, "problems": -1, "issue": -1,
+	"issues": -1, "missing": -1, "lost": -1, "worse": -2, "glitch": -2, "glitches": -2,
+	"schlecht": -2, "nutzlos": -3, "malo": -2, "nul": -2, "mauvais": -2, "pessimo": -3,
+}
+
+// sentimentNegators flip the score of the next scored word.
+var sentimentNegators = map[string]bool{
+	"not": true, "no": true, "never": true, "dont": true, "don't": true, "doesnt": true,
+	"doesn't": true, "didnt": true, "didn't": true, "isnt": true, "isn't": true, "cant": true,
+	"can't": true, "wont": true, "won't": true, "nicht": true, "kein": true, "pas": true,
+}
+
+// reportStopwords are skipped when extracting keywords and phrases.
+var reportStopwords = map[string]bool{
+	"the": true, "and": true, "for": true, "this": true, "that": true, "with": true, "you": true,
+	"but": true, "are": true, "was": true, "have": true, "has": true, "had": true, "not": true,
+	"its": true, "it's": true, "app": true, "just": true, "can": true, "all": true, "get": true,
+	"from": true, "they": true, "their": true, "there": true, "when": true, "what": true,
+	"would": true, "will": true, "been": true, "very": true, "really": true, "one": true,
+	"more": true, "also": true, "use": true, "using": true, "only": true, "even": true,
+	"out": true, "now": true, "than": true, "then": true, "some": true, "any": true,
+	"about": true, "after": true, "because": true, "which": true, "your": true, "our": true,
+	"don't": true, "dont": true, "i'm": true, "im": true, "can't": true, "cant": true,
+	"much": true, "too": true, "still": true, "like": true, "app's": true, "apps": true,
+	"der": true, "die": true, "das": true, "und": true, "ist": true, "nicht": true, "les": true,
+	"est": true, "une": true, "pour": true, "que": true, "los": true, "las": true, "por": true,
+	"con": true, "una": true, "para": true, "del": true, "che": true, "per": true, "non": true,
+}
+
+// tokenizeReview lowercases text and splits it into word tokens.
+func tokenizeReview(text string) []string {
+	fields := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r) && r != '\''
+	})
+	tokens := make([]string, 0, len(fields))
+	for _, field := range fields {
+		field = strings.Trim(field, "'")
+		if field != "" {
+			tokens = append(tokens, field)
+		}
+	}
+	return tokens
+}
+
+// scoreSentiment returns the summed lexicon score of tokens, honoring simple negation.
+func scoreSentiment(tokens []string) int {
+	score := 0
+	negate := false
+	for _, token := range tokens {
+		if sentimentNegators[token] {
+			negate = true
+			continue
+		}
+		value, ok := sentimentLexicon[token]
+		if !ok {
+			continue
+		}
+		if negate {
+			value = -value
+			negate = false
+		}
+		score += value
+	}
+	return score
+}
+
+// sentimentLabel buckets a score into positive, neutral or negative.
+func sentimentLabel(score int) string {
+	switch {
+	case score > 0:
+		return "positive"
+	case score < 0:
+		return "negative"
+	default:
+		return "neutral"
+	}
+}
+
+// reviewTerms returns the unique keywords and two-word phrases in a review.
+func reviewTerms(tokens []string) (keywords []string, phrases []string) {
+	seenKeywords := map[string]bool{}
+	seenPhrases := map[string]bool{}
+	previous := ""
+	for _, token := range tokens {
+		if reportStopwords[token] || len([]rune(token)) < 3 {
+			previous = ""
+			continue
+		}
+		if !seenKeywords[token] {
+			seenKeywords[token] = true
+			keywords = append(keywords, token)
+		}
+		if previous != "" {
+			phrase := previous + " " + token
+			if !seenPhrases[phrase] {
+				seenPhrases[phrase] = true
+				phrases = append(phrases, phrase)
+			}
+		}
+		previous = token
+	}
+	return keywords, phrases
+}
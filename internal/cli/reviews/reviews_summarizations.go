@@ -17,7 +17,7 @@ import (
 func ReviewsSummarizationsCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("summarizations", flag.ExitOnError)
 
-	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (or ASC_APP_ID env)")
 	platforms := fs.String("platform", "", "Filter by platform(s), comma-separated: "+strings.Join(reviewSummarizationPlatformList(), ", "))
 	territories := fs.String("territory", "", "Filter by 3-letter territory code(s), comma-separated (e.g., USA, GBR)")
 	fields := fs.String("fields", "", "Fields to include: "+strings.Join(reviewSummarizationFieldsList(), ", "))
@@ -44,7 +44,7 @@ Examples:
 func RoutingCoverageGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("routing-coverage get", flag.ExitOnError)
 
-	versionID := fs.String("version-id", "", "App Store version ID (required)")
+	versionID := shared.ReferenceFlag(fs, shared.ReferenceVersionID, "", "App Store version ID or version string like 1.2.3 (required)")
 	output := shared.BindOutputFlags(fs)
 
 	return &ffcli.Command{
@@ -128,7 +128,7 @@ Examples:
 func RoutingCoverageCreateCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("routing-coverage create", flag.ExitOnError)
 
-	versionID := fs.String("version-id", "", "App Store version ID (required)")
+	versionID := shared.ReferenceFlag(fs, shared.ReferenceVersionID, "", "App Store version ID or version string like 1.2.3 (required)")
 	filePath := fs.String("file", "", "Path to routing coverage file (required)")
 	output := shared.BindOutputFlags(fs)
 
@@ -24,6 +24,7 @@ Examples:
   asc sandbox get --id "SANDBOX_TESTER_ID"
   asc sandbox update --id "SANDBOX_TESTER_ID" --territory "USA"
   asc sandbox clear-history --id "SANDBOX_TESTER_ID" --confirm
+  asc sandbox provision --file "./testers.yaml" --dry-run
 `,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
@@ -32,6 +33,8 @@ Examples:
 			SandboxGetCommand(),
 			SandboxUpdateCommand(),
 			SandboxClearHistoryCommand(),
+			SandboxProvisionCommand(),
+			SandboxCredentialsCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
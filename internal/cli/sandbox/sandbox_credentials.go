@@ -0,0 +1,215 @@
+package sandbox
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	defaultCredentialsPassphraseEnv = "ASC_SANDBOX_PASSPHRASE"
+	credentialsFileVersion          = 1
+	credentialsKDFIterations        = 600_000
+	credentialsMaxKDFIterations     = 10 * credentialsKDFIterations
+	credentialsSaltSize             = 16
+)
+
+// SandboxCredential is one tester entry in the encrypted credentials file.
+type SandboxCredential struct {
+	ID        string `json:"id"`
+	Email     string `json:"email"`
+	FirstName string `json:"firstName,omitempty"`
+	LastName  string `json:"lastName,omitempty"`
+	Territory string `json:"territory,omitempty"`
+	Password  string `json:"password,omitempty"`
+}
+
+// sandboxCredentialsEnvelope is the on-disk format: AES-256-GCM with a
+// PBKDF2-SHA256 derived key.
+type sandboxCredentialsEnvelope struct {
+	Version    int    `json:"version"`
+	KDF        string `json:"kdf"`
+	Iterations int    `json:"iterations"`
+	Salt       []byte `json:"salt"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// SandboxCredentialsCommand returns the sandbox credentials subcommand.
+func SandboxCredentialsCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("credentials", flag.ExitOnError)
+
+	file := fs.String("file", "", "Path to an encrypted credentials file (required)")
+	passphraseEnv := fs.String("passphrase-env", defaultCredentialsPassphraseEnv, "Environment variable holding the credentials file passphrase")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "credentials",
+		ShortUsage: "asc sandbox credentials --file \"./sandbox-credentials.enc\" [flags]",
+		ShortHelp:  "Decrypt a sandbox credentials file written by provision.",
+		LongHelp: `Decrypt a sandbox credentials file written by "asc sandbox provision".
+
+Examples:
+  ASC_SANDBOX_PASSPHRASE=... asc sandbox credentials --file "./sandbox-credentials.enc"
+  asc sandbox credentials --file "./sandbox-credentials.enc" --passphrase-env "QA_PASSPHRASE" --output table`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if len(args) > 0 {
+				return shared.UsageError("sandbox credentials does not accept positional arguments")
+			}
+			fileValue := strings.TrimSpace(*file)
+			if fileValue == "" {
+				return shared.UsageError("--file is required")
+			}
+			envName := strings.TrimSpace(*passphraseEnv)
+			if envName == "" {
+				return shared.UsageError("--passphrase-env must not be empty")
+			}
+			passphrase := os.Getenv(envName)
+			if strings.TrimSpace(passphrase) == "" {
+				return shared.UsageErrorf("%s must be set", envName)
+			}
+
+			credentials, err := readSandboxCredentials(fileValue, passphrase)
+			if err != nil {
+				return fmt.Errorf("sandbox credentials: %w", err)
+			}
+
+			return shared.PrintOutputWithRenderers(
+				credentials,
+				*output.Output,
+				*output.Pretty,
+				func() error {
+					asc.RenderTable([]string{"id", "email", "name", "territory", "password"}, sandboxCredentialRows(credentials))
+					return nil
+				},
+				func() error {
+					asc.RenderMarkdown([]string{"id", "email", "name", "territory", "password"}, sandboxCredentialRows(credentials))
+					return nil
+				},
+			)
+		},
+	}
+}
+
+func writeSandboxCredentials(path, passphrase string, credentials []SandboxCredential) error {
+	plaintext, err := json.Marshal(credentials)
+	if err != nil {
+		return fmt.Errorf("encode credentials: %w", err)
+	}
+	envelope, err := encryptSandboxCredentials(plaintext, passphrase)
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(envelope, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode credentials file: %w", err)
+	}
+	if _, err := shared.WriteFileNoSymlinkOverwrite(path, bytes.NewReader(data), 0o600, ".asc-sandbox-*", ".asc-sandbox-backup-*"); err != nil {
+		return fmt.Errorf("write credentials file: %w", err)
+	}
+	return nil
+}
+
+func readSandboxCredentials(path, passphrase string) ([]SandboxCredential, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read credentials file: %w", err)
+	}
+	var envelope sandboxCredentialsEnvelope
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return nil, fmt.Errorf("parse credentials file: %w", err)
+	}
+	plaintext, err := decryptSandboxCredentials(envelope, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	var credentials []SandboxCredential
+	if err := json.Unmarshal(plaintext, &credentials); err != nil {
+		return nil, fmt.Errorf("parse credentials: %w", err)
+	}
+	return credentials, nil
+}
+
+func encryptSandboxCredentials(plaintext []byte, passphrase string) (sandboxCredentialsEnvelope, error) {
+	salt := make([]byte, credentialsSaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return sandboxCredentialsEnvelope{}, fmt.Errorf("generate salt: %w", err)
+	}
+	aead, err := newCredentialsAEAD(passphrase, salt, credentialsKDFIterations)
+	if err != nil {
+		return sandboxCredentialsEnvelope{}, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return sandboxCredentialsEnvelope{}, fmt.Errorf("generate nonce: %w", err)
+	}
+	return sandboxCredentialsEnvelope{
+		Version:    credentialsFileVersion,
+		KDF:        "pbkdf2-sha256",
+		Iterations: credentialsKDFIterations,
+		Salt:       salt,
+		Nonce:      nonce,
+		Ciphertext: aead.Seal(nil, nonce, plaintext, nil),
+	}, nil
+}
+
+func decryptSandboxCredentials(envelope sandboxCredentialsEnvelope, passphrase string) ([]byte, error) {
+	if envelope.Version != credentialsFileVersion || envelope.KDF != "pbkdf2-sha256" {
+		return nil, fmt.Errorf("unsupported credentials file format (version %d, kdf %q)", envelope.Version, envelope.KDF)
+	}
+	if envelope.Iterations <= 0 {
+		return nil, fmt.Errorf("invalid credentials file: iterations must be positive")
+	}
+	if envelope.Iterations > credentialsMaxKDFIterations {
+		return nil, fmt.Errorf("invalid credentials file: iterations %d exceeds the maximum of %d", envelope.Iterations, credentialsMaxKDFIterations)
+	}
+	aead, err := newCredentialsAEAD(passphrase, envelope.Salt, envelope.Iterations)
+	if err != nil {
+		return nil, err
+	}
+	if len(envelope.Nonce) != aead.NonceSize() {
+		return nil, fmt.Errorf("invalid credentials file: bad nonce")
+	}
+	plaintext, err := aead.Open(nil, envelope.Nonce, envelope.Ciphertext, nil)
+	if err != nil {
+		return nil, fmt.Errorf("decrypt credentials: wrong passphrase or corrupted file")
+	}
+	return plaintext, nil
+}
+
+func newCredentialsAEAD(passphrase string, salt []byte, iterations int) (cipher.AEAD, error) {
+	key, err := pbkdf2.Key(sha256.New, passphrase, salt, iterations, 32)
+	if err != nil {
+		return nil, fmt.Errorf("derive key: %w", err)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("init cipher: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
+
+func sandboxCredentialRows(credentials []SandboxCredential) [][]string {
+	rows := make([][]string, 0, len(credentials))
+	for _, credential := range credentials {
+		name := strings.TrimSpace(credential.FirstName + " " + credential.LastName)
+		rows = append(rows, []string{credential.ID, credential.Email, name, credential.Territory, credential.Password})
+	}
+	return rows
+}
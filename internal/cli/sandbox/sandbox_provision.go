@@ -0,0 +1,513 @@
+package sandbox
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+	"gopkg.in/yaml.v3"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	provisionActionUpdate       = "update"
+	provisionActionClearHistory = "clear-history"
+	provisionActionMissing      = "missing"
+)
+
+// Action statuses recorded by applySandboxProvision.
+const (
+	provisionStatusApplied = "applied"
+	provisionStatusFailed  = "failed"
+)
+
+// SandboxProvisionTemplate is the YAML schema for sandbox provision.
+type SandboxProvisionTemplate struct {
+	Defaults SandboxTesterTemplate   `yaml:"defaults,omitempty"`
+	Testers  []SandboxTesterTemplate `yaml:"testers"`
+}
+
+// SandboxTesterTemplate declares the desired settings for one sandbox tester.
+type SandboxTesterTemplate struct {
+	Email                   string `yaml:"email,omitempty"`
+	FirstName               string `yaml:"firstName,omitempty"`
+	LastName                string `yaml:"lastName,omitempty"`
+	Territory               string `yaml:"territory,omitempty"`
+	InterruptPurchases      *bool  `yaml:"interruptPurchases,omitempty"`
+	SubscriptionRenewalRate string `yaml:"subscriptionRenewalRate,omitempty"`
+	ClearHistory            bool   `yaml:"clearHistory,omitempty"`
+	Password                string `yaml:"password,omitempty"`
+	PasswordEnv             string `yaml:"passwordEnv,omitempty"`
+}
+
+// SandboxProvisionAction is one planned or applied provisioning step.
+type SandboxProvisionAction struct {
+	Email    string `json:"email"`
+	TesterID string `json:"testerId,omitempty"`
+	Action   string `json:"action"`
+	Detail   string `json:"detail,omitempty"`
+	Status   string `json:"status,omitempty"`
+
+	update *asc.SandboxTesterUpdateAttributes
+}
+
+// SandboxProvisionResult is the output of sandbox provision.
+type SandboxProvisionResult struct {
+	File            string                   `json:"file"`
+	DryRun          bool                     `json:"dryRun"`
+	Applied         bool                     `json:"applied"`
+	Testers         int                      `json:"testers"`
+	InSync          int                      `json:"inSync"`
+	Missing         int                      `json:"missing"`
+	CredentialsFile string                   `json:"credentialsFile,omitempty"`
+	Actions         []SandboxProvisionAction `json:"actions"`
+	Error           string                   `json:"error,omitempty"`
+}
+
+type sandboxProvisionClient interface {
+	GetSandboxTesters(ctx context.Context, opts ...asc.SandboxTestersOption) (*asc.SandboxTestersResponse, error)
+	UpdateSandboxTester(ctx context.Context, testerID string, attributes asc.SandboxTesterUpdateAttributes) (*asc.SandboxTesterResponse, error)
+	ClearSandboxTesterPurchaseHistory(ctx context.Context, testerID string) (*asc.SandboxTesterClearHistoryResponse, error)
+}
+
+// sandboxDesiredTester is a template entry with defaults applied and values normalized.
+type sandboxDesiredTester struct {
+	email              string
+	firstName          string
+	lastName           string
+	territory          string
+	interruptPurchases *bool
+	renewalRate        asc.SandboxTesterSubscriptionRenewalRate
+	clearHistory       bool
+	password           string
+}
+
+// SandboxProvisionCommand returns the sandbox provision subcommand.
+func SandboxProvisionCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("provision", flag.ExitOnError)
+
+	file := fs.String("file", "", "Path to sandbox testers YAML template (required)")
+	dryRun := fs.Bool("dry-run", false, "Preview changes without mutating App Store Connect")
+	clearHistory := fs.Bool("clear-history", false, "Clear purchase history for testers marked clearHistory: true")
+	confirm := fs.Bool("confirm", false, "Confirm clearing purchase history (required with --clear-history)")
+	credentialsFile := fs.String("credentials-file", "", "Write tester credentials to an encrypted file at this path")
+	passphraseEnv := fs.String("passphrase-env", defaultCredentialsPassphraseEnv, "Environment variable holding the credentials file passphrase")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "provision",
+		ShortUsage: "asc sandbox provision --file \"./testers.yaml\" [flags]",
+		ShortHelp:  "Provision sandbox testers from a template.",
+		LongHelp: `Provision sandbox testers from a template.
+
+Makes sure each declared tester has the requested territory, interrupted
+purchase and subscription renewal rate settings. Running it again when the
+state already matches changes nothing.
+
+The App Store Connect API cannot create sandbox testers. Testers missing from
+App Store Connect are reported as "missing" and must be created in
+Users and Access > Sandbox before they can be provisioned.
+
+Template:
+  defaults:
+    territory: USA
+    interruptPurchases: false
+    subscriptionRenewalRate: MONTHLY_RENEWAL_EVERY_ONE_HOUR
+  testers:
+    - email: qa+us@example.com
+    - email: qa+jp@example.com
+      territory: JPN
+      clearHistory: true
+      passwordEnv: SANDBOX_JP_PASSWORD
+
+Examples:
+  asc sandbox provision --file "./testers.yaml" --dry-run
+  asc sandbox provision --file "./testers.yaml"
+  asc sandbox provision --file "./testers.yaml" --clear-history --confirm
+  asc sandbox provision --file "./testers.yaml" --credentials-file "./sandbox-credentials.enc"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if len(args) > 0 {
+				return shared.UsageError("sandbox provision does not accept positional arguments")
+			}
+			fileValue := strings.TrimSpace(*file)
+			if fileValue == "" {
+				return shared.UsageError("--file is required")
+			}
+			if *clearHistory && !*dryRun && !*confirm {
+				return shared.UsageError("--confirm is required with --clear-history")
+			}
+
+			credentialsPath := strings.TrimSpace(*credentialsFile)
+			var passphrase string
+			if credentialsPath != "" {
+				envName := strings.TrimSpace(*passphraseEnv)
+				if envName == "" {
+					return shared.UsageError("--passphrase-env must not be empty")
+				}
+				passphrase = os.Getenv(envName)
+				if strings.TrimSpace(passphrase) == "" {
+					return shared.UsageErrorf("%s must be set to write --credentials-file", envName)
+				}
+			}
+
+			desired, err := loadSandboxProvisionTemplate(fileValue)
+			if err != nil {
+				return fmt.Errorf("sandbox provision: %w", err)
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("sandbox provision: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			remote, err := fetchSandboxTestersByEmail(requestCtx, client)
+			if err != nil {
+				return fmt.Errorf("sandbox provision: %w", err)
+			}
+
+			actions := planSandboxProvision(desired, remote, *clearHistory)
+			result := SandboxProvisionResult{
+				File:    filepath.Clean(fileValue),
+				DryRun:  *dryRun,
+				Testers: len(desired),
+				Actions: actions,
+			}
+			result.InSync, result.Missing = countSandboxProvisionStates(desired, actions)
+
+			if !*dryRun {
+				applyErr := applySandboxProvision(requestCtx, client, actions)
+				if applyErr == nil {
+					result.Applied = true
+					if credentialsPath != "" {
+						applyErr = writeSandboxCredentials(credentialsPath, passphrase, sandboxCredentialsFromDesired(desired, remote))
+						if applyErr == nil {
+							result.CredentialsFile = filepath.Clean(credentialsPath)
+						}
+					}
+				}
+				if applyErr != nil {
+					// Show which testers already changed before failing.
+					result.Error = applyErr.Error()
+					if err := printSandboxProvisionResult(result, *output.Output, *output.Pretty); err != nil {
+						return err
+					}
+					return shared.NewReportedError(fmt.Errorf("sandbox provision: %w", applyErr))
+				}
+			}
+
+			if err := printSandboxProvisionResult(result, *output.Output, *output.Pretty); err != nil {
+				return err
+			}
+
+			if result.Missing > 0 && !*dryRun {
+				return shared.NewReportedError(fmt.Errorf("sandbox provision: %d tester(s) missing from App Store Connect", result.Missing))
+			}
+			return nil
+		},
+	}
+}
+
+func loadSandboxProvisionTemplate(path string) ([]sandboxDesiredTester, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read template: %w", err)
+	}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	var template SandboxProvisionTemplate
+	if err := decoder.Decode(&template); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("template %s is empty", path)
+		}
+		return nil, fmt.Errorf("parse template %s: %w", path, err)
+	}
+	if len(template.Testers) == 0 {
+		return nil, fmt.Errorf("template %s declares no testers", path)
+	}
+
+	seen := make(map[string]struct{}, len(template.Testers))
+	desired := make([]sandboxDesiredTester, 0, len(template.Testers))
+	for i, entry := range template.Testers {
+		tester, err := resolveSandboxTesterTemplate(template.Defaults, entry)
+		if err != nil {
+			return nil, fmt.Errorf("testers[%d]: %w", i, err)
+		}
+		key := strings.ToLower(tester.email)
+		if _, exists := seen[key]; exists {
+			return nil, fmt.Errorf("testers[%d]: duplicate email %q", i, tester.email)
+		}
+		seen[key] = struct{}{}
+		desired = append(desired, tester)
+	}
+	return desired, nil
+}
+
+func resolveSandboxTesterTemplate(defaults, entry SandboxTesterTemplate) (sandboxDesiredTester, error) {
+	email := strings.TrimSpace(entry.Email)
+	if err := validateSandboxEmail(email); err != nil {
+		return sandboxDesiredTester{}, err
+	}
+
+	territory := firstNonEmptyString(entry.Territory, defaults.Territory)
+	normalizedTerritory, err := normalizeSandboxTerritoryFilter(territory)
+	if err != nil {
+		return sandboxDesiredTester{}, err
+	}
+	rate, err := normalizeSandboxRenewalRate(firstNonEmptyString(entry.SubscriptionRenewalRate, defaults.SubscriptionRenewalRate))
+	if err != nil {
+		return sandboxDesiredTester{}, err
+	}
+	interrupt := entry.InterruptPurchases
+	if interrupt == nil {
+		interrupt = defaults.InterruptPurchases
+	}
+
+	password := entry.Password
+	if envName := strings.TrimSpace(entry.PasswordEnv); envName != "" {
+		if password != "" {
+			return sandboxDesiredTester{}, fmt.Errorf("password and passwordEnv are mutually exclusive")
+		}
+		password = os.Getenv(envName)
+	}
+
+	return sandboxDesiredTester{
+		email:              email,
+		firstName:          strings.TrimSpace(entry.FirstName),
+		lastName:           strings.TrimSpace(entry.LastName),
+		territory:          normalizedTerritory,
+		interruptPurchases: interrupt,
+		renewalRate:        rate,
+		clearHistory:       entry.ClearHistory || defaults.ClearHistory,
+		password:           password,
+	}, nil
+}
+
+func fetchSandboxTestersByEmail(ctx context.Context, client sandboxProvisionClient) (map[string]asc.Resource[asc.SandboxTesterAttributes], error) {
+	firstPage, err := client.GetSandboxTesters(ctx, asc.WithSandboxTestersLimit(200))
+	if err != nil {
+		return nil, fmt.Errorf("fetch sandbox testers: %w", err)
+	}
+	all, err := asc.PaginateAll(ctx, firstPage, func(ctx context.Context, nextURL string) (asc.PaginatedResponse, error) {
+		return client.GetSandboxTesters(ctx, asc.WithSandboxTestersNextURL(nextURL))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("fetch sandbox testers: %w", err)
+	}
+	resp, ok := all.(*asc.SandboxTestersResponse)
+	if !ok || resp == nil {
+		return nil, fmt.Errorf("unexpected sandbox testers response type")
+	}
+
+	byEmail := make(map[string]asc.Resource[asc.SandboxTesterAttributes], len(resp.Data))
+	for _, tester := range resp.Data {
+		email := strings.ToLower(strings.TrimSpace(tester.Attributes.Email))
+		if email == "" {
+			continue
+		}
+		byEmail[email] = tester
+	}
+	return byEmail, nil
+}
+
+func planSandboxProvision(desired []sandboxDesiredTester, remote map[string]asc.Resource[asc.SandboxTesterAttributes], clearHistory bool) []SandboxProvisionAction {
+	actions := make([]SandboxProvisionAction, 0)
+	for _, tester := range desired {
+		current, ok := remote[strings.ToLower(tester.email)]
+		if !ok {
+			actions = append(actions, SandboxProvisionAction{
+				Email:  tester.email,
+				Action: provisionActionMissing,
+				Detail: "create this tester in App Store Connect (Users and Access > Sandbox)",
+			})
+			continue
+		}
+
+		if update, detail := diffSandboxTester(tester, current.Attributes); update != nil {
+			actions = append(actions, SandboxProvisionAction{
+				Email:    tester.email,
+				TesterID: current.ID,
+				Action:   provisionActionUpdate,
+				Detail:   detail,
+				update:   update,
+			})
+		}
+		if clearHistory && tester.clearHistory {
+			actions = append(actions, SandboxProvisionAction{
+				Email:    tester.email,
+				TesterID: current.ID,
+				Action:   provisionActionClearHistory,
+			})
+		}
+	}
+	return actions
+}
+
+func diffSandboxTester(desired sandboxDesiredTester, current asc.SandboxTesterAttributes) (*asc.SandboxTesterUpdateAttributes, string) {
+	update := &asc.SandboxTesterUpdateAttributes{}
+	details := make([]string, 0, 3)
+
+	currentTerritory := strings.ToUpper(firstNonEmptyString(current.Territory, current.AppStoreTerritory))
+	if desired.territory != "" && desired.territory != currentTerritory {
+		territory := desired.territory
+		update.Territory = &territory
+		details = append(details, fmt.Sprintf("territory: %s -> %s", shared.OrNA(currentTerritory), territory))
+	}
+	if desired.interruptPurchases != nil {
+		currentInterrupt := current.InterruptPurchases != nil && *current.InterruptPurchases
+		if *desired.interruptPurchases != currentInterrupt {
+			value := *desired.interruptPurchases
+			update.InterruptPurchases = &value
+			details = append(details, fmt.Sprintf("interruptPurchases: %t -> %t", currentInterrupt, value))
+		}
+	}
+	if desired.renewalRate != "" && string(desired.renewalRate) != current.SubscriptionRenewalRate {
+		rate := desired.renewalRate
+		update.SubscriptionRenewalRate = &rate
+		details = append(details, fmt.Sprintf("subscriptionRenewalRate: %s -> %s", shared.OrNA(current.SubscriptionRenewalRate), rate))
+	}
+
+	if len(details) == 0 {
+		return nil, ""
+	}
+	return update, strings.Join(details, "; ")
+}
+
+// applySandboxProvision runs the planned actions in order and records each
+// one's status, stopping at the first failure.
+func applySandboxProvision(ctx context.Context, client sandboxProvisionClient, actions []SandboxProvisionAction) error {
+	for i := range actions {
+		action := &actions[i]
+		var err error
+		switch action.Action {
+		case provisionActionUpdate:
+			if _, err = client.UpdateSandboxTester(ctx, action.TesterID, *action.update); err != nil {
+				err = fmt.Errorf("update %s: %w", action.Email, err)
+			}
+		case provisionActionClearHistory:
+			if _, err = client.ClearSandboxTesterPurchaseHistory(ctx, action.TesterID); err != nil {
+				err = fmt.Errorf("clear history for %s: %w", action.Email, err)
+			}
+		default:
+			continue
+		}
+		if err != nil {
+			action.Status = provisionStatusFailed
+			return err
+		}
+		action.Status = provisionStatusApplied
+	}
+	return nil
+}
+
+func countSandboxProvisionStates(desired []sandboxDesiredTester, actions []SandboxProvisionAction) (int, int) {
+	changed := make(map[string]struct{})
+	missing := 0
+	for _, action := range actions {
+		switch action.Action {
+		case provisionActionMissing:
+			missing++
+			changed[strings.ToLower(action.Email)] = struct{}{}
+		case provisionActionUpdate:
+			changed[strings.ToLower(action.Email)] = struct{}{}
+		}
+	}
+	return len(desired) - len(changed), missing
+}
+
+func sandboxCredentialsFromDesired(desired []sandboxDesiredTester, remote map[string]asc.Resource[asc.SandboxTesterAttributes]) []SandboxCredential {
+	credentials := make([]SandboxCredential, 0, len(desired))
+	for _, tester := range desired {
+		current, ok := remote[strings.ToLower(tester.email)]
+		if !ok {
+			continue
+		}
+		credentials = append(credentials, SandboxCredential{
+			ID:        current.ID,
+			Email:     tester.email,
+			FirstName: firstNonEmptyString(tester.firstName, current.Attributes.FirstName),
+			LastName:  firstNonEmptyString(tester.lastName, current.Attributes.LastName),
+			Territory: firstNonEmptyString(tester.territory, current.Attributes.Territory),
+			Password:  tester.password,
+		})
+	}
+	return credentials
+}
+
+func firstNonEmptyString(values ...string) string {
+	for _, value := range values {
+		if trimmed := strings.TrimSpace(value); trimmed != "" {
+			return trimmed
+		}
+	}
+	return ""
+}
+
+func sandboxProvisionRows(actions []SandboxProvisionAction) [][]string {
+	rows := make([][]string, 0, len(actions))
+	for _, action := range actions {
+		rows = append(rows, []string{action.Action, action.Email, action.TesterID, action.Detail, action.Status})
+	}
+	return rows
+}
+
+func printSandboxProvisionResult(result SandboxProvisionResult, format string, pretty bool) error {
+	return shared.PrintOutputWithRenderers(
+		result,
+		format,
+		pretty,
+		func() error { return printSandboxProvisionTable(result) },
+		func() error { return printSandboxProvisionMarkdown(result) },
+	)
+}
+
+func printSandboxProvisionTable(result SandboxProvisionResult) error {
+	fmt.Printf("File: %s\n", result.File)
+	fmt.Printf("Dry Run: %t\n", result.DryRun)
+	fmt.Printf("Testers: %d (in sync: %d, missing: %d)\n", result.Testers, result.InSync, result.Missing)
+	if result.CredentialsFile != "" {
+		fmt.Printf("Credentials File: %s\n", result.CredentialsFile)
+	}
+	fmt.Println()
+	if len(result.Actions) == 0 {
+		fmt.Println("No changes. Sandbox testers are up to date.")
+		return nil
+	}
+	asc.RenderTable([]string{"action", "email", "testerId", "detail", "status"}, sandboxProvisionRows(result.Actions))
+	if result.Error != "" {
+		fmt.Printf("\nError: %s\n", result.Error)
+	}
+	return nil
+}
+
+func printSandboxProvisionMarkdown(result SandboxProvisionResult) error {
+	fmt.Printf("**File:** %s\n\n", result.File)
+	fmt.Printf("**Dry Run:** %t\n\n", result.DryRun)
+	fmt.Printf("**Testers:** %d (in sync: %d, missing: %d)\n\n", result.Testers, result.InSync, result.Missing)
+	if result.CredentialsFile != "" {
+		fmt.Printf("**Credentials File:** %s\n\n", result.CredentialsFile)
+	}
+	if len(result.Actions) == 0 {
+		fmt.Println("No changes. Sandbox testers are up to date.")
+		return nil
+	}
+	asc.RenderMarkdown([]string{"action", "email", "testerId", "detail", "status"}, sandboxProvisionRows(result.Actions))
+	if result.Error != "" {
+		fmt.Printf("\n**Error:** %s\n", result.Error)
+	}
+	return nil
+}
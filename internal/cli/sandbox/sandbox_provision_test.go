@@ -0,0 +1,229 @@
+package sandbox
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+type sandboxProvisionStub struct {
+	testers []asc.Resource[asc.SandboxTesterAttributes]
+	updates map[string]asc.SandboxTesterUpdateAttributes
+	cleared []string
+	// clearErr fails every purchase history clear when set.
+	clearErr error
+}
+
+func (s *sandboxProvisionStub) GetSandboxTesters(ctx context.Context, opts ...asc.SandboxTestersOption) (*asc.SandboxTestersResponse, error) {
+	return &asc.SandboxTestersResponse{Data: s.testers}, nil
+}
+
+func (s *sandboxProvisionStub) UpdateSandboxTester(ctx context.Context, testerID string, attributes asc.SandboxTesterUpdateAttributes) (*asc.SandboxTesterResponse, error) {
+	if s.updates == nil {
+		s.updates = make(map[string]asc.SandboxTesterUpdateAttributes)
+	}
+	s.updates[testerID] = attributes
+	return &asc.SandboxTesterResponse{}, nil
+}
+
+func (s *sandboxProvisionStub) ClearSandboxTesterPurchaseHistory(ctx context.Context, testerID string) (*asc.SandboxTesterClearHistoryResponse, error) {
+	if s.clearErr != nil {
+		return nil, s.clearErr
+	}
+	s.cleared = append(s.cleared, testerID)
+	return &asc.SandboxTesterClearHistoryResponse{}, nil
+}
+
+func writeSandboxTemplate(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "testers.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("write template: %v", err)
+	}
+	return path
+}
+
+func TestPlanSandboxProvision(t *testing.T) {
+	path := writeSandboxTemplate(t, `defaults:
+  territory: usa
+  interruptPurchases: false
+testers:
+  - email: us@example.com
+  - email: jp@example.com
+    territory: JPN
+    subscriptionRenewalRate: MONTHLY_RENEWAL_EVERY_FIVE_MINUTES
+    clearHistory: true
+  - email: missing@example.com
+`)
+	desired, err := loadSandboxProvisionTemplate(path)
+	if err != nil {
+		t.Fatalf("loadSandboxProvisionTemplate() error: %v", err)
+	}
+
+	interrupt := false
+	stub := &sandboxProvisionStub{
+		testers: []asc.Resource[asc.SandboxTesterAttributes]{
+			{ID: "t-us", Attributes: asc.SandboxTesterAttributes{Email: "US@example.com", Territory: "USA", InterruptPurchases: &interrupt}},
+			{ID: "t-jp", Attributes: asc.SandboxTesterAttributes{Email: "jp@example.com", Territory: "USA", SubscriptionRenewalRate: "MONTHLY_RENEWAL_EVERY_ONE_HOUR"}},
+		},
+	}
+	remote, err := fetchSandboxTestersByEmail(context.Background(), stub)
+	if err != nil {
+		t.Fatalf("fetchSandboxTestersByEmail() error: %v", err)
+	}
+
+	actions := planSandboxProvision(desired, remote, true)
+	if len(actions) != 3 {
+		t.Fatalf("expected 3 actions, got %+v", actions)
+	}
+	if actions[0].Action != provisionActionUpdate || actions[0].TesterID != "t-jp" {
+		t.Fatalf("expected update for t-jp, got %+v", actions[0])
+	}
+	if !strings.Contains(actions[0].Detail, "territory: USA -> JPN") || !strings.Contains(actions[0].Detail, "MONTHLY_RENEWAL_EVERY_FIVE_MINUTES") {
+		t.Fatalf("unexpected detail %q", actions[0].Detail)
+	}
+	if actions[1].Action != provisionActionClearHistory || actions[1].TesterID != "t-jp" {
+		t.Fatalf("expected clear-history for t-jp, got %+v", actions[1])
+	}
+	if actions[2].Action != provisionActionMissing || actions[2].Email != "missing@example.com" {
+		t.Fatalf("expected missing tester, got %+v", actions[2])
+	}
+
+	inSync, missing := countSandboxProvisionStates(desired, actions)
+	if inSync != 1 || missing != 1 {
+		t.Fatalf("expected inSync=1 missing=1, got %d %d", inSync, missing)
+	}
+
+	if err := applySandboxProvision(context.Background(), stub, actions); err != nil {
+		t.Fatalf("applySandboxProvision() error: %v", err)
+	}
+	update, ok := stub.updates["t-jp"]
+	if !ok || update.Territory == nil || *update.Territory != "JPN" {
+		t.Fatalf("expected territory update for t-jp, got %+v", stub.updates)
+	}
+	if update.InterruptPurchases != nil {
+		t.Fatalf("expected interruptPurchases to be unchanged, got %v", *update.InterruptPurchases)
+	}
+	if len(stub.cleared) != 1 || stub.cleared[0] != "t-jp" {
+		t.Fatalf("expected history cleared for t-jp, got %v", stub.cleared)
+	}
+
+	// Without --clear-history, a matching state plans nothing for in-sync testers.
+	if again := planSandboxProvision(desired[:1], remote, false); len(again) != 0 {
+		t.Fatalf("expected no actions for in-sync tester, got %+v", again)
+	}
+}
+
+func TestApplySandboxProvision_RecordsStatusUntilFailure(t *testing.T) {
+	territory := "JPN"
+	actions := []SandboxProvisionAction{
+		{Email: "jp@example.com", TesterID: "t-jp", Action: provisionActionUpdate, update: &asc.SandboxTesterUpdateAttributes{Territory: &territory}},
+		{Email: "jp@example.com", TesterID: "t-jp", Action: provisionActionClearHistory},
+		{Email: "us@example.com", TesterID: "t-us", Action: provisionActionClearHistory},
+		{Email: "missing@example.com", Action: provisionActionMissing},
+	}
+	stub := &sandboxProvisionStub{clearErr: errors.New("server error")}
+
+	err := applySandboxProvision(context.Background(), stub, actions)
+	if err == nil || !strings.Contains(err.Error(), "clear history for jp@example.com") {
+		t.Fatalf("expected clear-history error, got %v", err)
+	}
+	var statuses []string
+	for _, action := range actions {
+		statuses = append(statuses, action.Status)
+	}
+	if want := []string{provisionStatusApplied, provisionStatusFailed, "", ""}; !slices.Equal(statuses, want) {
+		t.Fatalf("statuses = %v, want %v", statuses, want)
+	}
+}
+
+func TestSandboxCredentialsCommand_RejectsPositionalArgs(t *testing.T) {
+	cmd := SandboxCredentialsCommand()
+	cmd.FlagSet.SetOutput(io.Discard)
+	if err := cmd.FlagSet.Parse([]string{"--file", "creds.enc"}); err != nil {
+		t.Fatalf("parse error: %v", err)
+	}
+	if err := cmd.Exec(context.Background(), []string{"extra"}); !errors.Is(err, flag.ErrHelp) {
+		t.Fatalf("expected flag.ErrHelp, got %v", err)
+	}
+}
+
+func TestLoadSandboxProvisionTemplate_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{name: "no testers", content: "testers: []\n", wantErr: "declares no testers"},
+		{name: "invalid email", content: "testers:\n  - email: nope\n", wantErr: "testers[0]"},
+		{name: "duplicate", content: "testers:\n  - email: a@example.com\n  - email: A@example.com\n", wantErr: "duplicate email"},
+		{name: "bad territory", content: "testers:\n  - email: a@example.com\n    territory: ZZZ\n", wantErr: "testers[0]"},
+		{name: "unknown field", content: "testers:\n  - email: a@example.com\n    region: USA\n", wantErr: "region"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := loadSandboxProvisionTemplate(writeSandboxTemplate(t, test.content))
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestSandboxCredentialsRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "creds.enc")
+	credentials := []SandboxCredential{{ID: "t-1", Email: "qa@example.com", Territory: "USA", Password: "s3cret"}}
+
+	if err := writeSandboxCredentials(path, "passphrase", credentials); err != nil {
+		t.Fatalf("writeSandboxCredentials() error: %v", err)
+	}
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read credentials: %v", err)
+	}
+	if strings.Contains(string(raw), "s3cret") || strings.Contains(string(raw), "qa@example.com") {
+		t.Fatalf("credentials file contains plaintext: %s", raw)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("stat credentials: %v", err)
+	}
+	if info.Mode().Perm() != 0o600 {
+		t.Fatalf("expected 0600 permissions, got %v", info.Mode().Perm())
+	}
+
+	got, err := readSandboxCredentials(path, "passphrase")
+	if err != nil {
+		t.Fatalf("readSandboxCredentials() error: %v", err)
+	}
+	if len(got) != 1 || got[0] != credentials[0] {
+		t.Fatalf("unexpected credentials %+v", got)
+	}
+
+	if _, err := readSandboxCredentials(path, "wrong"); err == nil || !strings.Contains(err.Error(), "wrong passphrase") {
+		t.Fatalf("expected wrong passphrase error, got %v", err)
+	}
+}
+
+func TestDecryptSandboxCredentials_RejectsExcessiveIterations(t *testing.T) {
+	envelope, err := encryptSandboxCredentials([]byte("[]"), "passphrase")
+	if err != nil {
+		t.Fatalf("encryptSandboxCredentials() error: %v", err)
+	}
+	envelope.Iterations = credentialsMaxKDFIterations + 1
+
+	_, err = decryptSandboxCredentials(envelope, "passphrase")
+	if err == nil || !strings.Contains(err.Error(), "exceeds the maximum") {
+		t.Fatalf("expected iterations cap error, got %v", err)
+	}
+}
@@ -0,0 +1,104 @@
+package shared
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/config"
+)
+
+// appLookupCacheTTL bounds how long a bundle ID or name resolution is reused.
+// Apps are rarely renamed, but a stale entry must not live forever.
+const appLookupCacheTTL = 7 * 24 * time.Hour
+
+var unsafeCacheNameChars = regexp.MustCompile(`[^A-Za-z0-9._-]`)
+
+type appLookupEntry struct {
+	ID         string    `json:"id"`
+	BundleID   string    `json:"bundleId,omitempty"`
+	Name       string    `json:"name,omitempty"`
+	ResolvedAt time.Time `json:"resolvedAt"`
+}
+
+type appLookupCacheFile struct {
+	Apps map[string]appLookupEntry `json:"apps"`
+}
+
+// appLookupCache maps lowercased bundle IDs and app names to app IDs. Caches
+// are per credential profile since profiles may belong to different teams.
+// A missing or unreadable cache is treated as empty.
+type appLookupCache struct {
+	path string
+	now  func() time.Time
+}
+
+func newAppLookupCache(path string) *appLookupCache {
+	return &appLookupCache{path: path, now: time.Now}
+}
+
+// CacheFilePath returns ~/.asc/cache/<name>-<profile>.json for the active
+// credential profile, or "" when the config directory cannot be determined.
+func CacheFilePath(name string) string {
+	globalPath, err := config.GlobalPath()
+	if err != nil {
+		return ""
+	}
+	profile := resolveProfileName()
+	if profile == "" {
+		profile = "default"
+	}
+	profile = unsafeCacheNameChars.ReplaceAllString(profile, "_")
+	return filepath.Join(filepath.Dir(globalPath), "cache", name+"-"+profile+".json")
+}
+
+func appLookupCachePath() string {
+	return CacheFilePath("app-lookup")
+}
+
+func (c *appLookupCache) load() appLookupCacheFile {
+	file := appLookupCacheFile{Apps: map[string]appLookupEntry{}}
+	if c == nil || c.path == "" {
+		return file
+	}
+	data, err := os.ReadFile(c.path)
+	if err != nil {
+		return file
+	}
+	if err := json.Unmarshal(data, &file); err != nil || file.Apps == nil {
+		return appLookupCacheFile{Apps: map[string]appLookupEntry{}}
+	}
+	return file
+}
+
+func (c *appLookupCache) get(value string) (string, bool) {
+	entry, ok := c.load().Apps[strings.ToLower(value)]
+	if !ok || entry.ID == "" || c.now().Sub(entry.ResolvedAt) > appLookupCacheTTL {
+		return "", false
+	}
+	return entry.ID, true
+}
+
+// put records a resolution. Write failures are ignored; the cache is only an
+// optimization.
+func (c *appLookupCache) put(value string, entry appLookupEntry) {
+	if c == nil || c.path == "" {
+		return
+	}
+	file := c.load()
+	entry.ResolvedAt = c.now().UTC()
+	file.Apps[strings.ToLower(value)] = entry
+
+	data, err := json.MarshalIndent(file, "", "  ")
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o700); err != nil {
+		return
+	}
+	_, _ = WriteFileNoSymlinkOverwrite(c.path, bytes.NewReader(data), 0o600, ".app-lookup-*", ".app-lookup-backup-*")
+}
@@ -27,7 +27,7 @@ type AvailabilitySetCommandConfig struct {
 func NewAvailabilitySetCommand(config AvailabilitySetCommandConfig) *ffcli.Command {
 	fs := flag.NewFlagSet(config.FlagSetName, flag.ExitOnError)
 
-	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID)")
+	appID := ReferenceFlag(fs, ReferenceApp, "", "App Store Connect app ID (or ASC_APP_ID)")
 	territory := fs.String("territory", "", "Territory IDs (comma-separated, e.g., USA,GBR)")
 	var available OptionalBool
 	fs.Var(&available, "available", "Set availability: true or false")
@@ -1,4 +1,4 @@
-package publish
+package shared
 
 import (
 	"context"
@@ -9,19 +9,22 @@ import (
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
 )
 
-type publishBetaGroupsClient interface {
+// BetaGroupsClient lists beta groups for an app.
+type BetaGroupsClient interface {
 	GetBetaGroups(ctx context.Context, appID string, opts ...asc.BetaGroupsOption) (*asc.BetaGroupsResponse, error)
 }
 
-func resolvePublishBetaGroupIDs(ctx context.Context, client publishBetaGroupsClient, appID string, groups []string) ([]string, error) {
-	allGroups, err := listAllPublishBetaGroups(ctx, client, appID)
+// ResolveBetaGroupIDs resolves beta group IDs or names for an app to IDs.
+func ResolveBetaGroupIDs(ctx context.Context, client BetaGroupsClient, appID string, groups []string) ([]string, error) {
+	allGroups, err := ListAllBetaGroups(ctx, client, appID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list beta groups: %w", err)
 	}
-	return resolvePublishBetaGroupIDsFromList(groups, allGroups)
+	return ResolveBetaGroupIDsFromList(groups, allGroups)
 }
 
-func listAllPublishBetaGroups(ctx context.Context, client publishBetaGroupsClient, appID string) (*asc.BetaGroupsResponse, error) {
+// ListAllBetaGroups fetches every beta group for an app.
+func ListAllBetaGroups(ctx context.Context, client BetaGroupsClient, appID string) (*asc.BetaGroupsResponse, error) {
 	firstPage, err := client.GetBetaGroups(ctx, appID, asc.WithBetaGroupsLimit(200))
 	if err != nil {
 		return nil, err
@@ -44,7 +47,9 @@ func listAllPublishBetaGroups(ctx context.Context, client publishBetaGroupsClien
 	return allGroups, nil
 }
 
-func resolvePublishBetaGroupIDsFromList(inputGroups []string, groups *asc.BetaGroupsResponse) ([]string, error) {
+// ResolveBetaGroupIDsFromList matches group IDs first, then case-insensitive
+// names, and returns unique IDs in input order.
+func ResolveBetaGroupIDsFromList(inputGroups []string, groups *asc.BetaGroupsResponse) ([]string, error) {
 	if groups == nil {
 		return nil, fmt.Errorf("no beta groups returned for app")
 	}
@@ -87,7 +92,7 @@ func resolvePublishBetaGroupIDsFromList(inputGroups []string, groups *asc.BetaGr
 			case 1:
 				resolvedID = matches[0]
 			default:
-				return nil, fmt.Errorf("multiple beta groups named %q; use group ID", group)
+				return nil, fmt.Errorf("multiple beta groups named %q; use group ID (candidates: %s)", group, strings.Join(matches, ", "))
 			}
 		}
 
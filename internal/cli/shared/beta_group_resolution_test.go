@@ -1,4 +1,4 @@
-package publish
+package shared
 
 import (
 	"context"
@@ -16,7 +16,6 @@ import (
 	"testing"
 
 	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
-	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
 )
 
 // --- helpers ---------------------------------------------------------------
@@ -77,9 +76,9 @@ func jsonResponse(status int, body string) *http.Response {
 	}
 }
 
-// --- resolvePublishBetaGroupIDsFromList (pure unit tests) ------------------
+// --- ResolveBetaGroupIDsFromList (pure unit tests) ------------------
 
-func TestResolvePublishBetaGroupIDsFromList_ResolvesByNameAndID(t *testing.T) {
+func TestResolveBetaGroupIDsFromList_ResolvesByNameAndID(t *testing.T) {
 	groups := &asc.BetaGroupsResponse{
 		Data: []asc.Resource[asc.BetaGroupAttributes]{
 			{ID: "GROUP_A", Attributes: asc.BetaGroupAttributes{Name: "External Testers"}},
@@ -87,12 +86,12 @@ func TestResolvePublishBetaGroupIDsFromList_ResolvesByNameAndID(t *testing.T) {
 		},
 	}
 
-	got, err := resolvePublishBetaGroupIDsFromList(
+	got, err := ResolveBetaGroupIDsFromList(
 		[]string{" external testers ", "GROUP_B", "GROUP_A", "EXTERNAL TESTERS"},
 		groups,
 	)
 	if err != nil {
-		t.Fatalf("resolvePublishBetaGroupIDsFromList() error = %v", err)
+		t.Fatalf("ResolveBetaGroupIDsFromList() error = %v", err)
 	}
 
 	want := []string{"GROUP_A", "GROUP_B"}
@@ -106,14 +105,14 @@ func TestResolvePublishBetaGroupIDsFromList_ResolvesByNameAndID(t *testing.T) {
 	}
 }
 
-func TestResolvePublishBetaGroupIDsFromList_MissingGroup(t *testing.T) {
+func TestResolveBetaGroupIDsFromList_MissingGroup(t *testing.T) {
 	groups := &asc.BetaGroupsResponse{
 		Data: []asc.Resource[asc.BetaGroupAttributes]{
 			{ID: "GROUP_A", Attributes: asc.BetaGroupAttributes{Name: "External Testers"}},
 		},
 	}
 
-	_, err := resolvePublishBetaGroupIDsFromList([]string{"does-not-exist"}, groups)
+	_, err := ResolveBetaGroupIDsFromList([]string{"does-not-exist"}, groups)
 	if err == nil {
 		t.Fatal("expected error for missing beta group")
 	}
@@ -122,7 +121,7 @@ func TestResolvePublishBetaGroupIDsFromList_MissingGroup(t *testing.T) {
 	}
 }
 
-func TestResolvePublishBetaGroupIDsFromList_AmbiguousName(t *testing.T) {
+func TestResolveBetaGroupIDsFromList_AmbiguousName(t *testing.T) {
 	groups := &asc.BetaGroupsResponse{
 		Data: []asc.Resource[asc.BetaGroupAttributes]{
 			{ID: "GROUP_A", Attributes: asc.BetaGroupAttributes{Name: "QA"}},
@@ -130,7 +129,7 @@ func TestResolvePublishBetaGroupIDsFromList_AmbiguousName(t *testing.T) {
 		},
 	}
 
-	_, err := resolvePublishBetaGroupIDsFromList([]string{"qa"}, groups)
+	_, err := ResolveBetaGroupIDsFromList([]string{"qa"}, groups)
 	if err == nil {
 		t.Fatal("expected error for ambiguous beta group name")
 	}
@@ -139,8 +138,8 @@ func TestResolvePublishBetaGroupIDsFromList_AmbiguousName(t *testing.T) {
 	}
 }
 
-func TestResolvePublishBetaGroupIDsFromList_NoGroupsReturned(t *testing.T) {
-	_, err := resolvePublishBetaGroupIDsFromList([]string{"group"}, nil)
+func TestResolveBetaGroupIDsFromList_NoGroupsReturned(t *testing.T) {
+	_, err := ResolveBetaGroupIDsFromList([]string{"group"}, nil)
 	if err == nil {
 		t.Fatal("expected error when no group list is available")
 	}
@@ -149,7 +148,7 @@ func TestResolvePublishBetaGroupIDsFromList_NoGroupsReturned(t *testing.T) {
 	}
 }
 
-func TestResolvePublishBetaGroupIDsFromList_SingleNameResolves(t *testing.T) {
+func TestResolveBetaGroupIDsFromList_SingleNameResolves(t *testing.T) {
 	groups := &asc.BetaGroupsResponse{
 		Data: []asc.Resource[asc.BetaGroupAttributes]{
 			{ID: "G1", Attributes: asc.BetaGroupAttributes{Name: "Alpha"}},
@@ -157,7 +156,7 @@ func TestResolvePublishBetaGroupIDsFromList_SingleNameResolves(t *testing.T) {
 		},
 	}
 
-	got, err := resolvePublishBetaGroupIDsFromList([]string{"beta"}, groups)
+	got, err := ResolveBetaGroupIDsFromList([]string{"beta"}, groups)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -166,12 +165,12 @@ func TestResolvePublishBetaGroupIDsFromList_SingleNameResolves(t *testing.T) {
 	}
 }
 
-func TestResolvePublishBetaGroupIDsFromList_EmptyDataList(t *testing.T) {
+func TestResolveBetaGroupIDsFromList_EmptyDataList(t *testing.T) {
 	groups := &asc.BetaGroupsResponse{
 		Data: []asc.Resource[asc.BetaGroupAttributes]{},
 	}
 
-	_, err := resolvePublishBetaGroupIDsFromList([]string{"anything"}, groups)
+	_, err := ResolveBetaGroupIDsFromList([]string{"anything"}, groups)
 	if err == nil {
 		t.Fatal("expected error when data list is empty")
 	}
@@ -180,14 +179,14 @@ func TestResolvePublishBetaGroupIDsFromList_EmptyDataList(t *testing.T) {
 	}
 }
 
-func TestResolvePublishBetaGroupIDsFromList_AllWhitespaceInputs(t *testing.T) {
+func TestResolveBetaGroupIDsFromList_AllWhitespaceInputs(t *testing.T) {
 	groups := &asc.BetaGroupsResponse{
 		Data: []asc.Resource[asc.BetaGroupAttributes]{
 			{ID: "G1", Attributes: asc.BetaGroupAttributes{Name: "Alpha"}},
 		},
 	}
 
-	_, err := resolvePublishBetaGroupIDsFromList([]string{"  ", "\t", ""}, groups)
+	_, err := ResolveBetaGroupIDsFromList([]string{"  ", "\t", ""}, groups)
 	if err == nil {
 		t.Fatal("expected error when all inputs are whitespace")
 	}
@@ -196,7 +195,7 @@ func TestResolvePublishBetaGroupIDsFromList_AllWhitespaceInputs(t *testing.T) {
 	}
 }
 
-func TestResolvePublishBetaGroupIDsFromList_IDsTakePriorityOverNames(t *testing.T) {
+func TestResolveBetaGroupIDsFromList_IDsTakePriorityOverNames(t *testing.T) {
 	// A group whose name happens to be a valid ID of another group.
 	groups := &asc.BetaGroupsResponse{
 		Data: []asc.Resource[asc.BetaGroupAttributes]{
@@ -206,7 +205,7 @@ func TestResolvePublishBetaGroupIDsFromList_IDsTakePriorityOverNames(t *testing.
 	}
 
 	// "DEF456" should resolve as an ID (exact match), not by name.
-	got, err := resolvePublishBetaGroupIDsFromList([]string{"DEF456"}, groups)
+	got, err := ResolveBetaGroupIDsFromList([]string{"DEF456"}, groups)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -215,7 +214,7 @@ func TestResolvePublishBetaGroupIDsFromList_IDsTakePriorityOverNames(t *testing.
 	}
 }
 
-func TestResolvePublishBetaGroupIDsFromList_SkipsGroupsWithEmptyIDOrName(t *testing.T) {
+func TestResolveBetaGroupIDsFromList_SkipsGroupsWithEmptyIDOrName(t *testing.T) {
 	groups := &asc.BetaGroupsResponse{
 		Data: []asc.Resource[asc.BetaGroupAttributes]{
 			{ID: "", Attributes: asc.BetaGroupAttributes{Name: "Ghost"}}, // empty ID, skipped
@@ -225,7 +224,7 @@ func TestResolvePublishBetaGroupIDsFromList_SkipsGroupsWithEmptyIDOrName(t *test
 	}
 
 	// Resolve by ID: G1 should still be found even though its name is empty.
-	got, err := resolvePublishBetaGroupIDsFromList([]string{"G1"}, groups)
+	got, err := ResolveBetaGroupIDsFromList([]string{"G1"}, groups)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -235,13 +234,13 @@ func TestResolvePublishBetaGroupIDsFromList_SkipsGroupsWithEmptyIDOrName(t *test
 
 	// Resolve by name "Ghost" should fail because the resource with that name
 	// had an empty ID and was skipped.
-	_, err = resolvePublishBetaGroupIDsFromList([]string{"Ghost"}, groups)
+	_, err = ResolveBetaGroupIDsFromList([]string{"Ghost"}, groups)
 	if err == nil {
 		t.Fatal("expected error for group with empty ID")
 	}
 }
 
-func TestResolvePublishBetaGroupIDsFromList_DeduplicatesNameAndID(t *testing.T) {
+func TestResolveBetaGroupIDsFromList_DeduplicatesNameAndID(t *testing.T) {
 	groups := &asc.BetaGroupsResponse{
 		Data: []asc.Resource[asc.BetaGroupAttributes]{
 			{ID: "G1", Attributes: asc.BetaGroupAttributes{Name: "Alpha"}},
@@ -249,7 +248,7 @@ func TestResolvePublishBetaGroupIDsFromList_DeduplicatesNameAndID(t *testing.T)
 	}
 
 	// Pass the same group by ID and by name: should resolve to just one entry.
-	got, err := resolvePublishBetaGroupIDsFromList([]string{"G1", "alpha"}, groups)
+	got, err := ResolveBetaGroupIDsFromList([]string{"G1", "alpha"}, groups)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -258,7 +257,7 @@ func TestResolvePublishBetaGroupIDsFromList_DeduplicatesNameAndID(t *testing.T)
 	}
 }
 
-func TestResolvePublishBetaGroupIDsFromList_DuplicateAPIEntries(t *testing.T) {
+func TestResolveBetaGroupIDsFromList_DuplicateAPIEntries(t *testing.T) {
 	// Simulate the same group appearing multiple times in the API response
 	// (can happen with pagination when data changes between page fetches).
 	groups := &asc.BetaGroupsResponse{
@@ -270,7 +269,7 @@ func TestResolvePublishBetaGroupIDsFromList_DuplicateAPIEntries(t *testing.T) {
 	}
 
 	// Should resolve "alpha" to G1 without reporting a false ambiguous error.
-	got, err := resolvePublishBetaGroupIDsFromList([]string{"alpha"}, groups)
+	got, err := ResolveBetaGroupIDsFromList([]string{"alpha"}, groups)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -279,9 +278,9 @@ func TestResolvePublishBetaGroupIDsFromList_DuplicateAPIEntries(t *testing.T) {
 	}
 }
 
-// --- resolvePublishBetaGroupIDs (HTTP integration tests) -------------------
+// --- ResolveBetaGroupIDs (HTTP integration tests) -------------------
 
-func TestResolvePublishBetaGroupIDs_SinglePage(t *testing.T) {
+func TestResolveBetaGroupIDs_SinglePage(t *testing.T) {
 	setupTestAuth(t)
 
 	swapTransport(t, roundTripFunc(func(req *http.Request) (*http.Response, error) {
@@ -300,14 +299,14 @@ func TestResolvePublishBetaGroupIDs_SinglePage(t *testing.T) {
 		return jsonResponse(http.StatusOK, body), nil
 	}))
 
-	client, err := shared.GetASCClient()
+	client, err := GetASCClient()
 	if err != nil {
-		t.Fatalf("shared.GetASCClient: %v", err)
+		t.Fatalf("GetASCClient: %v", err)
 	}
 
-	got, err := resolvePublishBetaGroupIDs(context.Background(), client, "APP1", []string{"Alpha", "G2"})
+	got, err := ResolveBetaGroupIDs(context.Background(), client, "APP1", []string{"Alpha", "G2"})
 	if err != nil {
-		t.Fatalf("resolvePublishBetaGroupIDs() error = %v", err)
+		t.Fatalf("ResolveBetaGroupIDs() error = %v", err)
 	}
 
 	want := []string{"G1", "G2"}
@@ -321,7 +320,7 @@ func TestResolvePublishBetaGroupIDs_SinglePage(t *testing.T) {
 	}
 }
 
-func TestResolvePublishBetaGroupIDs_APIError(t *testing.T) {
+func TestResolveBetaGroupIDs_APIError(t *testing.T) {
 	setupTestAuth(t)
 
 	swapTransport(t, roundTripFunc(func(req *http.Request) (*http.Response, error) {
@@ -329,12 +328,12 @@ func TestResolvePublishBetaGroupIDs_APIError(t *testing.T) {
 		return jsonResponse(http.StatusForbidden, body), nil
 	}))
 
-	client, err := shared.GetASCClient()
+	client, err := GetASCClient()
 	if err != nil {
-		t.Fatalf("shared.GetASCClient: %v", err)
+		t.Fatalf("GetASCClient: %v", err)
 	}
 
-	_, err = resolvePublishBetaGroupIDs(context.Background(), client, "APP1", []string{"Alpha"})
+	_, err = ResolveBetaGroupIDs(context.Background(), client, "APP1", []string{"Alpha"})
 	if err == nil {
 		t.Fatal("expected error from API failure")
 	}
@@ -343,7 +342,7 @@ func TestResolvePublishBetaGroupIDs_APIError(t *testing.T) {
 	}
 }
 
-func TestResolvePublishBetaGroupIDs_NameNotFound(t *testing.T) {
+func TestResolveBetaGroupIDs_NameNotFound(t *testing.T) {
 	setupTestAuth(t)
 
 	swapTransport(t, roundTripFunc(func(req *http.Request) (*http.Response, error) {
@@ -355,12 +354,12 @@ func TestResolvePublishBetaGroupIDs_NameNotFound(t *testing.T) {
 		return jsonResponse(http.StatusOK, body), nil
 	}))
 
-	client, err := shared.GetASCClient()
+	client, err := GetASCClient()
 	if err != nil {
-		t.Fatalf("shared.GetASCClient: %v", err)
+		t.Fatalf("GetASCClient: %v", err)
 	}
 
-	_, err = resolvePublishBetaGroupIDs(context.Background(), client, "APP1", []string{"NonExistent"})
+	_, err = ResolveBetaGroupIDs(context.Background(), client, "APP1", []string{"NonExistent"})
 	if err == nil {
 		t.Fatal("expected error for non-existent group name")
 	}
@@ -369,9 +368,9 @@ func TestResolvePublishBetaGroupIDs_NameNotFound(t *testing.T) {
 	}
 }
 
-// --- listAllPublishBetaGroups (pagination tests) ---------------------------
+// --- ListAllBetaGroups (pagination tests) ---------------------------
 
-func TestListAllPublishBetaGroups_SinglePage(t *testing.T) {
+func TestListAllBetaGroups_SinglePage(t *testing.T) {
 	setupTestAuth(t)
 
 	swapTransport(t, roundTripFunc(func(req *http.Request) (*http.Response, error) {
@@ -384,21 +383,21 @@ func TestListAllPublishBetaGroups_SinglePage(t *testing.T) {
 		return jsonResponse(http.StatusOK, body), nil
 	}))
 
-	client, err := shared.GetASCClient()
+	client, err := GetASCClient()
 	if err != nil {
-		t.Fatalf("shared.GetASCClient: %v", err)
+		t.Fatalf("GetASCClient: %v", err)
 	}
 
-	resp, err := listAllPublishBetaGroups(context.Background(), client, "APP1")
+	resp, err := ListAllBetaGroups(context.Background(), client, "APP1")
 	if err != nil {
-		t.Fatalf("listAllPublishBetaGroups() error = %v", err)
+		t.Fatalf("ListAllBetaGroups() error = %v", err)
 	}
 	if len(resp.Data) != 2 {
 		t.Fatalf("expected 2 groups, got %d", len(resp.Data))
 	}
 }
 
-func TestListAllPublishBetaGroups_Paginated(t *testing.T) {
+func TestListAllBetaGroups_Paginated(t *testing.T) {
 	setupTestAuth(t)
 
 	callCount := 0
@@ -435,14 +434,14 @@ func TestListAllPublishBetaGroups_Paginated(t *testing.T) {
 		}
 	}))
 
-	client, err := shared.GetASCClient()
+	client, err := GetASCClient()
 	if err != nil {
-		t.Fatalf("shared.GetASCClient: %v", err)
+		t.Fatalf("GetASCClient: %v", err)
 	}
 
-	resp, err := listAllPublishBetaGroups(context.Background(), client, "APP1")
+	resp, err := ListAllBetaGroups(context.Background(), client, "APP1")
 	if err != nil {
-		t.Fatalf("listAllPublishBetaGroups() error = %v", err)
+		t.Fatalf("ListAllBetaGroups() error = %v", err)
 	}
 	if len(resp.Data) != 3 {
 		t.Fatalf("expected 3 groups across 2 pages, got %d", len(resp.Data))
@@ -460,7 +459,7 @@ func TestListAllPublishBetaGroups_Paginated(t *testing.T) {
 	}
 }
 
-func TestListAllPublishBetaGroups_PaginationAPIError(t *testing.T) {
+func TestListAllBetaGroups_PaginationAPIError(t *testing.T) {
 	setupTestAuth(t)
 
 	callCount := 0
@@ -482,12 +481,12 @@ func TestListAllPublishBetaGroups_PaginationAPIError(t *testing.T) {
 		}
 	}))
 
-	client, err := shared.GetASCClient()
+	client, err := GetASCClient()
 	if err != nil {
-		t.Fatalf("shared.GetASCClient: %v", err)
+		t.Fatalf("GetASCClient: %v", err)
 	}
 
-	_, err = listAllPublishBetaGroups(context.Background(), client, "APP1")
+	_, err = ListAllBetaGroups(context.Background(), client, "APP1")
 	if err == nil {
 		t.Fatal("expected error from second page failure")
 	}
@@ -495,7 +494,7 @@ func TestListAllPublishBetaGroups_PaginationAPIError(t *testing.T) {
 
 // --- end-to-end: resolve names through paginated API -----------------------
 
-func TestResolvePublishBetaGroupIDs_PaginatedNameResolution(t *testing.T) {
+func TestResolveBetaGroupIDs_PaginatedNameResolution(t *testing.T) {
 	setupTestAuth(t)
 
 	callCount := 0
@@ -523,15 +522,15 @@ func TestResolvePublishBetaGroupIDs_PaginatedNameResolution(t *testing.T) {
 		}
 	}))
 
-	client, err := shared.GetASCClient()
+	client, err := GetASCClient()
 	if err != nil {
-		t.Fatalf("shared.GetASCClient: %v", err)
+		t.Fatalf("GetASCClient: %v", err)
 	}
 
 	// Resolve a name that only exists on page 2.
-	got, err := resolvePublishBetaGroupIDs(context.Background(), client, "APP1", []string{"External Testers"})
+	got, err := ResolveBetaGroupIDs(context.Background(), client, "APP1", []string{"External Testers"})
 	if err != nil {
-		t.Fatalf("resolvePublishBetaGroupIDs() error = %v", err)
+		t.Fatalf("ResolveBetaGroupIDs() error = %v", err)
 	}
 	if len(got) != 1 || got[0] != "G2" {
 		t.Fatalf("expected [G2], got %v", got)
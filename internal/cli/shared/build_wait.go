@@ -31,7 +31,7 @@ func WaitForBuildByNumber(ctx context.Context, client *asc.Client, appID, versio
 	}
 
 	return asc.PollUntil(ctx, pollInterval, func(ctx context.Context) (*asc.BuildResponse, bool, error) {
-		build, err := findBuildByNumber(ctx, client, appID, version, buildNumber, platform)
+		build, err := FindBuildByNumber(ctx, client, appID, version, buildNumber, platform)
 		if err != nil {
 			return nil, false, err
 		}
@@ -42,7 +42,9 @@ func WaitForBuildByNumber(ctx context.Context, client *asc.Client, appID, versio
 	})
 }
 
-func findBuildByNumber(ctx context.Context, client *asc.Client, appID, version, buildNumber, platform string) (*asc.BuildResponse, error) {
+// FindBuildByNumber returns the build matching version/build number, or nil
+// when App Store Connect does not list it yet.
+func FindBuildByNumber(ctx context.Context, client *asc.Client, appID, version, buildNumber, platform string) (*asc.BuildResponse, error) {
 	preReleaseResp, err := client.GetPreReleaseVersions(ctx, appID,
 		asc.WithPreReleaseVersionsVersion(version),
 		asc.WithPreReleaseVersionsPlatform(platform),
@@ -38,6 +38,20 @@ func NewReportedError(err error) error {
 	return reportedError{err: err}
 }
 
+// ExitCodeError carries the exit status of an external process, such as a
+// plugin, that has already written its own output.
+type ExitCodeError struct {
+	Code int
+}
+
+func (e *ExitCodeError) Error() string {
+	return fmt.Sprintf("exit status %d", e.Code)
+}
+
+func (e *ExitCodeError) Reported() bool {
+	return true
+}
+
 // UsageError prints a CLI validation error and returns flag.ErrHelp so callers
 // map the failure to usage exit code semantics.
 func UsageError(message string) error {
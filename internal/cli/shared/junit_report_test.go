@@ -109,6 +109,35 @@ func TestJUnitReport_WriteWithFailure(t *testing.T) {
 	}
 }
 
+func TestJUnitReport_MarshalSkipped(t *testing.T) {
+	report := JUnitReport{
+		Tests: []JUnitTestCase{
+			{Name: "testSlow", Classname: "AppTests", Skipped: true},
+			{Name: "testFlaky", Classname: "AppTests", Skipped: true, Failure: "FAILURE"},
+		},
+		Timestamp: time.Now(),
+	}
+
+	data, err := report.Marshal()
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	var result struct {
+		Skipped  int `xml:"skipped,attr"`
+		Failures int `xml:"failures,attr"`
+	}
+	if err := xml.Unmarshal(data, &result); err != nil {
+		t.Fatalf("XML unmarshal error = %v", err)
+	}
+	if result.Skipped != 1 || result.Failures != 1 {
+		t.Errorf("expected 1 skipped and 1 failure, got %+v", result)
+	}
+	if strings.Count(string(data), "<skipped>") != 1 {
+		t.Errorf("expected one <skipped> element, got %s", data)
+	}
+}
+
 func TestJUnitReport_EscapeSpecialChars(t *testing.T) {
 	report := JUnitReport{
 		Tests: []JUnitTestCase{
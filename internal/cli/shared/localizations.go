@@ -46,6 +46,11 @@ func VersionLocalizationKeys() []string {
 	return append([]string(nil), versionLocalizationKeys...)
 }
 
+// AppInfoLocalizationKeys returns the supported app-info localization keys.
+func AppInfoLocalizationKeys() []string {
+	return append([]string(nil), appInfoLocalizationKeys...)
+}
+
 // ValidateVersionLocalizationKeys validates .strings keys for a version localization locale.
 func ValidateVersionLocalizationKeys(locale string, values map[string]string) error {
 	return validateLocalizationKeys(locale, values, versionLocalizationAllowedKeys)
@@ -207,6 +212,11 @@ func mapAppInfoLocalizationStrings(attrs asc.AppInfoLocalizationAttributes) map[
 	return values
 }
 
+// MapAppInfoLocalizationStrings converts app-info localization attributes into .strings keys.
+func MapAppInfoLocalizationStrings(attrs asc.AppInfoLocalizationAttributes) map[string]string {
+	return mapAppInfoLocalizationStrings(attrs)
+}
+
 func setIfNotEmpty(values map[string]string, key, value string) {
 	if strings.TrimSpace(value) == "" {
 		return
@@ -31,7 +31,7 @@ type PricingSetCommandConfig struct {
 func NewPricingSetCommand(config PricingSetCommandConfig) *ffcli.Command {
 	fs := flag.NewFlagSet(config.FlagSetName, flag.ExitOnError)
 
-	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID)")
+	appID := ReferenceFlag(fs, ReferenceApp, "", "App Store Connect app ID (or ASC_APP_ID)")
 	pricePointID := fs.String("price-point", "", "App price point ID")
 	baseTerritory := fs.String("base-territory", "", "Base territory ID (e.g., USA)")
 	startDate := fs.String("start-date", "", config.StartDateHelp)
@@ -0,0 +1,523 @@
+package shared
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+// referenceClient is the API surface needed to resolve human-friendly
+// references to resource IDs.
+type referenceClient interface {
+	BetaGroupsClient
+	GetApps(ctx context.Context, opts ...asc.AppsOption) (*asc.AppsResponse, error)
+	GetBuilds(ctx context.Context, appID string, opts ...asc.BuildsOption) (*asc.BuildsResponse, error)
+	GetPreReleaseVersions(ctx context.Context, appID string, opts ...asc.PreReleaseVersionsOption) (*asc.PreReleaseVersionsResponse, error)
+	GetAppStoreVersions(ctx context.Context, appID string, opts ...asc.AppStoreVersionsOption) (*asc.AppStoreVersionsResponse, error)
+}
+
+var (
+	numericIDPattern  = regexp.MustCompile(`^\d+$`)
+	resourceIDPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	versionRefPattern = regexp.MustCompile(`^\d+(\.\d+){0,3}$`)
+	buildRefPattern   = regexp.MustCompile(`^(\d+(?:\.\d+){0,3})\s*\(\s*([^()\s]+)\s*\)$`)
+)
+
+// ResolveFlagReferences rewrites human-friendly values of the parsed command's
+// flags into App Store Connect IDs before the command runs:
+//
+//	--app         bundle ID or app name (also ASC_APP_ID / config app_id)
+//	--build       "latest" or "1.2.3(45)"
+//	--version-id  version string such as "1.2.3"
+//	--group       beta group names
+//
+// Values that already look like IDs are left alone and cost no API calls.
+// Only flags defined with ReferenceFlag or ReferenceListFlag are touched, so
+// unrelated flags with the same names (for example Game Center version IDs)
+// keep their meaning.
+func ResolveFlagReferences(ctx context.Context, fs *flag.FlagSet) error {
+	if fs == nil {
+		return nil
+	}
+	resolver := &referenceResolver{
+		fs:    fs,
+		cache: newAppLookupCache(appLookupCachePath()),
+		newClient: func() (referenceClient, error) {
+			return GetASCClient()
+		},
+	}
+	return resolver.resolve(ctx)
+}
+
+// EnableDefaultAppResolution makes ResolveAppID("") resolve a bundle ID or
+// app name in ASC_APP_ID or the config app_id to an app ID, so commands
+// without an --app flag and plugins also receive an ID. The returned func
+// turns resolution off again.
+func EnableDefaultAppResolution(ctx context.Context) func() {
+	setDefaultAppResolver(ctx, &referenceResolver{
+		cache: newAppLookupCache(appLookupCachePath()),
+		newClient: func() (referenceClient, error) {
+			return GetASCClient()
+		},
+	})
+	return func() { setDefaultAppResolver(context.Background(), nil) }
+}
+
+// defaultApp resolves the ASC_APP_ID or config app_id reference at most once
+// per process, on the first ResolveAppID("") call that needs it.
+var defaultApp struct {
+	mu       sync.Mutex
+	ctx      context.Context
+	resolver *referenceResolver
+	value    string
+	id       string
+}
+
+func setDefaultAppResolver(ctx context.Context, resolver *referenceResolver) {
+	defaultApp.mu.Lock()
+	defer defaultApp.mu.Unlock()
+	defaultApp.ctx = ctx
+	defaultApp.resolver = resolver
+	defaultApp.value = ""
+	defaultApp.id = ""
+}
+
+// resolveDefaultApp returns the app ID for a default app value. The value is
+// returned unchanged when it is already an ID, when no resolver is set up, or
+// when resolution fails; failures other than missing credentials are
+// reported on stderr so the command's own error has context.
+func resolveDefaultApp(value string) string {
+	if value == "" || numericIDPattern.MatchString(value) {
+		return value
+	}
+	defaultApp.mu.Lock()
+	defer defaultApp.mu.Unlock()
+	if defaultApp.resolver == nil {
+		return value
+	}
+	if defaultApp.value == value {
+		return defaultApp.id
+	}
+	id, err := defaultApp.resolver.resolveApp(defaultApp.ctx, value)
+	if err != nil {
+		if !errors.Is(err, errReferenceClientUnavailable) && !errors.Is(err, flag.ErrHelp) {
+			fmt.Fprintf(os.Stderr, "Warning: could not resolve ASC_APP_ID %q: %v\n", value, err)
+		}
+		id = value
+	}
+	defaultApp.value = value
+	defaultApp.id = id
+	return id
+}
+
+type referenceResolver struct {
+	fs        *flag.FlagSet
+	cache     *appLookupCache
+	newClient func() (referenceClient, error)
+	client    referenceClient
+}
+
+// errReferenceClientUnavailable means references cannot be resolved because
+// no credentials are configured. Values are then passed through unchanged so
+// the command reports its own validation or authentication error.
+var errReferenceClientUnavailable = errors.New("reference resolution needs credentials")
+
+func (r *referenceResolver) apiClient() (referenceClient, error) {
+	if r.client == nil {
+		client, err := r.newClient()
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", errReferenceClientUnavailable, err)
+		}
+		r.client = client
+	}
+	return r.client, nil
+}
+
+func (r *referenceResolver) resolve(ctx context.Context) error {
+	appID := defaultAppValue()
+	if f := r.lookup(ReferenceApp); f != nil {
+		if value := strings.TrimSpace(f.Value.String()); value != "" {
+			appID = value
+		}
+		if appID != "" && !numericIDPattern.MatchString(appID) {
+			resolved, err := r.resolveApp(ctx, appID)
+			if ok, err := r.set("app", resolved, err); !ok {
+				return err
+			}
+			appID = resolved
+		}
+	}
+
+	platform := ""
+	if f := r.fs.Lookup("platform"); f != nil {
+		platform = strings.ToUpper(strings.TrimSpace(f.Value.String()))
+	}
+
+	if f := r.lookup(ReferenceBuild); f != nil {
+		value := strings.TrimSpace(f.Value.String())
+		if strings.EqualFold(value, "latest") || buildRefPattern.MatchString(value) {
+			resolved, err := r.withApp(ctx, appID, "--build "+value, func(id string) (string, error) {
+				return r.resolveBuild(ctx, id, value, platform)
+			})
+			if ok, err := r.set("build", resolved, err); !ok {
+				return err
+			}
+		}
+	}
+
+	if f := r.lookup(ReferenceVersionID); f != nil {
+		value := strings.TrimSpace(f.Value.String())
+		if versionRefPattern.MatchString(value) {
+			resolved, err := r.withApp(ctx, appID, "--version-id "+value, func(id string) (string, error) {
+				return r.resolveVersion(ctx, id, value, platform)
+			})
+			if ok, err := r.set("version-id", resolved, err); !ok {
+				return err
+			}
+		}
+	}
+
+	if f := r.lookup(ReferenceBetaGroup); f != nil {
+		value := strings.TrimSpace(f.Value.String())
+		groups := []string{value}
+		if isMultiValueFlag(f) {
+			groups = splitCSV(value)
+		}
+		// Without an app the command decides how to treat names.
+		if value != "" && appID != "" && hasNonIDReference(groups) {
+			resolved, err := r.withApp(ctx, appID, "--group", func(id string) (string, error) {
+				return r.resolveGroups(ctx, id, groups)
+			})
+			if ok, err := r.set("group", resolved, err); !ok {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// set stores a resolved flag value. It reports false when the caller should
+// stop and return err; a missing API client is not an error.
+func (r *referenceResolver) set(name, resolved string, err error) (bool, error) {
+	if errors.Is(err, errReferenceClientUnavailable) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, r.fs.Set(name, resolved)
+}
+
+// withApp resolves the app needed by another flag, including ASC_APP_ID or
+// config values when the command has no --app, and then calls resolve.
+func (r *referenceResolver) withApp(ctx context.Context, appID, flagDescription string, resolve func(appID string) (string, error)) (string, error) {
+	if appID == "" {
+		return "", UsageErrorf("%s requires --app (or ASC_APP_ID)", flagDescription)
+	}
+	if !numericIDPattern.MatchString(appID) {
+		resolved, err := r.resolveApp(ctx, appID)
+		if err != nil {
+			return "", err
+		}
+		appID = resolved
+	}
+	return resolve(appID)
+}
+
+func (r *referenceResolver) resolveGroups(ctx context.Context, appID string, groups []string) (string, error) {
+	client, err := r.apiClient()
+	if err != nil {
+		return "", err
+	}
+	allGroups, err := ListAllBetaGroups(ctx, client, appID)
+	if err != nil {
+		return "", fmt.Errorf("resolve --group: failed to list beta groups: %w", err)
+	}
+	ids, err := ResolveBetaGroupIDsFromList(groups, allGroups)
+	if err != nil {
+		return "", UsageErrorf("--group: %v", err)
+	}
+	return strings.Join(ids, ","), nil
+}
+
+func (r *referenceResolver) lookup(kind string) *flag.Flag {
+	f := r.fs.Lookup(kind)
+	if FlagReferenceKind(f) != kind {
+		return nil
+	}
+	return f
+}
+
+// Flag reference kinds reported by FlagReferenceKind. Each kind is also the
+// name of the flag that carries it.
+const (
+	ReferenceApp       = "app"
+	ReferenceBuild     = "build"
+	ReferenceVersionID = "version-id"
+	ReferenceBetaGroup = "group"
+)
+
+// referenceValue is a string flag value marked as an App Store Connect
+// resource reference.
+type referenceValue struct {
+	kind  string
+	multi bool
+	value string
+}
+
+func (v *referenceValue) String() string {
+	if v == nil {
+		return ""
+	}
+	return v.value
+}
+
+func (v *referenceValue) Set(value string) error {
+	v.value = value
+	return nil
+}
+
+func (v *referenceValue) Get() any {
+	return v.value
+}
+
+// ReferenceFlag defines a string flag named after kind whose human-friendly
+// value (bundle ID, app name, "latest", version string or group name) is
+// rewritten to an ID by ResolveFlagReferences.
+func ReferenceFlag(fs *flag.FlagSet, kind, value, usage string) *string {
+	return bindReferenceFlag(fs, kind, value, usage, false)
+}
+
+// ReferenceListFlag is ReferenceFlag for comma-separated values.
+func ReferenceListFlag(fs *flag.FlagSet, kind, value, usage string) *string {
+	return bindReferenceFlag(fs, kind, value, usage, true)
+}
+
+func bindReferenceFlag(fs *flag.FlagSet, kind, value, usage string, multi bool) *string {
+	ref := &referenceValue{kind: kind, multi: multi, value: value}
+	fs.Var(ref, kind, usage)
+	return &ref.value
+}
+
+// FlagReferenceKind reports which kind of App Store Connect resource a flag
+// defined with ReferenceFlag or ReferenceListFlag refers to, or "" for other
+// flags.
+func FlagReferenceKind(f *flag.Flag) string {
+	if f == nil {
+		return ""
+	}
+	if ref, ok := f.Value.(*referenceValue); ok {
+		return ref.kind
+	}
+	return ""
+}
+
+func isMultiValueFlag(f *flag.Flag) bool {
+	ref, ok := f.Value.(*referenceValue)
+	return ok && ref.multi
+}
+
+func hasNonIDReference(values []string) bool {
+	for _, value := range values {
+		if !resourceIDPattern.MatchString(strings.TrimSpace(value)) {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveApp resolves a bundle ID or app name to an app ID, using the
+// per-profile lookup cache when possible.
+func (r *referenceResolver) resolveApp(ctx context.Context, value string) (string, error) {
+	if id, ok := r.cache.get(value); ok {
+		return id, nil
+	}
+	client, err := r.apiClient()
+	if err != nil {
+		return "", err
+	}
+
+	var candidates []asc.Resource[asc.AppAttributes]
+	if looksLikeBundleID(value) {
+		resp, err := client.GetApps(ctx, asc.WithAppsBundleIDs([]string{value}), asc.WithAppsLimit(200))
+		if err != nil {
+			return "", fmt.Errorf("resolve app %q: %w", value, err)
+		}
+		candidates = matchingApps(resp, value, func(attrs asc.AppAttributes) string { return attrs.BundleID })
+	}
+	if len(candidates) == 0 {
+		resp, err := client.GetApps(ctx, asc.WithAppsNames([]string{value}), asc.WithAppsLimit(200))
+		if err != nil {
+			return "", fmt.Errorf("resolve app %q: %w", value, err)
+		}
+		candidates = matchingApps(resp, value, func(attrs asc.AppAttributes) string { return attrs.Name })
+		if len(candidates) == 0 && resp != nil && len(resp.Data) > 0 {
+			return "", UsageErrorf("no app with bundle ID or name %q; similar apps:\n%s", value, formatAppCandidates(resp.Data))
+		}
+	}
+
+	switch len(candidates) {
+	case 0:
+		return "", UsageErrorf("no app with bundle ID or name %q", value)
+	case 1:
+		app := candidates[0]
+		r.cache.put(value, appLookupEntry{ID: app.ID, BundleID: app.Attributes.BundleID, Name: app.Attributes.Name})
+		return app.ID, nil
+	default:
+		return "", UsageErrorf("%q matches multiple apps; use the app ID:\n%s", value, formatAppCandidates(candidates))
+	}
+}
+
+func looksLikeBundleID(value string) bool {
+	return strings.Contains(value, ".") && !strings.ContainsAny(value, " \t")
+}
+
+func matchingApps(resp *asc.AppsResponse, value string, field func(asc.AppAttributes) string) []asc.Resource[asc.AppAttributes] {
+	if resp == nil {
+		return nil
+	}
+	var matches []asc.Resource[asc.AppAttributes]
+	for _, app := range resp.Data {
+		if strings.EqualFold(strings.TrimSpace(field(app.Attributes)), value) {
+			matches = append(matches, app)
+		}
+	}
+	return matches
+}
+
+func formatAppCandidates(apps []asc.Resource[asc.AppAttributes]) string {
+	lines := make([]string, 0, len(apps))
+	for _, app := range apps {
+		lines = append(lines, fmt.Sprintf("  %s  %s  %s", app.ID, app.Attributes.BundleID, app.Attributes.Name))
+	}
+	sort.Strings(lines)
+	return strings.Join(lines, "\n")
+}
+
+// resolveBuild resolves "latest" or "1.2.3(45)" to a build ID.
+func (r *referenceResolver) resolveBuild(ctx context.Context, appID, value, platform string) (string, error) {
+	client, err := r.apiClient()
+	if err != nil {
+		return "", err
+	}
+
+	if strings.EqualFold(value, "latest") {
+		if platform == "" {
+			resp, err := client.GetBuilds(ctx, appID, asc.WithBuildsSort("-uploadedDate"), asc.WithBuildsLimit(1))
+			if err != nil {
+				return "", fmt.Errorf("resolve --build latest: %w", err)
+			}
+			if resp == nil || len(resp.Data) == 0 {
+				return "", UsageErrorf("--build latest: no builds found for app %s", appID)
+			}
+			return resp.Data[0].ID, nil
+		}
+
+		// Builds have no platform filter; compare the newest build of each
+		// pre-release version on that platform, as builds latest does.
+		versions, err := listPreReleaseVersions(ctx, client, appID, "", platform)
+		if err != nil {
+			return "", err
+		}
+		newestID, newestDate := "", ""
+		for _, prv := range versions {
+			resp, err := client.GetBuilds(ctx, appID, asc.WithBuildsPreReleaseVersion(prv.ID), asc.WithBuildsSort("-uploadedDate"), asc.WithBuildsLimit(1))
+			if err != nil {
+				return "", fmt.Errorf("resolve --build latest: %w", err)
+			}
+			if len(resp.Data) > 0 && (newestID == "" || resp.Data[0].Attributes.UploadedDate > newestDate) {
+				newestID, newestDate = resp.Data[0].ID, resp.Data[0].Attributes.UploadedDate
+			}
+		}
+		if newestID == "" {
+			return "", UsageErrorf("--build latest: no %s builds found for app %s", platform, appID)
+		}
+		return newestID, nil
+	}
+
+	parts := buildRefPattern.FindStringSubmatch(value)
+	version, number := parts[1], parts[2]
+	versions, err := listPreReleaseVersions(ctx, client, appID, version, platform)
+	if err != nil {
+		return "", err
+	}
+	type buildMatch struct{ id, platform string }
+	var matches []buildMatch
+	for _, prv := range versions {
+		resp, err := client.GetBuilds(ctx, appID, asc.WithBuildsPreReleaseVersion(prv.ID), asc.WithBuildsVersion(number), asc.WithBuildsLimit(10))
+		if err != nil {
+			return "", fmt.Errorf("resolve --build %s: %w", value, err)
+		}
+		for _, build := range resp.Data {
+			matches = append(matches, buildMatch{id: build.ID, platform: string(prv.Attributes.Platform)})
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return "", UsageErrorf("--build %s: no build %s for version %s", value, number, version)
+	case 1:
+		return matches[0].id, nil
+	default:
+		lines := make([]string, 0, len(matches))
+		for _, match := range matches {
+			lines = append(lines, fmt.Sprintf("  %s  %s", match.id, match.platform))
+		}
+		return "", UsageErrorf("--build %s matches multiple builds; use --platform or the build ID:\n%s", value, strings.Join(lines, "\n"))
+	}
+}
+
+// resolveVersion resolves a version string to an App Store version ID.
+func (r *referenceResolver) resolveVersion(ctx context.Context, appID, version, platform string) (string, error) {
+	client, err := r.apiClient()
+	if err != nil {
+		return "", err
+	}
+	opts := []asc.AppStoreVersionsOption{
+		asc.WithAppStoreVersionsVersionStrings([]string{version}),
+		asc.WithAppStoreVersionsLimit(10),
+	}
+	if platform != "" {
+		opts = append(opts, asc.WithAppStoreVersionsPlatforms([]string{platform}))
+	}
+	resp, err := client.GetAppStoreVersions(ctx, appID, opts...)
+	if err != nil {
+		return "", fmt.Errorf("resolve --version-id %s: %w", version, err)
+	}
+	if resp == nil || len(resp.Data) == 0 {
+		return "", UsageErrorf("--version-id: no App Store version %s for app %s", version, appID)
+	}
+	if len(resp.Data) > 1 {
+		lines := make([]string, 0, len(resp.Data))
+		for _, item := range resp.Data {
+			lines = append(lines, fmt.Sprintf("  %s  %s  %s", item.ID, item.Attributes.Platform, item.Attributes.AppStoreState))
+		}
+		return "", UsageErrorf("--version-id %s matches multiple versions; use --platform or the version ID:\n%s", version, strings.Join(lines, "\n"))
+	}
+	return resp.Data[0].ID, nil
+}
+
+// listPreReleaseVersions lists an app's pre-release versions, optionally
+// filtered by version string and platform.
+func listPreReleaseVersions(ctx context.Context, client referenceClient, appID, version, platform string) ([]asc.PreReleaseVersion, error) {
+	opts := []asc.PreReleaseVersionsOption{asc.WithPreReleaseVersionsLimit(200)}
+	if version != "" {
+		opts = append(opts, asc.WithPreReleaseVersionsVersion(version))
+	}
+	if platform != "" {
+		opts = append(opts, asc.WithPreReleaseVersionsPlatform(platform))
+	}
+	resp, err := client.GetPreReleaseVersions(ctx, appID, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to lookup pre-release versions: %w", err)
+	}
+	return resp.Data, nil
+}
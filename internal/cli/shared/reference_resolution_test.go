@@ -0,0 +1,322 @@
+package shared
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+type fakeReferenceClient struct {
+	apps               []asc.Resource[asc.AppAttributes]
+	appsCalls          int
+	preReleaseVersions []asc.PreReleaseVersion
+	numberedBuilds     []asc.Resource[asc.BuildAttributes]
+	latestBuild        string
+	versions           []asc.Resource[asc.AppStoreVersionAttributes]
+	groups             []asc.Resource[asc.BetaGroupAttributes]
+}
+
+func (c *fakeReferenceClient) GetApps(_ context.Context, _ ...asc.AppsOption) (*asc.AppsResponse, error) {
+	c.appsCalls++
+	return &asc.AppsResponse{Data: c.apps}, nil
+}
+
+// GetBuilds tells lookups apart by option count: "latest" passes sort and
+// limit, "1.2.3(45)" passes pre-release version, build number and limit.
+func (c *fakeReferenceClient) GetBuilds(_ context.Context, _ string, opts ...asc.BuildsOption) (*asc.BuildsResponse, error) {
+	if len(opts) == 3 {
+		return &asc.BuildsResponse{Data: c.numberedBuilds}, nil
+	}
+	if c.latestBuild == "" {
+		return &asc.BuildsResponse{}, nil
+	}
+	return &asc.BuildsResponse{Data: []asc.Resource[asc.BuildAttributes]{{ID: c.latestBuild}}}, nil
+}
+
+func (c *fakeReferenceClient) GetPreReleaseVersions(_ context.Context, _ string, _ ...asc.PreReleaseVersionsOption) (*asc.PreReleaseVersionsResponse, error) {
+	return &asc.PreReleaseVersionsResponse{Data: c.preReleaseVersions}, nil
+}
+
+func (c *fakeReferenceClient) GetAppStoreVersions(_ context.Context, _ string, _ ...asc.AppStoreVersionsOption) (*asc.AppStoreVersionsResponse, error) {
+	return &asc.AppStoreVersionsResponse{Data: c.versions}, nil
+}
+
+func (c *fakeReferenceClient) GetBetaGroups(_ context.Context, _ string, _ ...asc.BetaGroupsOption) (*asc.BetaGroupsResponse, error) {
+	return &asc.BetaGroupsResponse{Data: c.groups}, nil
+}
+
+func newReferenceFlagSet() *flag.FlagSet {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	ReferenceFlag(fs, ReferenceApp, "", "App Store Connect app ID (or ASC_APP_ID env)")
+	ReferenceFlag(fs, ReferenceBuild, "", "Build ID")
+	ReferenceFlag(fs, ReferenceVersionID, "", "App Store version ID")
+	ReferenceListFlag(fs, ReferenceBetaGroup, "", "Comma-separated beta group IDs")
+	fs.String("platform", "", "Platform: IOS, MAC_OS, TV_OS, VISION_OS")
+	return fs
+}
+
+func newTestReferenceResolver(t *testing.T, fs *flag.FlagSet, client *fakeReferenceClient) *referenceResolver {
+	t.Helper()
+	t.Setenv("ASC_APP_ID", "")
+	return &referenceResolver{
+		fs:    fs,
+		cache: newAppLookupCache(filepath.Join(t.TempDir(), "cache", "app-lookup-default.json")),
+		newClient: func() (referenceClient, error) {
+			return client, nil
+		},
+	}
+}
+
+func testApp(id, bundleID, name string) asc.Resource[asc.AppAttributes] {
+	return asc.Resource[asc.AppAttributes]{ID: id, Attributes: asc.AppAttributes{BundleID: bundleID, Name: name}}
+}
+
+func TestResolveFlagReferences_AppByBundleIDAndName(t *testing.T) {
+	client := &fakeReferenceClient{apps: []asc.Resource[asc.AppAttributes]{
+		testApp("111", "com.example.myapp", "My App"),
+	}}
+
+	for _, value := range []string{"com.example.myapp", "my app"} {
+		fs := newReferenceFlagSet()
+		if err := fs.Parse([]string{"--app", value}); err != nil {
+			t.Fatal(err)
+		}
+		if err := newTestReferenceResolver(t, fs, client).resolve(context.Background()); err != nil {
+			t.Fatalf("resolve(%q) error: %v", value, err)
+		}
+		if got := fs.Lookup("app").Value.String(); got != "111" {
+			t.Fatalf("resolve(%q) app = %q, want 111", value, got)
+		}
+	}
+}
+
+func TestResolveFlagReferences_AppUsesCache(t *testing.T) {
+	client := &fakeReferenceClient{apps: []asc.Resource[asc.AppAttributes]{
+		testApp("111", "com.example.myapp", "My App"),
+	}}
+	fs := newReferenceFlagSet()
+	if err := fs.Parse([]string{"--app", "com.example.myapp"}); err != nil {
+		t.Fatal(err)
+	}
+	resolver := newTestReferenceResolver(t, fs, client)
+	if err := resolver.resolve(context.Background()); err != nil {
+		t.Fatalf("first resolve error: %v", err)
+	}
+
+	second := newReferenceFlagSet()
+	if err := second.Parse([]string{"--app", "COM.EXAMPLE.MYAPP"}); err != nil {
+		t.Fatal(err)
+	}
+	resolver.fs = second
+	resolver.client = nil
+	resolver.newClient = func() (referenceClient, error) {
+		return nil, errors.New("no credentials")
+	}
+	if err := resolver.resolve(context.Background()); err != nil {
+		t.Fatalf("cached resolve error: %v", err)
+	}
+	if got := second.Lookup("app").Value.String(); got != "111" {
+		t.Fatalf("cached app = %q, want 111", got)
+	}
+	if client.appsCalls != 1 {
+		t.Fatalf("expected 1 apps lookup, got %d", client.appsCalls)
+	}
+
+	resolver.cache.now = func() time.Time { return time.Now().Add(appLookupCacheTTL + time.Hour) }
+	if _, ok := resolver.cache.get("com.example.myapp"); ok {
+		t.Fatal("expected expired cache entry to be ignored")
+	}
+}
+
+func TestResolveFlagReferences_AmbiguousAppListsCandidates(t *testing.T) {
+	client := &fakeReferenceClient{apps: []asc.Resource[asc.AppAttributes]{
+		testApp("111", "com.example.one", "Demo"),
+		testApp("222", "com.example.two", "Demo"),
+	}}
+	fs := newReferenceFlagSet()
+	if err := fs.Parse([]string{"--app", "Demo"}); err != nil {
+		t.Fatal(err)
+	}
+
+	var err error
+	stderr := captureStderr(t, func() {
+		err = newTestReferenceResolver(t, fs, client).resolve(context.Background())
+	})
+	if !errors.Is(err, flag.ErrHelp) {
+		t.Fatalf("expected usage error, got %v", err)
+	}
+	for _, want := range []string{`"Demo" matches multiple apps`, "111  com.example.one  Demo", "222  com.example.two  Demo"} {
+		if !strings.Contains(stderr, want) {
+			t.Fatalf("expected stderr to contain %q, got %q", want, stderr)
+		}
+	}
+}
+
+func TestResolveFlagReferences_NoCredentialsPassesThrough(t *testing.T) {
+	fs := newReferenceFlagSet()
+	if err := fs.Parse([]string{"--app", "APP_ID", "--build", "latest"}); err != nil {
+		t.Fatal(err)
+	}
+	resolver := newTestReferenceResolver(t, fs, nil)
+	resolver.newClient = func() (referenceClient, error) {
+		return nil, errors.New("missing authentication")
+	}
+	if err := resolver.resolve(context.Background()); err != nil {
+		t.Fatalf("resolve error: %v", err)
+	}
+	if got := fs.Lookup("app").Value.String(); got != "APP_ID" {
+		t.Fatalf("app = %q, want unchanged", got)
+	}
+	if got := fs.Lookup("build").Value.String(); got != "latest" {
+		t.Fatalf("build = %q, want unchanged", got)
+	}
+}
+
+func TestResolveFlagReferences_BuildLatestAndVersionNumber(t *testing.T) {
+	client := &fakeReferenceClient{
+		latestBuild: "build-latest",
+		preReleaseVersions: []asc.PreReleaseVersion{
+			{ID: "prv-ios", Attributes: asc.PreReleaseVersionAttributes{Version: "1.2.3", Platform: "IOS"}},
+		},
+		numberedBuilds: []asc.Resource[asc.BuildAttributes]{
+			{ID: "build-45", Attributes: asc.BuildAttributes{Version: "45"}},
+		},
+	}
+
+	tests := []struct {
+		build string
+		want  string
+	}{
+		{build: "latest", want: "build-latest"},
+		{build: "1.2.3(45)", want: "build-45"},
+		{build: "1.2.3 (45)", want: "build-45"},
+		{build: "BUILD_ID", want: "BUILD_ID"},
+	}
+	for _, test := range tests {
+		fs := newReferenceFlagSet()
+		if err := fs.Parse([]string{"--app", "123", "--build", test.build}); err != nil {
+			t.Fatal(err)
+		}
+		if err := newTestReferenceResolver(t, fs, client).resolve(context.Background()); err != nil {
+			t.Fatalf("resolve(%q) error: %v", test.build, err)
+		}
+		if got := fs.Lookup("build").Value.String(); got != test.want {
+			t.Fatalf("resolve(%q) build = %q, want %q", test.build, got, test.want)
+		}
+	}
+}
+
+func TestResolveFlagReferences_BuildRequiresApp(t *testing.T) {
+	fs := newReferenceFlagSet()
+	if err := fs.Parse([]string{"--build", "latest"}); err != nil {
+		t.Fatal(err)
+	}
+	var err error
+	stderr := captureStderr(t, func() {
+		err = newTestReferenceResolver(t, fs, &fakeReferenceClient{}).resolve(context.Background())
+	})
+	if !errors.Is(err, flag.ErrHelp) {
+		t.Fatalf("expected usage error, got %v", err)
+	}
+	if !strings.Contains(stderr, "--build latest requires --app") {
+		t.Fatalf("unexpected stderr %q", stderr)
+	}
+}
+
+func TestResolveFlagReferences_VersionAndGroups(t *testing.T) {
+	client := &fakeReferenceClient{
+		versions: []asc.Resource[asc.AppStoreVersionAttributes]{
+			{ID: "version-1", Attributes: asc.AppStoreVersionAttributes{VersionString: "2.0", Platform: "IOS"}},
+		},
+		groups: []asc.Resource[asc.BetaGroupAttributes]{
+			{ID: "aaaaaaaa-0000-0000-0000-000000000001", Attributes: asc.BetaGroupAttributes{Name: "Internal"}},
+			{ID: "aaaaaaaa-0000-0000-0000-000000000002", Attributes: asc.BetaGroupAttributes{Name: "External"}},
+		},
+	}
+	fs := newReferenceFlagSet()
+	if err := fs.Parse([]string{"--app", "123", "--version-id", "2.0", "--group", "internal, External"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := newTestReferenceResolver(t, fs, client).resolve(context.Background()); err != nil {
+		t.Fatalf("resolve error: %v", err)
+	}
+	if got := fs.Lookup("version-id").Value.String(); got != "version-1" {
+		t.Fatalf("version-id = %q, want version-1", got)
+	}
+	want := "aaaaaaaa-0000-0000-0000-000000000001,aaaaaaaa-0000-0000-0000-000000000002"
+	if got := fs.Lookup("group").Value.String(); got != want {
+		t.Fatalf("group = %q, want %q", got, want)
+	}
+}
+
+func TestFlagReferenceKindUsesMarkedFlags(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	appID := ReferenceFlag(fs, ReferenceApp, "default-app", "App ID (required)")
+	fs.String("build", "", "Build ID")
+	if got := FlagReferenceKind(fs.Lookup("app")); got != ReferenceApp {
+		t.Fatalf("expected marked --app to be an app reference, got %q", got)
+	}
+	if got := FlagReferenceKind(fs.Lookup("build")); got != "" {
+		t.Fatalf("expected unmarked --build to be ignored, got %q", got)
+	}
+	if err := fs.Parse([]string{"--app", "com.example.app"}); err != nil {
+		t.Fatal(err)
+	}
+	if *appID != "com.example.app" || fs.Lookup("app").DefValue != "default-app" {
+		t.Fatalf("unexpected flag value %q (default %q)", *appID, fs.Lookup("app").DefValue)
+	}
+}
+
+func TestResolveFlagReferences_IgnoresUnrelatedFlags(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.String("app", "", "Comma-separated app IDs")
+	fs.String("version-id", "", "Game Center leaderboard version ID")
+	if err := fs.Parse([]string{"--app", "a,b", "--version-id", "1.0"}); err != nil {
+		t.Fatal(err)
+	}
+	resolver := newTestReferenceResolver(t, fs, nil)
+	resolver.newClient = func() (referenceClient, error) {
+		t.Fatal("unexpected API client")
+		return nil, nil
+	}
+	if err := resolver.resolve(context.Background()); err != nil {
+		t.Fatalf("resolve error: %v", err)
+	}
+}
+
+func TestResolveAppID_ResolvesDefaultAppReferenceOnce(t *testing.T) {
+	client := &fakeReferenceClient{apps: []asc.Resource[asc.AppAttributes]{
+		testApp("111", "com.example.myapp", "My App"),
+	}}
+	resolver := newTestReferenceResolver(t, flag.NewFlagSet("test", flag.ContinueOnError), client)
+	t.Cleanup(func() { setDefaultAppResolver(context.Background(), nil) })
+
+	t.Setenv("ASC_APP_ID", "com.example.myapp")
+	if got := ResolveAppID(""); got != "com.example.myapp" {
+		t.Fatalf("ResolveAppID without a resolver = %q, want the raw value", got)
+	}
+
+	setDefaultAppResolver(context.Background(), resolver)
+	for range 2 {
+		if got := ResolveAppID(""); got != "111" {
+			t.Fatalf("ResolveAppID = %q, want 111", got)
+		}
+	}
+	if client.appsCalls != 1 {
+		t.Fatalf("expected 1 apps lookup, got %d", client.appsCalls)
+	}
+	if got := ResolveAppID("999"); got != "999" {
+		t.Fatalf("ResolveAppID(999) = %q, want the explicit value", got)
+	}
+
+	t.Setenv("ASC_APP_ID", "222")
+	if got := ResolveAppID(""); got != "222" || client.appsCalls != 1 {
+		t.Fatalf("expected numeric ASC_APP_ID without lookups, got %q after %d calls", got, client.appsCalls)
+	}
+}
@@ -752,6 +752,11 @@ func resolveAppID(appID string) string {
 	if appID != "" {
 		return appID
 	}
+	return resolveDefaultApp(defaultAppValue())
+}
+
+// defaultAppValue returns ASC_APP_ID, or else the config app_id, as written.
+func defaultAppValue() string {
 	if env, ok := os.LookupEnv("ASC_APP_ID"); ok {
 		return strings.TrimSpace(env)
 	}
@@ -813,7 +818,15 @@ func validateNextURL(next string) error {
 	if err != nil {
 		return fmt.Errorf("--next must be a valid URL: %w", err)
 	}
-	if parsed.Scheme != "https" || parsed.Host != "api.appstoreconnect.apple.com" {
+	baseURL, err := asc.ResolveBaseURL()
+	if err != nil {
+		return err
+	}
+	base, err := url.Parse(baseURL)
+	if err != nil {
+		return fmt.Errorf("invalid base URL: %w", err)
+	}
+	if parsed.Scheme != base.Scheme || parsed.Host != base.Host {
 		return fmt.Errorf("--next must be an App Store Connect URL")
 	}
 	return nil
@@ -0,0 +1,189 @@
+package status
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	tuiDefaultInterval = 30 * time.Second
+	// tuiMinInterval keeps auto-refresh well inside the API rate limit; each
+	// dashboard refresh issues several requests.
+	tuiMinInterval = 10 * time.Second
+)
+
+// TUICommand returns the interactive terminal dashboard command.
+func TUICommand() *ffcli.Command {
+	fs := flag.NewFlagSet("tui", flag.ExitOnError)
+
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name to open first (or ASC_APP_ID env)")
+	appIDs := fs.String("apps", "", "Comma-separated app IDs to switch between (default: all apps)")
+	interval := fs.Duration("interval", tuiDefaultInterval, "Auto-refresh interval (minimum 10s)")
+
+	return &ffcli.Command{
+		Name:       "tui",
+		ShortUsage: "asc tui [flags]",
+		ShortHelp:  "Open an interactive terminal dashboard for your apps.",
+		LongHelp: `Open an interactive terminal dashboard for your apps.
+
+Shows the same release pipeline as "asc status" in a full-screen view that
+refreshes automatically, with drill-down views for builds, testers, reviews
+and crashes. Common actions run behind a confirmation prompt:
+
+  g  add the selected build to a beta group (Builds view)
+  s  submit the selected build for App Store review (Builds view)
+  p  pause or resume the phased release
+
+Press ? inside the dashboard for all keys and q to quit.
+
+Examples:
+  asc tui
+  asc tui --app "123456789"
+  asc tui --apps "123456789,987654321" --interval 1m`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if len(args) > 0 {
+				fmt.Fprintln(os.Stderr, "Error: tui does not accept positional arguments")
+				return flag.ErrHelp
+			}
+			if *interval < tuiMinInterval {
+				return shared.UsageErrorf("--interval must be at least %s", tuiMinInterval)
+			}
+			if !isTUITerminal(os.Stdin, os.Stdout) {
+				return fmt.Errorf("tui: %w (use \"asc status\" for scripts)", errTUINotTerminal)
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("tui: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			apps, err := loadTUIApps(requestCtx, client, shared.SplitCSV(*appIDs))
+			cancel()
+			if err != nil {
+				return fmt.Errorf("tui: failed to list apps: %w", err)
+			}
+
+			initialAppID := shared.ResolveAppID(*appID)
+			if initialAppID != "" && !containsTUIApp(apps, initialAppID) {
+				apps = append([]tuiApp{{ID: initialAppID, Name: initialAppID}}, apps...)
+			}
+			if len(apps) == 0 {
+				return fmt.Errorf("tui: no apps found for this API key")
+			}
+
+			screen, err := openTUITerminal(os.Stdin, os.Stdout)
+			if err != nil {
+				return fmt.Errorf("tui: %w", err)
+			}
+			defer screen.Close()
+
+			return runTUI(ctx, screen, &clientTUIBackend{client: client}, newTUIModel(apps, initialAppID), *interval)
+		},
+	}
+}
+
+func containsTUIApp(apps []tuiApp, appID string) bool {
+	for _, app := range apps {
+		if app.ID == appID {
+			return true
+		}
+	}
+	return false
+}
+
+// tuiResult applies a finished API call to the model on the event loop.
+type tuiResult func(m *tuiModel, now time.Time) tuiRequest
+
+// runTUI is the event loop. API calls run in goroutines and report back
+// through results, so the screen stays responsive while requests are in
+// flight; the model itself is only touched here.
+func runTUI(ctx context.Context, screen *tuiTerminal, backend tuiBackend, model *tuiModel, interval time.Duration) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	keys := make(chan string, 16)
+	go readTUIKeys(ctx, screen.in, keys)
+
+	results := make(chan tuiResult, 4)
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	pending := model.load(time.Now())
+	for {
+		switch pending.Kind {
+		case tuiRequestQuit:
+			return nil
+		case tuiRequestNone:
+		default:
+			go func(req tuiRequest) {
+				result := executeTUIRequest(ctx, backend, req)
+				select {
+				case results <- result:
+				case <-ctx.Done():
+				}
+			}(pending)
+		}
+
+		width, height := screen.Size()
+		screen.Draw(model.render(width, height, time.Now()))
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case key, ok := <-keys:
+			if !ok {
+				return nil
+			}
+			pending = model.handleKey(key, time.Now())
+		case apply := <-results:
+			pending = apply(model, time.Now())
+		case now := <-ticker.C:
+			pending = model.tick(now, interval)
+		}
+	}
+}
+
+// executeTUIRequest performs the API work for req and returns how to apply
+// the outcome to the model.
+func executeTUIRequest(ctx context.Context, backend tuiBackend, req tuiRequest) tuiResult {
+	requestCtx, cancel := shared.ContextWithTimeout(ctx)
+	defer cancel()
+
+	switch req.Kind {
+	case tuiRequestLoad:
+		loaded := tuiLoaded{AppID: req.AppID, View: req.View}
+		if req.View == tuiViewDashboard {
+			loaded.Dashboard, loaded.Err = backend.Dashboard(requestCtx, req.AppID)
+		} else {
+			loaded.Columns, loaded.Rows, loaded.Err = backend.List(requestCtx, req.View, req.AppID)
+		}
+		loaded.At = time.Now()
+		return func(m *tuiModel, _ time.Time) tuiRequest {
+			m.applyLoaded(loaded)
+			return tuiRequest{}
+		}
+	case tuiRequestLoadGroups:
+		rows, err := backend.BetaGroups(requestCtx, req.AppID)
+		return func(m *tuiModel, _ time.Time) tuiRequest {
+			m.applyGroups(req.Build, rows, err)
+			return tuiRequest{}
+		}
+	case tuiRequestRun:
+		message, err := backend.Run(requestCtx, req.Action)
+		return func(m *tuiModel, now time.Time) tuiRequest {
+			return m.applyActionDone(message, err, now)
+		}
+	default:
+		return func(*tuiModel, time.Time) tuiRequest { return tuiRequest{} }
+	}
+}
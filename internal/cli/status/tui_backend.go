@@ -0,0 +1,189 @@
+package status
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// tuiListLimit bounds each drill-down view to a single API page.
+const tuiListLimit = 50
+
+// tuiBackend is the App Store Connect surface used by the TUI.
+type tuiBackend interface {
+	Dashboard(ctx context.Context, appID string) (*dashboardResponse, error)
+	List(ctx context.Context, view tuiView, appID string) ([]string, []tuiRow, error)
+	BetaGroups(ctx context.Context, appID string) ([]tuiRow, error)
+	Run(ctx context.Context, action tuiAction) (string, error)
+}
+
+type clientTUIBackend struct {
+	client *asc.Client
+}
+
+func (b *clientTUIBackend) Dashboard(ctx context.Context, appID string) (*dashboardResponse, error) {
+	includes, err := parseInclude("")
+	if err != nil {
+		return nil, err
+	}
+	includes.links = false
+	return collectDashboard(ctx, b.client, appID, includes)
+}
+
+func (b *clientTUIBackend) List(ctx context.Context, view tuiView, appID string) ([]string, []tuiRow, error) {
+	switch view {
+	case tuiViewBuilds:
+		resp, err := b.client.GetBuilds(ctx, appID, asc.WithBuildsSort("-uploadedDate"), asc.WithBuildsLimit(tuiListLimit))
+		if err != nil {
+			return nil, nil, err
+		}
+		rows := make([]tuiRow, 0, len(resp.Data))
+		for _, build := range resp.Data {
+			attrs := build.Attributes
+			expired := ""
+			if attrs.Expired {
+				expired = "expired"
+			}
+			rows = append(rows, tuiRow{
+				ID:    build.ID,
+				Label: "build " + shared.OrNA(attrs.Version),
+				Cells: []string{shared.OrNA(attrs.Version), prefixedState(attrs.ProcessingState), formatDateWithRelative(attrs.UploadedDate), expired, build.ID},
+			})
+		}
+		return []string{"BUILD", "STATE", "UPLOADED", "EXPIRED", "ID"}, rows, nil
+	case tuiViewTesters:
+		resp, err := b.client.GetBetaTesters(ctx, appID, asc.WithBetaTestersLimit(tuiListLimit))
+		if err != nil {
+			return nil, nil, err
+		}
+		rows := make([]tuiRow, 0, len(resp.Data))
+		for _, tester := range resp.Data {
+			attrs := tester.Attributes
+			name := strings.TrimSpace(attrs.FirstName + " " + attrs.LastName)
+			rows = append(rows, tuiRow{
+				ID:    tester.ID,
+				Label: attrs.Email,
+				Cells: []string{shared.OrNA(name), shared.OrNA(attrs.Email), prefixedState(string(attrs.State)), shared.OrNA(string(attrs.InviteType))},
+			})
+		}
+		return []string{"NAME", "EMAIL", "STATE", "INVITE"}, rows, nil
+	case tuiViewReviews:
+		resp, err := b.client.GetReviews(ctx, appID, asc.WithReviewSort("-createdDate"), asc.WithLimit(tuiListLimit))
+		if err != nil {
+			return nil, nil, err
+		}
+		rows := make([]tuiRow, 0, len(resp.Data))
+		for _, review := range resp.Data {
+			attrs := review.Attributes
+			rows = append(rows, tuiRow{
+				ID:    review.ID,
+				Label: attrs.Title,
+				Cells: []string{tuiStars(attrs.Rating), shared.OrNA(attrs.Title), shared.OrNA(attrs.Territory), formatDateWithRelative(attrs.CreatedDate)},
+			})
+		}
+		return []string{"RATING", "TITLE", "TERRITORY", "DATE"}, rows, nil
+	case tuiViewCrashes:
+		resp, err := b.client.GetCrashes(ctx, appID, asc.WithCrashSort("-createdDate"), asc.WithCrashLimit(tuiListLimit))
+		if err != nil {
+			return nil, nil, err
+		}
+		rows := make([]tuiRow, 0, len(resp.Data))
+		for _, crash := range resp.Data {
+			attrs := crash.Attributes
+			rows = append(rows, tuiRow{
+				ID:    crash.ID,
+				Label: crash.ID,
+				Cells: []string{formatDateWithRelative(attrs.CreatedDate), shared.OrNA(attrs.DeviceModel), shared.OrNA(attrs.OSVersion), shared.OrNA(attrs.Comment)},
+			})
+		}
+		return []string{"DATE", "DEVICE", "OS", "COMMENT"}, rows, nil
+	default:
+		return nil, nil, fmt.Errorf("unsupported view %d", view)
+	}
+}
+
+func (b *clientTUIBackend) BetaGroups(ctx context.Context, appID string) ([]tuiRow, error) {
+	groups, err := shared.ListAllBetaGroups(ctx, b.client, appID)
+	if err != nil {
+		return nil, err
+	}
+	rows := make([]tuiRow, 0, len(groups.Data))
+	for _, group := range groups.Data {
+		kind := "external"
+		if group.Attributes.IsInternalGroup {
+			kind = "internal"
+		}
+		rows = append(rows, tuiRow{ID: group.ID, Label: group.Attributes.Name, Cells: []string{group.Attributes.Name, kind}})
+	}
+	return rows, nil
+}
+
+func (b *clientTUIBackend) Run(ctx context.Context, action tuiAction) (string, error) {
+	switch action.Kind {
+	case tuiActionAddBuildToGroup:
+		if err := b.client.AddBetaGroupsToBuild(ctx, action.BuildID, []string{action.GroupID}); err != nil {
+			return "", fmt.Errorf("failed to add build to group: %w", err)
+		}
+		return fmt.Sprintf("Added build %s to group %s", action.BuildID, action.GroupID), nil
+	case tuiActionSubmitForReview:
+		if err := b.client.AttachBuildToVersion(ctx, action.VersionID, action.BuildID); err != nil {
+			return "", fmt.Errorf("failed to attach build: %w", err)
+		}
+		submission, err := b.client.CreateReviewSubmission(ctx, action.AppID, asc.Platform(action.Platform))
+		if err != nil {
+			return "", fmt.Errorf("failed to create review submission: %w", err)
+		}
+		if _, err := b.client.AddReviewSubmissionItem(ctx, submission.Data.ID, action.VersionID); err != nil {
+			return "", fmt.Errorf("failed to add version to submission: %w", err)
+		}
+		if _, err := b.client.SubmitReviewSubmission(ctx, submission.Data.ID); err != nil {
+			return "", fmt.Errorf("failed to submit for review: %w", err)
+		}
+		return fmt.Sprintf("Submitted for review (submission %s)", submission.Data.ID), nil
+	case tuiActionPausePhasedRelease, tuiActionResumePhasedRelease:
+		state := asc.PhasedReleaseStatePaused
+		if action.Kind == tuiActionResumePhasedRelease {
+			state = asc.PhasedReleaseStateActive
+		}
+		if _, err := b.client.UpdateAppStoreVersionPhasedRelease(ctx, action.PhasedReleaseID, state); err != nil {
+			return "", fmt.Errorf("failed to update phased release: %w", err)
+		}
+		return fmt.Sprintf("Phased release is now %s", state), nil
+	default:
+		return "", fmt.Errorf("unsupported action %d", action.Kind)
+	}
+}
+
+// loadTUIApps returns the apps the TUI can switch between: the explicit
+// --apps list, or every app visible to the API key.
+func loadTUIApps(ctx context.Context, client *asc.Client, appIDs []string) ([]tuiApp, error) {
+	if len(appIDs) > 0 {
+		apps := make([]tuiApp, 0, len(appIDs))
+		for _, id := range appIDs {
+			name := id
+			if resp, err := client.GetApp(ctx, id); err == nil && resp.Data.Attributes.Name != "" {
+				name = resp.Data.Attributes.Name
+			}
+			apps = append(apps, tuiApp{ID: id, Name: name})
+		}
+		return apps, nil
+	}
+
+	resp, err := client.GetApps(ctx, asc.WithAppsLimit(200))
+	if err != nil {
+		return nil, err
+	}
+	apps := make([]tuiApp, 0, len(resp.Data))
+	for _, app := range resp.Data {
+		apps = append(apps, tuiApp{ID: app.ID, Name: app.Attributes.Name})
+	}
+	return apps, nil
+}
+
+func tuiStars(rating int) string {
+	rating = min(max(rating, 0), 5)
+	return strings.Repeat("*", rating) + strings.Repeat(".", 5-rating)
+}
@@ -0,0 +1,421 @@
+package status
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+type tuiView int
+
+const (
+	tuiViewDashboard tuiView = iota
+	tuiViewBuilds
+	tuiViewTesters
+	tuiViewReviews
+	tuiViewCrashes
+)
+
+var tuiViewNames = []string{"Dashboard", "Builds", "Testers", "Reviews", "Crashes"}
+
+// tuiMinManualRefresh throttles the refresh key so a held-down "r" can't
+// burn through the API rate limit.
+const tuiMinManualRefresh = 5 * time.Second
+
+type tuiApp struct {
+	ID   string
+	Name string
+}
+
+type tuiRow struct {
+	ID    string
+	Label string
+	Cells []string
+}
+
+type tuiActionKind int
+
+const (
+	tuiActionAddBuildToGroup tuiActionKind = iota + 1
+	tuiActionSubmitForReview
+	tuiActionPausePhasedRelease
+	tuiActionResumePhasedRelease
+)
+
+// tuiAction is a mutating request that runs only after confirmation.
+type tuiAction struct {
+	Kind            tuiActionKind
+	AppID           string
+	BuildID         string
+	GroupID         string
+	VersionID       string
+	Platform        string
+	PhasedReleaseID string
+	Prompt          string
+}
+
+type tuiRequestKind int
+
+const (
+	tuiRequestNone tuiRequestKind = iota
+	tuiRequestQuit
+	tuiRequestLoad
+	tuiRequestLoadGroups
+	tuiRequestRun
+)
+
+// tuiRequest tells the event loop which API work a key press or tick needs.
+type tuiRequest struct {
+	Kind   tuiRequestKind
+	AppID  string
+	View   tuiView
+	Build  tuiRow
+	Action tuiAction
+}
+
+type tuiPickerKind int
+
+const (
+	tuiPickApp tuiPickerKind = iota
+	tuiPickGroup
+)
+
+type tuiPicker struct {
+	kind     tuiPickerKind
+	title    string
+	rows     []tuiRow
+	selected int
+	build    tuiRow
+}
+
+// tuiLoaded carries the result of a view load back to the model.
+type tuiLoaded struct {
+	AppID     string
+	View      tuiView
+	Dashboard *dashboardResponse
+	Columns   []string
+	Rows      []tuiRow
+	Err       error
+	At        time.Time
+}
+
+// tuiModel is the TUI state. It performs no I/O: key presses and results
+// go in, render state and tuiRequests come out.
+type tuiModel struct {
+	apps      []tuiApp
+	appIndex  int
+	view      tuiView
+	dashboard *dashboardResponse
+	columns   []string
+	rows      []tuiRow
+	selected  int
+	picker    *tuiPicker
+	confirm   *tuiAction
+	showHelp  bool
+	loading   bool
+	running   bool
+	message   string
+	errText   string
+	lastLoad  time.Time
+	lastFetch time.Time
+}
+
+func newTUIModel(apps []tuiApp, initialAppID string) *tuiModel {
+	m := &tuiModel{apps: apps}
+	for i, app := range apps {
+		if app.ID == initialAppID {
+			m.appIndex = i
+			break
+		}
+	}
+	return m
+}
+
+func (m *tuiModel) currentApp() tuiApp {
+	if len(m.apps) == 0 {
+		return tuiApp{}
+	}
+	return m.apps[m.appIndex]
+}
+
+// load marks the current view as loading and returns the request for it.
+func (m *tuiModel) load(now time.Time) tuiRequest {
+	m.loading = true
+	m.lastFetch = now
+	return tuiRequest{Kind: tuiRequestLoad, AppID: m.currentApp().ID, View: m.view}
+}
+
+func (m *tuiModel) switchView(view tuiView, now time.Time) tuiRequest {
+	if view == m.view {
+		return tuiRequest{}
+	}
+	m.view = view
+	m.columns = nil
+	m.rows = nil
+	m.selected = 0
+	m.errText = ""
+	return m.load(now)
+}
+
+func (m *tuiModel) switchApp(index int, now time.Time) tuiRequest {
+	if len(m.apps) == 0 {
+		return tuiRequest{}
+	}
+	index = (index%len(m.apps) + len(m.apps)) % len(m.apps)
+	if index == m.appIndex {
+		return tuiRequest{}
+	}
+	m.appIndex = index
+	m.dashboard = nil
+	m.columns = nil
+	m.rows = nil
+	m.selected = 0
+	m.errText = ""
+	m.message = ""
+	return m.load(now)
+}
+
+// tick returns a load request once the polling interval has elapsed since
+// the last fetch, and never while a fetch is still in flight.
+func (m *tuiModel) tick(now time.Time, interval time.Duration) tuiRequest {
+	if m.loading || m.running || m.confirm != nil || m.picker != nil {
+		return tuiRequest{}
+	}
+	if now.Sub(m.lastFetch) < interval {
+		return tuiRequest{}
+	}
+	return m.load(now)
+}
+
+func (m *tuiModel) applyLoaded(result tuiLoaded) {
+	if result.AppID != m.currentApp().ID || result.View != m.view {
+		// A stale response for a view or app the user already left.
+		return
+	}
+	m.loading = false
+	if result.Err != nil {
+		m.errText = result.Err.Error()
+		return
+	}
+	m.errText = ""
+	m.lastLoad = result.At
+	if result.View == tuiViewDashboard {
+		m.dashboard = result.Dashboard
+		return
+	}
+	m.columns = result.Columns
+	m.rows = result.Rows
+	if m.selected >= len(m.rows) {
+		m.selected = max(len(m.rows)-1, 0)
+	}
+}
+
+func (m *tuiModel) applyGroups(build tuiRow, rows []tuiRow, err error) {
+	m.running = false
+	if err != nil {
+		m.errText = err.Error()
+		return
+	}
+	if len(rows) == 0 {
+		m.message = "No beta groups found for this app"
+		return
+	}
+	m.picker = &tuiPicker{
+		kind:  tuiPickGroup,
+		title: fmt.Sprintf("Add %s to beta group", build.Label),
+		rows:  rows,
+		build: build,
+	}
+}
+
+// applyActionDone records an action result. Successful actions refresh the
+// current view so the change is visible.
+func (m *tuiModel) applyActionDone(message string, err error, now time.Time) tuiRequest {
+	m.running = false
+	if err != nil {
+		m.errText = err.Error()
+		m.message = ""
+		return tuiRequest{}
+	}
+	m.errText = ""
+	m.message = message
+	return m.load(now)
+}
+
+func (m *tuiModel) handleKey(key string, now time.Time) tuiRequest {
+	if key == "ctrl+c" {
+		return tuiRequest{Kind: tuiRequestQuit}
+	}
+	if m.showHelp {
+		m.showHelp = false
+		return tuiRequest{}
+	}
+	if m.confirm != nil {
+		return m.handleConfirmKey(key)
+	}
+	if m.picker != nil {
+		return m.handlePickerKey(key, now)
+	}
+
+	switch key {
+	case "q":
+		return tuiRequest{Kind: tuiRequestQuit}
+	case "?":
+		m.showHelp = true
+	case "1", "2", "3", "4", "5":
+		return m.switchView(tuiView(key[0]-'1'), now)
+	case "tab", "right", "l":
+		return m.switchView((m.view+1)%tuiView(len(tuiViewNames)), now)
+	case "backtab", "left", "h":
+		return m.switchView((m.view+tuiView(len(tuiViewNames))-1)%tuiView(len(tuiViewNames)), now)
+	case "down", "j":
+		if m.selected < len(m.rows)-1 {
+			m.selected++
+		}
+	case "up", "k":
+		if m.selected > 0 {
+			m.selected--
+		}
+	case "]":
+		return m.switchApp(m.appIndex+1, now)
+	case "[":
+		return m.switchApp(m.appIndex-1, now)
+	case "a":
+		m.openAppPicker()
+	case "r":
+		if m.loading {
+			return tuiRequest{}
+		}
+		if wait := tuiMinManualRefresh - now.Sub(m.lastFetch); wait > 0 {
+			m.message = fmt.Sprintf("Refresh throttled; try again in %ds", int(wait.Round(time.Second)/time.Second))
+			return tuiRequest{}
+		}
+		m.message = ""
+		return m.load(now)
+	case "g":
+		return m.startAddToGroup()
+	case "s":
+		m.startSubmit()
+	case "p":
+		m.startPhasedReleaseToggle()
+	}
+	return tuiRequest{}
+}
+
+func (m *tuiModel) handleConfirmKey(key string) tuiRequest {
+	switch key {
+	case "y", "Y":
+		action := *m.confirm
+		m.confirm = nil
+		m.running = true
+		m.message = "Working..."
+		return tuiRequest{Kind: tuiRequestRun, AppID: action.AppID, Action: action}
+	case "n", "N", "esc", "q":
+		m.confirm = nil
+		m.message = "Cancelled"
+	}
+	return tuiRequest{}
+}
+
+func (m *tuiModel) handlePickerKey(key string, now time.Time) tuiRequest {
+	picker := m.picker
+	switch key {
+	case "down", "j":
+		if picker.selected < len(picker.rows)-1 {
+			picker.selected++
+		}
+	case "up", "k":
+		if picker.selected > 0 {
+			picker.selected--
+		}
+	case "esc", "q":
+		m.picker = nil
+	case "enter":
+		m.picker = nil
+		choice := picker.rows[picker.selected]
+		if picker.kind == tuiPickApp {
+			return m.switchApp(picker.selected, now)
+		}
+		m.confirm = &tuiAction{
+			Kind:    tuiActionAddBuildToGroup,
+			AppID:   m.currentApp().ID,
+			BuildID: picker.build.ID,
+			GroupID: choice.ID,
+			Prompt:  fmt.Sprintf("Add %s to beta group %q?", picker.build.Label, choice.Label),
+		}
+	}
+	return tuiRequest{}
+}
+
+func (m *tuiModel) openAppPicker() {
+	if len(m.apps) < 2 {
+		m.message = "Only one app available"
+		return
+	}
+	rows := make([]tuiRow, 0, len(m.apps))
+	for _, app := range m.apps {
+		rows = append(rows, tuiRow{ID: app.ID, Label: app.Name, Cells: []string{app.Name, app.ID}})
+	}
+	m.picker = &tuiPicker{kind: tuiPickApp, title: "Switch app", rows: rows, selected: m.appIndex}
+}
+
+func (m *tuiModel) selectedBuild() (tuiRow, bool) {
+	if m.view != tuiViewBuilds || len(m.rows) == 0 {
+		m.message = "Select a build in the Builds view (2) first"
+		return tuiRow{}, false
+	}
+	return m.rows[m.selected], true
+}
+
+func (m *tuiModel) startAddToGroup() tuiRequest {
+	build, ok := m.selectedBuild()
+	if !ok || m.running {
+		return tuiRequest{}
+	}
+	m.running = true
+	m.message = "Loading beta groups..."
+	return tuiRequest{Kind: tuiRequestLoadGroups, AppID: m.currentApp().ID, Build: build}
+}
+
+func (m *tuiModel) startSubmit() {
+	build, ok := m.selectedBuild()
+	if !ok {
+		return
+	}
+	if m.dashboard == nil || m.dashboard.AppStore == nil || strings.TrimSpace(m.dashboard.AppStore.VersionID) == "" {
+		m.message = "No App Store version found; open the Dashboard (1) to load it"
+		return
+	}
+	version := m.dashboard.AppStore
+	m.confirm = &tuiAction{
+		Kind:      tuiActionSubmitForReview,
+		AppID:     m.currentApp().ID,
+		BuildID:   build.ID,
+		VersionID: version.VersionID,
+		Platform:  version.Platform,
+		Prompt:    fmt.Sprintf("Attach %s to version %s (%s) and submit for App Store review?", build.Label, shared.OrNA(version.Version), shared.OrNA(version.Platform)),
+	}
+}
+
+func (m *tuiModel) startPhasedReleaseToggle() {
+	if m.dashboard == nil || m.dashboard.PhasedRelease == nil || !m.dashboard.PhasedRelease.Configured || m.dashboard.PhasedRelease.ID == "" {
+		m.message = "No phased release configured"
+		return
+	}
+	phased := m.dashboard.PhasedRelease
+	action := tuiAction{AppID: m.currentApp().ID, PhasedReleaseID: phased.ID}
+	switch strings.ToUpper(phased.State) {
+	case "ACTIVE":
+		action.Kind = tuiActionPausePhasedRelease
+		action.Prompt = "Pause the phased release?"
+	case "PAUSED":
+		action.Kind = tuiActionResumePhasedRelease
+		action.Prompt = "Resume the phased release?"
+	default:
+		m.message = fmt.Sprintf("Phased release is %s; only ACTIVE or PAUSED releases can be toggled", shared.OrNA(phased.State))
+		return
+	}
+	m.confirm = &action
+}
@@ -0,0 +1,277 @@
+package status
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	ansiReset   = "\x1b[0m"
+	ansiBold    = "\x1b[1m"
+	ansiReverse = "\x1b[7m"
+	ansiRed     = "\x1b[31m"
+	ansiDim     = "\x1b[2m"
+
+	tuiMaxColumnWidth = 40
+)
+
+var tuiHelpLines = []string{
+	"1-5 / tab      switch view (Dashboard, Builds, Testers, Reviews, Crashes)",
+	"j/k / arrows   move selection",
+	"[ / ]          previous / next app",
+	"a              pick app",
+	"r              refresh now",
+	"g              add selected build to a beta group (Builds)",
+	"s              submit selected build for App Store review (Builds)",
+	"p              pause or resume the phased release",
+	"?              toggle this help",
+	"q              quit",
+}
+
+// render draws the full screen as exactly height lines, each at most width
+// columns wide.
+func (m *tuiModel) render(width, height int, now time.Time) []string {
+	width = max(width, 20)
+	height = max(height, 8)
+
+	lines := []string{
+		styled(ansiBold, fit(m.headerText(now), width)),
+		m.tabsLine(width),
+		fit(strings.Repeat("-", width), width),
+	}
+
+	bodyHeight := height - len(lines) - 2
+	var body []string
+	switch {
+	case m.showHelp:
+		body = m.helpBody(width)
+	case m.confirm != nil:
+		body = m.confirmBody(width)
+	case m.picker != nil:
+		body = m.pickerBody(width, bodyHeight)
+	case m.view == tuiViewDashboard:
+		body = m.dashboardBody(width)
+	default:
+		body = m.tableBody(width, bodyHeight)
+	}
+	for len(body) < bodyHeight {
+		body = append(body, "")
+	}
+	lines = append(lines, body[:bodyHeight]...)
+
+	status := m.message
+	if m.errText != "" {
+		lines = append(lines, styled(ansiRed, fit("Error: "+shared.SanitizeTerminal(m.errText), width)))
+	} else {
+		lines = append(lines, fit(status, width))
+	}
+	lines = append(lines, styled(ansiDim, fit(m.hintText(), width)))
+	return lines
+}
+
+func (m *tuiModel) headerText(now time.Time) string {
+	app := m.currentApp()
+	header := fmt.Sprintf(" asc tui  %s (%s)", shared.SanitizeTerminal(app.Name), app.ID)
+	if len(m.apps) > 1 {
+		header += fmt.Sprintf("  [%d/%d]", m.appIndex+1, len(m.apps))
+	}
+	switch {
+	case m.loading:
+		header += "  loading..."
+	case !m.lastLoad.IsZero():
+		header += "  updated " + relativeTimeText(m.lastLoad, now)
+	}
+	return header
+}
+
+func (m *tuiModel) tabsLine(width int) string {
+	var b strings.Builder
+	used := 0
+	for i, name := range tuiViewNames {
+		tab := fmt.Sprintf(" %d %s ", i+1, name)
+		if used+utf8.RuneCountInString(tab) > width {
+			break
+		}
+		used += utf8.RuneCountInString(tab)
+		if tuiView(i) == m.view {
+			b.WriteString(styled(ansiReverse, tab))
+		} else {
+			b.WriteString(tab)
+		}
+	}
+	return b.String()
+}
+
+func (m *tuiModel) hintText() string {
+	switch {
+	case m.showHelp:
+		return " any key: close help"
+	case m.confirm != nil:
+		return " y: confirm  n: cancel"
+	case m.picker != nil:
+		return " j/k: move  enter: select  esc: cancel"
+	case m.view == tuiViewBuilds:
+		return " g: add to group  s: submit  r: refresh  [ ]: app  ?: help  q: quit"
+	case m.view == tuiViewDashboard:
+		return " p: pause/resume phased release  r: refresh  [ ]: app  ?: help  q: quit"
+	default:
+		return " j/k: move  r: refresh  [ ]: app  ?: help  q: quit"
+	}
+}
+
+func (m *tuiModel) helpBody(width int) []string {
+	lines := []string{styled(ansiBold, fit(" Keys", width)), ""}
+	for _, line := range tuiHelpLines {
+		lines = append(lines, fit("  "+line, width))
+	}
+	return lines
+}
+
+func (m *tuiModel) confirmBody(width int) []string {
+	return []string{
+		"",
+		styled(ansiBold, fit(" Confirm", width)),
+		"",
+		fit("  "+shared.SanitizeTerminal(m.confirm.Prompt), width),
+		"",
+		fit("  [y] yes   [n] no", width),
+	}
+}
+
+func (m *tuiModel) pickerBody(width, height int) []string {
+	lines := []string{styled(ansiBold, fit(" "+shared.SanitizeTerminal(m.picker.title), width)), ""}
+	rows := make([][]string, 0, len(m.picker.rows))
+	for _, row := range m.picker.rows {
+		rows = append(rows, row.Cells)
+	}
+	return append(lines, tableLines(nil, rows, m.picker.selected, width, height-len(lines))...)
+}
+
+func (m *tuiModel) tableBody(width, height int) []string {
+	if len(m.rows) == 0 {
+		if m.loading {
+			return []string{fit(" Loading "+tuiViewNames[m.view]+"...", width)}
+		}
+		return []string{fit(" No "+strings.ToLower(tuiViewNames[m.view])+" found.", width)}
+	}
+	rows := make([][]string, 0, len(m.rows))
+	for _, row := range m.rows {
+		rows = append(rows, row.Cells)
+	}
+	return tableLines(m.columns, rows, m.selected, width, height)
+}
+
+func (m *tuiModel) dashboardBody(width int) []string {
+	resp := m.dashboard
+	if resp == nil {
+		return []string{fit(" Loading dashboard...", width)}
+	}
+	summary := resp.Summary
+	field := func(name, value string) string {
+		return fit(fmt.Sprintf("  %-16s %s", name, shared.SanitizeTerminal(value)), width)
+	}
+
+	lines := []string{
+		field("Health", fmt.Sprintf("%s %s", healthSymbol(summary.Health), shared.OrNA(summary.Health))),
+		field("Next action", shared.OrNA(summary.NextAction)),
+	}
+	for _, blocker := range summary.Blockers {
+		lines = append(lines, styled(ansiRed, field("", "[x] "+blocker)))
+	}
+	lines = append(lines, "")
+
+	if resp.Builds != nil {
+		latest := "[-] none"
+		if build := resp.Builds.Latest; build != nil {
+			latest = fmt.Sprintf("%s (%s) %s, uploaded %s", shared.OrNA(build.Version), shared.OrNA(build.BuildNumber), prefixedState(build.ProcessingState), formatDateWithRelative(build.UploadedDate))
+		}
+		lines = append(lines, field("Latest build", latest))
+	}
+	if resp.TestFlight != nil {
+		lines = append(lines,
+			field("Beta review", prefixedState(resp.TestFlight.BetaReviewState)),
+			field("External build", prefixedState(resp.TestFlight.ExternalBuildState)),
+		)
+	}
+	if resp.AppStore != nil {
+		lines = append(lines, field("App Store", fmt.Sprintf("%s %s %s", shared.OrNA(resp.AppStore.Version), shared.OrNA(resp.AppStore.Platform), prefixedState(resp.AppStore.State))))
+	}
+	if resp.Review != nil {
+		lines = append(lines, field("Review", fmt.Sprintf("%s, submitted %s", prefixedState(resp.Review.State), formatDateWithRelative(resp.Review.SubmittedDate))))
+	}
+	if resp.PhasedRelease != nil {
+		phased := phasedReleaseProgressBar(resp.PhasedRelease)
+		if resp.PhasedRelease.Configured {
+			phased = prefixedState(resp.PhasedRelease.State) + " " + phased
+		}
+		lines = append(lines, field("Phased release", phased))
+	}
+	return lines
+}
+
+// tableLines renders rows as aligned columns, scrolled so the selected row
+// stays visible, with the selected row in reverse video.
+func tableLines(headers []string, rows [][]string, selected, width, height int) []string {
+	widths := make([]int, len(headers))
+	for i, header := range headers {
+		widths[i] = utf8.RuneCountInString(header)
+	}
+	for _, row := range rows {
+		for i, cell := range row {
+			if i >= len(widths) {
+				widths = append(widths, 0)
+			}
+			widths[i] = max(widths[i], min(utf8.RuneCountInString(cell), tuiMaxColumnWidth))
+		}
+	}
+	join := func(cells []string) string {
+		parts := make([]string, len(cells))
+		for i, cell := range cells {
+			parts[i] = fit(shared.SanitizeTerminal(cell), widths[i])
+		}
+		return " " + strings.Join(parts, "  ")
+	}
+
+	var lines []string
+	if len(headers) > 0 {
+		lines = append(lines, styled(ansiBold, fit(join(headers), width)))
+		height--
+	}
+	height = max(height, 1)
+	offset := 0
+	if selected >= height {
+		offset = selected - height + 1
+	}
+	for i := offset; i < len(rows) && i < offset+height; i++ {
+		line := fit(join(rows[i]), width)
+		if i == selected {
+			line = styled(ansiReverse, line)
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// fit truncates or pads value to exactly width runes.
+func fit(value string, width int) string {
+	if width <= 0 {
+		return ""
+	}
+	count := utf8.RuneCountInString(value)
+	if count > width {
+		runes := []rune(value)
+		if width == 1 {
+			return string(runes[:1])
+		}
+		return string(runes[:width-1]) + "~"
+	}
+	return value + strings.Repeat(" ", width-count)
+}
+
+func styled(style, value string) string {
+	return style + value + ansiReset
+}
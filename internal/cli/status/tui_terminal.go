@@ -0,0 +1,137 @@
+package status
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"golang.org/x/term"
+)
+
+var errTUINotTerminal = errors.New("requires an interactive terminal")
+
+// tuiTerminal puts the terminal into raw mode on the alternate screen and
+// restores it on Close.
+type tuiTerminal struct {
+	in    *os.File
+	out   *os.File
+	state *term.State
+}
+
+func openTUITerminal(in, out *os.File) (*tuiTerminal, error) {
+	if !isTUITerminal(in, out) {
+		return nil, errTUINotTerminal
+	}
+	state, err := term.MakeRaw(int(in.Fd()))
+	if err != nil {
+		return nil, err
+	}
+	// Alternate screen, hidden cursor.
+	fmt.Fprint(out, "\x1b[?1049h\x1b[?25l")
+	return &tuiTerminal{in: in, out: out, state: state}, nil
+}
+
+func isTUITerminal(in, out *os.File) bool {
+	return term.IsTerminal(int(in.Fd())) && term.IsTerminal(int(out.Fd()))
+}
+
+func (t *tuiTerminal) Close() error {
+	fmt.Fprint(t.out, "\x1b[?25h\x1b[?1049l")
+	return term.Restore(int(t.in.Fd()), t.state)
+}
+
+func (t *tuiTerminal) Size() (int, int) {
+	width, height, err := term.GetSize(int(t.out.Fd()))
+	if err != nil || width <= 0 || height <= 0 {
+		return 80, 24
+	}
+	return width, height
+}
+
+// Draw repaints the screen in place. Raw mode needs explicit carriage
+// returns between lines.
+func (t *tuiTerminal) Draw(lines []string) {
+	var b strings.Builder
+	b.WriteString("\x1b[H")
+	for i, line := range lines {
+		b.WriteString(line)
+		b.WriteString("\x1b[K")
+		if i < len(lines)-1 {
+			b.WriteString("\r\n")
+		}
+	}
+	_, _ = io.WriteString(t.out, b.String())
+}
+
+// readTUIKeys forwards key names read from r until r fails or ctx ends.
+func readTUIKeys(ctx context.Context, r io.Reader, keys chan<- string) {
+	defer close(keys)
+	buf := make([]byte, 64)
+	for {
+		n, err := r.Read(buf)
+		for _, key := range parseTUIKeys(buf[:n]) {
+			select {
+			case keys <- key:
+			case <-ctx.Done():
+				return
+			}
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+var tuiEscapeKeys = map[string]string{
+	"\x1b[A": "up",
+	"\x1b[B": "down",
+	"\x1b[C": "right",
+	"\x1b[D": "left",
+	"\x1bOA": "up",
+	"\x1bOB": "down",
+	"\x1bOC": "right",
+	"\x1bOD": "left",
+	"\x1b[Z": "backtab",
+}
+
+// parseTUIKeys converts one raw read into key names such as "up", "enter",
+// "esc", "ctrl+c" or the typed character.
+func parseTUIKeys(data []byte) []string {
+	var keys []string
+	for len(data) > 0 {
+		if data[0] == 0x1b {
+			if len(data) >= 3 {
+				if key, ok := tuiEscapeKeys[string(data[:3])]; ok {
+					keys = append(keys, key)
+					data = data[3:]
+					continue
+				}
+			}
+			if len(data) > 1 && (data[1] == '[' || data[1] == 'O') {
+				// Unknown escape sequence: drop it.
+				return keys
+			}
+			keys = append(keys, "esc")
+			data = data[1:]
+			continue
+		}
+
+		switch data[0] {
+		case 0x03:
+			keys = append(keys, "ctrl+c")
+		case '\r', '\n':
+			keys = append(keys, "enter")
+		case '\t':
+			keys = append(keys, "tab")
+		default:
+			if data[0] >= 0x20 && data[0] < 0x7f {
+				keys = append(keys, string(data[0]))
+			}
+		}
+		data = data[1:]
+	}
+	return keys
+}
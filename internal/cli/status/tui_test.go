@@ -0,0 +1,240 @@
+package status
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+var tuiTestNow = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func newLoadedTUIModel(t *testing.T) *tuiModel {
+	t.Helper()
+	m := newTUIModel([]tuiApp{{ID: "111", Name: "Alpha"}, {ID: "222", Name: "Beta"}}, "222")
+	req := m.load(tuiTestNow)
+	if req.Kind != tuiRequestLoad || req.AppID != "222" || req.View != tuiViewDashboard {
+		t.Fatalf("unexpected initial request %+v", req)
+	}
+	m.applyLoaded(tuiLoaded{
+		AppID: "222",
+		View:  tuiViewDashboard,
+		At:    tuiTestNow,
+		Dashboard: &dashboardResponse{
+			Summary:       statusSummary{Health: "green", NextAction: "Ship it"},
+			AppStore:      &appStoreSection{VersionID: "ver-1", Version: "1.2.0", Platform: "IOS", State: "PREPARE_FOR_SUBMISSION"},
+			PhasedRelease: &phasedReleaseSection{Configured: true, ID: "phase-1", State: "ACTIVE", CurrentDayNumber: 3},
+		},
+	})
+	return m
+}
+
+func TestTUIModel_SwitchesViewsAndApps(t *testing.T) {
+	m := newLoadedTUIModel(t)
+
+	req := m.handleKey("2", tuiTestNow)
+	if req.Kind != tuiRequestLoad || req.View != tuiViewBuilds || req.AppID != "222" {
+		t.Fatalf("expected builds load, got %+v", req)
+	}
+	if req := m.handleKey("tab", tuiTestNow); req.View != tuiViewTesters {
+		t.Fatalf("expected tab to move to testers, got %+v", req)
+	}
+	if req := m.handleKey("]", tuiTestNow); req.AppID != "111" || req.View != tuiViewTesters {
+		t.Fatalf("expected next app to wrap to 111 on the same view, got %+v", req)
+	}
+	if m.dashboard != nil {
+		t.Fatal("expected dashboard cleared after switching apps")
+	}
+}
+
+func TestTUIModel_IgnoresStaleResults(t *testing.T) {
+	m := newLoadedTUIModel(t)
+	m.handleKey("2", tuiTestNow)
+	m.applyLoaded(tuiLoaded{AppID: "222", View: tuiViewDashboard, Err: errors.New("late")})
+	if m.errText != "" || !m.loading {
+		t.Fatalf("expected stale dashboard result ignored, got err=%q loading=%v", m.errText, m.loading)
+	}
+}
+
+func TestTUIModel_RateLimitsRefresh(t *testing.T) {
+	m := newLoadedTUIModel(t)
+
+	if req := m.handleKey("r", tuiTestNow.Add(time.Second)); req.Kind != tuiRequestNone {
+		t.Fatalf("expected refresh to be throttled, got %+v", req)
+	}
+	if !strings.Contains(m.message, "throttled") {
+		t.Fatalf("expected throttle message, got %q", m.message)
+	}
+	if req := m.handleKey("r", tuiTestNow.Add(tuiMinManualRefresh)); req.Kind != tuiRequestLoad {
+		t.Fatalf("expected refresh after throttle window, got %+v", req)
+	}
+	if req := m.tick(tuiTestNow.Add(time.Hour), time.Minute); req.Kind != tuiRequestNone {
+		t.Fatalf("expected no poll while a load is in flight, got %+v", req)
+	}
+	m.applyLoaded(tuiLoaded{AppID: "222", View: tuiViewDashboard, At: tuiTestNow})
+	if req := m.tick(tuiTestNow.Add(tuiMinManualRefresh+30*time.Second), time.Minute); req.Kind != tuiRequestNone {
+		t.Fatalf("expected no poll before the interval, got %+v", req)
+	}
+	if req := m.tick(tuiTestNow.Add(tuiMinManualRefresh+time.Minute), time.Minute); req.Kind != tuiRequestLoad {
+		t.Fatalf("expected poll after the interval, got %+v", req)
+	}
+}
+
+func TestTUIModel_PausePhasedReleaseNeedsConfirmation(t *testing.T) {
+	m := newLoadedTUIModel(t)
+
+	if req := m.handleKey("p", tuiTestNow); req.Kind != tuiRequestNone {
+		t.Fatalf("expected confirmation before running, got %+v", req)
+	}
+	if m.confirm == nil || m.confirm.Kind != tuiActionPausePhasedRelease {
+		t.Fatalf("expected pause confirmation, got %+v", m.confirm)
+	}
+	if req := m.handleKey("n", tuiTestNow); req.Kind != tuiRequestNone || m.confirm != nil {
+		t.Fatalf("expected cancel, got %+v confirm=%+v", req, m.confirm)
+	}
+
+	m.handleKey("p", tuiTestNow)
+	req := m.handleKey("y", tuiTestNow)
+	if req.Kind != tuiRequestRun || req.Action.PhasedReleaseID != "phase-1" || req.Action.Kind != tuiActionPausePhasedRelease {
+		t.Fatalf("expected pause run request, got %+v", req)
+	}
+}
+
+func TestTUIModel_AddBuildToGroupFlow(t *testing.T) {
+	m := newLoadedTUIModel(t)
+	m.handleKey("2", tuiTestNow)
+	m.applyLoaded(tuiLoaded{AppID: "222", View: tuiViewBuilds, At: tuiTestNow, Rows: []tuiRow{
+		{ID: "build-2", Label: "build 2"},
+		{ID: "build-1", Label: "build 1"},
+	}})
+	m.handleKey("j", tuiTestNow)
+
+	req := m.handleKey("g", tuiTestNow)
+	if req.Kind != tuiRequestLoadGroups || req.Build.ID != "build-1" {
+		t.Fatalf("expected group load for build-1, got %+v", req)
+	}
+	m.applyGroups(req.Build, []tuiRow{{ID: "group-1", Label: "Internal"}, {ID: "group-2", Label: "External"}}, nil)
+	m.handleKey("down", tuiTestNow)
+	m.handleKey("enter", tuiTestNow)
+	if m.confirm == nil || m.confirm.GroupID != "group-2" || m.confirm.BuildID != "build-1" {
+		t.Fatalf("expected confirmation for build-1 -> group-2, got %+v", m.confirm)
+	}
+	if !strings.Contains(m.confirm.Prompt, `"External"`) {
+		t.Fatalf("expected prompt to name the group, got %q", m.confirm.Prompt)
+	}
+}
+
+func TestTUIModel_SubmitUsesDashboardVersion(t *testing.T) {
+	m := newLoadedTUIModel(t)
+	if m.handleKey("s", tuiTestNow); m.confirm != nil {
+		t.Fatal("expected submit to require the builds view")
+	}
+
+	m.handleKey("2", tuiTestNow)
+	m.applyLoaded(tuiLoaded{AppID: "222", View: tuiViewBuilds, At: tuiTestNow, Rows: []tuiRow{{ID: "build-9", Label: "build 9"}}})
+	m.handleKey("s", tuiTestNow)
+	want := &tuiAction{
+		Kind:      tuiActionSubmitForReview,
+		AppID:     "222",
+		BuildID:   "build-9",
+		VersionID: "ver-1",
+		Platform:  "IOS",
+		Prompt:    "Attach build 9 to version 1.2.0 (IOS) and submit for App Store review?",
+	}
+	if !reflect.DeepEqual(m.confirm, want) {
+		t.Fatalf("unexpected confirmation %+v", m.confirm)
+	}
+}
+
+func TestTUIModel_FailedActionKeepsError(t *testing.T) {
+	m := newLoadedTUIModel(t)
+	if req := m.applyActionDone("", errors.New("forbidden"), tuiTestNow); req.Kind != tuiRequestNone {
+		t.Fatalf("expected no reload after a failed action, got %+v", req)
+	}
+	if m.errText != "forbidden" {
+		t.Fatalf("expected error kept, got %q", m.errText)
+	}
+	if req := m.applyActionDone("done", nil, tuiTestNow); req.Kind != tuiRequestLoad || m.message != "done" {
+		t.Fatalf("expected reload and message after success, got %+v %q", req, m.message)
+	}
+}
+
+func TestTUIRender_FitsScreen(t *testing.T) {
+	m := newLoadedTUIModel(t)
+	lines := m.render(60, 12, tuiTestNow.Add(2*time.Minute))
+	if len(lines) != 12 {
+		t.Fatalf("expected 12 lines, got %d", len(lines))
+	}
+	screen := strings.Join(lines, "\n")
+	for _, want := range []string{"Beta (222)  [2/2]", "updated 2m ago", "[+] green", "[####------] 3/7", "p: pause/resume"} {
+		if !strings.Contains(screen, want) {
+			t.Fatalf("expected screen to contain %q, got:\n%s", want, screen)
+		}
+	}
+
+	m.handleKey("p", tuiTestNow)
+	if screen := strings.Join(m.render(80, 12, tuiTestNow), "\n"); !strings.Contains(screen, "Pause the phased release?") {
+		t.Fatalf("expected confirmation dialog, got:\n%s", screen)
+	}
+}
+
+func TestTableLinesScrollsToSelection(t *testing.T) {
+	rows := [][]string{{"a"}, {"b"}, {"c"}, {"d"}}
+	lines := tableLines([]string{"NAME"}, rows, 3, 20, 3)
+	if len(lines) != 3 || !strings.Contains(lines[2], "d") || !strings.Contains(lines[2], ansiReverse) {
+		t.Fatalf("expected selected last row visible and highlighted, got %q", lines)
+	}
+}
+
+func TestParseTUIKeys(t *testing.T) {
+	got := parseTUIKeys([]byte("j\x1b[A\x1bOB\r\t\x1b[Zq\x03\x1b"))
+	want := []string{"j", "up", "down", "enter", "tab", "backtab", "q", "ctrl+c", "esc"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("parseTUIKeys = %v, want %v", got, want)
+	}
+}
+
+type fakeTUIBackend struct {
+	action tuiAction
+}
+
+func (f *fakeTUIBackend) Dashboard(context.Context, string) (*dashboardResponse, error) {
+	return &dashboardResponse{Summary: statusSummary{Health: "yellow"}}, nil
+}
+
+func (f *fakeTUIBackend) List(_ context.Context, view tuiView, _ string) ([]string, []tuiRow, error) {
+	return []string{"NAME"}, []tuiRow{{ID: tuiViewNames[view]}}, nil
+}
+
+func (f *fakeTUIBackend) BetaGroups(context.Context, string) ([]tuiRow, error) {
+	return nil, nil
+}
+
+func (f *fakeTUIBackend) Run(_ context.Context, action tuiAction) (string, error) {
+	f.action = action
+	return "ok", nil
+}
+
+func TestExecuteTUIRequest(t *testing.T) {
+	backend := &fakeTUIBackend{}
+	m := newTUIModel([]tuiApp{{ID: "111", Name: "Alpha"}}, "")
+
+	executeTUIRequest(context.Background(), backend, m.load(tuiTestNow))(m, tuiTestNow)
+	if m.dashboard == nil || m.dashboard.Summary.Health != "yellow" || m.loading {
+		t.Fatalf("expected dashboard applied, got %+v loading=%v", m.dashboard, m.loading)
+	}
+
+	req := m.handleKey("5", tuiTestNow)
+	executeTUIRequest(context.Background(), backend, req)(m, tuiTestNow)
+	if len(m.rows) != 1 || m.rows[0].ID != "Crashes" {
+		t.Fatalf("expected crashes rows, got %+v", m.rows)
+	}
+
+	action := tuiAction{Kind: tuiActionResumePhasedRelease, PhasedReleaseID: "phase-1"}
+	next := executeTUIRequest(context.Background(), backend, tuiRequest{Kind: tuiRequestRun, Action: action})(m, tuiTestNow)
+	if backend.action != action || m.message != "ok" || next.Kind != tuiRequestLoad {
+		t.Fatalf("expected action run and reload, got action=%+v message=%q next=%+v", backend.action, m.message, next)
+	}
+}
@@ -34,10 +34,10 @@ func SubmitCommand() *ffcli.Command {
 func SubmitCreateCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("submit create", flag.ExitOnError)
 
-	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID)")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (or ASC_APP_ID)")
 	version := fs.String("version", "", "App Store version string")
-	versionID := fs.String("version-id", "", "App Store version ID")
-	buildID := fs.String("build", "", "Build ID to attach")
+	versionID := shared.ReferenceFlag(fs, shared.ReferenceVersionID, "", "App Store version ID or version string like 1.2.3")
+	buildID := shared.ReferenceFlag(fs, shared.ReferenceBuild, "", "Build ID, \"latest\" or \"1.2.3(45)\" to attach")
 	platform := fs.String("platform", "IOS", "Platform: IOS, MAC_OS, TV_OS, VISION_OS")
 	confirm := fs.Bool("confirm", false, "Confirm submission (required)")
 	output := shared.BindOutputFlags(fs)
@@ -97,52 +97,60 @@ Examples:
 				}
 			}
 
-			// Attach build to version
-			if err := client.AttachBuildToVersion(requestCtx, resolvedVersionID, strings.TrimSpace(*buildID)); err != nil {
-				return fmt.Errorf("submit create: failed to attach build: %w", err)
-			}
-
-			// Use the new reviewSubmissions API (the old appStoreVersionSubmissions is deprecated)
-			// Step 1: Create review submission for the app
-			reviewSubmission, err := client.CreateReviewSubmission(requestCtx, resolvedAppID, asc.Platform(normalizedPlatform))
+			result, err := SubmitForReview(requestCtx, client, resolvedAppID, resolvedVersionID, strings.TrimSpace(*buildID), asc.Platform(normalizedPlatform))
 			if err != nil {
-				return fmt.Errorf("submit create: failed to create review submission: %w", err)
+				return fmt.Errorf("submit create: %w", err)
 			}
 
-			// Step 2: Add the app store version as a submission item
-			_, err = client.AddReviewSubmissionItem(requestCtx, reviewSubmission.Data.ID, resolvedVersionID)
-			if err != nil {
-				return fmt.Errorf("submit create: failed to add version to submission: %w", err)
-			}
+			return shared.PrintOutput(result, *output.Output, *output.Pretty)
+		},
+	}
+}
 
-			// Step 3: Submit for review
-			submitResp, err := client.SubmitReviewSubmission(requestCtx, reviewSubmission.Data.ID)
-			if err != nil {
-				return fmt.Errorf("submit create: failed to submit for review: %w", err)
-			}
+// SubmitForReview attaches a build to an App Store version and submits the
+// version for review.
+func SubmitForReview(ctx context.Context, client *asc.Client, appID, versionID, buildID string, platform asc.Platform) (*asc.AppStoreVersionSubmissionCreateResult, error) {
+	// Attach build to version
+	if err := client.AttachBuildToVersion(ctx, versionID, buildID); err != nil {
+		return nil, fmt.Errorf("failed to attach build: %w", err)
+	}
 
-			submittedDate := submitResp.Data.Attributes.SubmittedDate
-			var createdDatePtr *string
-			if submittedDate != "" {
-				createdDatePtr = &submittedDate
-			}
-			result := &asc.AppStoreVersionSubmissionCreateResult{
-				SubmissionID: submitResp.Data.ID,
-				VersionID:    resolvedVersionID,
-				BuildID:      strings.TrimSpace(*buildID),
-				CreatedDate:  createdDatePtr,
-			}
+	// Use the new reviewSubmissions API (the old appStoreVersionSubmissions is deprecated)
+	// Step 1: Create review submission for the app
+	reviewSubmission, err := client.CreateReviewSubmission(ctx, appID, platform)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create review submission: %w", err)
+	}
 
-			return shared.PrintOutput(result, *output.Output, *output.Pretty)
-		},
+	// Step 2: Add the app store version as a submission item
+	if _, err := client.AddReviewSubmissionItem(ctx, reviewSubmission.Data.ID, versionID); err != nil {
+		return nil, fmt.Errorf("failed to add version to submission: %w", err)
+	}
+
+	// Step 3: Submit for review
+	submitResp, err := client.SubmitReviewSubmission(ctx, reviewSubmission.Data.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to submit for review: %w", err)
+	}
+
+	submittedDate := submitResp.Data.Attributes.SubmittedDate
+	var createdDatePtr *string
+	if submittedDate != "" {
+		createdDatePtr = &submittedDate
 	}
+	return &asc.AppStoreVersionSubmissionCreateResult{
+		SubmissionID: submitResp.Data.ID,
+		VersionID:    versionID,
+		BuildID:      buildID,
+		CreatedDate:  createdDatePtr,
+	}, nil
 }
 
 func SubmitStatusCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("submit status", flag.ExitOnError)
 
 	submissionID := fs.String("id", "", "Submission ID")
-	versionID := fs.String("version-id", "", "App Store version ID")
+	versionID := shared.ReferenceFlag(fs, shared.ReferenceVersionID, "", "App Store version ID or version string like 1.2.3")
 	output := shared.BindOutputFlags(fs)
 
 	return &ffcli.Command{
@@ -220,7 +228,7 @@ func SubmitCancelCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("submit cancel", flag.ExitOnError)
 
 	submissionID := fs.String("id", "", "Submission ID")
-	versionID := fs.String("version-id", "", "App Store version ID")
+	versionID := shared.ReferenceFlag(fs, shared.ReferenceVersionID, "", "App Store version ID or version string like 1.2.3")
 	confirm := fs.Bool("confirm", false, "Confirm cancellation (required)")
 	output := shared.BindOutputFlags(fs)
 
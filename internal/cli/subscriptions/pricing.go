@@ -51,7 +51,7 @@ type subMoney struct {
 func SubscriptionsPricingCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("pricing", flag.ExitOnError)
 
-	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (or ASC_APP_ID env)")
 	subscriptionID := fs.String("subscription-id", "", "Subscription ID")
 	territory := fs.String("territory", "USA", "Territory for pricing (e.g., USA)")
 	output := shared.BindOutputFlags(fs)
@@ -57,7 +57,7 @@ Examples:
 func BetaGroupsListCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("list", flag.ExitOnError)
 
-	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (or ASC_APP_ID env)")
 	global := fs.Bool("global", false, "List beta groups across all apps (top-level endpoint)")
 	internal := fs.Bool("internal", false, "Filter to internal groups only")
 	external := fs.Bool("external", false, "Filter to external groups only")
@@ -250,7 +250,7 @@ Examples:
 func BetaGroupsCreateCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("create", flag.ExitOnError)
 
-	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (or ASC_APP_ID env)")
 	name := fs.String("name", "", "Beta group name")
 	internal := fs.Bool("internal", false, "Create as internal group")
 	output := shared.BindOutputFlags(fs)
@@ -494,7 +494,7 @@ Examples:
 func BetaGroupsAddTestersCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("add-testers", flag.ExitOnError)
 
-	group := fs.String("group", "", "Beta group ID")
+	group := shared.ReferenceFlag(fs, shared.ReferenceBetaGroup, "", "Beta group ID or name")
 	tester := fs.String("tester", "", "Beta tester ID(s), comma-separated")
 
 	return &ffcli.Command{
@@ -543,7 +543,7 @@ Examples:
 func BetaGroupsRemoveTestersCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("remove-testers", flag.ExitOnError)
 
-	group := fs.String("group", "", "Beta group ID")
+	group := shared.ReferenceFlag(fs, shared.ReferenceBetaGroup, "", "Beta group ID or name")
 	tester := fs.String("tester", "", "Beta tester ID(s), comma-separated")
 	confirm := fs.Bool("confirm", false, "Confirm removal")
 
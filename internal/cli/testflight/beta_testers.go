@@ -69,9 +69,9 @@ Examples:
 func BetaTestersListCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("list", flag.ExitOnError)
 
-	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
-	buildID := fs.String("build", "", "Build ID to filter")
-	group := fs.String("group", "", "Beta group name or ID to filter")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (or ASC_APP_ID env)")
+	buildID := shared.ReferenceFlag(fs, shared.ReferenceBuild, "", "Build ID, \"latest\" or \"1.2.3(45)\" to filter")
+	group := shared.ReferenceFlag(fs, shared.ReferenceBetaGroup, "", "Beta group name or ID to filter")
 	email := fs.String("email", "", "Filter by tester email")
 	output := shared.BindOutputFlags(fs)
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
@@ -208,11 +208,11 @@ Examples:
 func BetaTestersAddCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("add", flag.ExitOnError)
 
-	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (or ASC_APP_ID env)")
 	email := fs.String("email", "", "Tester email address")
 	firstName := fs.String("first-name", "", "Tester first name")
 	lastName := fs.String("last-name", "", "Tester last name")
-	group := fs.String("group", "", "Beta group name or ID")
+	group := shared.ReferenceFlag(fs, shared.ReferenceBetaGroup, "", "Beta group name or ID")
 	output := shared.BindOutputFlags(fs)
 
 	return &ffcli.Command{
@@ -267,7 +267,7 @@ Examples:
 func BetaTestersRemoveCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("remove", flag.ExitOnError)
 
-	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (or ASC_APP_ID env)")
 	email := fs.String("email", "", "Tester email address")
 	output := shared.BindOutputFlags(fs)
 
@@ -328,7 +328,7 @@ func BetaTestersAddGroupsCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("add-groups", flag.ExitOnError)
 
 	id := fs.String("id", "", "Beta tester ID")
-	groups := fs.String("group", "", "Comma-separated beta group IDs")
+	groups := shared.ReferenceListFlag(fs, shared.ReferenceBetaGroup, "", "Comma-separated beta group IDs or names")
 	output := shared.BindOutputFlags(fs)
 
 	return &ffcli.Command{
@@ -388,7 +388,7 @@ func BetaTestersRemoveGroupsCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("remove-groups", flag.ExitOnError)
 
 	id := fs.String("id", "", "Beta tester ID")
-	groups := fs.String("group", "", "Comma-separated beta group IDs")
+	groups := shared.ReferenceListFlag(fs, shared.ReferenceBetaGroup, "", "Comma-separated beta group IDs or names")
 	confirm := fs.Bool("confirm", false, "Confirm removal")
 	output := shared.BindOutputFlags(fs)
 
@@ -642,9 +642,9 @@ Examples:
 func BetaTestersInviteCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("invite", flag.ExitOnError)
 
-	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (or ASC_APP_ID env)")
 	email := fs.String("email", "", "Tester email address")
-	group := fs.String("group", "", "Beta group name or ID (optional, creates tester if missing)")
+	group := shared.ReferenceFlag(fs, shared.ReferenceBetaGroup, "", "Beta group name or ID (optional, creates tester if missing)")
 	output := shared.BindOutputFlags(fs)
 
 	return &ffcli.Command{
@@ -60,10 +60,10 @@ type betaTestersImportSummary struct {
 func BetaTestersExportCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("export", flag.ExitOnError)
 
-	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (or ASC_APP_ID env)")
 	outputPath := fs.String("output", "", "Output CSV file path (required)")
-	group := fs.String("group", "", "Beta group name or ID to filter (optional)")
-	buildID := fs.String("build", "", "Build ID to filter (optional)")
+	group := shared.ReferenceFlag(fs, shared.ReferenceBetaGroup, "", "Beta group name or ID to filter (optional)")
+	buildID := shared.ReferenceFlag(fs, shared.ReferenceBuild, "", "Build ID, \"latest\" or \"1.2.3(45)\" to filter (optional)")
 	email := fs.String("email", "", "Filter by tester email (optional)")
 	includeGroups := fs.Bool("include-groups", false, "Include a groups column (requires additional API calls)")
 	format := shared.BindOutputFlagsWith(fs, "format", "json", "Summary output format: json (default), table, markdown")
@@ -246,11 +246,11 @@ Examples:
 func BetaTestersImportCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("import", flag.ExitOnError)
 
-	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (or ASC_APP_ID env)")
 	inputPath := fs.String("input", "", "Input CSV file path (required)")
 	dryRun := fs.Bool("dry-run", false, "Validate and print plan without mutating network state")
 	invite := fs.Bool("invite", false, "Invite newly created testers (default false)")
-	group := fs.String("group", "", "Beta group name or ID to apply to all rows (optional)")
+	group := shared.ReferenceFlag(fs, shared.ReferenceBetaGroup, "", "Beta group name or ID to apply to all rows (optional)")
 	skipExisting := fs.Bool("skip-existing", false, "If tester already exists, do not modify group membership")
 	continueOnError := fs.Bool("continue-on-error", true, "Continue processing rows after failures (default true)")
 	format := shared.BindOutputFlagsWith(fs, "format", "json", "Summary output format: json (default), table, markdown")
@@ -26,7 +26,7 @@ func BetaTestersMetricsCommand() *ffcli.Command {
 
 	testerID := fs.String("tester-id", "", "Beta tester ID")
 	aliasID := fs.String("id", "", "Beta tester ID (alias of --tester-id)")
-	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (or ASC_APP_ID env)")
 	period := fs.String("period", "", "Reporting period: "+strings.Join(betaTesterUsagePeriodList(), ", "))
 	limit := fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next := fs.String("next", "", "Fetch next page using a links.next URL")
@@ -24,7 +24,7 @@ type betaTesterUsagesPage struct {
 func TestFlightMetricsBetaTesterUsagesCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("metrics beta-tester-usages", flag.ExitOnError)
 
-	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (or ASC_APP_ID env)")
 	period := fs.String("period", "", "Reporting period: "+strings.Join(betaTesterUsagePeriodList(), ", "))
 	groupBy := fs.String("group-by", "betaTesters", "Group results by dimension (betaTesters)")
 	filterTester := fs.String("filter-tester", "", "Filter by beta tester ID")
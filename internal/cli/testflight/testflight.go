@@ -170,7 +170,7 @@ Examples:
 func TestFlightAppsGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("get", flag.ExitOnError)
 
-	appID := fs.String("app", "", "App Store Connect app ID (required)")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (required)")
 	output := shared.BindOutputFlags(fs)
 
 	return &ffcli.Command{
@@ -40,6 +40,15 @@ type TestFlightGroupConfig struct {
 	PublicLinkLimit   *int     `yaml:"publicLinkLimit,omitempty"`
 	FeedbackEnabled   bool     `yaml:"feedbackEnabled"`
 	Builds            []string `yaml:"builds,omitempty"`
+	// RecruitmentCriteria is only reconciled by push when present.
+	RecruitmentCriteria []TestFlightRecruitmentFilter `yaml:"recruitmentCriteria,omitempty"`
+}
+
+// TestFlightRecruitmentFilter describes one device family/OS recruitment filter.
+type TestFlightRecruitmentFilter struct {
+	DeviceFamily       string `yaml:"deviceFamily"`
+	MinimumOsInclusive string `yaml:"minimumOsInclusive,omitempty"`
+	MaximumOsInclusive string `yaml:"maximumOsInclusive,omitempty"`
 }
 
 // TestFlightBuildConfig describes build metadata and group assignments.
@@ -94,11 +103,13 @@ func TestFlightSyncCommand() *ffcli.Command {
 		LongHelp: `Sync TestFlight configuration.
 
 Examples:
-  asc testflight sync pull --app "APP_ID" --output "./testflight.yaml"`,
+  asc testflight sync pull --app "APP_ID" --output "./testflight.yaml"
+  asc testflight sync push --app "APP_ID" --file "./testflight.yaml" --dry-run`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			TestFlightSyncPullCommand(),
+			TestFlightSyncPushCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
@@ -110,11 +121,11 @@ Examples:
 func TestFlightSyncPullCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("pull", flag.ExitOnError)
 
-	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (or ASC_APP_ID env)")
 	output := fs.String("output", "", "Output file path for YAML (required)")
 	includeBuilds := fs.Bool("include-builds", false, "Include builds and group assignments")
 	includeTesters := fs.Bool("include-testers", false, "Include testers and group memberships")
-	groupFilter := fs.String("group", "", "Filter to a specific beta group (name or ID)")
+	groupFilter := shared.ReferenceFlag(fs, shared.ReferenceBetaGroup, "", "Filter to a specific beta group (name or ID)")
 	buildFilter := fs.String("build", "", "Filter to build ID(s), comma-separated")
 	testerFilter := fs.String("tester", "", "Filter to tester ID(s) or emails, comma-separated")
 	pretty := shared.BindPrettyJSONFlag(fs)
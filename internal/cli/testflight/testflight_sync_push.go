@@ -0,0 +1,1020 @@
+package testflight
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+	"gopkg.in/yaml.v3"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	syncActionCreate = "create"
+	syncActionUpdate = "update"
+	syncActionDelete = "delete"
+	syncActionAdd    = "add"
+	syncActionRemove = "remove"
+
+	syncResourceGroup       = "betaGroup"
+	syncResourceTester      = "betaTester"
+	syncResourceGroupTester = "groupTester"
+	syncResourceGroupBuild  = "groupBuild"
+	syncResourceRecruitment = "recruitmentCriteria"
+)
+
+// TestFlightSyncChange is one reconciliation step in a sync push plan.
+type TestFlightSyncChange struct {
+	Action   string `json:"action"`
+	Resource string `json:"resource"`
+	Group    string `json:"group,omitempty"`
+	GroupID  string `json:"groupId,omitempty"`
+	Target   string `json:"target,omitempty"`
+	Detail   string `json:"detail,omitempty"`
+
+	groupKey    string
+	ids         []string
+	createAttrs asc.BetaGroupAttributes
+	updateAttrs *asc.BetaGroupUpdateAttributes
+	filters     []asc.DeviceFamilyOsVersionFilter
+	criteriaID  string
+	email       string
+	firstName   string
+	lastName    string
+	groupKeys   []string
+}
+
+// TestFlightSyncPushResult is the output of testflight sync push.
+type TestFlightSyncPushResult struct {
+	File      string                 `json:"file"`
+	AppID     string                 `json:"appId"`
+	DryRun    bool                   `json:"dryRun"`
+	Prune     bool                   `json:"prune"`
+	Applied   bool                   `json:"applied"`
+	NoChanges bool                   `json:"noChanges"`
+	Changes   []TestFlightSyncChange `json:"changes"`
+}
+
+type testFlightSyncPushClient interface {
+	testFlightSyncClient
+	GetBetaTesters(ctx context.Context, appID string, opts ...asc.BetaTestersOption) (*asc.BetaTestersResponse, error)
+	GetBetaGroupBetaRecruitmentCriteria(ctx context.Context, groupID string) (*asc.BetaRecruitmentCriteriaResponse, error)
+	CreateBetaGroupWithAttributes(ctx context.Context, appID string, attrs asc.BetaGroupAttributes) (*asc.BetaGroupResponse, error)
+	UpdateBetaGroup(ctx context.Context, groupID string, req asc.BetaGroupUpdateRequest) (*asc.BetaGroupResponse, error)
+	DeleteBetaGroup(ctx context.Context, groupID string) error
+	CreateBetaTester(ctx context.Context, email, firstName, lastName string, groupIDs []string) (*asc.BetaTesterResponse, error)
+	AddBetaTestersToGroup(ctx context.Context, groupID string, testerIDs []string) error
+	RemoveBetaTestersFromGroup(ctx context.Context, groupID string, testerIDs []string) error
+	AddBuildsToBetaGroup(ctx context.Context, groupID string, buildIDs []string) error
+	RemoveBuildsFromBetaGroup(ctx context.Context, groupID string, buildIDs []string) error
+	CreateBetaRecruitmentCriteria(ctx context.Context, groupID string, filters []asc.DeviceFamilyOsVersionFilter) (*asc.BetaRecruitmentCriteriaResponse, error)
+	UpdateBetaRecruitmentCriteria(ctx context.Context, criteriaID string, filters []asc.DeviceFamilyOsVersionFilter) (*asc.BetaRecruitmentCriteriaResponse, error)
+	DeleteBetaRecruitmentCriteria(ctx context.Context, criteriaID string) error
+}
+
+type testFlightSyncPushOptions struct {
+	prune            bool
+	allowEmptyGroups bool
+}
+
+// syncGroupState pairs a declared group with its remote counterpart.
+type syncGroupState struct {
+	key      string
+	config   TestFlightGroupConfig
+	remoteID string
+	remote   *asc.Resource[asc.BetaGroupAttributes]
+}
+
+// syncRemoteTester is a tester known remotely, keyed by ID.
+type syncRemoteTester struct {
+	id    string
+	email string
+}
+
+// TestFlightSyncPushCommand applies a TestFlight YAML config to App Store Connect.
+func TestFlightSyncPushCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("push", flag.ExitOnError)
+
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (or ASC_APP_ID env; defaults to app.id in the file)")
+	file := fs.String("file", "", "Path to TestFlight YAML config (required)")
+	dryRun := fs.Bool("dry-run", false, "Preview the plan without mutating App Store Connect")
+	prune := fs.Bool("prune", false, "Delete groups and remove memberships not declared in the file")
+	confirm := fs.Bool("confirm", false, "Confirm destructive operations (required with --prune)")
+	allowEmptyGroups := fs.Bool("allow-empty-groups", false, "Allow --prune to delete every beta group when the file declares an empty groups list")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "push",
+		ShortUsage: "asc testflight sync push --file \"./testflight.yaml\" [flags]",
+		ShortHelp:  "Apply TestFlight configuration from YAML.",
+		LongHelp: `Apply TestFlight configuration from YAML.
+
+Reconciles beta groups (public link, link limit, feedback), tester
+memberships, build assignments and recruitment criteria against the
+current App Store Connect state. The file uses the same schema as
+"asc testflight sync pull".
+
+Examples:
+  asc testflight sync push --app "APP_ID" --file "./testflight.yaml" --dry-run
+  asc testflight sync push --app "APP_ID" --file "./testflight.yaml"
+  asc testflight sync push --app "APP_ID" --file "./testflight.yaml" --prune --confirm
+
+Notes:
+  - groups are matched by id, then by name; groups without an id are created.
+  - testers are matched by id, then by email; unknown emails are invited.
+  - memberships are only reconciled for sections present in the file.
+  - without --prune, nothing is deleted or removed.
+  - --prune only deletes undeclared groups when the file has a groups key;
+    an empty groups list also requires --allow-empty-groups.`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if len(args) > 0 {
+				return shared.UsageError("testflight sync push does not accept positional arguments")
+			}
+			fileValue := strings.TrimSpace(*file)
+			if fileValue == "" {
+				return shared.UsageError("--file is required")
+			}
+			if *prune && !*dryRun && !*confirm {
+				return shared.UsageError("--confirm is required with --prune")
+			}
+
+			config, err := readTestFlightConfigYAML(fileValue)
+			if err != nil {
+				return fmt.Errorf("testflight sync push: %w", err)
+			}
+
+			resolvedAppID := shared.ResolveAppID(*appID)
+			if resolvedAppID == "" {
+				resolvedAppID = strings.TrimSpace(config.App.ID)
+			}
+			if resolvedAppID == "" {
+				return shared.UsageError("--app is required (or set ASC_APP_ID or app.id in the file)")
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("testflight sync push: %w", err)
+			}
+
+			requestCtx, cancel := shared.ContextWithTimeout(ctx)
+			defer cancel()
+
+			opts := testFlightSyncPushOptions{prune: *prune, allowEmptyGroups: *allowEmptyGroups}
+			changes, groups, err := planTestFlightSyncPush(requestCtx, client, resolvedAppID, config, opts)
+			if err != nil {
+				return fmt.Errorf("testflight sync push: %w", err)
+			}
+
+			result := TestFlightSyncPushResult{
+				File:      filepath.Clean(fileValue),
+				AppID:     resolvedAppID,
+				DryRun:    *dryRun,
+				Prune:     *prune,
+				NoChanges: len(changes) == 0,
+				Changes:   changes,
+			}
+
+			if !*dryRun && len(changes) > 0 {
+				applied, applyErr := applyTestFlightSyncPlan(requestCtx, client, resolvedAppID, groups, changes)
+				result.Changes = applied
+				if applyErr != nil {
+					// Report the steps that already ran before surfacing the failure.
+					if err := printTestFlightSyncPushResult(result, *output.Output, *output.Pretty); err != nil {
+						return err
+					}
+					return shared.NewReportedError(fmt.Errorf("testflight sync push: %w", applyErr))
+				}
+				result.Applied = true
+			}
+
+			return printTestFlightSyncPushResult(result, *output.Output, *output.Pretty)
+		},
+	}
+}
+
+func printTestFlightSyncPushResult(result TestFlightSyncPushResult, format string, pretty bool) error {
+	return shared.PrintOutputWithRenderers(
+		result,
+		format,
+		pretty,
+		func() error { return printTestFlightSyncPushTable(result) },
+		func() error { return printTestFlightSyncPushMarkdown(result) },
+	)
+}
+
+func readTestFlightConfigYAML(path string) (*TestFlightConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read config: %w", err)
+	}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	var config TestFlightConfig
+	if err := decoder.Decode(&config); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("config file %s is empty", path)
+		}
+		return nil, fmt.Errorf("parse config %s: %w", path, err)
+	}
+	if err := validateTestFlightConfig(&config); err != nil {
+		return nil, err
+	}
+	return &config, nil
+}
+
+func validateTestFlightConfig(config *TestFlightConfig) error {
+	names := make(map[string]struct{}, len(config.Groups))
+	ids := make(map[string]struct{}, len(config.Groups))
+	for i, group := range config.Groups {
+		name := strings.TrimSpace(group.Name)
+		if name == "" {
+			return fmt.Errorf("groups[%d]: name is required", i)
+		}
+		key := strings.ToLower(name)
+		if _, exists := names[key]; exists {
+			return fmt.Errorf("groups[%d]: duplicate group name %q", i, name)
+		}
+		names[key] = struct{}{}
+		if id := strings.TrimSpace(group.ID); id != "" {
+			if _, exists := ids[id]; exists {
+				return fmt.Errorf("groups[%d]: duplicate group id %q", i, id)
+			}
+			ids[id] = struct{}{}
+		}
+		if group.PublicLinkLimit != nil && *group.PublicLinkLimit <= 0 {
+			return fmt.Errorf("groups[%d]: publicLinkLimit must be positive", i)
+		}
+		for j, filter := range group.RecruitmentCriteria {
+			if strings.TrimSpace(filter.DeviceFamily) == "" {
+				return fmt.Errorf("groups[%d].recruitmentCriteria[%d]: deviceFamily is required", i, j)
+			}
+		}
+	}
+	for i, build := range config.Builds {
+		if strings.TrimSpace(build.ID) == "" {
+			return fmt.Errorf("builds[%d]: id is required", i)
+		}
+	}
+	for i, tester := range config.Testers {
+		if strings.TrimSpace(tester.ID) == "" && strings.TrimSpace(tester.Email) == "" {
+			return fmt.Errorf("testers[%d]: id or email is required", i)
+		}
+	}
+	return nil
+}
+
+func planTestFlightSyncPush(ctx context.Context, client testFlightSyncPushClient, appID string, config *TestFlightConfig, opts testFlightSyncPushOptions) ([]TestFlightSyncChange, []*syncGroupState, error) {
+	if client == nil {
+		return nil, nil, fmt.Errorf("client is required")
+	}
+	if config == nil {
+		return nil, nil, fmt.Errorf("config is required")
+	}
+
+	firstPage, err := client.GetBetaGroups(ctx, appID, asc.WithBetaGroupsLimit(200))
+	if err != nil {
+		return nil, nil, fmt.Errorf("fetch beta groups: %w", err)
+	}
+	remoteGroupsResp, err := paginateBetaGroups(ctx, client, appID, firstPage)
+	if err != nil {
+		return nil, nil, fmt.Errorf("fetch beta groups: %w", err)
+	}
+
+	groups, err := matchSyncGroups(config.Groups, remoteGroupsResp.Data)
+	if err != nil {
+		return nil, nil, err
+	}
+	resolveGroup := newSyncGroupRefResolver(groups)
+
+	changes := make([]TestFlightSyncChange, 0)
+
+	// Group attributes.
+	for _, group := range groups {
+		if group.remote == nil {
+			changes = append(changes, TestFlightSyncChange{
+				Action:      syncActionCreate,
+				Resource:    syncResourceGroup,
+				Group:       group.config.Name,
+				Detail:      describeGroupSettings(group.config),
+				groupKey:    group.key,
+				createAttrs: groupCreateAttributes(group.config),
+			})
+			continue
+		}
+		if update, detail := diffGroupSettings(group.config, group.remote.Attributes); update != nil {
+			changes = append(changes, TestFlightSyncChange{
+				Action:      syncActionUpdate,
+				Resource:    syncResourceGroup,
+				Group:       group.config.Name,
+				GroupID:     group.remoteID,
+				Detail:      detail,
+				groupKey:    group.key,
+				updateAttrs: update,
+			})
+		}
+	}
+
+	// Builds.
+	if buildsManaged(config) {
+		desired, err := desiredGroupBuilds(config, resolveGroup)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, group := range groups {
+			current := map[string]struct{}{}
+			if group.remote != nil {
+				resp, err := client.GetBetaGroupBuilds(ctx, group.remoteID, asc.WithBetaGroupBuildsLimit(200))
+				if err != nil {
+					return nil, nil, fmt.Errorf("fetch beta group builds: %w", err)
+				}
+				all, err := paginateBetaGroupBuilds(ctx, client, group.remoteID, resp)
+				if err != nil {
+					return nil, nil, fmt.Errorf("fetch beta group builds: %w", err)
+				}
+				for _, build := range all.Data {
+					current[build.ID] = struct{}{}
+				}
+			}
+			adds, removes := diffStringSets(desired[group.key], current)
+			if len(adds) > 0 {
+				changes = append(changes, TestFlightSyncChange{
+					Action:   syncActionAdd,
+					Resource: syncResourceGroupBuild,
+					Group:    group.config.Name,
+					GroupID:  group.remoteID,
+					Target:   strings.Join(adds, ","),
+					groupKey: group.key,
+					ids:      adds,
+				})
+			}
+			if opts.prune && len(removes) > 0 {
+				changes = append(changes, TestFlightSyncChange{
+					Action:   syncActionRemove,
+					Resource: syncResourceGroupBuild,
+					Group:    group.config.Name,
+					GroupID:  group.remoteID,
+					Target:   strings.Join(removes, ","),
+					groupKey: group.key,
+					ids:      removes,
+				})
+			}
+		}
+	}
+
+	// Testers.
+	if config.Testers != nil {
+		testerChanges, err := planSyncTesters(ctx, client, appID, config.Testers, groups, resolveGroup, opts)
+		if err != nil {
+			return nil, nil, err
+		}
+		changes = append(changes, testerChanges...)
+	}
+
+	// Recruitment criteria.
+	for _, group := range groups {
+		if group.config.RecruitmentCriteria == nil {
+			continue
+		}
+		desired := recruitmentFilters(group.config.RecruitmentCriteria)
+		var currentID string
+		var current []asc.DeviceFamilyOsVersionFilter
+		if group.remote != nil {
+			resp, err := client.GetBetaGroupBetaRecruitmentCriteria(ctx, group.remoteID)
+			if err != nil && !asc.IsNotFound(err) {
+				return nil, nil, fmt.Errorf("fetch recruitment criteria: %w", err)
+			}
+			if err == nil && resp != nil && strings.TrimSpace(resp.Data.ID) != "" {
+				currentID = resp.Data.ID
+				current = resp.Data.Attributes.DeviceFamilyOsVersionFilters
+			}
+		}
+		change := TestFlightSyncChange{
+			Resource:   syncResourceRecruitment,
+			Group:      group.config.Name,
+			GroupID:    group.remoteID,
+			Target:     formatRecruitmentFilters(desired),
+			groupKey:   group.key,
+			filters:    desired,
+			criteriaID: currentID,
+		}
+		switch {
+		case currentID == "" && len(desired) > 0:
+			change.Action = syncActionCreate
+		case currentID != "" && len(desired) == 0:
+			if !opts.prune {
+				continue
+			}
+			change.Action = syncActionDelete
+			change.Target = formatRecruitmentFilters(current)
+		case currentID != "" && formatRecruitmentFilters(current) != formatRecruitmentFilters(desired):
+			change.Action = syncActionUpdate
+			change.Detail = "from " + formatRecruitmentFilters(current)
+		default:
+			continue
+		}
+		changes = append(changes, change)
+	}
+
+	// Undeclared groups, only when the file manages the groups section.
+	if opts.prune && config.Groups != nil {
+		if len(config.Groups) == 0 && len(remoteGroupsResp.Data) > 0 && !opts.allowEmptyGroups {
+			return nil, nil, fmt.Errorf("groups is empty; --prune would delete all %d beta group(s) (use --allow-empty-groups to confirm)", len(remoteGroupsResp.Data))
+		}
+		declared := make(map[string]struct{}, len(groups))
+		for _, group := range groups {
+			if group.remoteID != "" {
+				declared[group.remoteID] = struct{}{}
+			}
+		}
+		for _, remote := range remoteGroupsResp.Data {
+			if _, ok := declared[remote.ID]; ok {
+				continue
+			}
+			changes = append(changes, TestFlightSyncChange{
+				Action:   syncActionDelete,
+				Resource: syncResourceGroup,
+				Group:    remote.Attributes.Name,
+				GroupID:  remote.ID,
+			})
+		}
+	}
+
+	return changes, groups, nil
+}
+
+func matchSyncGroups(configs []TestFlightGroupConfig, remote []asc.Resource[asc.BetaGroupAttributes]) ([]*syncGroupState, error) {
+	byID := make(map[string]*asc.Resource[asc.BetaGroupAttributes], len(remote))
+	byName := make(map[string][]*asc.Resource[asc.BetaGroupAttributes], len(remote))
+	for i := range remote {
+		group := &remote[i]
+		byID[group.ID] = group
+		name := strings.ToLower(strings.TrimSpace(group.Attributes.Name))
+		byName[name] = append(byName[name], group)
+	}
+
+	states := make([]*syncGroupState, 0, len(configs))
+	for _, cfg := range configs {
+		state := &syncGroupState{
+			key:    strings.ToLower(strings.TrimSpace(cfg.Name)),
+			config: cfg,
+		}
+		if id := strings.TrimSpace(cfg.ID); id != "" {
+			match, ok := byID[id]
+			if !ok {
+				return nil, fmt.Errorf("beta group id %q (%s) not found", id, cfg.Name)
+			}
+			state.remote = match
+		} else {
+			matches := byName[state.key]
+			switch len(matches) {
+			case 0:
+			case 1:
+				state.remote = matches[0]
+			default:
+				return nil, fmt.Errorf("multiple beta groups named %q; set id in the file", cfg.Name)
+			}
+		}
+		if state.remote != nil {
+			state.remoteID = state.remote.ID
+			if state.remote.Attributes.IsInternalGroup != cfg.IsInternalGroup {
+				return nil, fmt.Errorf("beta group %q: isInternalGroup cannot be changed after creation", cfg.Name)
+			}
+		}
+		states = append(states, state)
+	}
+	return states, nil
+}
+
+// newSyncGroupRefResolver maps a group reference (ID or name) to its key.
+func newSyncGroupRefResolver(groups []*syncGroupState) func(ref string) (string, error) {
+	lookup := make(map[string]string, len(groups)*2)
+	for _, group := range groups {
+		lookup[group.key] = group.key
+		if group.remoteID != "" {
+			lookup[group.remoteID] = group.key
+		}
+		if id := strings.TrimSpace(group.config.ID); id != "" {
+			lookup[id] = group.key
+		}
+	}
+	return func(ref string) (string, error) {
+		trimmed := strings.TrimSpace(ref)
+		if key, ok := lookup[trimmed]; ok {
+			return key, nil
+		}
+		if key, ok := lookup[strings.ToLower(trimmed)]; ok {
+			return key, nil
+		}
+		return "", fmt.Errorf("group %q is not declared in groups", trimmed)
+	}
+}
+
+func buildsManaged(config *TestFlightConfig) bool {
+	if config.Builds != nil {
+		return true
+	}
+	for _, group := range config.Groups {
+		if group.Builds != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func desiredGroupBuilds(config *TestFlightConfig, resolve func(string) (string, error)) (map[string]map[string]struct{}, error) {
+	desired := make(map[string]map[string]struct{})
+	add := func(groupRef, buildID string) error {
+		key, err := resolve(groupRef)
+		if err != nil {
+			return fmt.Errorf("build %q: %w", buildID, err)
+		}
+		if desired[key] == nil {
+			desired[key] = make(map[string]struct{})
+		}
+		desired[key][strings.TrimSpace(buildID)] = struct{}{}
+		return nil
+	}
+	for _, group := range config.Groups {
+		for _, buildID := range group.Builds {
+			if strings.TrimSpace(buildID) == "" {
+				continue
+			}
+			if err := add(group.Name, buildID); err != nil {
+				return nil, err
+			}
+		}
+	}
+	for _, build := range config.Builds {
+		for _, groupRef := range build.Groups {
+			if err := add(groupRef, build.ID); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return desired, nil
+}
+
+func planSyncTesters(
+	ctx context.Context,
+	client testFlightSyncPushClient,
+	appID string,
+	testers []TestFlightTesterConfig,
+	groups []*syncGroupState,
+	resolveGroup func(string) (string, error),
+	opts testFlightSyncPushOptions,
+) ([]TestFlightSyncChange, error) {
+	// Current memberships, plus an email index of every tester seen.
+	current := make(map[string]map[string]struct{}, len(groups))
+	emailIndex := make(map[string]string)
+	for _, group := range groups {
+		current[group.key] = make(map[string]struct{})
+		if group.remote == nil {
+			continue
+		}
+		resp, err := client.GetBetaGroupTesters(ctx, group.remoteID, asc.WithBetaGroupTestersLimit(200))
+		if err != nil {
+			return nil, fmt.Errorf("fetch beta group testers: %w", err)
+		}
+		all, err := paginateBetaGroupTesters(ctx, client, group.remoteID, resp)
+		if err != nil {
+			return nil, fmt.Errorf("fetch beta group testers: %w", err)
+		}
+		for _, tester := range all.Data {
+			current[group.key][tester.ID] = struct{}{}
+			if email := strings.ToLower(strings.TrimSpace(tester.Attributes.Email)); email != "" {
+				emailIndex[email] = tester.ID
+			}
+		}
+	}
+
+	desired := make(map[string]map[string]struct{}, len(groups))
+	labels := make(map[string]string)
+	changes := make([]TestFlightSyncChange, 0)
+	for _, tester := range testers {
+		groupKeys := make([]string, 0, len(tester.Groups))
+		for _, ref := range tester.Groups {
+			key, err := resolveGroup(ref)
+			if err != nil {
+				return nil, fmt.Errorf("tester %q: %w", testerLabel(tester), err)
+			}
+			groupKeys = append(groupKeys, key)
+		}
+		groupKeys = uniqueSortedStrings(groupKeys)
+
+		remote, err := resolveSyncTester(ctx, client, appID, tester, emailIndex)
+		if err != nil {
+			return nil, err
+		}
+		if remote == nil {
+			firstName, lastName := splitTesterName(tester.Name)
+			changes = append(changes, TestFlightSyncChange{
+				Action:    syncActionCreate,
+				Resource:  syncResourceTester,
+				Target:    strings.TrimSpace(tester.Email),
+				Detail:    "groups: " + strings.Join(groupNamesForKeys(groups, groupKeys), ","),
+				email:     strings.TrimSpace(tester.Email),
+				firstName: firstName,
+				lastName:  lastName,
+				groupKeys: groupKeys,
+			})
+			continue
+		}
+		labels[remote.id] = testerLabel(tester)
+		for _, key := range groupKeys {
+			if desired[key] == nil {
+				desired[key] = make(map[string]struct{})
+			}
+			desired[key][remote.id] = struct{}{}
+		}
+	}
+
+	for _, group := range groups {
+		adds, removes := diffStringSets(desired[group.key], current[group.key])
+		if len(adds) > 0 {
+			changes = append(changes, TestFlightSyncChange{
+				Action:   syncActionAdd,
+				Resource: syncResourceGroupTester,
+				Group:    group.config.Name,
+				GroupID:  group.remoteID,
+				Target:   joinLabels(adds, labels),
+				groupKey: group.key,
+				ids:      adds,
+			})
+		}
+		if opts.prune && len(removes) > 0 {
+			changes = append(changes, TestFlightSyncChange{
+				Action:   syncActionRemove,
+				Resource: syncResourceGroupTester,
+				Group:    group.config.Name,
+				GroupID:  group.remoteID,
+				Target:   joinLabels(removes, labels),
+				groupKey: group.key,
+				ids:      removes,
+			})
+		}
+	}
+	return changes, nil
+}
+
+// resolveSyncTester returns the remote tester for a declared tester, or nil
+// when it does not exist yet and must be invited.
+func resolveSyncTester(ctx context.Context, client testFlightSyncPushClient, appID string, tester TestFlightTesterConfig, emailIndex map[string]string) (*syncRemoteTester, error) {
+	email := strings.TrimSpace(tester.Email)
+	if id := strings.TrimSpace(tester.ID); id != "" {
+		return &syncRemoteTester{id: id, email: email}, nil
+	}
+	if id, ok := emailIndex[strings.ToLower(email)]; ok {
+		return &syncRemoteTester{id: id, email: email}, nil
+	}
+	resp, err := client.GetBetaTesters(ctx, appID, asc.WithBetaTestersEmail(email))
+	if err != nil {
+		return nil, fmt.Errorf("lookup tester %q: %w", email, err)
+	}
+	switch len(resp.Data) {
+	case 0:
+		if !isValidTesterEmail(email) {
+			return nil, fmt.Errorf("tester %q: invalid email", email)
+		}
+		return nil, nil
+	case 1:
+		emailIndex[strings.ToLower(email)] = resp.Data[0].ID
+		return &syncRemoteTester{id: resp.Data[0].ID, email: email}, nil
+	default:
+		return nil, fmt.Errorf("multiple beta testers found for %q", email)
+	}
+}
+
+func applyTestFlightSyncPlan(ctx context.Context, client testFlightSyncPushClient, appID string, groups []*syncGroupState, changes []TestFlightSyncChange) ([]TestFlightSyncChange, error) {
+	groupIDs := make(map[string]string, len(groups))
+	for _, group := range groups {
+		if group.remoteID != "" {
+			groupIDs[group.key] = group.remoteID
+		}
+	}
+	resolveID := func(key string) (string, error) {
+		id := groupIDs[key]
+		if id == "" {
+			return "", fmt.Errorf("beta group %q has no ID", key)
+		}
+		return id, nil
+	}
+
+	applied := make([]TestFlightSyncChange, len(changes))
+	copy(applied, changes)
+	for i := range applied {
+		change := &applied[i]
+		if change.GroupID == "" && change.groupKey != "" {
+			change.GroupID = groupIDs[change.groupKey]
+		}
+
+		var err error
+		switch {
+		case change.Resource == syncResourceGroup && change.Action == syncActionCreate:
+			var resp *asc.BetaGroupResponse
+			resp, err = client.CreateBetaGroupWithAttributes(ctx, appID, change.createAttrs)
+			if err == nil {
+				groupIDs[change.groupKey] = resp.Data.ID
+				change.GroupID = resp.Data.ID
+				if update := postCreateGroupUpdate(change.createAttrs); update != nil {
+					_, err = client.UpdateBetaGroup(ctx, resp.Data.ID, betaGroupUpdateRequest(resp.Data.ID, update))
+				}
+			}
+		case change.Resource == syncResourceGroup && change.Action == syncActionUpdate:
+			_, err = client.UpdateBetaGroup(ctx, change.GroupID, betaGroupUpdateRequest(change.GroupID, change.updateAttrs))
+		case change.Resource == syncResourceGroup && change.Action == syncActionDelete:
+			err = client.DeleteBetaGroup(ctx, change.GroupID)
+		case change.Resource == syncResourceGroupBuild:
+			if change.GroupID, err = resolveID(change.groupKey); err == nil {
+				if change.Action == syncActionAdd {
+					err = client.AddBuildsToBetaGroup(ctx, change.GroupID, change.ids)
+				} else {
+					err = client.RemoveBuildsFromBetaGroup(ctx, change.GroupID, change.ids)
+				}
+			}
+		case change.Resource == syncResourceGroupTester:
+			if change.GroupID, err = resolveID(change.groupKey); err == nil {
+				if change.Action == syncActionAdd {
+					err = client.AddBetaTestersToGroup(ctx, change.GroupID, change.ids)
+				} else {
+					err = client.RemoveBetaTestersFromGroup(ctx, change.GroupID, change.ids)
+				}
+			}
+		case change.Resource == syncResourceTester:
+			ids := make([]string, 0, len(change.groupKeys))
+			for _, key := range change.groupKeys {
+				id, resolveErr := resolveID(key)
+				if resolveErr != nil {
+					err = resolveErr
+					break
+				}
+				ids = append(ids, id)
+			}
+			if err == nil {
+				_, err = client.CreateBetaTester(ctx, change.email, change.firstName, change.lastName, ids)
+			}
+		case change.Resource == syncResourceRecruitment:
+			switch change.Action {
+			case syncActionCreate:
+				if change.GroupID, err = resolveID(change.groupKey); err == nil {
+					_, err = client.CreateBetaRecruitmentCriteria(ctx, change.GroupID, change.filters)
+				}
+			case syncActionUpdate:
+				_, err = client.UpdateBetaRecruitmentCriteria(ctx, change.criteriaID, change.filters)
+			case syncActionDelete:
+				err = client.DeleteBetaRecruitmentCriteria(ctx, change.criteriaID)
+			}
+		default:
+			err = fmt.Errorf("unsupported change %s %s", change.Action, change.Resource)
+		}
+		if err != nil {
+			return applied[:i], fmt.Errorf("%s %s %s: %w", change.Action, change.Resource, firstNonEmpty(change.Group, change.Target), err)
+		}
+	}
+	return applied, nil
+}
+
+func groupCreateAttributes(cfg TestFlightGroupConfig) asc.BetaGroupAttributes {
+	attrs := asc.BetaGroupAttributes{
+		Name:            strings.TrimSpace(cfg.Name),
+		IsInternalGroup: cfg.IsInternalGroup,
+		FeedbackEnabled: cfg.FeedbackEnabled,
+	}
+	if !cfg.IsInternalGroup {
+		attrs.PublicLinkEnabled = cfg.PublicLinkEnabled
+		if cfg.PublicLinkLimit != nil {
+			attrs.PublicLinkLimitEnabled = true
+			attrs.PublicLinkLimit = *cfg.PublicLinkLimit
+		}
+	}
+	return attrs
+}
+
+// postCreateGroupUpdate returns the settings that must be sent after create
+// because false values are dropped from the create payload.
+func postCreateGroupUpdate(attrs asc.BetaGroupAttributes) *asc.BetaGroupUpdateAttributes {
+	if attrs.FeedbackEnabled || attrs.IsInternalGroup {
+		return nil
+	}
+	disabled := false
+	return &asc.BetaGroupUpdateAttributes{FeedbackEnabled: &disabled}
+}
+
+func diffGroupSettings(cfg TestFlightGroupConfig, remote asc.BetaGroupAttributes) (*asc.BetaGroupUpdateAttributes, string) {
+	update := &asc.BetaGroupUpdateAttributes{}
+	details := make([]string, 0, 3)
+	changed := false
+
+	if cfg.FeedbackEnabled != remote.FeedbackEnabled {
+		value := cfg.FeedbackEnabled
+		update.FeedbackEnabled = &value
+		details = append(details, fmt.Sprintf("feedbackEnabled: %t -> %t", remote.FeedbackEnabled, value))
+		changed = true
+	}
+	if !cfg.IsInternalGroup {
+		if cfg.PublicLinkEnabled != remote.PublicLinkEnabled {
+			value := cfg.PublicLinkEnabled
+			update.PublicLinkEnabled = &value
+			details = append(details, fmt.Sprintf("publicLinkEnabled: %t -> %t", remote.PublicLinkEnabled, value))
+			changed = true
+		}
+		remoteLimit := 0
+		if remote.PublicLinkLimitEnabled {
+			remoteLimit = remote.PublicLinkLimit
+		}
+		desiredLimit := 0
+		if cfg.PublicLinkLimit != nil {
+			desiredLimit = *cfg.PublicLinkLimit
+		}
+		if remoteLimit != desiredLimit {
+			enabled := desiredLimit > 0
+			update.PublicLinkLimitEnabled = &enabled
+			update.PublicLinkLimit = desiredLimit
+			details = append(details, fmt.Sprintf("publicLinkLimit: %s -> %s", formatLimit(remoteLimit), formatLimit(desiredLimit)))
+			changed = true
+		}
+	}
+	if !changed {
+		return nil, ""
+	}
+	return update, strings.Join(details, "; ")
+}
+
+func describeGroupSettings(cfg TestFlightGroupConfig) string {
+	parts := []string{
+		fmt.Sprintf("internal: %t", cfg.IsInternalGroup),
+		fmt.Sprintf("feedbackEnabled: %t", cfg.FeedbackEnabled),
+	}
+	if !cfg.IsInternalGroup {
+		parts = append(parts, fmt.Sprintf("publicLinkEnabled: %t", cfg.PublicLinkEnabled))
+		if cfg.PublicLinkLimit != nil {
+			parts = append(parts, fmt.Sprintf("publicLinkLimit: %d", *cfg.PublicLinkLimit))
+		}
+	}
+	return strings.Join(parts, "; ")
+}
+
+func betaGroupUpdateRequest(groupID string, attrs *asc.BetaGroupUpdateAttributes) asc.BetaGroupUpdateRequest {
+	return asc.BetaGroupUpdateRequest{
+		Data: asc.BetaGroupUpdateData{
+			Type:       asc.ResourceTypeBetaGroups,
+			ID:         groupID,
+			Attributes: attrs,
+		},
+	}
+}
+
+func recruitmentFilters(values []TestFlightRecruitmentFilter) []asc.DeviceFamilyOsVersionFilter {
+	filters := make([]asc.DeviceFamilyOsVersionFilter, 0, len(values))
+	for _, value := range values {
+		filters = append(filters, asc.DeviceFamilyOsVersionFilter{
+			DeviceFamily:       asc.DeviceFamily(strings.ToUpper(strings.TrimSpace(value.DeviceFamily))),
+			MinimumOsInclusive: strings.TrimSpace(value.MinimumOsInclusive),
+			MaximumOsInclusive: strings.TrimSpace(value.MaximumOsInclusive),
+		})
+	}
+	return filters
+}
+
+func formatRecruitmentFilters(filters []asc.DeviceFamilyOsVersionFilter) string {
+	if len(filters) == 0 {
+		return ""
+	}
+	parts := make([]string, 0, len(filters))
+	for _, filter := range filters {
+		parts = append(parts, fmt.Sprintf("%s[%s..%s]", filter.DeviceFamily, filter.MinimumOsInclusive, filter.MaximumOsInclusive))
+	}
+	sort.Strings(parts)
+	return strings.Join(parts, ",")
+}
+
+func diffStringSets(desired, current map[string]struct{}) ([]string, []string) {
+	adds := make([]string, 0)
+	for value := range desired {
+		if _, ok := current[value]; !ok {
+			adds = append(adds, value)
+		}
+	}
+	removes := make([]string, 0)
+	for value := range current {
+		if _, ok := desired[value]; !ok {
+			removes = append(removes, value)
+		}
+	}
+	sort.Strings(adds)
+	sort.Strings(removes)
+	return adds, removes
+}
+
+func groupNamesForKeys(groups []*syncGroupState, keys []string) []string {
+	names := make([]string, 0, len(keys))
+	for _, key := range keys {
+		for _, group := range groups {
+			if group.key == key {
+				names = append(names, group.config.Name)
+				break
+			}
+		}
+	}
+	return names
+}
+
+func joinLabels(ids []string, labels map[string]string) string {
+	values := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if label := labels[id]; label != "" && label != id {
+			values = append(values, label)
+			continue
+		}
+		values = append(values, id)
+	}
+	return strings.Join(values, ",")
+}
+
+func testerLabel(tester TestFlightTesterConfig) string {
+	if email := strings.TrimSpace(tester.Email); email != "" {
+		return email
+	}
+	return strings.TrimSpace(tester.ID)
+}
+
+func splitTesterName(name string) (string, string) {
+	fields := strings.Fields(name)
+	switch len(fields) {
+	case 0:
+		return "", ""
+	case 1:
+		return fields[0], ""
+	default:
+		return fields[0], strings.Join(fields[1:], " ")
+	}
+}
+
+func formatLimit(value int) string {
+	if value <= 0 {
+		return "none"
+	}
+	return fmt.Sprintf("%d", value)
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if strings.TrimSpace(value) != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+func testFlightSyncChangeRows(changes []TestFlightSyncChange) [][]string {
+	rows := make([][]string, 0, len(changes))
+	for _, change := range changes {
+		rows = append(rows, []string{
+			change.Action,
+			change.Resource,
+			change.Group,
+			change.GroupID,
+			change.Target,
+			change.Detail,
+		})
+	}
+	return rows
+}
+
+func printTestFlightSyncPushTable(result TestFlightSyncPushResult) error {
+	fmt.Printf("App ID: %s\n", result.AppID)
+	fmt.Printf("File: %s\n", result.File)
+	fmt.Printf("Dry Run: %t\n", result.DryRun)
+	fmt.Printf("Applied: %t\n\n", result.Applied)
+	if result.NoChanges {
+		fmt.Println("No changes. TestFlight configuration is up to date.")
+		return nil
+	}
+	asc.RenderTable([]string{"action", "resource", "group", "groupId", "target", "detail"}, testFlightSyncChangeRows(result.Changes))
+	return nil
+}
+
+func printTestFlightSyncPushMarkdown(result TestFlightSyncPushResult) error {
+	fmt.Printf("**App ID:** %s\n\n", result.AppID)
+	fmt.Printf("**File:** %s\n\n", result.File)
+	fmt.Printf("**Dry Run:** %t\n\n", result.DryRun)
+	fmt.Printf("**Applied:** %t\n\n", result.Applied)
+	if result.NoChanges {
+		fmt.Println("No changes. TestFlight configuration is up to date.")
+		return nil
+	}
+	asc.RenderMarkdown([]string{"action", "resource", "group", "groupId", "target", "detail"}, testFlightSyncChangeRows(result.Changes))
+	return nil
+}
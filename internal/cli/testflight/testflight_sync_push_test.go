@@ -0,0 +1,364 @@
+package testflight
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+type testFlightSyncPushStub struct {
+	testFlightSyncStub
+	testersByEmail map[string]string
+	criteria       map[string]*asc.BetaRecruitmentCriteriaResponse
+	calls          []string
+}
+
+func (s *testFlightSyncPushStub) record(format string, args ...any) {
+	s.calls = append(s.calls, fmt.Sprintf(format, args...))
+}
+
+func (s *testFlightSyncPushStub) GetBetaTesters(ctx context.Context, appID string, opts ...asc.BetaTestersOption) (*asc.BetaTestersResponse, error) {
+	resp := &asc.BetaTestersResponse{}
+	for email, id := range s.testersByEmail {
+		resp.Data = append(resp.Data, asc.Resource[asc.BetaTesterAttributes]{
+			ID:         id,
+			Attributes: asc.BetaTesterAttributes{Email: email},
+		})
+	}
+	if len(opts) == 0 {
+		return resp, nil
+	}
+	// Only email lookups are issued; unknown emails return no data.
+	return &asc.BetaTestersResponse{}, nil
+}
+
+func (s *testFlightSyncPushStub) GetBetaGroupBetaRecruitmentCriteria(ctx context.Context, groupID string) (*asc.BetaRecruitmentCriteriaResponse, error) {
+	if resp, ok := s.criteria[groupID]; ok {
+		return resp, nil
+	}
+	return nil, asc.ErrNotFound
+}
+
+func (s *testFlightSyncPushStub) CreateBetaGroupWithAttributes(ctx context.Context, appID string, attrs asc.BetaGroupAttributes) (*asc.BetaGroupResponse, error) {
+	s.record("create group %s", attrs.Name)
+	return &asc.BetaGroupResponse{Data: asc.Resource[asc.BetaGroupAttributes]{ID: "new-" + strings.ToLower(attrs.Name), Attributes: attrs}}, nil
+}
+
+func (s *testFlightSyncPushStub) UpdateBetaGroup(ctx context.Context, groupID string, req asc.BetaGroupUpdateRequest) (*asc.BetaGroupResponse, error) {
+	s.record("update group %s", groupID)
+	return &asc.BetaGroupResponse{}, nil
+}
+
+func (s *testFlightSyncPushStub) DeleteBetaGroup(ctx context.Context, groupID string) error {
+	s.record("delete group %s", groupID)
+	return nil
+}
+
+func (s *testFlightSyncPushStub) CreateBetaTester(ctx context.Context, email, firstName, lastName string, groupIDs []string) (*asc.BetaTesterResponse, error) {
+	s.record("create tester %s %s", email, strings.Join(groupIDs, ","))
+	return &asc.BetaTesterResponse{}, nil
+}
+
+func (s *testFlightSyncPushStub) AddBetaTestersToGroup(ctx context.Context, groupID string, testerIDs []string) error {
+	s.record("add testers %s %s", groupID, strings.Join(testerIDs, ","))
+	return nil
+}
+
+func (s *testFlightSyncPushStub) RemoveBetaTestersFromGroup(ctx context.Context, groupID string, testerIDs []string) error {
+	s.record("remove testers %s %s", groupID, strings.Join(testerIDs, ","))
+	return nil
+}
+
+func (s *testFlightSyncPushStub) AddBuildsToBetaGroup(ctx context.Context, groupID string, buildIDs []string) error {
+	s.record("add builds %s %s", groupID, strings.Join(buildIDs, ","))
+	return nil
+}
+
+func (s *testFlightSyncPushStub) RemoveBuildsFromBetaGroup(ctx context.Context, groupID string, buildIDs []string) error {
+	s.record("remove builds %s %s", groupID, strings.Join(buildIDs, ","))
+	return nil
+}
+
+func (s *testFlightSyncPushStub) CreateBetaRecruitmentCriteria(ctx context.Context, groupID string, filters []asc.DeviceFamilyOsVersionFilter) (*asc.BetaRecruitmentCriteriaResponse, error) {
+	s.record("create criteria %s %d", groupID, len(filters))
+	return &asc.BetaRecruitmentCriteriaResponse{}, nil
+}
+
+func (s *testFlightSyncPushStub) UpdateBetaRecruitmentCriteria(ctx context.Context, criteriaID string, filters []asc.DeviceFamilyOsVersionFilter) (*asc.BetaRecruitmentCriteriaResponse, error) {
+	s.record("update criteria %s %d", criteriaID, len(filters))
+	return &asc.BetaRecruitmentCriteriaResponse{}, nil
+}
+
+func (s *testFlightSyncPushStub) DeleteBetaRecruitmentCriteria(ctx context.Context, criteriaID string) error {
+	s.record("delete criteria %s", criteriaID)
+	return nil
+}
+
+func newTestFlightSyncPushStub() *testFlightSyncPushStub {
+	return &testFlightSyncPushStub{
+		testFlightSyncStub: testFlightSyncStub{
+			groups: &asc.BetaGroupsResponse{
+				Data: []asc.Resource[asc.BetaGroupAttributes]{
+					{
+						ID: "group-1",
+						Attributes: asc.BetaGroupAttributes{
+							Name:            "Alpha",
+							IsInternalGroup: true,
+							FeedbackEnabled: true,
+						},
+					},
+					{
+						ID: "group-2",
+						Attributes: asc.BetaGroupAttributes{
+							Name:              "Legacy",
+							PublicLinkEnabled: true,
+						},
+					},
+				},
+			},
+			buildsByGroup: map[string]*asc.BuildsResponse{
+				"group-1": {Data: []asc.Resource[asc.BuildAttributes]{{ID: "build-1"}}},
+			},
+			testersByGroup: map[string]*asc.BetaTestersResponse{
+				"group-1": {Data: []asc.Resource[asc.BetaTesterAttributes]{
+					{ID: "tester-1", Attributes: asc.BetaTesterAttributes{Email: "one@example.com"}},
+				}},
+				"group-2": {Data: []asc.Resource[asc.BetaTesterAttributes]{
+					{ID: "tester-9", Attributes: asc.BetaTesterAttributes{Email: "old@example.com"}},
+				}},
+			},
+		},
+		testersByEmail: map[string]string{},
+		criteria:       map[string]*asc.BetaRecruitmentCriteriaResponse{},
+	}
+}
+
+func changeSummaries(changes []TestFlightSyncChange) []string {
+	result := make([]string, 0, len(changes))
+	for _, change := range changes {
+		result = append(result, fmt.Sprintf("%s %s %s %s", change.Action, change.Resource, change.Group, change.Target))
+	}
+	return result
+}
+
+func TestPlanTestFlightSyncPush_NoChangesWhenInSync(t *testing.T) {
+	stub := newTestFlightSyncPushStub()
+	config := &TestFlightConfig{
+		Groups: []TestFlightGroupConfig{
+			{ID: "group-1", Name: "Alpha", IsInternalGroup: true, FeedbackEnabled: true, Builds: []string{"build-1"}},
+			{ID: "group-2", Name: "Legacy", PublicLinkEnabled: true, Builds: []string{}},
+		},
+		Testers: []TestFlightTesterConfig{
+			{ID: "tester-1", Email: "one@example.com", Groups: []string{"group-1"}},
+			{ID: "tester-9", Email: "old@example.com", Groups: []string{"Legacy"}},
+		},
+	}
+
+	changes, _, err := planTestFlightSyncPush(context.Background(), stub, "app-1", config, testFlightSyncPushOptions{prune: true})
+	if err != nil {
+		t.Fatalf("planTestFlightSyncPush() error: %v", err)
+	}
+	if len(changes) != 0 {
+		t.Fatalf("expected no changes, got %v", changeSummaries(changes))
+	}
+}
+
+func TestPlanTestFlightSyncPush_CreatesUpdatesAndAdds(t *testing.T) {
+	stub := newTestFlightSyncPushStub()
+	limit := 50
+	config := &TestFlightConfig{
+		Groups: []TestFlightGroupConfig{
+			{ID: "group-1", Name: "Alpha", IsInternalGroup: true, FeedbackEnabled: true, Builds: []string{"build-1", "build-2"}},
+			{Name: "Legacy", PublicLinkEnabled: true, PublicLinkLimit: &limit, FeedbackEnabled: true},
+			{Name: "Public", PublicLinkEnabled: true, RecruitmentCriteria: []TestFlightRecruitmentFilter{
+				{DeviceFamily: "iphone", MinimumOsInclusive: "17.0"},
+			}},
+		},
+		Testers: []TestFlightTesterConfig{
+			{Email: "one@example.com", Groups: []string{"Alpha", "Public"}},
+			{Email: "new@example.com", Name: "New Tester", Groups: []string{"Public"}},
+		},
+	}
+
+	changes, groups, err := planTestFlightSyncPush(context.Background(), stub, "app-1", config, testFlightSyncPushOptions{})
+	if err != nil {
+		t.Fatalf("planTestFlightSyncPush() error: %v", err)
+	}
+
+	want := []string{
+		"update betaGroup Legacy publicLinkLimit: none -> 50",
+		"create betaGroup Public ",
+		"add groupBuild Alpha build-2",
+		"create betaTester  new@example.com",
+		"add groupTester Public one@example.com",
+		"create recruitmentCriteria Public IPHONE[17.0..]",
+	}
+	got := changeSummaries(changes)
+	// Detail strings carry the update description; compare prefixes only.
+	got[0] = fmt.Sprintf("%s %s %s %s", changes[0].Action, changes[0].Resource, changes[0].Group, "publicLinkLimit: none -> 50")
+	if !strings.Contains(changes[0].Detail, "feedbackEnabled: false -> true") {
+		t.Fatalf("expected feedback update detail, got %q", changes[0].Detail)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected plan:\n got %q\nwant %q", got, want)
+	}
+
+	applied, err := applyTestFlightSyncPlan(context.Background(), stub, "app-1", groups, changes)
+	if err != nil {
+		t.Fatalf("applyTestFlightSyncPlan() error: %v", err)
+	}
+	if len(applied) != len(changes) {
+		t.Fatalf("expected %d applied changes, got %d", len(changes), len(applied))
+	}
+	wantCalls := []string{
+		"update group group-2",
+		"create group Public",
+		"update group new-public",
+		"add builds group-1 build-2",
+		"create tester new@example.com new-public",
+		"add testers new-public tester-1",
+		"create criteria new-public 1",
+	}
+	if !reflect.DeepEqual(stub.calls, wantCalls) {
+		t.Fatalf("unexpected calls:\n got %q\nwant %q", stub.calls, wantCalls)
+	}
+}
+
+func TestPlanTestFlightSyncPush_PruneRemovesUndeclared(t *testing.T) {
+	stub := newTestFlightSyncPushStub()
+	stub.criteria["group-1"] = &asc.BetaRecruitmentCriteriaResponse{
+		Data: asc.Resource[asc.BetaRecruitmentCriteriaAttributes]{ID: "criteria-1"},
+	}
+	config := &TestFlightConfig{
+		Groups: []TestFlightGroupConfig{
+			{ID: "group-1", Name: "Alpha", IsInternalGroup: true, FeedbackEnabled: true, Builds: []string{}, RecruitmentCriteria: []TestFlightRecruitmentFilter{}},
+		},
+		Testers: []TestFlightTesterConfig{},
+	}
+
+	withoutPrune, _, err := planTestFlightSyncPush(context.Background(), stub, "app-1", config, testFlightSyncPushOptions{})
+	if err != nil {
+		t.Fatalf("planTestFlightSyncPush() error: %v", err)
+	}
+	if len(withoutPrune) != 0 {
+		t.Fatalf("expected no changes without prune, got %v", changeSummaries(withoutPrune))
+	}
+
+	changes, _, err := planTestFlightSyncPush(context.Background(), stub, "app-1", config, testFlightSyncPushOptions{prune: true})
+	if err != nil {
+		t.Fatalf("planTestFlightSyncPush() error: %v", err)
+	}
+	want := []string{
+		"remove groupBuild Alpha build-1",
+		"remove groupTester Alpha tester-1",
+		"delete recruitmentCriteria Alpha ",
+		"delete betaGroup Legacy ",
+	}
+	if got := changeSummaries(changes); !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected plan:\n got %q\nwant %q", got, want)
+	}
+}
+
+func TestPlanTestFlightSyncPush_PruneKeepsGroupsWhenSectionMissingOrEmpty(t *testing.T) {
+	stub := newTestFlightSyncPushStub()
+	testersOnly := &TestFlightConfig{
+		Testers: []TestFlightTesterConfig{},
+	}
+
+	changes, _, err := planTestFlightSyncPush(context.Background(), stub, "app-1", testersOnly, testFlightSyncPushOptions{prune: true})
+	if err != nil {
+		t.Fatalf("planTestFlightSyncPush() error: %v", err)
+	}
+	if len(changes) != 0 {
+		t.Fatalf("expected no group deletes without a groups section, got %v", changeSummaries(changes))
+	}
+
+	emptyGroups := &TestFlightConfig{Groups: []TestFlightGroupConfig{}}
+	_, _, err = planTestFlightSyncPush(context.Background(), stub, "app-1", emptyGroups, testFlightSyncPushOptions{prune: true})
+	if err == nil || !strings.Contains(err.Error(), "--allow-empty-groups") {
+		t.Fatalf("expected empty groups refusal, got %v", err)
+	}
+
+	changes, _, err = planTestFlightSyncPush(context.Background(), stub, "app-1", emptyGroups, testFlightSyncPushOptions{prune: true, allowEmptyGroups: true})
+	if err != nil {
+		t.Fatalf("planTestFlightSyncPush() error: %v", err)
+	}
+	want := []string{
+		"delete betaGroup Alpha ",
+		"delete betaGroup Legacy ",
+	}
+	if got := changeSummaries(changes); !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected plan:\n got %q\nwant %q", got, want)
+	}
+}
+
+func TestReadTestFlightConfigYAML_DistinguishesMissingAndEmptyGroups(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "testflight.yaml")
+
+	if err := os.WriteFile(path, []byte("testers: []\n"), 0o600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+	config, err := readTestFlightConfigYAML(path)
+	if err != nil {
+		t.Fatalf("readTestFlightConfigYAML() error: %v", err)
+	}
+	if config.Groups != nil {
+		t.Fatalf("expected nil groups when the key is missing, got %#v", config.Groups)
+	}
+
+	if err := os.WriteFile(path, []byte("groups: []\n"), 0o600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+	config, err = readTestFlightConfigYAML(path)
+	if err != nil {
+		t.Fatalf("readTestFlightConfigYAML() error: %v", err)
+	}
+	if config.Groups == nil || len(config.Groups) != 0 {
+		t.Fatalf("expected empty non-nil groups, got %#v", config.Groups)
+	}
+}
+
+func TestPlanTestFlightSyncPush_RejectsInternalGroupChange(t *testing.T) {
+	stub := newTestFlightSyncPushStub()
+	config := &TestFlightConfig{
+		Groups: []TestFlightGroupConfig{{ID: "group-1", Name: "Alpha"}},
+	}
+
+	_, _, err := planTestFlightSyncPush(context.Background(), stub, "app-1", config, testFlightSyncPushOptions{})
+	if err == nil || !strings.Contains(err.Error(), "isInternalGroup cannot be changed") {
+		t.Fatalf("expected internal group error, got %v", err)
+	}
+}
+
+func TestReadTestFlightConfigYAML_ValidatesReferences(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "testflight.yaml")
+	content := `app:
+  id: app-1
+groups:
+  - name: Alpha
+  - name: alpha
+`
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+
+	_, err := readTestFlightConfigYAML(path)
+	if err == nil || !strings.Contains(err.Error(), "duplicate group name") {
+		t.Fatalf("expected duplicate group error, got %v", err)
+	}
+
+	if err := os.WriteFile(path, []byte("groups:\n  - name: Alpha\n    unknownField: true\n"), 0o600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+	if _, err := readTestFlightConfigYAML(path); err == nil {
+		t.Fatal("expected unknown field error")
+	}
+}
@@ -29,8 +29,8 @@ var clientFactory = shared.GetASCClient
 func ValidateCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("validate", flag.ExitOnError)
 
-	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID)")
-	versionID := fs.String("version-id", "", "App Store version ID (required)")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (or ASC_APP_ID)")
+	versionID := shared.ReferenceFlag(fs, shared.ReferenceVersionID, "", "App Store version ID or version string like 1.2.3 (required)")
 	platform := fs.String("platform", "", "Platform: IOS, MAC_OS, TV_OS, VISION_OS")
 	strict := fs.Bool("strict", false, "Treat warnings as errors (exit non-zero)")
 	output := shared.BindOutputFlags(fs)
@@ -117,61 +117,80 @@ func runValidate(ctx context.Context, opts validateOptions) error {
 	requestCtx, cancel := shared.ContextWithTimeout(ctx)
 	defer cancel()
 
-	versionResp, err := client.GetAppStoreVersion(requestCtx, opts.VersionID)
+	report, err := BuildReport(requestCtx, client, opts.AppID, opts.VersionID, opts.Platform, opts.Strict)
 	if err != nil {
-		return fmt.Errorf("validate: failed to fetch app store version: %w", err)
+		return err
+	}
+
+	if err := shared.PrintOutput(report, opts.Output, opts.Pretty); err != nil {
+		return err
+	}
+
+	if report.Summary.Blocking > 0 {
+		return shared.NewReportedError(fmt.Errorf("validate: found %d blocking issue(s)", report.Summary.Blocking))
 	}
 
-	appResp, err := client.GetApp(requestCtx, opts.AppID)
+	return nil
+}
+
+// BuildReport fetches everything App Store review readiness depends on and
+// runs the validation checks for one App Store version.
+func BuildReport(ctx context.Context, client *asc.Client, appID, versionID, platform string, strict bool) (*validation.Report, error) {
+	versionResp, err := client.GetAppStoreVersion(ctx, versionID)
 	if err != nil {
-		return fmt.Errorf("validate: failed to fetch app: %w", err)
+		return nil, fmt.Errorf("validate: failed to fetch app store version: %w", err)
 	}
 
-	versionLocsResp, err := client.GetAppStoreVersionLocalizations(requestCtx, opts.VersionID)
+	appResp, err := client.GetApp(ctx, appID)
 	if err != nil {
-		return fmt.Errorf("validate: failed to fetch version localizations: %w", err)
+		return nil, fmt.Errorf("validate: failed to fetch app: %w", err)
 	}
 
-	appInfosResp, err := client.GetAppInfos(requestCtx, opts.AppID)
+	versionLocsResp, err := client.GetAppStoreVersionLocalizations(ctx, versionID)
 	if err != nil {
-		return fmt.Errorf("validate: failed to fetch app info: %w", err)
+		return nil, fmt.Errorf("validate: failed to fetch version localizations: %w", err)
+	}
+
+	appInfosResp, err := client.GetAppInfos(ctx, appID)
+	if err != nil {
+		return nil, fmt.Errorf("validate: failed to fetch app info: %w", err)
 	}
 
 	appInfoID := shared.SelectBestAppInfoID(appInfosResp)
 	if strings.TrimSpace(appInfoID) == "" {
-		return fmt.Errorf("validate: failed to select app info for app")
+		return nil, fmt.Errorf("validate: failed to select app info for app")
 	}
 
-	appInfoLocsResp, err := client.GetAppInfoLocalizations(requestCtx, appInfoID)
+	appInfoLocsResp, err := client.GetAppInfoLocalizations(ctx, appInfoID)
 	if err != nil {
-		return fmt.Errorf("validate: failed to fetch app info localizations: %w", err)
+		return nil, fmt.Errorf("validate: failed to fetch app info localizations: %w", err)
 	}
 
 	primaryCategoryID := ""
-	primaryCategoryResp, err := client.GetAppInfoPrimaryCategoryRelationship(requestCtx, appInfoID)
+	primaryCategoryResp, err := client.GetAppInfoPrimaryCategoryRelationship(ctx, appInfoID)
 	if err != nil {
 		if !asc.IsNotFound(err) {
-			return fmt.Errorf("validate: failed to fetch app primary category: %w", err)
+			return nil, fmt.Errorf("validate: failed to fetch app primary category: %w", err)
 		}
 	} else {
 		primaryCategoryID = primaryCategoryResp.Data.ID
 	}
 
 	var ageRatingDecl *validation.AgeRatingDeclaration
-	ageRatingResp, err := client.GetAgeRatingDeclarationForAppStoreVersion(requestCtx, opts.VersionID)
+	ageRatingResp, err := client.GetAgeRatingDeclarationForAppStoreVersion(ctx, versionID)
 	if err != nil {
 		if !asc.IsNotFound(err) {
-			return fmt.Errorf("validate: failed to fetch age rating declaration: %w", err)
+			return nil, fmt.Errorf("validate: failed to fetch age rating declaration: %w", err)
 		}
 	} else {
 		ageRatingDecl = mapAgeRatingDeclaration(ageRatingResp.Data.Attributes)
 	}
 
 	var reviewDetails *validation.ReviewDetails
-	reviewDetailsResp, err := client.GetAppStoreReviewDetailForVersion(requestCtx, opts.VersionID)
+	reviewDetailsResp, err := client.GetAppStoreReviewDetailForVersion(ctx, versionID)
 	if err != nil {
 		if !asc.IsNotFound(err) {
-			return fmt.Errorf("validate: failed to fetch review details: %w", err)
+			return nil, fmt.Errorf("validate: failed to fetch review details: %w", err)
 		}
 	} else {
 		attrs := reviewDetailsResp.Data.Attributes
@@ -189,10 +208,10 @@ func runValidate(ctx context.Context, opts validateOptions) error {
 	}
 
 	var attachedBuild *validation.Build
-	buildResp, err := client.GetAppStoreVersionBuild(requestCtx, opts.VersionID)
+	buildResp, err := client.GetAppStoreVersionBuild(ctx, versionID)
 	if err != nil {
 		if !asc.IsNotFound(err) {
-			return fmt.Errorf("validate: failed to fetch attached build: %w", err)
+			return nil, fmt.Errorf("validate: failed to fetch attached build: %w", err)
 		}
 	} else if strings.TrimSpace(buildResp.Data.ID) != "" {
 		attrs := buildResp.Data.Attributes
@@ -205,10 +224,10 @@ func runValidate(ctx context.Context, opts validateOptions) error {
 	}
 
 	priceScheduleID := ""
-	priceScheduleResp, err := client.GetAppPriceSchedule(requestCtx, opts.AppID)
+	priceScheduleResp, err := client.GetAppPriceSchedule(ctx, appID)
 	if err != nil {
 		if !asc.IsNotFound(err) {
-			return fmt.Errorf("validate: failed to fetch app price schedule: %w", err)
+			return nil, fmt.Errorf("validate: failed to fetch app price schedule: %w", err)
 		}
 	} else {
 		priceScheduleID = priceScheduleResp.Data.ID
@@ -216,13 +235,13 @@ func runValidate(ctx context.Context, opts validateOptions) error {
 
 	availabilityID := ""
 	availableTerritories := 0
-	availabilityResp, err := client.GetAppAvailabilityV2(requestCtx, opts.AppID)
+	availabilityResp, err := client.GetAppAvailabilityV2(ctx, appID)
 	if err != nil {
 		// ASC can report missing app availability with non-404 errors
 		// (e.g. "resource does not exist"). Treat those as "missing" rather than
 		// aborting validation.
 		if !shared.IsAppAvailabilityMissing(err) {
-			return fmt.Errorf("validate: failed to fetch app availability: %w", err)
+			return nil, fmt.Errorf("validate: failed to fetch app availability: %w", err)
 		}
 	} else {
 		availabilityID = availabilityResp.Data.ID
@@ -231,12 +250,12 @@ func runValidate(ctx context.Context, opts validateOptions) error {
 			for {
 				var territoryResp *asc.TerritoryAvailabilitiesResponse
 				if strings.TrimSpace(nextURL) != "" {
-					territoryResp, err = client.GetTerritoryAvailabilities(requestCtx, availabilityID, asc.WithTerritoryAvailabilitiesNextURL(nextURL))
+					territoryResp, err = client.GetTerritoryAvailabilities(ctx, availabilityID, asc.WithTerritoryAvailabilitiesNextURL(nextURL))
 				} else {
-					territoryResp, err = client.GetTerritoryAvailabilities(requestCtx, availabilityID, asc.WithTerritoryAvailabilitiesLimit(200))
+					territoryResp, err = client.GetTerritoryAvailabilities(ctx, availabilityID, asc.WithTerritoryAvailabilitiesLimit(200))
 				}
 				if err != nil {
-					return fmt.Errorf("validate: failed to fetch territory availabilities: %w", err)
+					return nil, fmt.Errorf("validate: failed to fetch territory availabilities: %w", err)
 				}
 
 				for _, territoryAvailability := range territoryResp.Data {
@@ -283,20 +302,19 @@ func runValidate(ctx context.Context, opts validateOptions) error {
 		})
 	}
 
-	screenshotSets, err := fetchScreenshotSets(requestCtx, client, versionLocsResp.Data)
+	screenshotSets, err := fetchScreenshotSets(ctx, client, versionLocsResp.Data)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	platform := opts.Platform
 	if platform == "" {
 		platform = string(versionResp.Data.Attributes.Platform)
 	}
 
 	report := validation.Validate(validation.Input{
-		AppID:                opts.AppID,
+		AppID:                appID,
 		AppInfoID:            appInfoID,
-		VersionID:            opts.VersionID,
+		VersionID:            versionID,
 		VersionString:        versionResp.Data.Attributes.VersionString,
 		Platform:             platform,
 		PrimaryLocale:        appResp.Data.Attributes.PrimaryLocale,
@@ -310,17 +328,9 @@ func runValidate(ctx context.Context, opts validateOptions) error {
 		AvailableTerritories: availableTerritories,
 		ScreenshotSets:       screenshotSets,
 		AgeRatingDeclaration: ageRatingDecl,
-	}, opts.Strict)
-
-	if err := shared.PrintOutput(&report, opts.Output, opts.Pretty); err != nil {
-		return err
-	}
+	}, strict)
 
-	if report.Summary.Blocking > 0 {
-		return shared.NewReportedError(fmt.Errorf("validate: found %d blocking issue(s)", report.Summary.Blocking))
-	}
-
-	return nil
+	return &report, nil
 }
 
 func fetchScreenshotSets(ctx context.Context, client *asc.Client, localizations []asc.Resource[asc.AppStoreVersionLocalizationAttributes]) ([]validation.ScreenshotSet, error) {
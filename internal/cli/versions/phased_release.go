@@ -37,19 +37,22 @@ func PhasedReleaseCommand() *ffcli.Command {
 Phased release gradually rolls out your app update over 7 days:
   Day 1: 1%, Day 2: 2%, Day 3: 5%, Day 4: 10%, Day 5: 20%, Day 6: 50%, Day 7: 100%
 
-You can pause, resume, or complete the rollout at any time.
+You can pause, resume, or complete the rollout at any time, or let "guard"
+do it based on crash, rating and custom health checks.
 
 Examples:
   asc versions phased-release get --version-id "VERSION_ID"
   asc versions phased-release create --version-id "VERSION_ID"
   asc versions phased-release update --id "PHASED_ID" --state PAUSED
-  asc versions phased-release delete --id "PHASED_ID" --confirm`,
+  asc versions phased-release delete --id "PHASED_ID" --confirm
+  asc versions phased-release guard --app "APP_ID" --version-id "VERSION_ID" --max-crashes 5`,
 		UsageFunc: shared.DefaultUsageFunc,
 		Subcommands: []*ffcli.Command{
 			PhasedReleaseGetCommand(),
 			PhasedReleaseCreateCommand(),
 			PhasedReleaseUpdateCommand(),
 			PhasedReleaseDeleteCommand(),
+			PhasedReleaseGuardCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return flag.ErrHelp
@@ -61,7 +64,7 @@ Examples:
 func PhasedReleaseGetCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("phased-release get", flag.ExitOnError)
 
-	versionID := fs.String("version-id", "", "App Store version ID (required)")
+	versionID := shared.ReferenceFlag(fs, shared.ReferenceVersionID, "", "App Store version ID or version string like 1.2.3 (required)")
 	output := shared.BindOutputFlags(fs)
 
 	return &ffcli.Command{
@@ -103,7 +106,7 @@ Examples:
 func PhasedReleaseCreateCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("phased-release create", flag.ExitOnError)
 
-	versionID := fs.String("version-id", "", "App Store version ID (required)")
+	versionID := shared.ReferenceFlag(fs, shared.ReferenceVersionID, "", "App Store version ID or version string like 1.2.3 (required)")
 	state := fs.String("state", "", "Initial state: INACTIVE, ACTIVE (optional, defaults to INACTIVE)")
 	output := shared.BindOutputFlags(fs)
 
@@ -0,0 +1,214 @@
+package versions
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/notify"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/itunes"
+)
+
+const (
+	phasedGuardDefaultInterval = time.Hour
+	phasedGuardMinInterval     = time.Minute
+	phasedGuardDefaultWindow   = 24 * time.Hour
+
+	phasedGuardDefaultCheckTimeout = 5 * time.Minute
+)
+
+// PhasedReleaseGuardCommand returns the guard subcommand.
+func PhasedReleaseGuardCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("phased-release guard", flag.ExitOnError)
+
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (or ASC_APP_ID env; required for crash and rating checks)")
+	versionID := shared.ReferenceFlag(fs, shared.ReferenceVersionID, "", "App Store version ID or version string like 1.2.3 (required)")
+	maxCrashes := fs.Int("max-crashes", 0, "Pause when more crashes than this were reported within --window")
+	minReviewRating := fs.Float64("min-review-rating", 0, "Pause when the average customer review rating within --window drops below this")
+	maxRatingDrop := fs.Float64("max-rating-drop", 0, "Pause when the current version's App Store rating is this many stars below the baseline")
+	country := fs.String("country", "us", "Storefront country code for App Store ratings")
+	check := fs.String("check", "", "Shell command run as a custom health check; a non-zero exit pauses")
+	checkTimeout := fs.Duration("check-timeout", phasedGuardDefaultCheckTimeout, "Timeout for the --check command; a timed-out command counts as unevaluated")
+	window := fs.Duration("window", phasedGuardDefaultWindow, "Lookback window for crash and review checks")
+	completeAfterDay := fs.Int("complete-after-day", 0, "Complete the rollout early from this day (1-7) when all checks pass")
+	notifyChannel := fs.String("notify", "", "Send pause and complete decisions through asc notify: "+strings.Join(notify.Channels, ", "))
+	interval := fs.Duration("interval", phasedGuardDefaultInterval, "Evaluation interval (minimum 1m)")
+	once := fs.Bool("once", false, "Evaluate once and exit (for cron)")
+	dryRun := fs.Bool("dry-run", false, "Log decisions without changing the phased release")
+	stateFile := fs.String("state-file", "", "Path to the guard state file (default: .asc/phased-release-guard/VERSION_ID.json)")
+
+	return &ffcli.Command{
+		Name:       "guard",
+		ShortUsage: "asc versions phased-release guard --version-id VERSION_ID [checks] [flags]",
+		ShortHelp:  "Pause or complete a phased release based on health checks.",
+		LongHelp: `Pause or complete a phased release based on health checks.
+
+Evaluates health signals while a phased release is ACTIVE and pauses the
+rollout as soon as a threshold is breached. With --complete-after-day, a
+rollout that reaches that day with every check passing is released to all
+users. Paused and inactive releases are left alone; the guard exits once the
+release is COMPLETE.
+
+Checks (at least one is required):
+  --max-crashes        TestFlight crash reports within --window (see "asc crashes")
+  --min-review-rating  Average customer review rating within --window
+  --max-rating-drop    Current-version App Store rating vs. the baseline
+                       recorded in the state file on the first run
+  --check              Custom command; receives ASC_APP_ID, ASC_VERSION_ID,
+                       ASC_PHASED_RELEASE_ID and ASC_PHASED_RELEASE_DAY, and
+                       is stopped after --check-timeout
+
+A check that cannot be evaluated (for example an API error or a timed-out
+--check command) never triggers an action. Without --once, network errors,
+rate limits and server errors are retried on the next interval; a missing
+version, an authentication failure or an unusable state file stops the guard. Every decision is printed to stdout as a JSON line. --notify sends
+pause and complete decisions through an "asc notify" channel configured by
+its environment variables (ASC_SLACK_WEBHOOK, ASC_TEAMS_WEBHOOK,
+ASC_DISCORD_WEBHOOK, ASC_NOTIFY_WEBHOOK_URL, or ASC_SMTP_HOST, ASC_SMTP_FROM
+and ASC_SMTP_TO for email).
+
+Examples:
+  asc versions phased-release guard --app "APP_ID" --version-id "VERSION_ID" --max-crashes 5
+  asc versions phased-release guard --app "APP_ID" --version-id "VERSION_ID" --max-rating-drop 0.3 --complete-after-day 5 --notify slack
+  asc versions phased-release guard --version-id "VERSION_ID" --check "./scripts/health.sh" --once
+  asc versions phased-release guard --app "APP_ID" --version-id "VERSION_ID" --min-review-rating 3.5 --dry-run --once`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			if len(args) > 0 {
+				return shared.UsageError("phased-release guard does not accept positional arguments")
+			}
+			version := strings.TrimSpace(*versionID)
+			if version == "" {
+				return shared.UsageError("--version-id is required")
+			}
+
+			visited := map[string]bool{}
+			fs.Visit(func(f *flag.Flag) {
+				visited[f.Name] = true
+			})
+
+			thresholds := phasedGuardThresholds{
+				window:           *window,
+				country:          strings.ToLower(strings.TrimSpace(*country)),
+				check:            strings.TrimSpace(*check),
+				checkTimeout:     *checkTimeout,
+				completeAfterDay: *completeAfterDay,
+			}
+			if visited["max-crashes"] {
+				if *maxCrashes < 0 {
+					return shared.UsageError("--max-crashes must be 0 or greater")
+				}
+				thresholds.maxCrashes = maxCrashes
+			}
+			if visited["min-review-rating"] {
+				if *minReviewRating < 1 || *minReviewRating > 5 {
+					return shared.UsageError("--min-review-rating must be between 1 and 5")
+				}
+				thresholds.minReviewRating = minReviewRating
+			}
+			if visited["max-rating-drop"] {
+				if *maxRatingDrop <= 0 || *maxRatingDrop > 4 {
+					return shared.UsageError("--max-rating-drop must be greater than 0 and at most 4")
+				}
+				thresholds.maxRatingDrop = maxRatingDrop
+			}
+			if !thresholds.hasChecks() {
+				return shared.UsageError("at least one check is required (--max-crashes, --min-review-rating, --max-rating-drop or --check)")
+			}
+			if *completeAfterDay < 0 || *completeAfterDay > 7 {
+				return shared.UsageError("--complete-after-day must be between 1 and 7")
+			}
+			if *checkTimeout <= 0 {
+				return shared.UsageError("--check-timeout must be greater than 0")
+			}
+			if *window <= 0 {
+				return shared.UsageError("--window must be greater than 0")
+			}
+			if *interval < phasedGuardMinInterval {
+				return shared.UsageErrorf("--interval must be at least %s", phasedGuardMinInterval)
+			}
+
+			resolvedAppID := shared.ResolveAppID(*appID)
+			if resolvedAppID == "" && thresholds.needsApp() {
+				return shared.UsageError("--app is required for --max-crashes, --min-review-rating and --max-rating-drop (or set ASC_APP_ID)")
+			}
+
+			channel := strings.TrimSpace(*notifyChannel)
+			if channel != "" {
+				if err := notify.ValidateChannel(channel); err != nil {
+					return shared.UsageError(err.Error())
+				}
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("phased-release guard: %w", err)
+			}
+
+			itunesClient := itunes.NewClient()
+			guard := &phasedReleaseGuard{
+				client:     client,
+				ratings:    itunesClient.GetRatings,
+				appID:      resolvedAppID,
+				versionID:  version,
+				thresholds: thresholds,
+				dryRun:     *dryRun,
+				statePath:  resolvePhasedGuardStatePath(*stateFile, version),
+				stdout:     os.Stdout,
+			}
+			if channel != "" {
+				guard.notify = func(ctx context.Context, msg notify.Message) error {
+					return notify.Send(ctx, channel, msg)
+				}
+			}
+
+			if *once {
+				if _, err := guard.evaluate(ctx); err != nil {
+					return fmt.Errorf("phased-release guard: %w", err)
+				}
+				return nil
+			}
+
+			fmt.Fprintf(os.Stderr, "Guarding phased release for version %s every %s\n", version, *interval)
+			_, err = asc.PollUntil(ctx, *interval, func(ctx context.Context) (struct{}, bool, error) {
+				done, err := guard.evaluate(ctx)
+				if err != nil {
+					if ctx.Err() != nil {
+						return struct{}{}, false, ctx.Err()
+					}
+					if !isPhasedGuardTransientError(err) {
+						return struct{}{}, false, err
+					}
+					// Transient API errors should not stop a long-running guard.
+					fmt.Fprintf(os.Stderr, "phased-release guard: evaluation failed, retrying in %s: %v\n", *interval, err)
+					return struct{}{}, false, nil
+				}
+				return struct{}{}, done, nil
+			})
+			if errors.Is(err, context.Canceled) {
+				return nil
+			}
+			if err != nil {
+				return fmt.Errorf("phased-release guard: %w", err)
+			}
+			return nil
+		},
+	}
+}
+
+func resolvePhasedGuardStatePath(value, versionID string) string {
+	if trimmed := strings.TrimSpace(value); trimmed != "" {
+		return filepath.Clean(trimmed)
+	}
+	return filepath.Join(".asc", "phased-release-guard", versionID+".json")
+}
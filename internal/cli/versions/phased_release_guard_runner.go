@@ -0,0 +1,534 @@
+package versions
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/notify"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/itunes"
+)
+
+// phasedGuardFetchLimit is the page size for crash and review fetches.
+const phasedGuardFetchLimit = 200
+
+// phasedGuardCommandWaitDelay bounds how long a timed-out --check command's
+// output pipes are drained after it is killed.
+const phasedGuardCommandWaitDelay = 5 * time.Second
+
+// Guard actions recorded in decisions.
+const (
+	phasedGuardActionNone     = "none"
+	phasedGuardActionPause    = "pause"
+	phasedGuardActionComplete = "complete"
+)
+
+var phasedGuardExecCommand = func(ctx context.Context, command string) *exec.Cmd {
+	return exec.CommandContext(ctx, "sh", "-c", command)
+}
+
+// phasedGuardClient is the subset of the App Store Connect client used by the guard.
+type phasedGuardClient interface {
+	GetAppStoreVersionPhasedRelease(ctx context.Context, versionID string) (*asc.AppStoreVersionPhasedReleaseResponse, error)
+	UpdateAppStoreVersionPhasedRelease(ctx context.Context, phasedReleaseID string, state asc.PhasedReleaseState) (*asc.AppStoreVersionPhasedReleaseResponse, error)
+	GetCrashes(ctx context.Context, appID string, opts ...asc.CrashOption) (*asc.CrashesResponse, error)
+	GetReviews(ctx context.Context, appID string, opts ...asc.ReviewOption) (*asc.ReviewsResponse, error)
+}
+
+// phasedGuardThresholds holds the configured checks; nil pointers are disabled.
+type phasedGuardThresholds struct {
+	maxCrashes       *int
+	minReviewRating  *float64
+	maxRatingDrop    *float64
+	check            string
+	checkTimeout     time.Duration
+	window           time.Duration
+	country          string
+	completeAfterDay int
+}
+
+func (t phasedGuardThresholds) hasChecks() bool {
+	return t.maxCrashes != nil || t.minReviewRating != nil || t.maxRatingDrop != nil || t.check != ""
+}
+
+func (t phasedGuardThresholds) needsApp() bool {
+	return t.maxCrashes != nil || t.minReviewRating != nil || t.maxRatingDrop != nil
+}
+
+// phasedGuardDecision is one evaluation, printed as a JSON line.
+type phasedGuardDecision struct {
+	Timestamp       string             `json:"timestamp"`
+	AppID           string             `json:"appId,omitempty"`
+	VersionID       string             `json:"versionId"`
+	PhasedReleaseID string             `json:"phasedReleaseId"`
+	State           string             `json:"state"`
+	Day             int                `json:"currentDayNumber"`
+	Healthy         bool               `json:"healthy"`
+	Checks          []phasedGuardCheck `json:"checks"`
+	Action          string             `json:"action"`
+	Applied         bool               `json:"applied"`
+	DryRun          bool               `json:"dryRun,omitempty"`
+	Reason          string             `json:"reason"`
+}
+
+// phasedGuardCheck is the outcome of one health check.
+type phasedGuardCheck struct {
+	Name      string  `json:"name"`
+	Passed    bool    `json:"passed"`
+	Skipped   bool    `json:"skipped,omitempty"`
+	Value     float64 `json:"value"`
+	Threshold float64 `json:"threshold"`
+	Detail    string  `json:"detail"`
+	Error     string  `json:"error,omitempty"`
+}
+
+// phasedGuardState is persisted between runs so rating drops compare
+// against the rating seen before the rollout progressed.
+type phasedGuardState struct {
+	VersionID        string   `json:"versionId"`
+	BaselineRating   *float64 `json:"baselineRating,omitempty"`
+	BaselineRecorded string   `json:"baselineRecordedAt,omitempty"`
+	LastDecision     string   `json:"lastDecision,omitempty"`
+	UpdatedAt        string   `json:"updatedAt"`
+}
+
+type phasedReleaseGuard struct {
+	client     phasedGuardClient
+	ratings    func(ctx context.Context, appID, country string) (*itunes.AppRatings, error)
+	notify     func(ctx context.Context, msg notify.Message) error
+	appID      string
+	versionID  string
+	thresholds phasedGuardThresholds
+	dryRun     bool
+	statePath  string
+	stdout     io.Writer
+	now        func() time.Time
+}
+
+func (g *phasedReleaseGuard) currentTime() time.Time {
+	if g.now != nil {
+		return g.now()
+	}
+	return time.Now()
+}
+
+// evaluate runs one guard cycle and reports whether the rollout is finished.
+func (g *phasedReleaseGuard) evaluate(ctx context.Context) (bool, error) {
+	state, err := g.loadState()
+	if err != nil {
+		return false, phasedGuardStateError{err: err}
+	}
+
+	fetchCtx, cancel := shared.ContextWithTimeout(ctx)
+	phased, err := g.client.GetAppStoreVersionPhasedRelease(fetchCtx, g.versionID)
+	cancel()
+	if err != nil {
+		return false, fmt.Errorf("fetch phased release: %w", err)
+	}
+	attrs := phased.Data.Attributes
+	decision := phasedGuardDecision{
+		Timestamp:       g.currentTime().UTC().Format(time.RFC3339),
+		AppID:           g.appID,
+		VersionID:       g.versionID,
+		PhasedReleaseID: phased.Data.ID,
+		State:           string(attrs.PhasedReleaseState),
+		Day:             attrs.CurrentDayNumber,
+		Checks:          []phasedGuardCheck{},
+		Action:          phasedGuardActionNone,
+		DryRun:          g.dryRun,
+	}
+
+	done := false
+	switch attrs.PhasedReleaseState {
+	case asc.PhasedReleaseStateComplete:
+		decision.Reason = "phased release is COMPLETE"
+		done = true
+	case asc.PhasedReleaseStateActive:
+		decision.Checks = g.runChecks(ctx, &state, decision)
+		g.decide(&decision)
+	default:
+		decision.Reason = fmt.Sprintf("phased release is %s; nothing to guard", shared.OrNA(string(attrs.PhasedReleaseState)))
+	}
+
+	if decision.Action != phasedGuardActionNone && !g.dryRun {
+		target := asc.PhasedReleaseStatePaused
+		if decision.Action == phasedGuardActionComplete {
+			target = asc.PhasedReleaseStateComplete
+			done = true
+		}
+		// The checks may have used most of a shared deadline, so the update
+		// gets its own.
+		updateCtx, cancel := shared.ContextWithTimeout(ctx)
+		_, err := g.client.UpdateAppStoreVersionPhasedRelease(updateCtx, phased.Data.ID, target)
+		cancel()
+		if err != nil {
+			decision.Reason += fmt.Sprintf("; update failed: %v", err)
+			g.print(decision)
+			return false, fmt.Errorf("set phased release to %s: %w", target, err)
+		}
+		decision.Applied = true
+	}
+
+	g.print(decision)
+	if decision.Action != phasedGuardActionNone {
+		g.sendNotification(ctx, decision)
+	}
+
+	state.LastDecision = decision.Action
+	if err := g.saveState(state); err != nil {
+		return done, phasedGuardStateError{err: err}
+	}
+	return done, nil
+}
+
+// decide picks the action: any failed check pauses, an unevaluated check
+// blocks every action, and an all-green rollout may complete early.
+func (g *phasedReleaseGuard) decide(decision *phasedGuardDecision) {
+	var failed, errored []string
+	for _, check := range decision.Checks {
+		switch {
+		case check.Error != "":
+			errored = append(errored, check.Name)
+		case !check.Passed:
+			failed = append(failed, check.Name+": "+check.Detail)
+		}
+	}
+	decision.Healthy = len(failed) == 0 && len(errored) == 0
+
+	switch {
+	case len(failed) > 0:
+		decision.Action = phasedGuardActionPause
+		decision.Reason = "threshold breached: " + strings.Join(failed, "; ")
+	case len(errored) > 0:
+		decision.Reason = "could not evaluate " + strings.Join(errored, ", ") + "; no action taken"
+	case g.thresholds.completeAfterDay > 0 && decision.Day >= g.thresholds.completeAfterDay:
+		decision.Action = phasedGuardActionComplete
+		decision.Reason = fmt.Sprintf("all checks passed on day %d (complete after day %d)", decision.Day, g.thresholds.completeAfterDay)
+	default:
+		decision.Reason = "all checks passed"
+	}
+}
+
+// runChecks evaluates each configured check under its own deadline: the API
+// checks use the request timeout and the command uses --check-timeout.
+func (g *phasedReleaseGuard) runChecks(ctx context.Context, state *phasedGuardState, decision phasedGuardDecision) []phasedGuardCheck {
+	since := g.currentTime().Add(-g.thresholds.window)
+	var checks []phasedGuardCheck
+	if g.thresholds.maxCrashes != nil {
+		checks = append(checks, g.checkCrashes(ctx, since))
+	}
+	if g.thresholds.minReviewRating != nil {
+		checks = append(checks, g.checkReviewRating(ctx, since))
+	}
+	if g.thresholds.maxRatingDrop != nil {
+		checks = append(checks, g.checkRatingDrop(ctx, state))
+	}
+	if g.thresholds.check != "" {
+		checks = append(checks, g.checkCommand(ctx, decision))
+	}
+	return checks
+}
+
+func (g *phasedReleaseGuard) checkCrashes(ctx context.Context, since time.Time) phasedGuardCheck {
+	limit := *g.thresholds.maxCrashes
+	check := phasedGuardCheck{Name: "crashes", Threshold: float64(limit)}
+	ctx, cancel := shared.ContextWithTimeout(ctx)
+	defer cancel()
+	count, err := g.countCrashesSince(ctx, since)
+	if err != nil {
+		check.Error = err.Error()
+		return check
+	}
+	check.Value = float64(count)
+	check.Passed = count <= limit
+	check.Detail = fmt.Sprintf("%d crashes in the last %s (max %d)", count, g.thresholds.window, limit)
+	return check
+}
+
+// countCrashesSince pages through crashes, newest first, until it reaches one
+// created before since.
+func (g *phasedReleaseGuard) countCrashesSince(ctx context.Context, since time.Time) (int, error) {
+	count := 0
+	resp, err := g.client.GetCrashes(ctx, g.appID, asc.WithCrashSort("-createdDate"), asc.WithCrashLimit(phasedGuardFetchLimit))
+	for {
+		if err != nil {
+			return 0, err
+		}
+		reachedSince := false
+		for _, crash := range resp.Data {
+			created, ok := parseGuardCreatedDate(crash.Attributes.CreatedDate)
+			if !ok {
+				continue
+			}
+			if created.Before(since) {
+				reachedSince = true
+				break
+			}
+			count++
+		}
+		if reachedSince || strings.TrimSpace(resp.Links.Next) == "" {
+			return count, nil
+		}
+		resp, err = g.client.GetCrashes(ctx, g.appID, asc.WithCrashNextURL(resp.Links.Next))
+	}
+}
+
+func (g *phasedReleaseGuard) checkReviewRating(ctx context.Context, since time.Time) phasedGuardCheck {
+	minimum := *g.thresholds.minReviewRating
+	check := phasedGuardCheck{Name: "review-rating", Threshold: minimum}
+	ctx, cancel := shared.ContextWithTimeout(ctx)
+	defer cancel()
+	total, count, err := g.sumReviewRatingsSince(ctx, since)
+	if err != nil {
+		check.Error = err.Error()
+		return check
+	}
+	if count == 0 {
+		check.Passed = true
+		check.Skipped = true
+		check.Detail = fmt.Sprintf("no reviews in the last %s", g.thresholds.window)
+		return check
+	}
+	check.Value = float64(total) / float64(count)
+	check.Passed = check.Value >= minimum
+	check.Detail = fmt.Sprintf("average %.2f from %d reviews in the last %s (min %.2f)", check.Value, count, g.thresholds.window, minimum)
+	return check
+}
+
+// sumReviewRatingsSince pages through reviews, newest first, until it reaches
+// one created before since, and returns the rating total and review count.
+func (g *phasedReleaseGuard) sumReviewRatingsSince(ctx context.Context, since time.Time) (int, int, error) {
+	total, count := 0, 0
+	resp, err := g.client.GetReviews(ctx, g.appID, asc.WithReviewSort("-createdDate"), asc.WithLimit(phasedGuardFetchLimit))
+	for {
+		if err != nil {
+			return 0, 0, err
+		}
+		reachedSince := false
+		for _, review := range resp.Data {
+			created, ok := parseGuardCreatedDate(review.Attributes.CreatedDate)
+			if !ok {
+				continue
+			}
+			if created.Before(since) {
+				reachedSince = true
+				break
+			}
+			total += review.Attributes.Rating
+			count++
+		}
+		if reachedSince || strings.TrimSpace(resp.Links.Next) == "" {
+			return total, count, nil
+		}
+		resp, err = g.client.GetReviews(ctx, g.appID, asc.WithNextURL(resp.Links.Next))
+	}
+}
+
+func (g *phasedReleaseGuard) checkRatingDrop(ctx context.Context, state *phasedGuardState) phasedGuardCheck {
+	maxDrop := *g.thresholds.maxRatingDrop
+	check := phasedGuardCheck{Name: "rating-drop", Threshold: maxDrop}
+	ctx, cancel := shared.ContextWithTimeout(ctx)
+	defer cancel()
+	ratings, err := g.ratings(ctx, g.appID, g.thresholds.country)
+	if err != nil {
+		check.Error = err.Error()
+		return check
+	}
+	if state.BaselineRating == nil {
+		baseline := ratings.AverageRating
+		state.BaselineRating = &baseline
+		state.BaselineRecorded = g.currentTime().UTC().Format(time.RFC3339)
+	}
+	if ratings.CurrentVersionCount == 0 {
+		check.Passed = true
+		check.Skipped = true
+		check.Detail = "no ratings for the current version yet"
+		return check
+	}
+	drop := *state.BaselineRating - ratings.CurrentVersionRating
+	check.Value = drop
+	check.Passed = drop <= maxDrop
+	check.Detail = fmt.Sprintf("current version %.2f vs baseline %.2f (max drop %.2f)", ratings.CurrentVersionRating, *state.BaselineRating, maxDrop)
+	return check
+}
+
+func (g *phasedReleaseGuard) checkCommand(ctx context.Context, decision phasedGuardDecision) phasedGuardCheck {
+	check := phasedGuardCheck{Name: "command"}
+	timeout := g.thresholds.checkTimeout
+	if timeout <= 0 {
+		timeout = phasedGuardDefaultCheckTimeout
+	}
+	ctx, cancel := shared.ContextWithTimeoutDuration(ctx, timeout)
+	defer cancel()
+	cmd := phasedGuardExecCommand(ctx, g.thresholds.check)
+	// Background children can hold stderr open after sh is killed.
+	cmd.WaitDelay = phasedGuardCommandWaitDelay
+	cmd.Stdout = os.Stderr
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	cmd.Env = append(os.Environ(),
+		"ASC_APP_ID="+g.appID,
+		"ASC_VERSION_ID="+g.versionID,
+		"ASC_PHASED_RELEASE_ID="+decision.PhasedReleaseID,
+		"ASC_PHASED_RELEASE_DAY="+strconv.Itoa(decision.Day),
+	)
+	err := cmd.Run()
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		// A command that never finished says nothing about health.
+		check.Error = fmt.Sprintf("timed out after %s", timeout)
+		return check
+	}
+	if err == nil {
+		check.Passed = true
+		check.Detail = "exit 0"
+		return check
+	}
+	if exitErr, ok := errors.AsType[*exec.ExitError](err); ok {
+		check.Value = float64(exitErr.ExitCode())
+		check.Detail = fmt.Sprintf("exit %d", exitErr.ExitCode())
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			check.Detail += ": " + message
+		}
+		return check
+	}
+	check.Error = err.Error()
+	return check
+}
+
+func (g *phasedReleaseGuard) print(decision phasedGuardDecision) {
+	data, err := json.Marshal(decision)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "phased-release guard: encode decision: %v\n", err)
+		return
+	}
+	fmt.Fprintln(g.stdout, string(data))
+}
+
+func (g *phasedReleaseGuard) sendNotification(ctx context.Context, decision phasedGuardDecision) {
+	if g.notify == nil {
+		return
+	}
+	title := "Phased release paused"
+	if decision.Action == phasedGuardActionComplete {
+		title = "Phased release completed"
+	}
+	if decision.DryRun {
+		title += " (dry run)"
+	}
+	msg := notify.Message{
+		Title: title,
+		Text:  fmt.Sprintf("Version %s, day %d: %s", decision.VersionID, decision.Day, decision.Reason),
+		Payload: map[string]any{
+			"versionId":       decision.VersionID,
+			"phasedReleaseId": decision.PhasedReleaseID,
+			"day":             decision.Day,
+			"action":          decision.Action,
+			"applied":         decision.Applied,
+		},
+		Success: decision.Action == phasedGuardActionComplete,
+	}
+	if err := g.notify(ctx, msg); err != nil {
+		fmt.Fprintf(os.Stderr, "phased-release guard: notify failed: %v\n", err)
+	}
+}
+
+// phasedGuardStateError marks a state-file failure, which retrying cannot fix.
+type phasedGuardStateError struct {
+	err error
+}
+
+func (e phasedGuardStateError) Error() string {
+	return e.err.Error()
+}
+
+func (e phasedGuardStateError) Unwrap() error {
+	return e.err
+}
+
+// isPhasedGuardTransientError reports whether a failed evaluation is worth
+// retrying on the next interval. State-file, not-found and authentication
+// errors stop the guard; network errors, timeouts, rate limits and server
+// errors do not.
+func isPhasedGuardTransientError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if _, ok := errors.AsType[phasedGuardStateError](err); ok {
+		return false
+	}
+	if asc.IsNotFound(err) || asc.IsUnauthorized(err) || errors.Is(err, asc.ErrForbidden) {
+		return false
+	}
+	if asc.IsRetryable(err) || errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	if apiErr, ok := errors.AsType[*asc.APIError](err); ok {
+		return apiErr.StatusCode == http.StatusTooManyRequests || apiErr.StatusCode >= http.StatusInternalServerError
+	}
+	if _, ok := errors.AsType[net.Error](err); ok {
+		return true
+	}
+	return errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.EPIPE) ||
+		errors.Is(err, syscall.ECONNREFUSED)
+}
+
+func parseGuardCreatedDate(value string) (time.Time, bool) {
+	created, err := time.Parse(time.RFC3339, strings.TrimSpace(value))
+	if err != nil {
+		return time.Time{}, false
+	}
+	return created, true
+}
+
+func (g *phasedReleaseGuard) loadState() (phasedGuardState, error) {
+	data, err := os.ReadFile(g.statePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return phasedGuardState{VersionID: g.versionID}, nil
+		}
+		return phasedGuardState{}, fmt.Errorf("read state: %w", err)
+	}
+	var state phasedGuardState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return phasedGuardState{}, fmt.Errorf("parse state %s: %w", g.statePath, err)
+	}
+	if state.VersionID != g.versionID {
+		return phasedGuardState{}, fmt.Errorf("state file %s belongs to version %s", g.statePath, state.VersionID)
+	}
+	return state, nil
+}
+
+func (g *phasedReleaseGuard) saveState(state phasedGuardState) error {
+	state.VersionID = g.versionID
+	state.UpdatedAt = g.currentTime().UTC().Format(time.RFC3339)
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(g.statePath), 0o755); err != nil {
+		return fmt.Errorf("write state: %w", err)
+	}
+	tmp := g.statePath + ".tmp"
+	if err := os.WriteFile(tmp, append(data, '\n'), 0o600); err != nil {
+		return fmt.Errorf("write state: %w", err)
+	}
+	if err := os.Rename(tmp, g.statePath); err != nil {
+		return fmt.Errorf("write state: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,396 @@
+package versions
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/notify"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/itunes"
+)
+
+var guardTestNow = time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+type fakeGuardClient struct {
+	state      asc.PhasedReleaseState
+	day        int
+	crashDates []string
+	reviews    []asc.ReviewAttributes
+	crashErr   error
+	updates    []asc.PhasedReleaseState
+	// updateCtxErr is the context error seen by the last update.
+	updateCtxErr error
+
+	// pageSize splits crashes and reviews into linked pages when set.
+	pageSize    int
+	crashCalls  int
+	reviewCalls int
+}
+
+// page returns the bounds of the given 0-based call and whether more pages follow.
+func (f *fakeGuardClient) page(call, total int) (int, int, bool) {
+	if f.pageSize <= 0 {
+		return 0, total, false
+	}
+	start := min(call*f.pageSize, total)
+	end := min(start+f.pageSize, total)
+	return start, end, end < total
+}
+
+func (f *fakeGuardClient) GetAppStoreVersionPhasedRelease(context.Context, string) (*asc.AppStoreVersionPhasedReleaseResponse, error) {
+	resp := &asc.AppStoreVersionPhasedReleaseResponse{}
+	resp.Data.ID = "phase-1"
+	resp.Data.Attributes = asc.AppStoreVersionPhasedReleaseAttributes{PhasedReleaseState: f.state, CurrentDayNumber: f.day}
+	return resp, nil
+}
+
+func (f *fakeGuardClient) UpdateAppStoreVersionPhasedRelease(ctx context.Context, _ string, state asc.PhasedReleaseState) (*asc.AppStoreVersionPhasedReleaseResponse, error) {
+	f.updateCtxErr = ctx.Err()
+	f.updates = append(f.updates, state)
+	f.state = state
+	return &asc.AppStoreVersionPhasedReleaseResponse{}, nil
+}
+
+func (f *fakeGuardClient) GetCrashes(context.Context, string, ...asc.CrashOption) (*asc.CrashesResponse, error) {
+	if f.crashErr != nil {
+		return nil, f.crashErr
+	}
+	resp := &asc.CrashesResponse{}
+	start, end, more := f.page(f.crashCalls, len(f.crashDates))
+	f.crashCalls++
+	for _, date := range f.crashDates[start:end] {
+		resp.Data = append(resp.Data, asc.Resource[asc.CrashAttributes]{Attributes: asc.CrashAttributes{CreatedDate: date}})
+	}
+	if more {
+		resp.Links.Next = "https://api.appstoreconnect.apple.com/v1/apps/app-1/crashes?cursor=next"
+	}
+	return resp, nil
+}
+
+func (f *fakeGuardClient) GetReviews(context.Context, string, ...asc.ReviewOption) (*asc.ReviewsResponse, error) {
+	resp := &asc.ReviewsResponse{}
+	start, end, more := f.page(f.reviewCalls, len(f.reviews))
+	f.reviewCalls++
+	for _, review := range f.reviews[start:end] {
+		resp.Data = append(resp.Data, asc.Resource[asc.ReviewAttributes]{Attributes: review})
+	}
+	if more {
+		resp.Links.Next = "https://api.appstoreconnect.apple.com/v1/apps/app-1/customerReviews?cursor=next"
+	}
+	return resp, nil
+}
+
+func newTestGuard(t *testing.T, client *fakeGuardClient, thresholds phasedGuardThresholds) (*phasedReleaseGuard, *bytes.Buffer) {
+	t.Helper()
+	if thresholds.window == 0 {
+		thresholds.window = 24 * time.Hour
+	}
+	var stdout bytes.Buffer
+	return &phasedReleaseGuard{
+		client:     client,
+		appID:      "app-1",
+		versionID:  "ver-1",
+		thresholds: thresholds,
+		statePath:  filepath.Join(t.TempDir(), "guard", "ver-1.json"),
+		stdout:     &stdout,
+		now:        func() time.Time { return guardTestNow },
+	}, &stdout
+}
+
+func decodeGuardDecision(t *testing.T, stdout *bytes.Buffer) phasedGuardDecision {
+	t.Helper()
+	lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+	var decision phasedGuardDecision
+	if err := json.Unmarshal([]byte(lines[len(lines)-1]), &decision); err != nil {
+		t.Fatalf("decode decision %q: %v", stdout.String(), err)
+	}
+	return decision
+}
+
+func TestPhasedReleaseGuard_PausesOnCrashThreshold(t *testing.T) {
+	maxCrashes := 1
+	client := &fakeGuardClient{
+		state:      asc.PhasedReleaseStateActive,
+		day:        2,
+		crashDates: []string{"2026-03-10T11:00:00Z", "2026-03-10T10:00:00Z", "2026-03-01T00:00:00Z"},
+	}
+	guard, stdout := newTestGuard(t, client, phasedGuardThresholds{maxCrashes: &maxCrashes})
+	var sent []notify.Message
+	guard.notify = func(_ context.Context, msg notify.Message) error {
+		sent = append(sent, msg)
+		return nil
+	}
+
+	done, err := guard.evaluate(context.Background())
+	if err != nil {
+		t.Fatalf("evaluate error: %v", err)
+	}
+	if done {
+		t.Fatal("expected guard to keep running after a pause")
+	}
+	if len(client.updates) != 1 || client.updates[0] != asc.PhasedReleaseStatePaused {
+		t.Fatalf("expected pause update, got %v", client.updates)
+	}
+
+	decision := decodeGuardDecision(t, stdout)
+	if decision.Action != phasedGuardActionPause || !decision.Applied || decision.Healthy {
+		t.Fatalf("unexpected decision %+v", decision)
+	}
+	if decision.Checks[0].Value != 2 || !strings.Contains(decision.Reason, "2 crashes in the last 24h0m0s (max 1)") {
+		t.Fatalf("unexpected crash check %+v reason %q", decision.Checks[0], decision.Reason)
+	}
+	if len(sent) != 1 || sent[0].Title != "Phased release paused" || sent[0].Success {
+		t.Fatalf("unexpected notifications %+v", sent)
+	}
+
+	// Once paused, the guard leaves the release alone.
+	if _, err := guard.evaluate(context.Background()); err != nil {
+		t.Fatalf("evaluate error: %v", err)
+	}
+	if len(client.updates) != 1 || decodeGuardDecision(t, stdout).Action != phasedGuardActionNone {
+		t.Fatalf("expected no further updates, got %v", client.updates)
+	}
+}
+
+func TestPhasedReleaseGuard_ChecksPageUntilWindowStart(t *testing.T) {
+	client := &fakeGuardClient{
+		pageSize: 2,
+		crashDates: []string{
+			"2026-03-10T11:00:00Z",
+			"2026-03-10T10:00:00Z",
+			"2026-03-10T09:00:00Z",
+			"2026-03-09T00:00:00Z",
+			"2026-03-08T00:00:00Z",
+			"2026-03-07T00:00:00Z",
+		},
+		reviews: []asc.ReviewAttributes{
+			{Rating: 5, CreatedDate: "2026-03-10T11:00:00Z"},
+			{Rating: 3, CreatedDate: "2026-03-10T10:00:00Z"},
+			{Rating: 1, CreatedDate: "2026-03-10T09:00:00Z"},
+			{Rating: 5, CreatedDate: "2026-03-01T00:00:00Z"},
+		},
+	}
+	guard, _ := newTestGuard(t, client, phasedGuardThresholds{})
+	since := guardTestNow.Add(-24 * time.Hour)
+
+	count, err := guard.countCrashesSince(context.Background(), since)
+	if err != nil {
+		t.Fatalf("countCrashesSince error: %v", err)
+	}
+	if count != 3 || client.crashCalls != 2 {
+		t.Fatalf("expected 3 crashes over 2 pages, got %d over %d", count, client.crashCalls)
+	}
+
+	total, reviews, err := guard.sumReviewRatingsSince(context.Background(), since)
+	if err != nil {
+		t.Fatalf("sumReviewRatingsSince error: %v", err)
+	}
+	if total != 9 || reviews != 3 || client.reviewCalls != 2 {
+		t.Fatalf("expected 9 stars from 3 reviews over 2 pages, got %d from %d over %d", total, reviews, client.reviewCalls)
+	}
+}
+
+func TestPhasedReleaseGuard_CompletesEarlyWhenGreen(t *testing.T) {
+	minRating := 4.0
+	client := &fakeGuardClient{
+		state: asc.PhasedReleaseStateActive,
+		day:   5,
+		reviews: []asc.ReviewAttributes{
+			{Rating: 5, CreatedDate: "2026-03-10T08:00:00Z"},
+			{Rating: 4, CreatedDate: "2026-03-10T09:00:00Z"},
+			{Rating: 1, CreatedDate: "2026-02-01T09:00:00Z"},
+		},
+	}
+	guard, stdout := newTestGuard(t, client, phasedGuardThresholds{minReviewRating: &minRating, completeAfterDay: 5})
+
+	done, err := guard.evaluate(context.Background())
+	if err != nil {
+		t.Fatalf("evaluate error: %v", err)
+	}
+	if !done || len(client.updates) != 1 || client.updates[0] != asc.PhasedReleaseStateComplete {
+		t.Fatalf("expected completion, got done=%v updates=%v", done, client.updates)
+	}
+	decision := decodeGuardDecision(t, stdout)
+	if decision.Checks[0].Value != 4.5 || !decision.Healthy {
+		t.Fatalf("unexpected decision %+v", decision)
+	}
+}
+
+func TestPhasedReleaseGuard_UnevaluatedCheckTakesNoAction(t *testing.T) {
+	maxCrashes := 0
+	client := &fakeGuardClient{state: asc.PhasedReleaseStateActive, day: 6, crashErr: errors.New("rate limited")}
+	guard, stdout := newTestGuard(t, client, phasedGuardThresholds{maxCrashes: &maxCrashes, completeAfterDay: 1})
+
+	if _, err := guard.evaluate(context.Background()); err != nil {
+		t.Fatalf("evaluate error: %v", err)
+	}
+	if len(client.updates) != 0 {
+		t.Fatalf("expected no updates, got %v", client.updates)
+	}
+	decision := decodeGuardDecision(t, stdout)
+	if decision.Action != phasedGuardActionNone || decision.Checks[0].Error != "rate limited" {
+		t.Fatalf("unexpected decision %+v", decision)
+	}
+}
+
+func TestPhasedReleaseGuard_RatingDropUsesBaselineAndDryRun(t *testing.T) {
+	maxDrop := 0.5
+	client := &fakeGuardClient{state: asc.PhasedReleaseStateActive, day: 3}
+	guard, stdout := newTestGuard(t, client, phasedGuardThresholds{maxRatingDrop: &maxDrop})
+	guard.dryRun = true
+
+	ratings := &itunes.AppRatings{AverageRating: 4.6}
+	guard.ratings = func(context.Context, string, string) (*itunes.AppRatings, error) {
+		copied := *ratings
+		return &copied, nil
+	}
+
+	if _, err := guard.evaluate(context.Background()); err != nil {
+		t.Fatalf("evaluate error: %v", err)
+	}
+	if decision := decodeGuardDecision(t, stdout); !decision.Checks[0].Skipped || decision.Action != phasedGuardActionNone {
+		t.Fatalf("expected skipped check without current version ratings, got %+v", decision)
+	}
+	data, err := os.ReadFile(guard.statePath)
+	if err != nil || !strings.Contains(string(data), `"baselineRating": 4.6`) {
+		t.Fatalf("expected baseline recorded, got %q (%v)", data, err)
+	}
+
+	ratings = &itunes.AppRatings{AverageRating: 4.5, CurrentVersionRating: 3.9, CurrentVersionCount: 40}
+	if _, err := guard.evaluate(context.Background()); err != nil {
+		t.Fatalf("evaluate error: %v", err)
+	}
+	decision := decodeGuardDecision(t, stdout)
+	if decision.Action != phasedGuardActionPause || decision.Applied || !decision.DryRun {
+		t.Fatalf("expected unapplied dry-run pause, got %+v", decision)
+	}
+	if len(client.updates) != 0 {
+		t.Fatalf("expected no updates in dry run, got %v", client.updates)
+	}
+}
+
+func TestPhasedReleaseGuard_CommandCheck(t *testing.T) {
+	client := &fakeGuardClient{state: asc.PhasedReleaseStateActive, day: 4}
+	guard, stdout := newTestGuard(t, client, phasedGuardThresholds{check: `test "$ASC_PHASED_RELEASE_DAY" = 4 && echo unhealthy >&2 && exit 3`})
+
+	if _, err := guard.evaluate(context.Background()); err != nil {
+		t.Fatalf("evaluate error: %v", err)
+	}
+	decision := decodeGuardDecision(t, stdout)
+	if decision.Action != phasedGuardActionPause || decision.Checks[0].Detail != "exit 3: unhealthy" {
+		t.Fatalf("unexpected decision %+v", decision)
+	}
+}
+
+func TestPhasedReleaseGuard_CommandCheckTimeoutTakesNoAction(t *testing.T) {
+	client := &fakeGuardClient{state: asc.PhasedReleaseStateActive, day: 2}
+	guard, stdout := newTestGuard(t, client, phasedGuardThresholds{check: "exec sleep 5", checkTimeout: 100 * time.Millisecond})
+
+	if _, err := guard.evaluate(context.Background()); err != nil {
+		t.Fatalf("evaluate error: %v", err)
+	}
+	decision := decodeGuardDecision(t, stdout)
+	if decision.Action != phasedGuardActionNone || !strings.Contains(decision.Checks[0].Error, "timed out after 100ms") {
+		t.Fatalf("expected unevaluated command check, got %+v", decision)
+	}
+	if len(client.updates) != 0 {
+		t.Fatalf("expected no updates, got %v", client.updates)
+	}
+}
+
+func TestPhasedReleaseGuard_PauseOutlivesRequestTimeoutSpentOnChecks(t *testing.T) {
+	t.Setenv("ASC_TIMEOUT", "200ms")
+	client := &fakeGuardClient{state: asc.PhasedReleaseStateActive, day: 2}
+	guard, stdout := newTestGuard(t, client, phasedGuardThresholds{check: "sleep 0.3; exit 1", checkTimeout: 5 * time.Second})
+
+	if _, err := guard.evaluate(context.Background()); err != nil {
+		t.Fatalf("evaluate error: %v", err)
+	}
+	decision := decodeGuardDecision(t, stdout)
+	if decision.Action != phasedGuardActionPause || !decision.Applied {
+		t.Fatalf("expected applied pause, got %+v", decision)
+	}
+	if client.updateCtxErr != nil {
+		t.Fatalf("expected a live context for the pause, got %v", client.updateCtxErr)
+	}
+}
+
+func TestIsPhasedGuardTransientError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "not found", err: fmt.Errorf("fetch phased release: %w", &asc.APIError{Code: "NOT_FOUND", StatusCode: 404}), want: false},
+		{name: "unauthorized", err: fmt.Errorf("fetch phased release: %w", asc.ErrUnauthorized), want: false},
+		{name: "forbidden", err: &asc.APIError{Code: "FORBIDDEN", StatusCode: 403}, want: false},
+		{name: "conflict", err: &asc.APIError{Code: "CONFLICT", StatusCode: 409}, want: false},
+		{name: "state file", err: phasedGuardStateError{err: errors.New("parse state: bad json")}, want: false},
+		{name: "server error", err: &asc.APIError{Code: "INTERNAL_ERROR", StatusCode: 500}, want: true},
+		{name: "rate limited", err: &asc.RetryableError{Err: errors.New("rate limited")}, want: true},
+		{name: "request timeout", err: fmt.Errorf("fetch phased release: %w", context.DeadlineExceeded), want: true},
+		{name: "network", err: &net.OpError{Op: "dial", Err: syscall.ECONNREFUSED}, want: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := isPhasedGuardTransientError(test.err); got != test.want {
+				t.Fatalf("isPhasedGuardTransientError(%v) = %v, want %v", test.err, got, test.want)
+			}
+		})
+	}
+}
+
+func TestPhasedReleaseGuard_StateFileErrorsAreNotTransient(t *testing.T) {
+	client := &fakeGuardClient{state: asc.PhasedReleaseStateActive, day: 2}
+	guard, _ := newTestGuard(t, client, phasedGuardThresholds{check: "true"})
+	if err := os.MkdirAll(filepath.Dir(guard.statePath), 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if err := os.WriteFile(guard.statePath, []byte(`{"versionId":"ver-2"}`), 0o600); err != nil {
+		t.Fatalf("write state: %v", err)
+	}
+
+	_, err := guard.evaluate(context.Background())
+	if err == nil || isPhasedGuardTransientError(err) {
+		t.Fatalf("expected a non-transient state error, got %v", err)
+	}
+}
+
+func TestPhasedReleaseGuardCommand_Validation(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+	}{
+		{name: "missing version", args: []string{"--max-crashes", "1"}},
+		{name: "no checks", args: []string{"--version-id", "ver-1"}},
+		{name: "interval too short", args: []string{"--version-id", "ver-1", "--check", "true", "--interval", "10s"}},
+		{name: "app required", args: []string{"--version-id", "ver-1", "--max-crashes", "1"}},
+		{name: "check timeout", args: []string{"--version-id", "ver-1", "--check", "true", "--check-timeout", "0s"}},
+		{name: "bad notify channel", args: []string{"--version-id", "ver-1", "--check", "true", "--notify", "pager"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Setenv("ASC_APP_ID", "")
+			cmd := PhasedReleaseGuardCommand()
+			cmd.FlagSet.SetOutput(io.Discard)
+			if err := cmd.FlagSet.Parse(test.args); err != nil {
+				t.Fatalf("failed to parse flags: %v", err)
+			}
+			if err := cmd.Exec(context.Background(), nil); !errors.Is(err, flag.ErrHelp) {
+				t.Fatalf("expected flag.ErrHelp, got %v", err)
+			}
+		})
+	}
+}
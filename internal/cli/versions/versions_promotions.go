@@ -40,7 +40,7 @@ Examples:
 func VersionsPromotionsCreateCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("versions promotions create", flag.ExitOnError)
 
-	versionID := fs.String("version-id", "", "App Store version ID (required)")
+	versionID := shared.ReferenceFlag(fs, shared.ReferenceVersionID, "", "App Store version ID or version string like 1.2.3 (required)")
 	treatmentID := fs.String("treatment-id", "", "App Store version experiment treatment ID (required)")
 	output := shared.BindOutputFlags(fs)
 
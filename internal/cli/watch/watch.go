@@ -0,0 +1,231 @@
+package watch
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	wf "github.com/rudrankriyam/App-Store-Connect-CLI/internal/workflow"
+)
+
+const (
+	watchDefaultInterval = 60 * time.Second
+	watchMinInterval     = 10 * time.Second
+	watchWorkflowPrefix  = "workflow:"
+)
+
+// Resource families that can be watched.
+const (
+	familyBuild      = "build"
+	familyVersion    = "version"
+	familyReview     = "review"
+	familyBetaReview = "beta-review"
+)
+
+var watchStatePattern = regexp.MustCompile(`^[A-Z][A-Z0-9_]*$`)
+
+// watchEvents maps trigger names to a resource family and an optional fixed state.
+var watchEvents = map[string]struct {
+	family string
+	state  string
+}{
+	"build.state":       {family: familyBuild},
+	"build.processed":   {family: familyBuild, state: "VALID"},
+	"version.state":     {family: familyVersion},
+	"review.state":      {family: familyReview},
+	"beta-review.state": {family: familyBetaReview},
+}
+
+// watchTrigger is one parsed --on value.
+type watchTrigger struct {
+	Event    string
+	family   string
+	State    string
+	Command  string
+	Workflow string
+}
+
+// triggerFlag collects repeated --on values.
+type triggerFlag []string
+
+func (f *triggerFlag) String() string {
+	return strings.Join(*f, ", ")
+}
+
+func (f *triggerFlag) Set(value string) error {
+	*f = append(*f, value)
+	return nil
+}
+
+// WatchCommand returns the watch command.
+func WatchCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("watch", flag.ExitOnError)
+
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (or ASC_APP_ID)")
+	var triggers triggerFlag
+	fs.Var(&triggers, "on", "Trigger EVENT[=STATE]=COMMAND or EVENT[=STATE]=workflow:NAME (repeatable)")
+	interval := fs.Duration("interval", watchDefaultInterval, "Polling interval (minimum 10s)")
+	stateFile := fs.String("state-file", "", "Path to the last-seen state file (default: .asc/watch/APP_ID.json)")
+	workflowFile := fs.String("workflow-file", wf.DefaultPath, "Path to workflow.json for workflow: triggers")
+	once := fs.Bool("once", false, "Poll once, fire triggers and exit")
+
+	return &ffcli.Command{
+		Name:       "watch",
+		ShortUsage: "asc watch --app APP_ID --on EVENT[=STATE]=COMMAND [flags]",
+		ShortHelp:  "Poll App Store Connect and run commands on state changes.",
+		LongHelp: `Poll App Store Connect and run commands or workflows on state transitions.
+
+A polling alternative to "asc webhooks serve" for machines that cannot expose
+a public endpoint. Last-seen states are kept in a local state file; the first
+run for each kind of resource (builds, versions, review submissions, beta
+review submissions) only records a baseline and fires nothing, including when
+a trigger for a new kind is added to an existing state file. Resources that
+no poll has returned for 30 days are dropped from the state file.
+
+Events:
+  build.processed     Build processing state became VALID
+  build.state         Build processing state changed
+  version.state       App Store version state changed
+  review.state        Review submission state changed
+  beta-review.state   Beta App Review submission state changed
+
+Add =STATE to fire only on one new state, e.g. version.state=READY_FOR_SALE.
+Prefix the action with "workflow:" to run a workflow from .asc/workflow.json.
+
+Each trigger receives a JSON event envelope shaped like webhook payloads on
+stdin (commands) and in ASC_WEBHOOK_EVENT_TYPE, ASC_WEBHOOK_EVENT_ID and
+ASC_WEBHOOK_PAYLOAD, so handlers work with either source. Fired events are
+printed to stdout as JSON lines.
+
+Examples:
+  asc watch --app "APP_ID" --on "build.processed=./scripts/on-build.sh"
+  asc watch --app "APP_ID" --on "version.state=READY_FOR_SALE=workflow:announce"
+  asc watch --app "APP_ID" --on "review.state=./scripts/notify.sh" --interval 5m
+  asc watch --app "APP_ID" --on "build.state=./scripts/log.sh" --once`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			resolvedAppID := shared.ResolveAppID(*appID)
+			if resolvedAppID == "" {
+				return shared.UsageError("--app is required (or set ASC_APP_ID)")
+			}
+			if len(triggers) == 0 {
+				return shared.UsageError("at least one --on trigger is required")
+			}
+			if *interval < watchMinInterval {
+				return shared.UsageErrorf("--interval must be at least %s", watchMinInterval)
+			}
+
+			parsed := make([]watchTrigger, 0, len(triggers))
+			for _, value := range triggers {
+				trigger, err := parseWatchTrigger(value)
+				if err != nil {
+					return shared.UsageError(err.Error())
+				}
+				parsed = append(parsed, trigger)
+			}
+
+			runner := &watchRunner{
+				appID:     resolvedAppID,
+				triggers:  parsed,
+				statePath: resolveWatchStatePath(*stateFile, resolvedAppID),
+				stdout:    os.Stdout,
+			}
+			for _, trigger := range parsed {
+				if trigger.Workflow == "" {
+					continue
+				}
+				if runner.workflowDef == nil {
+					def, err := wf.Load(strings.TrimSpace(*workflowFile))
+					if err != nil {
+						return fmt.Errorf("watch: %w", err)
+					}
+					runner.workflowDef = def
+				}
+				if _, ok := runner.workflowDef.Workflows[trigger.Workflow]; !ok {
+					return fmt.Errorf("watch: workflow %q not found in %s", trigger.Workflow, *workflowFile)
+				}
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("watch: %w", err)
+			}
+			runner.client = client
+
+			if *once {
+				if err := runner.poll(ctx); err != nil {
+					return fmt.Errorf("watch: %w", err)
+				}
+				return nil
+			}
+
+			fmt.Fprintf(os.Stderr, "Watching app %s every %s (state: %s)\n", resolvedAppID, *interval, runner.statePath)
+			_, err = asc.PollUntil(ctx, *interval, func(ctx context.Context) (struct{}, bool, error) {
+				if err := runner.poll(ctx); err != nil {
+					// Transient API errors should not stop a long-running watcher.
+					fmt.Fprintf(os.Stderr, "watch: poll failed: %v\n", err)
+				}
+				return struct{}{}, false, nil
+			})
+			if errors.Is(err, context.Canceled) {
+				return nil
+			}
+			if err != nil {
+				return fmt.Errorf("watch: %w", err)
+			}
+			return nil
+		},
+	}
+}
+
+// parseWatchTrigger parses EVENT[=STATE]=ACTION.
+func parseWatchTrigger(value string) (watchTrigger, error) {
+	value = strings.TrimSpace(value)
+	eventName, rest, ok := strings.Cut(value, "=")
+	eventName = strings.ToLower(strings.TrimSpace(eventName))
+	if !ok || strings.TrimSpace(rest) == "" {
+		return watchTrigger{}, fmt.Errorf("--on %q must be EVENT[=STATE]=COMMAND", value)
+	}
+	event, known := watchEvents[eventName]
+	if !known {
+		return watchTrigger{}, fmt.Errorf("--on %q: unknown event %q", value, eventName)
+	}
+
+	trigger := watchTrigger{Event: eventName, family: event.family, State: event.state}
+	if state, action, hasState := strings.Cut(rest, "="); hasState && event.state == "" && watchStatePattern.MatchString(strings.TrimSpace(state)) {
+		trigger.State = strings.TrimSpace(state)
+		rest = action
+	}
+
+	action := strings.TrimSpace(rest)
+	if strings.HasPrefix(action, watchWorkflowPrefix) {
+		trigger.Workflow = strings.TrimSpace(strings.TrimPrefix(action, watchWorkflowPrefix))
+		if trigger.Workflow == "" {
+			return watchTrigger{}, fmt.Errorf("--on %q: workflow name is required", value)
+		}
+		return trigger, nil
+	}
+	if action == "" {
+		return watchTrigger{}, fmt.Errorf("--on %q: command is required", value)
+	}
+	trigger.Command = action
+	return trigger, nil
+}
+
+func resolveWatchStatePath(value, appID string) string {
+	if trimmed := strings.TrimSpace(value); trimmed != "" {
+		return filepath.Clean(trimmed)
+	}
+	return filepath.Join(".asc", "watch", appID+".json")
+}
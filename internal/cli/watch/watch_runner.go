@@ -0,0 +1,411 @@
+package watch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+	wf "github.com/rudrankriyam/App-Store-Connect-CLI/internal/workflow"
+)
+
+const watchPollLimit = 20
+
+// watchStateRetention is how long a resource that left the polled window stays
+// in the state file before it is pruned.
+const watchStateRetention = 30 * 24 * time.Hour
+
+var watchExecCommand = func(ctx context.Context, command string) *exec.Cmd {
+	return exec.CommandContext(ctx, "sh", "-c", command)
+}
+
+// watchEventTypes are the webhook-style event types used in envelopes.
+// Review submissions have no webhook equivalent, so they use watch-only names.
+var watchEventTypes = map[string]struct {
+	eventType    string
+	dataType     string
+	resourceType string
+}{
+	familyBuild:      {eventType: string(asc.WebhookEventBuildUploadStateUpdated), dataType: "buildUploadStateUpdated", resourceType: "builds"},
+	familyVersion:    {eventType: string(asc.WebhookEventAppStoreVersionStateUpdated), dataType: "appStoreVersionAppVersionStateUpdated", resourceType: "appStoreVersions"},
+	familyReview:     {eventType: "REVIEW_SUBMISSION_STATE_UPDATED", dataType: "reviewSubmissionStateUpdated", resourceType: "reviewSubmissions"},
+	familyBetaReview: {eventType: "BETA_APP_REVIEW_SUBMISSION_STATE_UPDATED", dataType: "betaAppReviewSubmissionStateUpdated", resourceType: "betaAppReviewSubmissions"},
+}
+
+// watchClient is the subset of the App Store Connect client polled by watch.
+type watchClient interface {
+	GetBuilds(ctx context.Context, appID string, opts ...asc.BuildsOption) (*asc.BuildsResponse, error)
+	GetAppStoreVersions(ctx context.Context, appID string, opts ...asc.AppStoreVersionsOption) (*asc.AppStoreVersionsResponse, error)
+	GetReviewSubmissions(ctx context.Context, appID string, opts ...asc.ReviewSubmissionsOption) (*asc.ReviewSubmissionsResponse, error)
+	GetBetaAppReviewSubmissions(ctx context.Context, opts ...asc.BetaAppReviewSubmissionsOption) (*asc.BetaAppReviewSubmissionsResponse, error)
+}
+
+// watchState is the persisted last-seen state.
+type watchState struct {
+	AppID     string                        `json:"appId"`
+	UpdatedAt string                        `json:"updatedAt"`
+	Families  []string                      `json:"families,omitempty"`
+	Resources map[string]watchResourceState `json:"resources"`
+}
+
+// watchResourceState is the last-seen state of one resource.
+type watchResourceState struct {
+	Family string `json:"family"`
+	ID     string `json:"id"`
+	State  string `json:"state"`
+	Label  string `json:"label,omitempty"`
+	// LastSeen is when a poll last returned the resource (RFC 3339).
+	LastSeen string `json:"lastSeen,omitempty"`
+}
+
+// watchTransition is a state change detected between two polls.
+type watchTransition struct {
+	Resource watchResourceState
+	OldState string
+}
+
+// watchEnvelope mirrors the App Store Connect webhook payload shape.
+type watchEnvelope struct {
+	ID        string            `json:"id"`
+	EventType string            `json:"eventType"`
+	Source    string            `json:"source"`
+	AppID     string            `json:"appId"`
+	Data      watchEnvelopeData `json:"data"`
+}
+
+type watchEnvelopeData struct {
+	Type          string                      `json:"type"`
+	ID            string                      `json:"id"`
+	Version       int                         `json:"version"`
+	Attributes    watchEnvelopeAttributes     `json:"attributes"`
+	Relationships map[string]watchRelationRef `json:"relationships"`
+}
+
+type watchEnvelopeAttributes struct {
+	OldValue  string `json:"oldValue,omitempty"`
+	NewValue  string `json:"newValue"`
+	Label     string `json:"label,omitempty"`
+	Timestamp string `json:"timestamp"`
+}
+
+type watchRelationRef struct {
+	Data asc.ResourceData `json:"data"`
+}
+
+type watchRunner struct {
+	client      watchClient
+	appID       string
+	triggers    []watchTrigger
+	statePath   string
+	workflowDef *wf.Definition
+	stdout      io.Writer
+	now         func() time.Time
+}
+
+// poll fetches the watched resources, fires triggers for transitions and saves the new state.
+// The first poll of each trigger family only records a baseline, so adding a
+// trigger to an existing state file doesn't fire for every resource it sees.
+func (r *watchRunner) poll(ctx context.Context) error {
+	previous, err := r.loadState()
+	if err != nil {
+		return err
+	}
+
+	requestCtx, cancel := shared.ContextWithTimeout(ctx)
+	current, err := r.snapshot(requestCtx)
+	cancel()
+	if err != nil {
+		return err
+	}
+	now := r.currentTime().UTC()
+	seenAt := now.Format(time.RFC3339)
+	for key, resource := range current {
+		resource.LastSeen = seenAt
+		current[key] = resource
+	}
+
+	baselined := make(map[string]bool, len(previous.Families))
+	for _, family := range previous.Families {
+		baselined[family] = true
+	}
+	for _, transition := range diffWatchStates(previous.Resources, current) {
+		if baselined[transition.Resource.Family] {
+			r.fire(ctx, transition)
+		}
+	}
+
+	// Keep resources that dropped out of the polled window so they don't
+	// re-fire as new, until they have been gone for watchStateRetention.
+	for key, resource := range previous.Resources {
+		if _, ok := current[key]; ok {
+			continue
+		}
+		lastSeen, err := time.Parse(time.RFC3339, resource.LastSeen)
+		if err != nil {
+			// Entries written before LastSeen existed start aging now.
+			resource.LastSeen = seenAt
+		} else if now.Sub(lastSeen) > watchStateRetention {
+			continue
+		}
+		current[key] = resource
+	}
+	for family := range r.families() {
+		baselined[family] = true
+	}
+	return r.saveState(current, baselined)
+}
+
+func (r *watchRunner) currentTime() time.Time {
+	if r.now != nil {
+		return r.now()
+	}
+	return time.Now()
+}
+
+func (r *watchRunner) families() map[string]bool {
+	families := make(map[string]bool)
+	for _, trigger := range r.triggers {
+		families[trigger.family] = true
+	}
+	return families
+}
+
+func (r *watchRunner) snapshot(ctx context.Context) (map[string]watchResourceState, error) {
+	families := r.families()
+	current := make(map[string]watchResourceState)
+	add := func(family, id, state, label string) {
+		if strings.TrimSpace(id) == "" || strings.TrimSpace(state) == "" {
+			return
+		}
+		current[family+":"+id] = watchResourceState{Family: family, ID: id, State: state, Label: label}
+	}
+
+	if families[familyBuild] || families[familyBetaReview] {
+		builds, err := r.client.GetBuilds(ctx, r.appID, asc.WithBuildsSort("-uploadedDate"), asc.WithBuildsLimit(watchPollLimit))
+		if err != nil {
+			return nil, fmt.Errorf("fetch builds: %w", err)
+		}
+		buildIDs := make([]string, 0, len(builds.Data))
+		buildLabels := make(map[string]string, len(builds.Data))
+		for _, build := range builds.Data {
+			buildIDs = append(buildIDs, build.ID)
+			buildLabels[build.ID] = build.Attributes.Version
+			if families[familyBuild] {
+				add(familyBuild, build.ID, build.Attributes.ProcessingState, build.Attributes.Version)
+			}
+		}
+		if families[familyBetaReview] && len(buildIDs) > 0 {
+			submissions, err := r.client.GetBetaAppReviewSubmissions(ctx,
+				asc.WithBetaAppReviewSubmissionsBuildIDs(buildIDs),
+				asc.WithBetaAppReviewSubmissionsInclude([]string{"build"}),
+				asc.WithBetaAppReviewSubmissionsLimit(watchPollLimit),
+			)
+			if err != nil {
+				return nil, fmt.Errorf("fetch beta review submissions: %w", err)
+			}
+			for _, submission := range submissions.Data {
+				add(familyBetaReview, submission.ID, submission.Attributes.BetaReviewState, buildLabels[watchSubmissionBuildID(submission.Relationships)])
+			}
+		}
+	}
+
+	if families[familyVersion] {
+		versions, err := r.client.GetAppStoreVersions(ctx, r.appID, asc.WithAppStoreVersionsLimit(watchPollLimit))
+		if err != nil {
+			return nil, fmt.Errorf("fetch app store versions: %w", err)
+		}
+		for _, version := range versions.Data {
+			label := strings.TrimSpace(string(version.Attributes.Platform) + " " + version.Attributes.VersionString)
+			add(familyVersion, version.ID, shared.ResolveAppStoreVersionState(version.Attributes), label)
+		}
+	}
+
+	if families[familyReview] {
+		submissions, err := r.client.GetReviewSubmissions(ctx, r.appID, asc.WithReviewSubmissionsLimit(watchPollLimit))
+		if err != nil {
+			return nil, fmt.Errorf("fetch review submissions: %w", err)
+		}
+		for _, submission := range submissions.Data {
+			add(familyReview, submission.ID, string(submission.Attributes.SubmissionState), string(submission.Attributes.Platform))
+		}
+	}
+
+	return current, nil
+}
+
+// watchSubmissionBuildID returns the build ID from a beta review submission's relationships.
+func watchSubmissionBuildID(raw json.RawMessage) string {
+	var relationships struct {
+		Build struct {
+			Data asc.ResourceData `json:"data"`
+		} `json:"build"`
+	}
+	if len(raw) == 0 || json.Unmarshal(raw, &relationships) != nil {
+		return ""
+	}
+	return relationships.Build.Data.ID
+}
+
+// diffWatchStates returns transitions sorted by resource key for stable firing order.
+func diffWatchStates(previous, current map[string]watchResourceState) []watchTransition {
+	keys := make([]string, 0, len(current))
+	for key := range current {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var transitions []watchTransition
+	for _, key := range keys {
+		resource := current[key]
+		old, seen := previous[key]
+		if seen && old.State == resource.State {
+			continue
+		}
+		transitions = append(transitions, watchTransition{Resource: resource, OldState: old.State})
+	}
+	return transitions
+}
+
+func (r *watchRunner) fire(ctx context.Context, transition watchTransition) {
+	var envelope []byte
+	for _, trigger := range r.triggers {
+		if trigger.family != transition.Resource.Family {
+			continue
+		}
+		if trigger.State != "" && trigger.State != transition.Resource.State {
+			continue
+		}
+		if envelope == nil {
+			built, err := json.Marshal(r.envelope(transition))
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "watch: encode event: %v\n", err)
+				return
+			}
+			envelope = built
+			fmt.Fprintln(r.stdout, string(envelope))
+		}
+		if err := r.runTrigger(ctx, trigger, transition, envelope); err != nil {
+			fmt.Fprintf(os.Stderr, "watch: %s trigger failed for %s %s: %v\n", trigger.Event, transition.Resource.Family, transition.Resource.ID, err)
+		}
+	}
+}
+
+func (r *watchRunner) envelope(transition watchTransition) watchEnvelope {
+	meta := watchEventTypes[transition.Resource.Family]
+	timestamp := r.currentTime().UTC().Format(time.RFC3339)
+	return watchEnvelope{
+		ID:        fmt.Sprintf("watch-%s-%s-%s", transition.Resource.Family, transition.Resource.ID, transition.Resource.State),
+		EventType: meta.eventType,
+		Source:    "asc-watch",
+		AppID:     r.appID,
+		Data: watchEnvelopeData{
+			Type:    meta.dataType,
+			ID:      transition.Resource.ID,
+			Version: 1,
+			Attributes: watchEnvelopeAttributes{
+				OldValue:  transition.OldState,
+				NewValue:  transition.Resource.State,
+				Label:     transition.Resource.Label,
+				Timestamp: timestamp,
+			},
+			Relationships: map[string]watchRelationRef{
+				"instance": {Data: asc.ResourceData{Type: asc.ResourceType(meta.resourceType), ID: transition.Resource.ID}},
+			},
+		},
+	}
+}
+
+func (r *watchRunner) runTrigger(ctx context.Context, trigger watchTrigger, transition watchTransition, envelope []byte) error {
+	env := map[string]string{
+		"ASC_WEBHOOK_EVENT_TYPE": watchEventTypes[transition.Resource.Family].eventType,
+		"ASC_WEBHOOK_EVENT_ID":   transition.Resource.ID,
+		"ASC_WEBHOOK_PAYLOAD":    string(envelope),
+	}
+	if trigger.Workflow != "" {
+		_, err := wf.Run(ctx, r.workflowDef, wf.RunOptions{
+			WorkflowName: trigger.Workflow,
+			Params:       env,
+			Stdout:       os.Stderr,
+			Stderr:       os.Stderr,
+		})
+		return err
+	}
+
+	cmd := watchExecCommand(ctx, trigger.Command)
+	cmd.Stdin = bytes.NewReader(envelope)
+	cmd.Stdout = os.Stderr
+	cmd.Env = os.Environ()
+	for key, value := range env {
+		cmd.Env = append(cmd.Env, key+"="+value)
+	}
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			return fmt.Errorf("%w: %s", err, message)
+		}
+		return err
+	}
+	return nil
+}
+
+// loadState returns the saved state, or an empty state on the first run.
+func (r *watchRunner) loadState() (watchState, error) {
+	data, err := os.ReadFile(r.statePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return watchState{AppID: r.appID, Resources: map[string]watchResourceState{}}, nil
+		}
+		return watchState{}, fmt.Errorf("read state: %w", err)
+	}
+	var state watchState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return watchState{}, fmt.Errorf("parse state %s: %w", r.statePath, err)
+	}
+	if state.AppID != r.appID {
+		return watchState{}, fmt.Errorf("state file %s belongs to app %s", r.statePath, state.AppID)
+	}
+	if state.Resources == nil {
+		state.Resources = map[string]watchResourceState{}
+	}
+	return state, nil
+}
+
+func (r *watchRunner) saveState(resources map[string]watchResourceState, families map[string]bool) error {
+	baselined := make([]string, 0, len(families))
+	for family := range families {
+		baselined = append(baselined, family)
+	}
+	sort.Strings(baselined)
+	state := watchState{
+		AppID:     r.appID,
+		UpdatedAt: r.currentTime().UTC().Format(time.RFC3339),
+		Families:  baselined,
+		Resources: resources,
+	}
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(r.statePath), 0o755); err != nil {
+		return fmt.Errorf("write state: %w", err)
+	}
+	tmp := r.statePath + ".tmp"
+	if err := os.WriteFile(tmp, append(data, '\n'), 0o600); err != nil {
+		return fmt.Errorf("write state: %w", err)
+	}
+	if err := os.Rename(tmp, r.statePath); err != nil {
+		return fmt.Errorf("write state: %w", err)
+	}
+	return nil
+}
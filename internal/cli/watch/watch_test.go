@@ -0,0 +1,290 @@
+package watch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+type watchStub struct {
+	builds      []asc.Resource[asc.BuildAttributes]
+	versions    []asc.Resource[asc.AppStoreVersionAttributes]
+	submissions []asc.Resource[asc.BetaAppReviewSubmissionAttributes]
+}
+
+func (s *watchStub) GetBuilds(ctx context.Context, appID string, opts ...asc.BuildsOption) (*asc.BuildsResponse, error) {
+	return &asc.BuildsResponse{Data: s.builds}, nil
+}
+
+func (s *watchStub) GetAppStoreVersions(ctx context.Context, appID string, opts ...asc.AppStoreVersionsOption) (*asc.AppStoreVersionsResponse, error) {
+	return &asc.AppStoreVersionsResponse{Data: s.versions}, nil
+}
+
+func (s *watchStub) GetReviewSubmissions(ctx context.Context, appID string, opts ...asc.ReviewSubmissionsOption) (*asc.ReviewSubmissionsResponse, error) {
+	return &asc.ReviewSubmissionsResponse{}, nil
+}
+
+func (s *watchStub) GetBetaAppReviewSubmissions(ctx context.Context, opts ...asc.BetaAppReviewSubmissionsOption) (*asc.BetaAppReviewSubmissionsResponse, error) {
+	return &asc.BetaAppReviewSubmissionsResponse{Data: s.submissions}, nil
+}
+
+func TestParseWatchTrigger(t *testing.T) {
+	tests := []struct {
+		value string
+		want  watchTrigger
+	}{
+		{value: "build.processed=./on-build.sh", want: watchTrigger{Event: "build.processed", family: familyBuild, State: "VALID", Command: "./on-build.sh"}},
+		{value: "version.state=READY_FOR_SALE=workflow:announce", want: watchTrigger{Event: "version.state", family: familyVersion, State: "READY_FOR_SALE", Workflow: "announce"}},
+		{value: "review.state=echo a=b", want: watchTrigger{Event: "review.state", family: familyReview, Command: "echo a=b"}},
+		{value: "Build.State=./log.sh", want: watchTrigger{Event: "build.state", family: familyBuild, Command: "./log.sh"}},
+	}
+	for _, test := range tests {
+		got, err := parseWatchTrigger(test.value)
+		if err != nil {
+			t.Fatalf("parseWatchTrigger(%q) error: %v", test.value, err)
+		}
+		if got != test.want {
+			t.Fatalf("parseWatchTrigger(%q) = %+v, want %+v", test.value, got, test.want)
+		}
+	}
+
+	for _, value := range []string{"build.processed", "unknown.event=./x.sh", "version.state=workflow:", "version.state=READY_FOR_SALE="} {
+		if _, err := parseWatchTrigger(value); err == nil {
+			t.Fatalf("expected error for %q", value)
+		}
+	}
+}
+
+func TestWatchRunnerFiresOnTransitionsAfterBaseline(t *testing.T) {
+	dir := t.TempDir()
+	outPath := filepath.Join(dir, "events.log")
+	stub := &watchStub{
+		builds: []asc.Resource[asc.BuildAttributes]{
+			{ID: "build-1", Attributes: asc.BuildAttributes{Version: "42", ProcessingState: "PROCESSING"}},
+		},
+		versions: []asc.Resource[asc.AppStoreVersionAttributes]{
+			{ID: "ver-1", Attributes: asc.AppStoreVersionAttributes{VersionString: "1.0", AppStoreState: "WAITING_FOR_REVIEW"}},
+		},
+	}
+	var stdout bytes.Buffer
+	runner := &watchRunner{
+		client: stub,
+		appID:  "app-1",
+		triggers: []watchTrigger{
+			{Event: "build.processed", family: familyBuild, State: "VALID", Command: "cat >> '" + outPath + "'; echo >> '" + outPath + "'"},
+			{Event: "version.state", family: familyVersion, State: "READY_FOR_SALE", Command: `printf '%s\n' "$ASC_WEBHOOK_EVENT_TYPE" >> '` + outPath + `'`},
+		},
+		statePath: filepath.Join(dir, "state", "app-1.json"),
+		stdout:    &stdout,
+		now:       func() time.Time { return time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC) },
+	}
+
+	if err := runner.poll(context.Background()); err != nil {
+		t.Fatalf("baseline poll error: %v", err)
+	}
+	if _, err := os.Stat(outPath); !os.IsNotExist(err) {
+		t.Fatalf("baseline poll should not fire triggers, stat err=%v", err)
+	}
+
+	// A new build that is already VALID fires too, since it was never seen.
+	stub.builds[0].Attributes.ProcessingState = "VALID"
+	stub.builds = append(stub.builds, asc.Resource[asc.BuildAttributes]{ID: "build-2", Attributes: asc.BuildAttributes{Version: "43", ProcessingState: "PROCESSING"}})
+	stub.versions[0].Attributes.AppStoreState = "PENDING_DEVELOPER_RELEASE"
+	if err := runner.poll(context.Background()); err != nil {
+		t.Fatalf("second poll error: %v", err)
+	}
+
+	stub.versions[0].Attributes.AppStoreState = "READY_FOR_SALE"
+	if err := runner.poll(context.Background()); err != nil {
+		t.Fatalf("third poll error: %v", err)
+	}
+	// No change: nothing fires.
+	if err := runner.poll(context.Background()); err != nil {
+		t.Fatalf("fourth poll error: %v", err)
+	}
+
+	data, err := os.ReadFile(outPath)
+	if err != nil {
+		t.Fatalf("read trigger output: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 trigger runs, got %d: %q", len(lines), data)
+	}
+
+	var envelope watchEnvelope
+	if err := json.Unmarshal([]byte(lines[0]), &envelope); err != nil {
+		t.Fatalf("trigger did not receive envelope JSON: %v (%q)", err, lines[0])
+	}
+	if envelope.EventType != string(asc.WebhookEventBuildUploadStateUpdated) || envelope.Data.ID != "build-1" {
+		t.Fatalf("unexpected envelope: %+v", envelope)
+	}
+	if envelope.Data.Attributes.OldValue != "PROCESSING" || envelope.Data.Attributes.NewValue != "VALID" {
+		t.Fatalf("unexpected transition attributes: %+v", envelope.Data.Attributes)
+	}
+	if ref := envelope.Data.Relationships["instance"].Data; ref.Type != "builds" || ref.ID != "build-1" {
+		t.Fatalf("unexpected instance relationship: %+v", ref)
+	}
+	if lines[1] != string(asc.WebhookEventAppStoreVersionStateUpdated) {
+		t.Fatalf("expected version event type in env, got %q", lines[1])
+	}
+
+	// Events are echoed to stdout as JSON lines: build-1 VALID and ver-1 READY_FOR_SALE.
+	if got := strings.Count(strings.TrimSpace(stdout.String()), "\n") + 1; got != 2 {
+		t.Fatalf("expected 2 event lines on stdout, got %d: %q", got, stdout.String())
+	}
+}
+
+func TestWatchRunnerBaselinesNewlyAddedFamilies(t *testing.T) {
+	dir := t.TempDir()
+	stub := &watchStub{
+		builds: []asc.Resource[asc.BuildAttributes]{
+			{ID: "build-1", Attributes: asc.BuildAttributes{Version: "42", ProcessingState: "VALID"}},
+		},
+		submissions: []asc.Resource[asc.BetaAppReviewSubmissionAttributes]{
+			{ID: "sub-1", Attributes: asc.BetaAppReviewSubmissionAttributes{BetaReviewState: "WAITING_FOR_REVIEW"}, Relationships: json.RawMessage(`{"build":{"data":{"type":"builds","id":"build-1"}}}`)},
+		},
+	}
+	var stdout bytes.Buffer
+	runner := &watchRunner{
+		client:    stub,
+		appID:     "app-1",
+		triggers:  []watchTrigger{{Event: "build.state", family: familyBuild, Command: "true"}},
+		statePath: filepath.Join(dir, "app-1.json"),
+		stdout:    &stdout,
+	}
+	if err := runner.poll(context.Background()); err != nil {
+		t.Fatalf("baseline poll error: %v", err)
+	}
+
+	// Adding a beta review trigger later must not fire for existing submissions.
+	runner.triggers = append(runner.triggers, watchTrigger{Event: "beta-review.state", family: familyBetaReview, Command: "true"})
+	if err := runner.poll(context.Background()); err != nil {
+		t.Fatalf("second poll error: %v", err)
+	}
+	if stdout.Len() != 0 {
+		t.Fatalf("expected new family to be baselined silently, got %q", stdout.String())
+	}
+
+	stub.submissions[0].Attributes.BetaReviewState = "APPROVED"
+	if err := runner.poll(context.Background()); err != nil {
+		t.Fatalf("third poll error: %v", err)
+	}
+	var envelope watchEnvelope
+	if err := json.Unmarshal(stdout.Bytes(), &envelope); err != nil {
+		t.Fatalf("expected one event, got %q: %v", stdout.String(), err)
+	}
+	if envelope.Data.ID != "sub-1" || envelope.Data.Attributes.NewValue != "APPROVED" || envelope.Data.Attributes.Label != "42" {
+		t.Fatalf("expected beta review event labelled with the build version, got %+v", envelope.Data)
+	}
+}
+
+func TestWatchRunnerRejectsStateFromOtherApp(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.json")
+	if err := os.WriteFile(path, []byte(`{"appId":"other","resources":{}}`), 0o600); err != nil {
+		t.Fatalf("write state: %v", err)
+	}
+	runner := &watchRunner{client: &watchStub{}, appID: "app-1", statePath: path,
+		triggers: []watchTrigger{{Event: "build.state", family: familyBuild, Command: "true"}}}
+	if err := runner.poll(context.Background()); err == nil || !strings.Contains(err.Error(), "belongs to app other") {
+		t.Fatalf("expected state ownership error, got %v", err)
+	}
+}
+
+func TestWatchCommandValidatesFlags(t *testing.T) {
+	t.Setenv("ASC_APP_ID", "")
+	tests := [][]string{
+		{"--on", "build.processed=true"},
+		{"--app", "app-1"},
+		{"--app", "app-1", "--on", "build.processed=true", "--interval", "1s"},
+		{"--app", "app-1", "--on", "nope=true"},
+	}
+	for _, args := range tests {
+		cmd := WatchCommand()
+		if err := cmd.FlagSet.Parse(args); err != nil {
+			t.Fatalf("parse %v: %v", args, err)
+		}
+		if err := cmd.Exec(context.Background(), nil); err == nil {
+			t.Fatalf("expected usage error for %v", args)
+		}
+	}
+}
+
+func TestWatchRunnerPrunesResourcesGoneLongerThanRetention(t *testing.T) {
+	statePath := filepath.Join(t.TempDir(), "app-1.json")
+	// build-legacy predates LastSeen and must start aging instead of being dropped.
+	if err := os.WriteFile(statePath, []byte(`{"appId":"app-1","families":["build"],"resources":{
+		"build:build-legacy":{"family":"build","id":"build-legacy","state":"VALID"}
+	}}`), 0o600); err != nil {
+		t.Fatalf("write state: %v", err)
+	}
+	stub := &watchStub{
+		builds: []asc.Resource[asc.BuildAttributes]{
+			{ID: "build-1", Attributes: asc.BuildAttributes{Version: "42", ProcessingState: "VALID"}},
+			{ID: "build-2", Attributes: asc.BuildAttributes{Version: "43", ProcessingState: "VALID"}},
+		},
+	}
+	start := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	now := start
+	var stdout bytes.Buffer
+	runner := &watchRunner{
+		client:    stub,
+		appID:     "app-1",
+		triggers:  []watchTrigger{{Event: "build.state", family: familyBuild, Command: "true"}},
+		statePath: statePath,
+		stdout:    &stdout,
+		now:       func() time.Time { return now },
+	}
+	readResources := func() map[string]watchResourceState {
+		t.Helper()
+		state, err := runner.loadState()
+		if err != nil {
+			t.Fatalf("loadState() error: %v", err)
+		}
+		return state.Resources
+	}
+
+	if err := runner.poll(context.Background()); err != nil {
+		t.Fatalf("first poll error: %v", err)
+	}
+	stdout.Reset()
+	if got := readResources()["build:build-legacy"].LastSeen; got != start.Format(time.RFC3339) {
+		t.Fatalf("expected legacy entry to be stamped, got %q", got)
+	}
+
+	// build-2 leaves the polled window but is remembered within the retention.
+	stub.builds = stub.builds[:1]
+	now = start.Add(24 * time.Hour)
+	if err := runner.poll(context.Background()); err != nil {
+		t.Fatalf("second poll error: %v", err)
+	}
+	resources := readResources()
+	if resources["build:build-2"].LastSeen != start.Format(time.RFC3339) || resources["build:build-1"].LastSeen != now.Format(time.RFC3339) {
+		t.Fatalf("unexpected state after resource left the window: %+v", resources)
+	}
+
+	now = start.Add(watchStateRetention + time.Hour)
+	if err := runner.poll(context.Background()); err != nil {
+		t.Fatalf("third poll error: %v", err)
+	}
+	resources = readResources()
+	if _, ok := resources["build:build-2"]; ok {
+		t.Fatalf("expected build-2 to be pruned, got %+v", resources)
+	}
+	if _, ok := resources["build:build-legacy"]; ok {
+		t.Fatalf("expected build-legacy to be pruned, got %+v", resources)
+	}
+	if _, ok := resources["build:build-1"]; !ok || len(resources) != 1 {
+		t.Fatalf("expected only build-1 to remain, got %+v", resources)
+	}
+	if stdout.Len() != 0 {
+		t.Fatalf("expected no events, got %q", stdout.String())
+	}
+}
@@ -0,0 +1,227 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+// webhookReplayResult summarizes a replay run.
+type webhookReplayResult struct {
+	Dir     string                `json:"dir"`
+	DryRun  bool                  `json:"dryRun"`
+	Total   int                   `json:"total"`
+	Failed  int                   `json:"failed"`
+	Skipped int                   `json:"skipped"`
+	Events  []webhookReplayRecord `json:"events"`
+}
+
+// webhookReplayRecord is the outcome for one stored payload.
+type webhookReplayRecord struct {
+	File      string `json:"file"`
+	EventType string `json:"eventType,omitempty"`
+	EventID   string `json:"eventId,omitempty"`
+	Kind      string `json:"kind"`
+	Target    string `json:"target,omitempty"`
+	Status    string `json:"status"`
+	Error     string `json:"error,omitempty"`
+}
+
+// WebhooksReplayCommand returns the webhooks replay subcommand.
+func WebhooksReplayCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("replay", flag.ExitOnError)
+
+	dir := fs.String("dir", "", "Directory of stored payloads (from webhooks serve --dir)")
+	routes := fs.String("routes", "", "Optional JSON file mapping event types to commands or workflows")
+	execCommand := fs.String("exec", "", "Optional command to execute per event without a route (payload JSON is piped on stdin)")
+	eventTypes := fs.String("event-type", "", "Only replay these event types (comma-separated)")
+	dryRun := fs.Bool("dry-run", false, "Show where each event would be routed without running anything")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "replay",
+		ShortUsage: "asc webhooks replay --dir DIR [flags]",
+		ShortHelp:  "Re-feed stored webhook payloads through event routing.",
+		LongHelp: `Re-feed stored webhook payloads through the same routing as webhooks serve.
+
+Payload files are replayed in name order, which is arrival order for files
+written by "asc webhooks serve --dir". The event type and ID come from the
+.meta file serve writes next to each payload, so events typed only by
+delivery headers route the same way; payloads without one fall back to the
+payload's own fields. Signatures are not checked.
+
+Examples:
+  asc webhooks replay --dir ./webhook-events --routes ./.asc/webhook-routes.json
+  asc webhooks replay --dir ./webhook-events --exec "./scripts/on-webhook.sh"
+  asc webhooks replay --dir ./webhook-events --routes ./.asc/webhook-routes.json --event-type BUILD_UPLOAD_STATE_UPDATED --dry-run`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			dirValue := strings.TrimSpace(*dir)
+			if dirValue == "" {
+				return shared.UsageError("--dir is required")
+			}
+			if strings.TrimSpace(*routes) == "" && strings.TrimSpace(*execCommand) == "" {
+				return shared.UsageError("--routes or --exec is required")
+			}
+
+			router, err := loadWebhookRouter(*routes, *execCommand)
+			if err != nil {
+				return fmt.Errorf("webhooks replay: %w", err)
+			}
+
+			result, err := replayWebhookEvents(ctx, router, dirValue, shared.SplitCSVUpper(*eventTypes), *dryRun)
+			if err != nil {
+				return fmt.Errorf("webhooks replay: %w", err)
+			}
+
+			if err := shared.PrintOutputWithRenderers(
+				result,
+				*output.Output,
+				*output.Pretty,
+				func() error { return printWebhookReplayTable(result) },
+				func() error { return printWebhookReplayMarkdown(result) },
+			); err != nil {
+				return err
+			}
+			if result.Failed > 0 {
+				return shared.NewReportedError(fmt.Errorf("webhooks replay: %d event(s) failed", result.Failed))
+			}
+			return nil
+		},
+	}
+}
+
+func replayWebhookEvents(ctx context.Context, router *webhookRouter, dir string, eventTypes []string, dryRun bool) (*webhookReplayResult, error) {
+	files, err := filepath.Glob(filepath.Join(filepath.Clean(dir), "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no .json payloads found in %s", dir)
+	}
+	sort.Strings(files)
+
+	include := make(map[string]bool, len(eventTypes))
+	for _, eventType := range eventTypes {
+		include[eventType] = true
+	}
+
+	result := &webhookReplayResult{Dir: dir, DryRun: dryRun, Events: []webhookReplayRecord{}}
+	for _, path := range files {
+		record := webhookReplayRecord{File: filepath.Base(path), Kind: webhookTargetNone}
+		data, err := os.ReadFile(path)
+		if err == nil {
+			data, err = compactWebhookServeJSON(data)
+		}
+		if err != nil {
+			record.Status = "failed"
+			record.Error = err.Error()
+			result.Failed++
+			result.Events = append(result.Events, record)
+			continue
+		}
+
+		event := webhookServeEvent{ReceivedAt: time.Now().UTC(), Payload: data}
+		event.EventType, event.EventID = extractWebhookServeEventMetadata(http.Header{}, data)
+		stored, err := readWebhookStoredEventMetadata(path)
+		if err != nil {
+			record.Status = "failed"
+			record.Error = err.Error()
+			result.Failed++
+			result.Events = append(result.Events, record)
+			continue
+		}
+		if stored != nil {
+			event.EventType = firstNonEmpty(stored.EventType, event.EventType)
+			event.EventID = firstNonEmpty(stored.EventID, event.EventID)
+		}
+		record.EventType = event.EventType
+		record.EventID = event.EventID
+		if len(include) > 0 && !include[strings.ToUpper(event.EventType)] {
+			continue
+		}
+		result.Total++
+
+		target := router.resolve(event.EventType)
+		record.Kind = target.Kind
+		record.Target = target.Target
+		switch {
+		case target.Kind == webhookTargetNone:
+			record.Status = "skipped"
+			result.Skipped++
+		case dryRun:
+			record.Status = "dry-run"
+		default:
+			if _, err := router.dispatch(ctx, event); err != nil {
+				record.Status = "failed"
+				record.Error = err.Error()
+				result.Failed++
+			} else {
+				record.Status = "ok"
+			}
+		}
+		result.Events = append(result.Events, record)
+	}
+	return result, nil
+}
+
+// readWebhookStoredEventMetadata reads the metadata file serve wrote for a
+// payload. It returns nil when there is none.
+func readWebhookStoredEventMetadata(payloadPath string) (*webhookStoredEventMetadata, error) {
+	metadataPath := webhookStoredEventMetadataPath(payloadPath)
+	data, err := os.ReadFile(metadataPath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var metadata webhookStoredEventMetadata
+	if err := json.Unmarshal(data, &metadata); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", filepath.Base(metadataPath), err)
+	}
+	return &metadata, nil
+}
+
+func webhookReplayRows(result *webhookReplayResult) [][]string {
+	rows := make([][]string, 0, len(result.Events))
+	for _, event := range result.Events {
+		rows = append(rows, []string{
+			event.File,
+			shared.OrNA(event.EventType),
+			event.Kind,
+			shared.OrNA(event.Target),
+			event.Status,
+			event.Error,
+		})
+	}
+	return rows
+}
+
+func printWebhookReplayTable(result *webhookReplayResult) error {
+	fmt.Printf("Dir: %s\n", result.Dir)
+	fmt.Printf("Events: %d (failed %d, skipped %d)\n\n", result.Total, result.Failed, result.Skipped)
+	asc.RenderTable([]string{"File", "Event Type", "Kind", "Target", "Status", "Error"}, webhookReplayRows(result))
+	return nil
+}
+
+func printWebhookReplayMarkdown(result *webhookReplayResult) error {
+	fmt.Printf("**Dir:** %s\n\n", result.Dir)
+	fmt.Printf("**Events:** %d (failed %d, skipped %d)\n\n", result.Total, result.Failed, result.Skipped)
+	asc.RenderMarkdown([]string{"File", "Event Type", "Kind", "Target", "Status", "Error"}, webhookReplayRows(result))
+	return nil
+}
@@ -0,0 +1,215 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	wf "github.com/rudrankriyam/App-Store-Connect-CLI/internal/workflow"
+)
+
+const (
+	webhookSecretEnvVar        = "ASC_WEBHOOK_SECRET"
+	webhookSignatureHeader     = "X-Apple-Signature"
+	webhookSignatureHashPrefix = "hmacsha256="
+
+	webhookTargetExec     = "exec"
+	webhookTargetWorkflow = "workflow"
+	webhookTargetNone     = "none"
+)
+
+var (
+	errWebhookSignatureMissing = errors.New("missing signature")
+	errWebhookSignatureInvalid = errors.New("signature mismatch")
+)
+
+// webhookRoutesFile is the on-disk event routing table.
+type webhookRoutesFile struct {
+	WorkflowFile string                  `json:"workflowFile,omitempty"`
+	Routes       map[string]webhookRoute `json:"routes"`
+}
+
+// webhookRoute maps one event type to a shell command or a workflow name.
+type webhookRoute struct {
+	Exec     string `json:"exec,omitempty"`
+	Workflow string `json:"workflow,omitempty"`
+}
+
+// webhookRouter dispatches events to their route, falling back to --exec.
+type webhookRouter struct {
+	routes      map[string]webhookRoute
+	fallback    string
+	workflowDef *wf.Definition
+}
+
+// webhookDispatch records where an event was sent.
+type webhookDispatch struct {
+	Kind   string `json:"kind"`
+	Target string `json:"target,omitempty"`
+}
+
+// verifyWebhookSignature checks an "hmacsha256=<hex>" signature over the raw request body.
+func verifyWebhookSignature(secret string, body []byte, signature string) error {
+	signature = strings.TrimSpace(signature)
+	if signature == "" {
+		return errWebhookSignatureMissing
+	}
+	if len(signature) >= len(webhookSignatureHashPrefix) && strings.EqualFold(signature[:len(webhookSignatureHashPrefix)], webhookSignatureHashPrefix) {
+		signature = signature[len(webhookSignatureHashPrefix):]
+	}
+	provided, err := hex.DecodeString(strings.TrimSpace(signature))
+	if err != nil {
+		return errWebhookSignatureInvalid
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	if !hmac.Equal(provided, mac.Sum(nil)) {
+		return errWebhookSignatureInvalid
+	}
+	return nil
+}
+
+func resolveWebhookSecret(value string) string {
+	if trimmed := strings.TrimSpace(value); trimmed != "" {
+		return trimmed
+	}
+	return strings.TrimSpace(os.Getenv(webhookSecretEnvVar))
+}
+
+// loadWebhookRouter reads the routes file (if any) and loads the workflow file when a route needs it.
+func loadWebhookRouter(routesPath, fallback string) (*webhookRouter, error) {
+	router := &webhookRouter{fallback: strings.TrimSpace(fallback)}
+	routesPath = strings.TrimSpace(routesPath)
+	if routesPath == "" {
+		return router, nil
+	}
+
+	data, err := os.ReadFile(filepath.Clean(routesPath))
+	if err != nil {
+		return nil, fmt.Errorf("read routes: %w", err)
+	}
+	var file webhookRoutesFile
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&file); err != nil {
+		return nil, fmt.Errorf("parse routes: %w", err)
+	}
+	if len(file.Routes) == 0 {
+		return nil, fmt.Errorf("routes file %s declares no routes", routesPath)
+	}
+
+	router.routes = make(map[string]webhookRoute, len(file.Routes))
+	needsWorkflow := false
+	for eventType, route := range file.Routes {
+		key := strings.ToUpper(strings.TrimSpace(eventType))
+		route.Exec = strings.TrimSpace(route.Exec)
+		route.Workflow = strings.TrimSpace(route.Workflow)
+		if key == "" {
+			return nil, fmt.Errorf("routes file %s has an empty event type", routesPath)
+		}
+		if (route.Exec == "") == (route.Workflow == "") {
+			return nil, fmt.Errorf("route %s must set exactly one of exec or workflow", key)
+		}
+		if route.Workflow != "" {
+			needsWorkflow = true
+		}
+		router.routes[key] = route
+	}
+
+	if needsWorkflow {
+		workflowPath := strings.TrimSpace(file.WorkflowFile)
+		if workflowPath == "" {
+			workflowPath = wf.DefaultPath
+		}
+		def, err := wf.Load(workflowPath)
+		if err != nil {
+			return nil, fmt.Errorf("load workflow for routes: %w", err)
+		}
+		for _, key := range router.routeKeys() {
+			name := router.routes[key].Workflow
+			if name == "" {
+				continue
+			}
+			workflow, ok := def.Workflows[name]
+			if !ok {
+				return nil, fmt.Errorf("route %s: workflow %q not found in %s", key, name, workflowPath)
+			}
+			if workflow.Private {
+				return nil, fmt.Errorf("route %s: workflow %q is private", key, name)
+			}
+		}
+		router.workflowDef = def
+	}
+	return router, nil
+}
+
+func (r *webhookRouter) routeKeys() []string {
+	keys := make([]string, 0, len(r.routes))
+	for key := range r.routes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// resolve returns the dispatch target for an event type without running it.
+func (r *webhookRouter) resolve(eventType string) webhookDispatch {
+	if route, ok := r.routes[strings.ToUpper(strings.TrimSpace(eventType))]; ok {
+		if route.Workflow != "" {
+			return webhookDispatch{Kind: webhookTargetWorkflow, Target: route.Workflow}
+		}
+		return webhookDispatch{Kind: webhookTargetExec, Target: route.Exec}
+	}
+	if r.fallback != "" {
+		return webhookDispatch{Kind: webhookTargetExec, Target: r.fallback}
+	}
+	return webhookDispatch{Kind: webhookTargetNone}
+}
+
+// dispatch runs the route for an event. Workflow output streams to stderr.
+func (r *webhookRouter) dispatch(ctx context.Context, event webhookServeEvent) (webhookDispatch, error) {
+	target := r.resolve(event.EventType)
+	switch target.Kind {
+	case webhookTargetWorkflow:
+		_, err := wf.Run(ctx, r.workflowDef, wf.RunOptions{
+			WorkflowName: target.Target,
+			Params:       webhookEventEnv(event),
+			Stdout:       os.Stderr,
+			Stderr:       os.Stderr,
+		})
+		return target, err
+	case webhookTargetExec:
+		return target, runWebhookExecCommand(ctx, target.Target, event.Payload, webhookEventEnvSlice(event)...)
+	default:
+		return target, nil
+	}
+}
+
+func webhookEventEnvSlice(event webhookServeEvent) []string {
+	env := webhookEventEnv(event)
+	values := make([]string, 0, len(env))
+	for key, value := range env {
+		values = append(values, key+"="+value)
+	}
+	sort.Strings(values)
+	return values
+}
+
+// webhookEventEnv is the environment exposed to routed commands and workflows.
+func webhookEventEnv(event webhookServeEvent) map[string]string {
+	return map[string]string{
+		"ASC_WEBHOOK_EVENT_TYPE": event.EventType,
+		"ASC_WEBHOOK_EVENT_ID":   event.EventID,
+		"ASC_WEBHOOK_PAYLOAD":    string(event.Payload),
+	}
+}
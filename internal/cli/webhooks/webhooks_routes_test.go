@@ -0,0 +1,241 @@
+package webhooks
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func signWebhookPayload(secret, payload string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(payload))
+	return webhookSignatureHashPrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+func writeWebhookTestFile(t *testing.T, path, content string) string {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("write %s: %v", path, err)
+	}
+	return path
+}
+
+func TestVerifyWebhookSignature(t *testing.T) {
+	const payload = `{"id":"evt-1"}`
+	valid := signWebhookPayload("secret123", payload)
+
+	if err := verifyWebhookSignature("secret123", []byte(payload), valid); err != nil {
+		t.Fatalf("expected valid signature, got %v", err)
+	}
+	if err := verifyWebhookSignature("secret123", []byte(payload), strings.TrimPrefix(valid, webhookSignatureHashPrefix)); err != nil {
+		t.Fatalf("expected bare hex signature to verify, got %v", err)
+	}
+	if err := verifyWebhookSignature("other", []byte(payload), valid); !errors.Is(err, errWebhookSignatureInvalid) {
+		t.Fatalf("expected signature mismatch, got %v", err)
+	}
+	if err := verifyWebhookSignature("secret123", []byte(payload), ""); !errors.Is(err, errWebhookSignatureMissing) {
+		t.Fatalf("expected missing signature, got %v", err)
+	}
+	if err := verifyWebhookSignature("secret123", []byte(payload), "hmacsha256=zz"); !errors.Is(err, errWebhookSignatureInvalid) {
+		t.Fatalf("expected invalid hex to be rejected, got %v", err)
+	}
+}
+
+func TestWebhooksServeHandlerVerifiesSignature(t *testing.T) {
+	runtime := &webhookServeRuntime{maxBodyBytes: webhooksServeDefaultMaxBodyBytes, secret: "secret123"}
+	handler := runtime.newHandler(context.Background())
+
+	// The signature covers the raw body, whitespace included.
+	const payload = "{\n  \"id\": \"evt-signed\"\n}"
+	tests := []struct {
+		name      string
+		signature string
+		want      int
+	}{
+		{name: "valid", signature: signWebhookPayload("secret123", payload), want: http.StatusAccepted},
+		{name: "wrong secret", signature: signWebhookPayload("nope", payload), want: http.StatusUnauthorized},
+		{name: "missing", signature: "", want: http.StatusUnauthorized},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(payload))
+			if test.signature != "" {
+				req.Header.Set("X-Apple-SIGNATURE", test.signature)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			if rec.Code != test.want {
+				t.Fatalf("expected status %d, got %d (%s)", test.want, rec.Code, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestLoadWebhookRouterValidatesRoutes(t *testing.T) {
+	dir := t.TempDir()
+	tests := map[string]string{
+		"both targets":     `{"routes":{"BUILD_UPLOAD_STATE_UPDATED":{"exec":"true","workflow":"x"}}}`,
+		"no target":        `{"routes":{"BUILD_UPLOAD_STATE_UPDATED":{}}}`,
+		"no routes":        `{"routes":{}}`,
+		"unknown field":    `{"routes":{"A":{"exec":"true"}},"extra":1}`,
+		"missing workflow": `{"workflowFile":"` + filepath.ToSlash(filepath.Join(dir, "workflow.json")) + `","routes":{"A":{"workflow":"missing"}}}`,
+	}
+	writeWebhookTestFile(t, filepath.Join(dir, "workflow.json"), `{"workflows":{"on_build":{"steps":["true"]}}}`)
+
+	for name, content := range tests {
+		t.Run(name, func(t *testing.T) {
+			path := writeWebhookTestFile(t, filepath.Join(dir, strings.ReplaceAll(name, " ", "_")+".json"), content)
+			if _, err := loadWebhookRouter(path, ""); err == nil {
+				t.Fatal("expected routes error")
+			}
+		})
+	}
+}
+
+func TestWebhookRouterResolve(t *testing.T) {
+	router := &webhookRouter{
+		routes: map[string]webhookRoute{
+			"BUILD_UPLOAD_STATE_UPDATED":                  {Workflow: "on_build_processed"},
+			"APP_STORE_VERSION_APP_VERSION_STATE_UPDATED": {Exec: "./notify.sh"},
+		},
+		fallback: "./default.sh",
+	}
+
+	cases := map[string]webhookDispatch{
+		"build_upload_state_updated":                  {Kind: webhookTargetWorkflow, Target: "on_build_processed"},
+		"APP_STORE_VERSION_APP_VERSION_STATE_UPDATED": {Kind: webhookTargetExec, Target: "./notify.sh"},
+		"OTHER": {Kind: webhookTargetExec, Target: "./default.sh"},
+	}
+	for eventType, want := range cases {
+		if got := router.resolve(eventType); got != want {
+			t.Fatalf("resolve(%q) = %+v, want %+v", eventType, got, want)
+		}
+	}
+
+	router.fallback = ""
+	if got := router.resolve("OTHER"); got.Kind != webhookTargetNone {
+		t.Fatalf("expected no route without fallback, got %+v", got)
+	}
+}
+
+func TestReplayWebhookEventsRoutesToWorkflowAndExec(t *testing.T) {
+	dir := t.TempDir()
+	outDir := filepath.Join(dir, "out")
+	if err := os.MkdirAll(outDir, 0o755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	step, _ := json.Marshal(fmt.Sprintf(`printf '%%s' "$ASC_WEBHOOK_EVENT_ID" > '%s'`, filepath.Join(outDir, "workflow.txt")))
+	workflowPath := writeWebhookTestFile(t, filepath.Join(dir, "workflow.json"), fmt.Sprintf(`{
+		"workflows": {
+			"on_build_processed": {"steps": [%s]}
+		}
+	}`, step))
+	execRoute, _ := json.Marshal(fmt.Sprintf("cat > '%s'", filepath.Join(outDir, "exec.json")))
+	routesPath := writeWebhookTestFile(t, filepath.Join(dir, "routes.json"), fmt.Sprintf(`{
+		"workflowFile": %q,
+		"routes": {
+			"BUILD_UPLOAD_STATE_UPDATED": {"workflow": "on_build_processed"},
+			"APP_STORE_VERSION_APP_VERSION_STATE_UPDATED": {"exec": %s}
+		}
+	}`, workflowPath, execRoute))
+
+	eventsDir := filepath.Join(dir, "events")
+	writeWebhookTestFile(t, filepath.Join(eventsDir, "001.json"), `{"id":"evt-build","eventType":"BUILD_UPLOAD_STATE_UPDATED"}`)
+	writeWebhookTestFile(t, filepath.Join(eventsDir, "002.json"), `{"id":"evt-version","eventType":"APP_STORE_VERSION_APP_VERSION_STATE_UPDATED"}`)
+	writeWebhookTestFile(t, filepath.Join(eventsDir, "003.json"), `{"id":"evt-other","eventType":"BETA_FEEDBACK_CRASH_SUBMISSION_CREATED"}`)
+	writeWebhookTestFile(t, filepath.Join(eventsDir, "ignored.txt"), `not a payload`)
+
+	router, err := loadWebhookRouter(routesPath, "")
+	if err != nil {
+		t.Fatalf("loadWebhookRouter() error: %v", err)
+	}
+
+	dryRun, err := replayWebhookEvents(context.Background(), router, eventsDir, nil, true)
+	if err != nil {
+		t.Fatalf("dry-run replay error: %v", err)
+	}
+	if dryRun.Total != 3 || dryRun.Skipped != 1 || dryRun.Events[0].Status != "dry-run" {
+		t.Fatalf("unexpected dry-run result: %+v", dryRun)
+	}
+	if _, err := os.Stat(filepath.Join(outDir, "workflow.txt")); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("dry-run should not execute routes, stat err=%v", err)
+	}
+
+	result, err := replayWebhookEvents(context.Background(), router, eventsDir, nil, false)
+	if err != nil {
+		t.Fatalf("replay error: %v", err)
+	}
+	if result.Failed != 0 || result.Skipped != 1 {
+		t.Fatalf("unexpected replay result: %+v", result)
+	}
+	if data, err := os.ReadFile(filepath.Join(outDir, "workflow.txt")); err != nil || string(data) != "evt-build" {
+		t.Fatalf("expected workflow to receive event id, got %q (%v)", data, err)
+	}
+	if data, err := os.ReadFile(filepath.Join(outDir, "exec.json")); err != nil || !strings.Contains(string(data), `"id":"evt-version"`) {
+		t.Fatalf("expected exec route to receive payload, got %q (%v)", data, err)
+	}
+
+	filtered, err := replayWebhookEvents(context.Background(), router, eventsDir, []string{"BUILD_UPLOAD_STATE_UPDATED"}, true)
+	if err != nil {
+		t.Fatalf("filtered replay error: %v", err)
+	}
+	if filtered.Total != 1 || len(filtered.Events) != 1 || filtered.Events[0].EventID != "evt-build" {
+		t.Fatalf("unexpected filtered result: %+v", filtered)
+	}
+}
+
+func TestReplayWebhookEventsUsesEventTypeFromDeliveryHeaders(t *testing.T) {
+	eventsDir := filepath.Join(t.TempDir(), "events")
+	runtime := &webhookServeRuntime{dir: eventsDir, maxBodyBytes: webhooksServeDefaultMaxBodyBytes}
+	handler := runtime.newHandler(context.Background())
+
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"data":{"type":"webhookEvents","id":"evt-header"}}`))
+	req.Header.Set("X-Apple-Event-Type", "BUILD_UPLOAD_STATE_UPDATED")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusAccepted {
+		t.Fatalf("expected status %d, got %d (%s)", http.StatusAccepted, rec.Code, rec.Body.String())
+	}
+	waitForJSONPayloadFile(t, eventsDir)
+
+	router := &webhookRouter{routes: map[string]webhookRoute{"BUILD_UPLOAD_STATE_UPDATED": {Exec: "true"}}}
+	result, err := replayWebhookEvents(context.Background(), router, eventsDir, []string{"BUILD_UPLOAD_STATE_UPDATED"}, true)
+	if err != nil {
+		t.Fatalf("replay error: %v", err)
+	}
+	if result.Total != 1 || len(result.Events) != 1 {
+		t.Fatalf("expected the header-typed event to be replayed, got %+v", result)
+	}
+	event := result.Events[0]
+	if event.EventType != "BUILD_UPLOAD_STATE_UPDATED" || event.Kind != webhookTargetExec || event.Status != "dry-run" {
+		t.Fatalf("unexpected replay record %+v", event)
+	}
+}
+
+func TestReplayWebhookEventsRejectsInvalidMetadata(t *testing.T) {
+	eventsDir := t.TempDir()
+	writeWebhookTestFile(t, filepath.Join(eventsDir, "001.json"), `{"id":"evt-1","eventType":"BUILD_UPLOAD_STATE_UPDATED"}`)
+	writeWebhookTestFile(t, filepath.Join(eventsDir, "001"+webhookServeMetadataExt), `not json`)
+
+	router := &webhookRouter{fallback: "true"}
+	result, err := replayWebhookEvents(context.Background(), router, eventsDir, nil, true)
+	if err != nil {
+		t.Fatalf("replay error: %v", err)
+	}
+	if result.Failed != 1 || !strings.Contains(result.Events[0].Error, "parse 001.meta") {
+		t.Fatalf("expected metadata parse failure, got %+v", result)
+	}
+}
@@ -42,9 +42,23 @@ type webhookServeStartup struct {
 	Port         int    `json:"port"`
 	Dir          string `json:"dir,omitempty"`
 	ExecEnabled  bool   `json:"execEnabled"`
+	Routes       int    `json:"routes"`
+	Verified     bool   `json:"signatureVerification"`
 	MaxBodyBytes int64  `json:"maxBodyBytes"`
 }
 
+// webhookStoredEventMetadata is written next to each stored payload so replay
+// sees the event type and ID that serve resolved from the delivery headers.
+type webhookStoredEventMetadata struct {
+	ReceivedAt string `json:"receivedAt"`
+	EventType  string `json:"eventType,omitempty"`
+	EventID    string `json:"eventId,omitempty"`
+}
+
+// webhookServeMetadataExt replaces .json in a stored payload's file name to
+// name its metadata file.
+const webhookServeMetadataExt = ".meta"
+
 type webhookServeEvent struct {
 	ReceivedAt time.Time
 	Payload    []byte
@@ -54,7 +68,8 @@ type webhookServeEvent struct {
 
 type webhookServeRuntime struct {
 	dir          string
-	execCommand  string
+	router       *webhookRouter
+	secret       string
 	maxBodyBytes int64
 	fileCounter  uint64
 }
@@ -65,8 +80,10 @@ func WebhooksServeCommand() *ffcli.Command {
 
 	host := fs.String("host", webhooksServeDefaultHost, "Host to bind the local webhook receiver")
 	port := fs.Int("port", webhooksServeDefaultPort, "Port to bind the local webhook receiver (0-65535)")
-	dir := fs.String("dir", "", "Optional directory to write one JSON payload file per event")
-	execCommand := fs.String("exec", "", "Optional command to execute per event (payload JSON is piped on stdin)")
+	dir := fs.String("dir", "", "Optional directory to write one JSON payload file (and a .meta file with the resolved event type) per event")
+	execCommand := fs.String("exec", "", "Optional command to execute per event without a route (payload JSON is piped on stdin)")
+	routes := fs.String("routes", "", "Optional JSON file mapping event types to commands or workflows")
+	secret := fs.String("secret", "", "Webhook secret for signature verification (or "+webhookSecretEnvVar+")")
 	output := fs.String("output", "text", "Output format: text (default), json")
 	maxBodyBytes := fs.Int64("max-body-bytes", webhooksServeDefaultMaxBodyBytes, "Maximum accepted request body size in bytes")
 
@@ -76,10 +93,30 @@ func WebhooksServeCommand() *ffcli.Command {
 		ShortHelp:  "Run a local webhook receiver for testing and automation.",
 		LongHelp: `Run a local webhook receiver for testing and automation.
 
+When a secret is set (--secret or ` + webhookSecretEnvVar + `), every delivery must carry
+a valid X-Apple-Signature HMAC-SHA256 header; rejected deliveries get 401
+and are logged to stderr.
+
+A --routes file maps event types to a shell command or a workflow from
+.asc/workflow.json. Events without a route fall back to --exec:
+
+  {
+    "workflowFile": ".asc/workflow.json",
+    "routes": {
+      "BUILD_UPLOAD_STATE_UPDATED": {"workflow": "on_build_processed"},
+      "APP_STORE_VERSION_APP_VERSION_STATE_UPDATED": {"exec": "./scripts/notify.sh"}
+    }
+  }
+
+Routed commands and workflows receive ASC_WEBHOOK_EVENT_TYPE,
+ASC_WEBHOOK_EVENT_ID and ASC_WEBHOOK_PAYLOAD; --exec and exec routes also get
+the payload JSON on stdin.
+
 Examples:
   asc webhooks serve --port 8787
   asc webhooks serve --port 8787 --dir ./webhook-events
-  asc webhooks serve --port 8787 --exec "./scripts/on-webhook.sh"`,
+  asc webhooks serve --port 8787 --exec "./scripts/on-webhook.sh"
+  asc webhooks serve --port 8787 --secret "$WEBHOOK_SECRET" --routes ./.asc/webhook-routes.json`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -116,6 +153,12 @@ Examples:
 				return fmt.Errorf("webhooks serve: %w", err)
 			}
 
+			router, err := loadWebhookRouter(*routes, *execCommand)
+			if err != nil {
+				return fmt.Errorf("webhooks serve: %w", err)
+			}
+			signingSecret := resolveWebhookSecret(*secret)
+
 			listener, err := net.Listen("tcp", net.JoinHostPort(bindHost, strconv.Itoa(*port)))
 			if err != nil {
 				return fmt.Errorf("webhooks serve: failed to listen on %s: %w", net.JoinHostPort(bindHost, strconv.Itoa(*port)), err)
@@ -133,12 +176,15 @@ Examples:
 				Port:         actualPort,
 				Dir:          eventsDir,
 				ExecEnabled:  strings.TrimSpace(*execCommand) != "",
+				Routes:       len(router.routes),
+				Verified:     signingSecret != "",
 				MaxBodyBytes: *maxBodyBytes,
 			}
 
 			runtime := &webhookServeRuntime{
 				dir:          eventsDir,
-				execCommand:  strings.TrimSpace(*execCommand),
+				router:       router,
+				secret:       signingSecret,
 				maxBodyBytes: *maxBodyBytes,
 			}
 			server := &http.Server{
@@ -195,7 +241,7 @@ func (r *webhookServeRuntime) newHandler(ctx context.Context) http.Handler {
 			return
 		}
 
-		payload, err := readWebhookServeJSONPayload(req.Body, r.maxBodyBytes)
+		raw, err := readWebhookServeBody(req.Body, r.maxBodyBytes)
 		if err != nil {
 			if errors.Is(err, errWebhookPayloadTooLarge) {
 				writeWebhookServeJSON(w, http.StatusRequestEntityTooLarge, map[string]any{
@@ -209,6 +255,24 @@ func (r *webhookServeRuntime) newHandler(ctx context.Context) http.Handler {
 			return
 		}
 
+		if r.secret != "" {
+			if err := verifyWebhookSignature(r.secret, raw, req.Header.Get(webhookSignatureHeader)); err != nil {
+				fmt.Fprintf(os.Stderr, "webhooks serve: rejected delivery from %s: %v\n", req.RemoteAddr, err)
+				writeWebhookServeJSON(w, http.StatusUnauthorized, map[string]any{
+					"error": "invalid signature",
+				})
+				return
+			}
+		}
+
+		payload, err := compactWebhookServeJSON(raw)
+		if err != nil {
+			writeWebhookServeJSON(w, http.StatusBadRequest, map[string]any{
+				"error": "invalid JSON payload",
+			})
+			return
+		}
+
 		eventType, eventID := extractWebhookServeEventMetadata(req.Header, payload)
 		event := webhookServeEvent{
 			ReceivedAt: time.Now().UTC(),
@@ -243,10 +307,12 @@ func (r *webhookServeRuntime) processEvent(ctx context.Context, event webhookSer
 		}
 	}
 
-	if r.execCommand != "" {
-		if err := runWebhookExecCommand(ctx, r.execCommand, event.Payload); err != nil {
-			fmt.Fprintf(os.Stderr, "webhooks serve: exec failed for event id=%s: %v\n", firstNonEmpty(event.EventID, "unknown"), err)
-		}
+	if r.router == nil {
+		return
+	}
+	target, err := r.router.dispatch(ctx, event)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "webhooks serve: %s failed for event id=%s: %v\n", target.Kind, firstNonEmpty(event.EventID, "unknown"), err)
 	}
 }
 
@@ -259,12 +325,28 @@ func (r *webhookServeRuntime) writeEventFile(event webhookServeEvent) (string, e
 		sanitizeWebhookServeFilenameSegment(event.EventType),
 	)
 	outputPath := filepath.Join(r.dir, fileName)
+	metadata, err := json.Marshal(webhookStoredEventMetadata{
+		ReceivedAt: event.ReceivedAt.Format(time.RFC3339Nano),
+		EventType:  event.EventType,
+		EventID:    event.EventID,
+	})
+	if err != nil {
+		return "", err
+	}
+	// Metadata goes first so a payload file is never visible without it.
+	if _, err := shared.WriteStreamToFile(webhookStoredEventMetadataPath(outputPath), bytes.NewReader(append(metadata, '\n'))); err != nil {
+		return "", err
+	}
 	if _, err := shared.WriteStreamToFile(outputPath, bytes.NewReader(event.Payload)); err != nil {
 		return "", err
 	}
 	return outputPath, nil
 }
 
+func webhookStoredEventMetadataPath(payloadPath string) string {
+	return strings.TrimSuffix(payloadPath, ".json") + webhookServeMetadataExt
+}
+
 func prepareWebhookServeDirectory(value string) (string, error) {
 	trimmed := strings.TrimSpace(value)
 	if trimmed == "" {
@@ -291,7 +373,8 @@ func prepareWebhookServeDirectory(value string) (string, error) {
 	return cleaned, nil
 }
 
-func readWebhookServeJSONPayload(body io.ReadCloser, maxBodyBytes int64) ([]byte, error) {
+// readWebhookServeBody returns the raw request body so signatures can be checked before parsing.
+func readWebhookServeBody(body io.ReadCloser, maxBodyBytes int64) ([]byte, error) {
 	defer body.Close()
 
 	limited := &io.LimitedReader{R: body, N: maxBodyBytes}
@@ -309,7 +392,10 @@ func readWebhookServeJSONPayload(body io.ReadCloser, maxBodyBytes int64) ([]byte
 			return nil, probeErr
 		}
 	}
+	return raw, nil
+}
 
+func compactWebhookServeJSON(raw []byte) ([]byte, error) {
 	trimmed := bytes.TrimSpace(raw)
 	if len(trimmed) == 0 {
 		return nil, fmt.Errorf("empty request body")
@@ -356,8 +442,11 @@ func extractWebhookServeEventMetadata(header http.Header, payload []byte) (strin
 	return eventType, eventID
 }
 
-func runWebhookExecCommand(ctx context.Context, command string, payload []byte) error {
+func runWebhookExecCommand(ctx context.Context, command string, payload []byte, env ...string) error {
 	cmd := webhookExecCommand(ctx, command)
+	if len(env) > 0 {
+		cmd.Env = append(os.Environ(), env...)
+	}
 	cmd.Stdin = bytes.NewReader(payload)
 	cmd.Stdout = io.Discard
 
@@ -87,38 +87,53 @@ func TestWebhooksServeExecReceivesPayload(t *testing.T) {
 	waitForFileContains(t, outPath, `"id":"evt-exec-1"`)
 }
 
-func TestReadWebhookServeJSONPayloadAllowsMaxInt64Limit(t *testing.T) {
-	payload, err := readWebhookServeJSONPayload(
-		io.NopCloser(strings.NewReader(`{"id":"evt-max-int","eventType":"TEST_EVENT"}`)),
-		math.MaxInt64,
-	)
+func TestReadWebhookServeBodyAllowsMaxInt64Limit(t *testing.T) {
+	const rawPayload = `{"id":"evt-max-int","eventType":"TEST_EVENT"}`
+	body, err := readWebhookServeBody(io.NopCloser(strings.NewReader(rawPayload)), math.MaxInt64)
 	if err != nil {
-		t.Fatalf("expected payload to be accepted with max int64 limit, got error: %v", err)
+		t.Fatalf("expected body to be accepted with max int64 limit, got error: %v", err)
 	}
-	if got, want := string(payload), `{"id":"evt-max-int","eventType":"TEST_EVENT"}`; got != want {
-		t.Fatalf("expected compact payload %q, got %q", want, got)
+	if string(body) != rawPayload {
+		t.Fatalf("expected body %q, got %q", rawPayload, string(body))
 	}
 }
 
-func TestReadWebhookServeJSONPayloadAllowsExactLimit(t *testing.T) {
+func TestReadWebhookServeBodyAllowsExactLimit(t *testing.T) {
 	const rawPayload = `{"id":"evt-exact"}`
-	payload, err := readWebhookServeJSONPayload(io.NopCloser(strings.NewReader(rawPayload)), int64(len(rawPayload)))
+	body, err := readWebhookServeBody(io.NopCloser(strings.NewReader(rawPayload)), int64(len(rawPayload)))
 	if err != nil {
-		t.Fatalf("expected exact-limit payload to be accepted, got %v", err)
+		t.Fatalf("expected exact-limit body to be accepted, got %v", err)
 	}
-	if string(payload) != rawPayload {
-		t.Fatalf("expected compact payload %q, got %q", rawPayload, string(payload))
+	if string(body) != rawPayload {
+		t.Fatalf("expected body %q, got %q", rawPayload, string(body))
 	}
 }
 
-func TestReadWebhookServeJSONPayloadRejectsOneByteOverLimit(t *testing.T) {
+func TestReadWebhookServeBodyRejectsOneByteOverLimit(t *testing.T) {
 	const rawPayload = `{"id":"evt-over"}`
-	_, err := readWebhookServeJSONPayload(io.NopCloser(strings.NewReader(rawPayload)), int64(len(rawPayload)-1))
+	_, err := readWebhookServeBody(io.NopCloser(strings.NewReader(rawPayload)), int64(len(rawPayload)-1))
 	if !errors.Is(err, errWebhookPayloadTooLarge) {
 		t.Fatalf("expected payload-too-large error, got %v", err)
 	}
 }
 
+func TestCompactWebhookServeJSON(t *testing.T) {
+	payload, err := compactWebhookServeJSON([]byte("\n{ \"id\": \"evt-1\",\n  \"eventType\": \"TEST_EVENT\" }\n"))
+	if err != nil {
+		t.Fatalf("compactWebhookServeJSON() error: %v", err)
+	}
+	if got, want := string(payload), `{"id":"evt-1","eventType":"TEST_EVENT"}`; got != want {
+		t.Fatalf("expected compact payload %q, got %q", want, got)
+	}
+
+	if _, err := compactWebhookServeJSON([]byte("  ")); err == nil || !strings.Contains(err.Error(), "empty request body") {
+		t.Fatalf("expected empty body error, got %v", err)
+	}
+	if _, err := compactWebhookServeJSON([]byte("{not json")); err == nil {
+		t.Fatal("expected invalid JSON error")
+	}
+}
+
 func TestWebhooksServeHandlerRejectsNonPOST(t *testing.T) {
 	runtime := &webhookServeRuntime{maxBodyBytes: webhooksServeDefaultMaxBodyBytes}
 	handler := runtime.newHandler(context.Background())
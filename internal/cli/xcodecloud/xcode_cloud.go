@@ -60,15 +60,16 @@ Examples:
 func XcodeCloudRunCommand() *ffcli.Command {
 	fs := flag.NewFlagSet("run", flag.ExitOnError)
 
-	appID := fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (or ASC_APP_ID env)")
 	workflowName := fs.String("workflow", "", "Workflow name to trigger")
 	workflowID := fs.String("workflow-id", "", "Workflow ID to trigger (alternative to --workflow)")
 	branch := fs.String("branch", "", "Branch or tag name to build")
 	gitReferenceID := fs.String("git-reference-id", "", "Git reference ID to build (alternative to --branch)")
 	wait := fs.Bool("wait", false, "Wait for build to complete")
+	follow := fs.Bool("follow", false, "With --wait, stream action state changes and print an issues/test summary")
 	pollInterval := fs.Duration("poll-interval", 10*time.Second, "Poll interval when waiting")
 	timeout := fs.Duration("timeout", 0, "Timeout for Xcode Cloud requests (0 = use ASC_TIMEOUT or 30m default)")
-	output := shared.BindOutputFlags(fs)
+	output := bindWaitOutputFlags(fs)
 
 	return &ffcli.Command{
 		Name:       "run",
@@ -79,11 +80,16 @@ func XcodeCloudRunCommand() *ffcli.Command {
 You can specify the workflow by name (requires --app) or by ID (--workflow-id).
 You can specify the branch/tag by name (--branch) or by ID (--git-reference-id).
 
+With --wait --follow, action state changes are streamed to stderr and the final
+output is the build run summary (see xcode-cloud build-runs summary), which also
+supports --output junit and --output github.
+
 Examples:
   asc xcode-cloud run --app "123456789" --workflow "CI" --branch "main"
   asc xcode-cloud run --workflow-id "WORKFLOW_ID" --git-reference-id "REF_ID"
   asc xcode-cloud run --app "123456789" --workflow "Deploy" --branch "release/1.0" --wait
-  asc xcode-cloud run --app "123456789" --workflow "CI" --branch "main" --wait --poll-interval 30s --timeout 1h`,
+  asc xcode-cloud run --app "123456789" --workflow "CI" --branch "main" --wait --poll-interval 30s --timeout 1h
+  asc xcode-cloud run --app "123456789" --workflow "CI" --branch "main" --wait --follow --output github`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -113,6 +119,10 @@ Examples:
 			if *wait && *pollInterval <= 0 {
 				return shared.UsageError("--poll-interval must be greater than 0")
 			}
+			outputFormat, err := validateWaitOutput(*wait, *follow, *output.Output, *output.Pretty)
+			if err != nil {
+				return err
+			}
 
 			resolvedAppID := shared.ResolveAppID(*appID)
 			if hasWorkflowName && resolvedAppID == "" {
@@ -207,6 +217,9 @@ Examples:
 			}
 
 			// Wait for completion
+			if *follow {
+				return waitForBuildSummary(requestCtx, client, resp.Data.ID, *pollInterval, outputFormat, *output.Pretty)
+			}
 			return waitForBuildCompletion(requestCtx, client, resp.Data.ID, *pollInterval, *output.Output, *output.Pretty)
 		},
 	}
@@ -218,9 +231,10 @@ func XcodeCloudStatusCommand() *ffcli.Command {
 
 	runID := fs.String("run-id", "", "Build run ID to check")
 	wait := fs.Bool("wait", false, "Wait for build to complete")
+	follow := fs.Bool("follow", false, "With --wait, stream action state changes and print an issues/test summary")
 	pollInterval := fs.Duration("poll-interval", 10*time.Second, "Poll interval when waiting")
 	timeout := fs.Duration("timeout", 0, "Timeout for Xcode Cloud requests (0 = use ASC_TIMEOUT or 30m default)")
-	output := shared.BindOutputFlags(fs)
+	output := bindWaitOutputFlags(fs)
 
 	return &ffcli.Command{
 		Name:       "status",
@@ -232,7 +246,8 @@ Examples:
   asc xcode-cloud status --run-id "BUILD_RUN_ID"
   asc xcode-cloud status --run-id "BUILD_RUN_ID" --output table
   asc xcode-cloud status --run-id "BUILD_RUN_ID" --wait
-  asc xcode-cloud status --run-id "BUILD_RUN_ID" --wait --poll-interval 30s --timeout 1h`,
+  asc xcode-cloud status --run-id "BUILD_RUN_ID" --wait --poll-interval 30s --timeout 1h
+  asc xcode-cloud status --run-id "BUILD_RUN_ID" --wait --follow --output junit > xcode-cloud.xml`,
 		FlagSet:   fs,
 		UsageFunc: shared.DefaultUsageFunc,
 		Exec: func(ctx context.Context, args []string) error {
@@ -246,6 +261,10 @@ Examples:
 			if *wait && *pollInterval <= 0 {
 				return shared.UsageError("--poll-interval must be greater than 0")
 			}
+			outputFormat, err := validateWaitOutput(*wait, *follow, *output.Output, *output.Pretty)
+			if err != nil {
+				return err
+			}
 
 			client, err := shared.GetASCClient()
 			if err != nil {
@@ -255,6 +274,9 @@ Examples:
 			requestCtx, cancel := contextWithXcodeCloudTimeout(ctx, *timeout)
 			defer cancel()
 
+			if *follow {
+				return waitForBuildSummary(requestCtx, client, strings.TrimSpace(*runID), *pollInterval, outputFormat, *output.Pretty)
+			}
 			if *wait {
 				return waitForBuildCompletion(requestCtx, client, strings.TrimSpace(*runID), *pollInterval, *output.Output, *output.Pretty)
 			}
@@ -40,6 +40,7 @@ Examples:
   asc xcode-cloud build-runs --workflow-id "WORKFLOW_ID"
   asc xcode-cloud build-runs list --workflow-id "WORKFLOW_ID"
   asc xcode-cloud build-runs builds --run-id "BUILD_RUN_ID"
+  asc xcode-cloud build-runs summary --id "BUILD_RUN_ID" --output github
   asc xcode-cloud build-runs --workflow-id "WORKFLOW_ID" --limit 50
   asc xcode-cloud build-runs --workflow-id "WORKFLOW_ID" --paginate`,
 		FlagSet:   fs,
@@ -47,6 +48,7 @@ Examples:
 		Subcommands: []*ffcli.Command{
 			XcodeCloudBuildRunsListCommand(),
 			XcodeCloudBuildRunsBuildsCommand(),
+			XcodeCloudBuildRunsSummaryCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return xcodeCloudBuildRunsList(ctx, *workflowID, *limit, *next, *paginate, *output, *pretty)
@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net"
+	"os"
 	"syscall"
 	"time"
 
@@ -36,17 +38,85 @@ func waitForBuildCompletion(ctx context.Context, client *asc.Client, buildRunID
 		}
 		return struct{}{}, false, nil
 	})
+	return waitError(err, buildRunID, lastStatus)
+}
+
+// waitForBuildSummary follows a build run, then prints its issues and test results summary.
+func waitForBuildSummary(ctx context.Context, client buildRunSummaryClient, buildRunID string, pollInterval time.Duration, outputFormat string, pretty bool) error {
+	run, err := followBuildRun(ctx, client, buildRunID, pollInterval, os.Stderr)
+	if err != nil {
+		return err
+	}
+	summary, err := buildRunSummary(ctx, client, run)
 	if err != nil {
-		if errors.Is(err, context.Canceled) {
-			return fmt.Errorf("xcode-cloud: canceled waiting for build run %s (last status: %s)", buildRunID, lastStatus)
+		return fmt.Errorf("xcode-cloud: failed to summarize build run %s: %w", buildRunID, err)
+	}
+	if err := printBuildRunSummary(summary, outputFormat, pretty); err != nil {
+		return err
+	}
+	if !asc.IsBuildRunSuccessful(run.Data.Attributes.CompletionStatus) {
+		return fmt.Errorf("build run %s completed with status: %s", buildRunID, run.Data.Attributes.CompletionStatus)
+	}
+	return nil
+}
+
+// followBuildRun polls a build run and its actions, writing each state transition to w
+// until the run completes.
+func followBuildRun(ctx context.Context, client buildRunSummaryClient, buildRunID string, pollInterval time.Duration, w io.Writer) (*asc.CiBuildRunResponse, error) {
+	lastStatus := "unknown"
+	states := map[string]string{}
+	transition := func(key, label, state string) {
+		if states[key] == state {
+			return
 		}
-		if errors.Is(err, context.DeadlineExceeded) {
-			return fmt.Errorf("xcode-cloud: timed out waiting for build run %s (last status: %s)", buildRunID, lastStatus)
+		states[key] = state
+		fmt.Fprintf(w, "[%s] %s: %s\n", time.Now().Format("15:04:05"), label, state)
+	}
+
+	run, err := asc.PollUntil(ctx, pollInterval, func(ctx context.Context) (*asc.CiBuildRunResponse, bool, error) {
+		resp, err := getCiBuildRunWithRetry(ctx, client, buildRunID)
+		if err != nil {
+			return nil, false, fmt.Errorf("xcode-cloud: failed to check status: %w", err)
 		}
-		return err
+		attrs := resp.Data.Attributes
+		lastStatus = string(attrs.ExecutionProgress)
+
+		actions, err := listBuildRunActions(ctx, client, buildRunID)
+		if err != nil && !isTransientNetworkError(err) {
+			return nil, false, fmt.Errorf("xcode-cloud: failed to list actions: %w", err)
+		}
+		for _, action := range actions {
+			label := fmt.Sprintf("  %s (%s)", action.Attributes.Name, action.Attributes.ActionType)
+			transition(action.ID, label, buildStateLabel(action.Attributes.ExecutionProgress, action.Attributes.CompletionStatus))
+		}
+		transition(buildRunID, fmt.Sprintf("build run %d", attrs.Number), buildStateLabel(attrs.ExecutionProgress, attrs.CompletionStatus))
+
+		return resp, asc.IsBuildRunComplete(attrs.ExecutionProgress), nil
+	})
+	if err != nil {
+		return nil, waitError(err, buildRunID, lastStatus)
+	}
+	return run, nil
+}
+
+func buildStateLabel(progress asc.CiBuildRunExecutionProgress, status asc.CiBuildRunCompletionStatus) string {
+	if asc.IsBuildRunComplete(progress) && status != "" {
+		return fmt.Sprintf("%s (%s)", progress, status)
 	}
+	return string(progress)
+}
 
-	return nil
+func waitError(err error, buildRunID, lastStatus string) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, context.Canceled) {
+		return fmt.Errorf("xcode-cloud: canceled waiting for build run %s (last status: %s)", buildRunID, lastStatus)
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return fmt.Errorf("xcode-cloud: timed out waiting for build run %s (last status: %s)", buildRunID, lastStatus)
+	}
+	return err
 }
 
 // buildStatusResult converts a CiBuildRunResponse to XcodeCloudStatusResult.
@@ -84,7 +154,7 @@ func contextWithXcodeCloudTimeout(ctx context.Context, timeout time.Duration) (c
 	return context.WithTimeout(ctx, timeout)
 }
 
-func getCiBuildRunWithRetry(ctx context.Context, client *asc.Client, buildRunID string) (*asc.CiBuildRunResponse, error) {
+func getCiBuildRunWithRetry(ctx context.Context, client buildRunSummaryClient, buildRunID string) (*asc.CiBuildRunResponse, error) {
 	retryOpts := asc.ResolveRetryOptions()
 	return asc.WithRetry(ctx, func() (*asc.CiBuildRunResponse, error) {
 		resp, err := client.GetCiBuildRun(ctx, buildRunID)
@@ -0,0 +1,548 @@
+package xcodecloud
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	summaryOutputJUnit  = "junit"
+	summaryOutputGitHub = "github"
+
+	// xcodeCloudWorkspacePrefix is where Xcode Cloud checks out the repository.
+	xcodeCloudWorkspacePrefix = "/Volumes/workspace/repository/"
+)
+
+var summaryOutputFormats = []string{"json", "table", "markdown", summaryOutputJUnit, summaryOutputGitHub}
+
+// buildRunSummaryClient is the subset of the ASC client used to summarize build runs.
+type buildRunSummaryClient interface {
+	GetCiBuildRun(ctx context.Context, buildRunID string) (*asc.CiBuildRunResponse, error)
+	GetCiBuildActions(ctx context.Context, buildRunID string, opts ...asc.CiBuildActionsOption) (*asc.CiBuildActionsResponse, error)
+	GetCiBuildActionIssues(ctx context.Context, buildActionID string, opts ...asc.CiIssuesOption) (*asc.CiIssuesResponse, error)
+	GetCiBuildActionTestResults(ctx context.Context, buildActionID string, opts ...asc.CiTestResultsOption) (*asc.CiTestResultsResponse, error)
+}
+
+// BuildRunSummary combines a build run's actions, issues and test results.
+type BuildRunSummary struct {
+	BuildRunID        string                  `json:"buildRunId"`
+	BuildNumber       int                     `json:"buildNumber,omitempty"`
+	WorkflowID        string                  `json:"workflowId,omitempty"`
+	ExecutionProgress string                  `json:"executionProgress"`
+	CompletionStatus  string                  `json:"completionStatus,omitempty"`
+	StartedDate       string                  `json:"startedDate,omitempty"`
+	FinishedDate      string                  `json:"finishedDate,omitempty"`
+	Actions           []BuildRunSummaryAction `json:"actions"`
+	Issues            []BuildRunSummaryIssue  `json:"issues"`
+	Tests             BuildRunSummaryTotals   `json:"tests"`
+	TestResults       []BuildRunSummaryTest   `json:"testResults"`
+}
+
+// BuildRunSummaryAction is one build action in a summary.
+type BuildRunSummaryAction struct {
+	ID                string             `json:"id"`
+	Name              string             `json:"name"`
+	ActionType        string             `json:"actionType,omitempty"`
+	ExecutionProgress string             `json:"executionProgress,omitempty"`
+	CompletionStatus  string             `json:"completionStatus,omitempty"`
+	IssueCounts       *asc.CiIssueCounts `json:"issueCounts,omitempty"`
+}
+
+// BuildRunSummaryIssue is one build issue in a summary.
+type BuildRunSummaryIssue struct {
+	Action    string `json:"action"`
+	IssueType string `json:"issueType"`
+	Category  string `json:"category,omitempty"`
+	Message   string `json:"message"`
+	File      string `json:"file,omitempty"`
+	Line      int    `json:"line,omitempty"`
+}
+
+// BuildRunSummaryTotals counts test results by status.
+type BuildRunSummaryTotals struct {
+	Total            int `json:"total"`
+	Passed           int `json:"passed"`
+	Failed           int `json:"failed"`
+	Skipped          int `json:"skipped"`
+	ExpectedFailures int `json:"expectedFailures"`
+}
+
+// BuildRunSummaryTest is one test result in a summary.
+type BuildRunSummaryTest struct {
+	Action    string  `json:"action"`
+	ClassName string  `json:"className,omitempty"`
+	Name      string  `json:"name"`
+	Status    string  `json:"status"`
+	Message   string  `json:"message,omitempty"`
+	File      string  `json:"file,omitempty"`
+	Line      int     `json:"line,omitempty"`
+	Duration  float64 `json:"duration,omitempty"`
+}
+
+// XcodeCloudBuildRunsSummaryCommand returns the xcode-cloud build-runs summary subcommand.
+func XcodeCloudBuildRunsSummaryCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("summary", flag.ExitOnError)
+
+	id := fs.String("id", "", "Build run ID to summarize")
+	timeout := fs.Duration("timeout", 0, "Timeout for Xcode Cloud requests (0 = use ASC_TIMEOUT or 30m default)")
+	output := bindSummaryOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "summary",
+		ShortUsage: "asc xcode-cloud build-runs summary --id \"BUILD_RUN_ID\" [flags]",
+		ShortHelp:  "Summarize issues and test results for a build run.",
+		LongHelp: `Summarize issues and test results for a build run.
+
+Gathers every action's issues and test results into one report.
+
+Output formats:
+  json      Structured summary (default)
+  table     Actions, issues and failed tests as tables
+  markdown  Same tables as markdown, e.g. for $GITHUB_STEP_SUMMARY
+  junit     JUnit XML with one test case per test result and build error
+  github    GitHub Actions annotation lines (::error file=...)
+
+Examples:
+  asc xcode-cloud build-runs summary --id "BUILD_RUN_ID"
+  asc xcode-cloud build-runs summary --id "BUILD_RUN_ID" --output junit > xcode-cloud.xml
+  asc xcode-cloud build-runs summary --id "BUILD_RUN_ID" --output github
+  asc xcode-cloud build-runs summary --id "BUILD_RUN_ID" --output markdown >> "$GITHUB_STEP_SUMMARY"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			idValue := strings.TrimSpace(*id)
+			if idValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --id is required")
+				return flag.ErrHelp
+			}
+			if *timeout < 0 {
+				return shared.UsageError("--timeout must be greater than or equal to 0")
+			}
+			format, err := shared.ValidateOutputFormatAllowed(*output.Output, *output.Pretty, summaryOutputFormats...)
+			if err != nil {
+				return shared.UsageError(err.Error())
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("xcode-cloud build-runs summary: %w", err)
+			}
+
+			requestCtx, cancel := contextWithXcodeCloudTimeout(ctx, *timeout)
+			defer cancel()
+
+			run, err := getCiBuildRunWithRetry(requestCtx, client, idValue)
+			if err != nil {
+				return fmt.Errorf("xcode-cloud build-runs summary: %w", err)
+			}
+			summary, err := buildRunSummary(requestCtx, client, run)
+			if err != nil {
+				return fmt.Errorf("xcode-cloud build-runs summary: %w", err)
+			}
+			return printBuildRunSummary(summary, format, *output.Pretty)
+		},
+	}
+}
+
+func bindSummaryOutputFlags(fs *flag.FlagSet) shared.OutputFlags {
+	return shared.BindOutputFlagsWith(fs, "output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown, junit, github")
+}
+
+func bindWaitOutputFlags(fs *flag.FlagSet) shared.OutputFlags {
+	return shared.BindOutputFlagsWith(fs, "output", shared.DefaultOutputFormat(), "Output format: json (default), table, markdown (junit, github with --follow)")
+}
+
+// validateWaitOutput checks --follow usage and returns the normalized summary format.
+func validateWaitOutput(wait, follow bool, output string, pretty bool) (string, error) {
+	if !follow {
+		return output, nil
+	}
+	if !wait {
+		return "", shared.UsageError("--follow requires --wait")
+	}
+	format, err := shared.ValidateOutputFormatAllowed(output, pretty, summaryOutputFormats...)
+	if err != nil {
+		return "", shared.UsageError(err.Error())
+	}
+	return format, nil
+}
+
+// buildRunSummary gathers actions, issues and test results for a build run.
+func buildRunSummary(ctx context.Context, client buildRunSummaryClient, run *asc.CiBuildRunResponse) (*BuildRunSummary, error) {
+	attrs := run.Data.Attributes
+	summary := &BuildRunSummary{
+		BuildRunID:        run.Data.ID,
+		BuildNumber:       attrs.Number,
+		ExecutionProgress: string(attrs.ExecutionProgress),
+		CompletionStatus:  string(attrs.CompletionStatus),
+		StartedDate:       attrs.StartedDate,
+		FinishedDate:      attrs.FinishedDate,
+		Actions:           []BuildRunSummaryAction{},
+		Issues:            []BuildRunSummaryIssue{},
+		TestResults:       []BuildRunSummaryTest{},
+	}
+	if run.Data.Relationships != nil && run.Data.Relationships.Workflow != nil {
+		summary.WorkflowID = run.Data.Relationships.Workflow.Data.ID
+	}
+
+	actions, err := listBuildRunActions(ctx, client, run.Data.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list actions: %w", err)
+	}
+
+	for _, action := range actions {
+		summary.Actions = append(summary.Actions, BuildRunSummaryAction{
+			ID:                action.ID,
+			Name:              action.Attributes.Name,
+			ActionType:        action.Attributes.ActionType,
+			ExecutionProgress: string(action.Attributes.ExecutionProgress),
+			CompletionStatus:  string(action.Attributes.CompletionStatus),
+			IssueCounts:       action.Attributes.IssueCounts,
+		})
+
+		issues, err := listBuildActionIssues(ctx, client, action.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list issues for action %s: %w", action.ID, err)
+		}
+		for _, issue := range issues {
+			item := BuildRunSummaryIssue{
+				Action:    action.Attributes.Name,
+				IssueType: issue.Attributes.IssueType,
+				Category:  issue.Attributes.Category,
+				Message:   issue.Attributes.Message,
+			}
+			if issue.Attributes.FileSource != nil {
+				item.File = issue.Attributes.FileSource.Path
+				item.Line = issue.Attributes.FileSource.LineNumber
+			}
+			summary.Issues = append(summary.Issues, item)
+		}
+
+		tests, err := listBuildActionTestResults(ctx, client, action.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list test results for action %s: %w", action.ID, err)
+		}
+		for _, test := range tests {
+			item := BuildRunSummaryTest{
+				Action:    action.Attributes.Name,
+				ClassName: test.Attributes.ClassName,
+				Name:      test.Attributes.Name,
+				Status:    string(test.Attributes.Status),
+				Message:   test.Attributes.Message,
+			}
+			if test.Attributes.FileSource != nil {
+				item.File = test.Attributes.FileSource.Path
+				item.Line = test.Attributes.FileSource.LineNumber
+			}
+			for _, destination := range test.Attributes.DestinationTestResults {
+				item.Duration += destination.Duration
+			}
+			summary.TestResults = append(summary.TestResults, item)
+			summary.Tests.add(test.Attributes.Status)
+		}
+	}
+
+	return summary, nil
+}
+
+func (t *BuildRunSummaryTotals) add(status asc.CiTestStatus) {
+	t.Total++
+	switch status {
+	case asc.CiTestStatusSuccess:
+		t.Passed++
+	case asc.CiTestStatusFailure, asc.CiTestStatusMixed:
+		t.Failed++
+	case asc.CiTestStatusSkipped:
+		t.Skipped++
+	case asc.CiTestStatusExpectedFailure:
+		t.ExpectedFailures++
+	}
+}
+
+func listBuildRunActions(ctx context.Context, client buildRunSummaryClient, buildRunID string) ([]asc.CiBuildActionResource, error) {
+	resp, err := client.GetCiBuildActions(ctx, buildRunID, asc.WithCiBuildActionsLimit(200))
+	if err != nil {
+		return nil, err
+	}
+	items := resp.Data
+	for next := resp.Links.Next; next != ""; next = resp.Links.Next {
+		if resp, err = client.GetCiBuildActions(ctx, buildRunID, asc.WithCiBuildActionsNextURL(next)); err != nil {
+			return nil, err
+		}
+		items = append(items, resp.Data...)
+	}
+	return items, nil
+}
+
+func listBuildActionIssues(ctx context.Context, client buildRunSummaryClient, actionID string) ([]asc.CiIssueResource, error) {
+	resp, err := client.GetCiBuildActionIssues(ctx, actionID, asc.WithCiIssuesLimit(200))
+	if err != nil {
+		return nil, err
+	}
+	items := resp.Data
+	for next := resp.Links.Next; next != ""; next = resp.Links.Next {
+		if resp, err = client.GetCiBuildActionIssues(ctx, actionID, asc.WithCiIssuesNextURL(next)); err != nil {
+			return nil, err
+		}
+		items = append(items, resp.Data...)
+	}
+	return items, nil
+}
+
+func listBuildActionTestResults(ctx context.Context, client buildRunSummaryClient, actionID string) ([]asc.CiTestResultResource, error) {
+	resp, err := client.GetCiBuildActionTestResults(ctx, actionID, asc.WithCiTestResultsLimit(200))
+	if err != nil {
+		return nil, err
+	}
+	items := resp.Data
+	for next := resp.Links.Next; next != ""; next = resp.Links.Next {
+		if resp, err = client.GetCiBuildActionTestResults(ctx, actionID, asc.WithCiTestResultsNextURL(next)); err != nil {
+			return nil, err
+		}
+		items = append(items, resp.Data...)
+	}
+	return items, nil
+}
+
+// printBuildRunSummary writes the summary in a format validated against summaryOutputFormats.
+func printBuildRunSummary(summary *BuildRunSummary, format string, pretty bool) error {
+	switch format {
+	case summaryOutputJUnit:
+		report := buildRunJUnitReport(summary)
+		if _, err := report.WriteTo(os.Stdout); err != nil {
+			return err
+		}
+		fmt.Println()
+		return nil
+	case summaryOutputGitHub:
+		for _, line := range buildRunAnnotations(summary) {
+			fmt.Println(line)
+		}
+		return nil
+	default:
+		return shared.PrintOutputWithRenderers(
+			summary,
+			format,
+			pretty,
+			func() error { return renderBuildRunSummary(summary, asc.RenderTable) },
+			func() error { return renderBuildRunSummary(summary, asc.RenderMarkdown) },
+		)
+	}
+}
+
+func renderBuildRunSummary(summary *BuildRunSummary, render func([]string, [][]string)) error {
+	render([]string{"Build Run", "Build", "Progress", "Status", "Tests", "Passed", "Failed", "Skipped"}, [][]string{{
+		summary.BuildRunID,
+		fmt.Sprintf("%d", summary.BuildNumber),
+		summary.ExecutionProgress,
+		summary.CompletionStatus,
+		fmt.Sprintf("%d", summary.Tests.Total),
+		fmt.Sprintf("%d", summary.Tests.Passed),
+		fmt.Sprintf("%d", summary.Tests.Failed),
+		fmt.Sprintf("%d", summary.Tests.Skipped),
+	}})
+
+	if len(summary.Actions) > 0 {
+		rows := make([][]string, 0, len(summary.Actions))
+		for _, action := range summary.Actions {
+			counts := action.IssueCounts
+			if counts == nil {
+				counts = &asc.CiIssueCounts{}
+			}
+			rows = append(rows, []string{
+				action.Name,
+				action.ActionType,
+				action.CompletionStatus,
+				fmt.Sprintf("%d", counts.Errors),
+				fmt.Sprintf("%d", counts.Warnings+counts.AnalyzerWarnings),
+				fmt.Sprintf("%d", counts.TestFailures),
+			})
+		}
+		fmt.Println()
+		render([]string{"Action", "Type", "Status", "Errors", "Warnings", "Test Failures"}, rows)
+	}
+
+	if len(summary.Issues) > 0 {
+		rows := make([][]string, 0, len(summary.Issues))
+		for _, issue := range summary.Issues {
+			rows = append(rows, []string{issue.Action, issue.IssueType, summaryLocation(issue.File, issue.Line), issue.Message})
+		}
+		fmt.Println()
+		render([]string{"Action", "Issue", "Location", "Message"}, rows)
+	}
+
+	failed := failedSummaryTests(summary)
+	if len(failed) > 0 {
+		rows := make([][]string, 0, len(failed))
+		for _, test := range failed {
+			rows = append(rows, []string{test.Action, summaryTestName(test), summaryLocation(test.File, test.Line), test.Message})
+		}
+		fmt.Println()
+		render([]string{"Action", "Failed Test", "Location", "Message"}, rows)
+	}
+	return nil
+}
+
+// buildRunJUnitReport maps test results and build errors to JUnit test cases.
+func buildRunJUnitReport(summary *BuildRunSummary) shared.JUnitReport {
+	report := shared.JUnitReport{
+		Name:      fmt.Sprintf("xcode-cloud build %d", summary.BuildNumber),
+		Timestamp: summaryTimestamp(summary),
+	}
+	for _, test := range summary.TestResults {
+		testCase := shared.JUnitTestCase{
+			Name:      test.Name,
+			Classname: strings.Trim(test.Action+"."+test.ClassName, "."),
+			Time:      time.Duration(test.Duration * float64(time.Second)),
+		}
+		switch asc.CiTestStatus(test.Status) {
+		case asc.CiTestStatusFailure, asc.CiTestStatusMixed:
+			testCase.Failure = test.Status
+			testCase.Message = strings.TrimSpace(summaryLocation(test.File, test.Line) + " " + test.Message)
+		case asc.CiTestStatusSkipped:
+			testCase.Skipped = true
+		}
+		report.Tests = append(report.Tests, testCase)
+	}
+	for _, issue := range summary.Issues {
+		if issue.IssueType != "ERROR" {
+			continue
+		}
+		report.Tests = append(report.Tests, shared.JUnitTestCase{
+			Name:      summaryLocation(issue.File, issue.Line),
+			Classname: issue.Action + ".build",
+			Failure:   issue.IssueType,
+			Message:   issue.Message,
+		})
+	}
+	return report
+}
+
+// buildRunAnnotations renders GitHub Actions workflow commands for issues and failed tests.
+// Test-failure issues are skipped for actions that report failing test results, which carry the same failures.
+func buildRunAnnotations(summary *BuildRunSummary) []string {
+	actionsWithFailedTests := map[string]bool{}
+	failed := failedSummaryTests(summary)
+	for _, test := range failed {
+		actionsWithFailedTests[test.Action] = true
+	}
+
+	var lines []string
+	for _, issue := range summary.Issues {
+		command := "warning"
+		switch issue.IssueType {
+		case "ERROR":
+			command = "error"
+		case "TEST_FAILURE":
+			if actionsWithFailedTests[issue.Action] {
+				continue
+			}
+			command = "error"
+		}
+		lines = append(lines, githubAnnotation(command, issue.File, issue.Line, issue.Action, issue.Message))
+	}
+	for _, test := range failed {
+		message := test.Message
+		if message == "" {
+			message = "Test failed"
+		}
+		lines = append(lines, githubAnnotation("error", test.File, test.Line, summaryTestName(test), message))
+	}
+
+	lines = append(lines, githubAnnotation("notice", "", 0, "Xcode Cloud", fmt.Sprintf(
+		"Build %d %s: %d tests, %d failed, %d skipped, %d issues",
+		summary.BuildNumber,
+		strings.ToLower(summaryStatus(summary)),
+		summary.Tests.Total,
+		summary.Tests.Failed,
+		summary.Tests.Skipped,
+		len(summary.Issues),
+	)))
+	return lines
+}
+
+func githubAnnotation(command, file string, line int, title, message string) string {
+	var props []string
+	if path := annotationPath(file); path != "" {
+		props = append(props, "file="+escapeAnnotationProperty(path))
+		if line > 0 {
+			props = append(props, fmt.Sprintf("line=%d", line))
+		}
+	}
+	if title != "" {
+		props = append(props, "title="+escapeAnnotationProperty(title))
+	}
+	result := "::" + command
+	if len(props) > 0 {
+		result += " " + strings.Join(props, ",")
+	}
+	return result + "::" + escapeAnnotationData(message)
+}
+
+// annotationPath makes Xcode Cloud workspace paths repository-relative so annotations attach to the diff.
+func annotationPath(path string) string {
+	return strings.TrimPrefix(strings.TrimSpace(path), xcodeCloudWorkspacePrefix)
+}
+
+func escapeAnnotationData(value string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A").Replace(value)
+}
+
+func escapeAnnotationProperty(value string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A", ":", "%3A", ",", "%2C").Replace(value)
+}
+
+func failedSummaryTests(summary *BuildRunSummary) []BuildRunSummaryTest {
+	var failed []BuildRunSummaryTest
+	for _, test := range summary.TestResults {
+		switch asc.CiTestStatus(test.Status) {
+		case asc.CiTestStatusFailure, asc.CiTestStatusMixed:
+			failed = append(failed, test)
+		}
+	}
+	sort.SliceStable(failed, func(i, j int) bool {
+		return failed[i].Action < failed[j].Action
+	})
+	return failed
+}
+
+func summaryTestName(test BuildRunSummaryTest) string {
+	if test.ClassName == "" {
+		return test.Name
+	}
+	return test.ClassName + "." + test.Name
+}
+
+func summaryLocation(file string, line int) string {
+	path := annotationPath(file)
+	if path == "" || line <= 0 {
+		return path
+	}
+	return fmt.Sprintf("%s:%d", path, line)
+}
+
+func summaryStatus(summary *BuildRunSummary) string {
+	if summary.CompletionStatus != "" {
+		return summary.CompletionStatus
+	}
+	return summary.ExecutionProgress
+}
+
+func summaryTimestamp(summary *BuildRunSummary) time.Time {
+	for _, value := range []string{summary.FinishedDate, summary.StartedDate} {
+		if parsed, err := time.Parse(time.RFC3339, value); err == nil {
+			return parsed
+		}
+	}
+	return time.Now().UTC()
+}
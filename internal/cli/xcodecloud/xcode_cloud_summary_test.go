@@ -0,0 +1,185 @@
+package xcodecloud
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+type fakeSummaryClient struct {
+	runs        []asc.CiBuildRunResponse
+	runCalls    int
+	actions     []asc.CiBuildActionResource
+	issues      map[string][]asc.CiIssueResource
+	testResults map[string][]asc.CiTestResultResource
+}
+
+func (f *fakeSummaryClient) GetCiBuildRun(ctx context.Context, buildRunID string) (*asc.CiBuildRunResponse, error) {
+	index := min(f.runCalls, len(f.runs)-1)
+	f.runCalls++
+	resp := f.runs[index]
+	return &resp, nil
+}
+
+func (f *fakeSummaryClient) GetCiBuildActions(ctx context.Context, buildRunID string, opts ...asc.CiBuildActionsOption) (*asc.CiBuildActionsResponse, error) {
+	return &asc.CiBuildActionsResponse{Data: f.actions}, nil
+}
+
+func (f *fakeSummaryClient) GetCiBuildActionIssues(ctx context.Context, buildActionID string, opts ...asc.CiIssuesOption) (*asc.CiIssuesResponse, error) {
+	return &asc.CiIssuesResponse{Data: f.issues[buildActionID]}, nil
+}
+
+func (f *fakeSummaryClient) GetCiBuildActionTestResults(ctx context.Context, buildActionID string, opts ...asc.CiTestResultsOption) (*asc.CiTestResultsResponse, error) {
+	return &asc.CiTestResultsResponse{Data: f.testResults[buildActionID]}, nil
+}
+
+func summaryBuildRun(progress asc.CiBuildRunExecutionProgress, status asc.CiBuildRunCompletionStatus) asc.CiBuildRunResponse {
+	var resp asc.CiBuildRunResponse
+	resp.Data.ID = "run-1"
+	resp.Data.Attributes.Number = 42
+	resp.Data.Attributes.ExecutionProgress = progress
+	resp.Data.Attributes.CompletionStatus = status
+	resp.Data.Attributes.FinishedDate = "2026-03-01T10:00:00Z"
+	return resp
+}
+
+func newFakeSummaryClient() *fakeSummaryClient {
+	return &fakeSummaryClient{
+		runs: []asc.CiBuildRunResponse{
+			summaryBuildRun(asc.CiBuildRunExecutionProgressComplete, asc.CiBuildRunCompletionStatusFailed),
+		},
+		actions: []asc.CiBuildActionResource{
+			{ID: "build", Attributes: asc.CiBuildActionAttributes{Name: "Build - iOS", ActionType: "BUILD", ExecutionProgress: asc.CiBuildRunExecutionProgressComplete, CompletionStatus: asc.CiBuildRunCompletionStatusSucceeded}},
+			{ID: "test", Attributes: asc.CiBuildActionAttributes{Name: "Test - iOS", ActionType: "TEST", ExecutionProgress: asc.CiBuildRunExecutionProgressComplete, CompletionStatus: asc.CiBuildRunCompletionStatusFailed}},
+		},
+		issues: map[string][]asc.CiIssueResource{
+			"build": {
+				{Attributes: asc.CiIssueAttributes{IssueType: "WARNING", Message: "unused variable 'x'", FileSource: &asc.FileLocation{Path: "/Volumes/workspace/repository/Sources/App.swift", LineNumber: 12}}},
+			},
+			"test": {
+				{Attributes: asc.CiIssueAttributes{IssueType: "TEST_FAILURE", Message: "XCTAssertEqual failed"}},
+			},
+		},
+		testResults: map[string][]asc.CiTestResultResource{
+			"test": {
+				{Attributes: asc.CiTestResultAttributes{ClassName: "AppTests", Name: "testLaunch()", Status: asc.CiTestStatusSuccess, DestinationTestResults: []asc.CiTestDestinationResult{{Duration: 1.5}}}},
+				{Attributes: asc.CiTestResultAttributes{ClassName: "AppTests", Name: "testSync()", Status: asc.CiTestStatusFailure, Message: "XCTAssertEqual failed: (1) is not equal to (2)", FileSource: &asc.FileLocation{Path: "/Volumes/workspace/repository/Tests/AppTests.swift", LineNumber: 30}}},
+				{Attributes: asc.CiTestResultAttributes{ClassName: "AppTests", Name: "testSlow()", Status: asc.CiTestStatusSkipped}},
+			},
+		},
+	}
+}
+
+func TestBuildRunSummaryGathersIssuesAndTests(t *testing.T) {
+	client := newFakeSummaryClient()
+	run := client.runs[0]
+
+	summary, err := buildRunSummary(context.Background(), client, &run)
+	if err != nil {
+		t.Fatalf("buildRunSummary error: %v", err)
+	}
+	if len(summary.Actions) != 2 || len(summary.Issues) != 2 || len(summary.TestResults) != 3 {
+		t.Fatalf("unexpected summary sizes: %+v", summary)
+	}
+	want := BuildRunSummaryTotals{Total: 3, Passed: 1, Failed: 1, Skipped: 1}
+	if summary.Tests != want {
+		t.Fatalf("expected totals %+v, got %+v", want, summary.Tests)
+	}
+	if summary.TestResults[0].Duration != 1.5 || summary.TestResults[0].Action != "Test - iOS" {
+		t.Fatalf("unexpected first test %+v", summary.TestResults[0])
+	}
+}
+
+func TestBuildRunAnnotations(t *testing.T) {
+	client := newFakeSummaryClient()
+	run := client.runs[0]
+	summary, err := buildRunSummary(context.Background(), client, &run)
+	if err != nil {
+		t.Fatalf("buildRunSummary error: %v", err)
+	}
+
+	lines := buildRunAnnotations(summary)
+	want := []string{
+		"::warning file=Sources/App.swift,line=12,title=Build - iOS::unused variable 'x'",
+		"::error file=Tests/AppTests.swift,line=30,title=AppTests.testSync()::XCTAssertEqual failed: (1) is not equal to (2)",
+	}
+	if len(lines) != 3 {
+		t.Fatalf("expected two annotations and a notice, got %q", lines)
+	}
+	for i, line := range want {
+		if lines[i] != line {
+			t.Fatalf("annotation %d: expected %q, got %q", i, line, lines[i])
+		}
+	}
+	if !strings.HasPrefix(lines[2], "::notice title=Xcode Cloud::Build 42 failed: 3 tests, 1 failed, 1 skipped, 2 issues") {
+		t.Fatalf("unexpected notice %q", lines[2])
+	}
+
+	if got := githubAnnotation("error", "a,b.swift", 0, "T", "50% done\nnext"); got != "::error file=a%2Cb.swift,title=T::50%25 done%0Anext" {
+		t.Fatalf("unexpected escaping %q", got)
+	}
+}
+
+func TestBuildRunJUnitReport(t *testing.T) {
+	client := newFakeSummaryClient()
+	client.issues["build"] = append(client.issues["build"], asc.CiIssueResource{
+		Attributes: asc.CiIssueAttributes{IssueType: "ERROR", Message: "cannot find 'foo' in scope", FileSource: &asc.FileLocation{Path: "Sources/Foo.swift", LineNumber: 3}},
+	})
+	run := client.runs[0]
+	summary, err := buildRunSummary(context.Background(), client, &run)
+	if err != nil {
+		t.Fatalf("buildRunSummary error: %v", err)
+	}
+
+	report := buildRunJUnitReport(summary)
+	data, err := report.Marshal()
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	xml := string(data)
+	for _, want := range []string{
+		`<testsuite name="xcode-cloud build 42" tests="4" failures="2" errors="0" skipped="1"`,
+		`<testcase name="testSync()" classname="Test - iOS.AppTests" time="0.000"><failure message="Tests/AppTests.swift:30 XCTAssertEqual failed: (1) is not equal to (2)" type="FAILURE">`,
+		`<testcase name="testSlow()" classname="Test - iOS.AppTests" time="0.000"><skipped></skipped></testcase>`,
+		`<testcase name="Sources/Foo.swift:3" classname="Build - iOS.build" time="0.000"><failure message="cannot find &#39;foo&#39; in scope" type="ERROR">`,
+	} {
+		if !strings.Contains(xml, want) {
+			t.Fatalf("expected JUnit XML to contain %q, got:\n%s", want, xml)
+		}
+	}
+}
+
+func TestFollowBuildRunStreamsTransitions(t *testing.T) {
+	client := newFakeSummaryClient()
+	client.runs = []asc.CiBuildRunResponse{
+		summaryBuildRun(asc.CiBuildRunExecutionProgressRunning, ""),
+		summaryBuildRun(asc.CiBuildRunExecutionProgressRunning, ""),
+		summaryBuildRun(asc.CiBuildRunExecutionProgressComplete, asc.CiBuildRunCompletionStatusSucceeded),
+	}
+
+	var progress bytes.Buffer
+	run, err := followBuildRun(context.Background(), client, "run-1", time.Millisecond, &progress)
+	if err != nil {
+		t.Fatalf("followBuildRun error: %v", err)
+	}
+	if run.Data.Attributes.CompletionStatus != asc.CiBuildRunCompletionStatusSucceeded {
+		t.Fatalf("expected final run, got %+v", run.Data.Attributes)
+	}
+
+	output := progress.String()
+	if strings.Count(output, "build run 42: RUNNING") != 1 {
+		t.Fatalf("expected a single RUNNING transition, got:\n%s", output)
+	}
+	for _, want := range []string{
+		"build run 42: COMPLETE (SUCCEEDED)",
+		"Test - iOS (TEST): COMPLETE (FAILED)",
+	} {
+		if !strings.Contains(output, want) {
+			t.Fatalf("expected %q in:\n%s", want, output)
+		}
+	}
+}
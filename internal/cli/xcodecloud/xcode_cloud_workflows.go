@@ -14,7 +14,7 @@ import (
 )
 
 func xcodeCloudWorkflowsListFlags(fs *flag.FlagSet) (appID *string, limit *int, next *string, paginate *bool, output *string, pretty *bool) {
-	appID = fs.String("app", "", "App Store Connect app ID (or ASC_APP_ID env)")
+	appID = shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (or ASC_APP_ID env)")
 	limit = fs.Int("limit", 0, "Maximum results per page (1-200)")
 	next = fs.String("next", "", "Fetch next page using a links.next URL")
 	paginate = fs.Bool("paginate", false, "Automatically fetch all pages (aggregate results)")
@@ -41,6 +41,8 @@ Examples:
   asc xcode-cloud workflows list --app "APP_ID"
   asc xcode-cloud workflows get --id "WORKFLOW_ID"
   asc xcode-cloud workflows repository --id "WORKFLOW_ID"
+  asc xcode-cloud workflows export --app "APP_ID" --dir "./xcode-cloud"
+  asc xcode-cloud workflows apply --app "APP_ID" --dir "./xcode-cloud" --dry-run
   asc xcode-cloud workflows --app "APP_ID" --limit 50
   asc xcode-cloud workflows --app "APP_ID" --paginate`,
 		FlagSet:   fs,
@@ -52,6 +54,8 @@ Examples:
 			XcodeCloudWorkflowsCreateCommand(),
 			XcodeCloudWorkflowsUpdateCommand(),
 			XcodeCloudWorkflowsDeleteCommand(),
+			XcodeCloudWorkflowsExportCommand(),
+			XcodeCloudWorkflowsApplyCommand(),
 		},
 		Exec: func(ctx context.Context, args []string) error {
 			return xcodeCloudWorkflowsList(ctx, *appID, *limit, *next, *paginate, *output, *pretty)
@@ -0,0 +1,877 @@
+package xcodecloud
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/peterbourgon/ff/v3/ffcli"
+	"gopkg.in/yaml.v3"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/cli/shared"
+)
+
+const (
+	workflowFormatYAML = "yaml"
+	workflowFormatJSON = "json"
+
+	workflowChangeCreate = "create"
+	workflowChangeUpdate = "update"
+)
+
+// workflowIncludes are the relationships needed to describe a workflow by name.
+var workflowIncludes = []string{"repository", "xcodeVersion", "macOsVersion"}
+
+// WorkflowDefinition is the canonical, reviewable file form of an Xcode Cloud workflow.
+// Repository, Xcode and macOS versions are stored by name and resolved to IDs on apply.
+type WorkflowDefinition struct {
+	ID                              string                           `json:"id,omitempty"`
+	Name                            string                           `json:"name"`
+	Description                     string                           `json:"description,omitempty"`
+	Repository                      string                           `json:"repository"`
+	XcodeVersion                    string                           `json:"xcodeVersion"`
+	MacOSVersion                    string                           `json:"macOsVersion"`
+	ContainerFilePath               string                           `json:"containerFilePath"`
+	IsEnabled                       bool                             `json:"isEnabled"`
+	Clean                           bool                             `json:"clean"`
+	BranchStartCondition            *asc.CiBranchStartCondition      `json:"branchStartCondition,omitempty"`
+	TagStartCondition               *asc.CiTagStartCondition         `json:"tagStartCondition,omitempty"`
+	PullRequestStartCondition       *asc.CiPullRequestStartCondition `json:"pullRequestStartCondition,omitempty"`
+	ScheduledStartCondition         *asc.CiScheduledStartCondition   `json:"scheduledStartCondition,omitempty"`
+	ManualBranchStartCondition      *asc.CiManualStartCondition      `json:"manualBranchStartCondition,omitempty"`
+	ManualTagStartCondition         *asc.CiManualStartCondition      `json:"manualTagStartCondition,omitempty"`
+	ManualPullRequestStartCondition *asc.CiManualStartCondition      `json:"manualPullRequestStartCondition,omitempty"`
+	Actions                         []asc.CiAction                   `json:"actions"`
+}
+
+// WorkflowExportFile is one file written by workflows export.
+type WorkflowExportFile struct {
+	Workflow   string `json:"workflow"`
+	WorkflowID string `json:"workflowId"`
+	File       string `json:"file"`
+}
+
+// WorkflowsExportResult is the output of xcode-cloud workflows export.
+type WorkflowsExportResult struct {
+	AppID     string               `json:"appId"`
+	ProductID string               `json:"productId"`
+	Dir       string               `json:"dir"`
+	Files     []WorkflowExportFile `json:"files"`
+}
+
+// WorkflowSyncChange is one step in a workflows apply plan.
+type WorkflowSyncChange struct {
+	Action     string   `json:"action"`
+	Workflow   string   `json:"workflow"`
+	WorkflowID string   `json:"workflowId,omitempty"`
+	File       string   `json:"file"`
+	Fields     []string `json:"fields,omitempty"`
+
+	payload json.RawMessage
+}
+
+// WorkflowsApplyResult is the output of xcode-cloud workflows apply.
+type WorkflowsApplyResult struct {
+	AppID     string               `json:"appId"`
+	Dir       string               `json:"dir"`
+	DryRun    bool                 `json:"dryRun"`
+	Applied   bool                 `json:"applied"`
+	NoChanges bool                 `json:"noChanges"`
+	Changes   []WorkflowSyncChange `json:"changes"`
+}
+
+type workflowSyncClient interface {
+	ResolveCiProductForApp(ctx context.Context, appID string) (*asc.CiProductResource, error)
+	GetCiWorkflows(ctx context.Context, productID string, opts ...asc.CiWorkflowsOption) (*asc.CiWorkflowsResponse, error)
+	GetCiProductPrimaryRepositories(ctx context.Context, productID string, opts ...asc.CiProductRepositoriesOption) (*asc.ScmRepositoriesResponse, error)
+	GetCiProductAdditionalRepositories(ctx context.Context, productID string, opts ...asc.CiProductRepositoriesOption) (*asc.ScmRepositoriesResponse, error)
+	GetCiXcodeVersions(ctx context.Context, opts ...asc.CiXcodeVersionsOption) (*asc.CiXcodeVersionsResponse, error)
+	GetCiMacOsVersions(ctx context.Context, opts ...asc.CiMacOsVersionsOption) (*asc.CiMacOsVersionsResponse, error)
+	CreateCiWorkflow(ctx context.Context, payload json.RawMessage) (*asc.CiWorkflowResponse, error)
+	UpdateCiWorkflow(ctx context.Context, workflowID string, payload json.RawMessage) (*asc.CiWorkflowResponse, error)
+}
+
+// workflowRef is a named resource a workflow points to.
+type workflowRef struct {
+	id     string
+	label  string
+	labels []string
+}
+
+// workflowRefs indexes repositories, Xcode versions and macOS versions for name resolution.
+type workflowRefs struct {
+	repositories  []workflowRef
+	xcodeVersions []workflowRef
+	macOSVersions []workflowRef
+}
+
+// XcodeCloudWorkflowsExportCommand returns the xcode-cloud workflows export subcommand.
+func XcodeCloudWorkflowsExportCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (or ASC_APP_ID env)")
+	dir := fs.String("dir", "", "Directory to write workflow files to (required)")
+	format := fs.String("format", workflowFormatYAML, "File format: yaml or json")
+	timeout := fs.Duration("timeout", 0, "Timeout for Xcode Cloud requests (0 = use ASC_TIMEOUT or 30m default)")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "export",
+		ShortUsage: "asc xcode-cloud workflows export --app \"APP_ID\" --dir \"./xcode-cloud\" [flags]",
+		ShortHelp:  "Export workflows as canonical YAML or JSON files.",
+		LongHelp: `Export workflows as canonical YAML or JSON files.
+
+Writes one file per workflow with start conditions, actions, and the
+repository, Xcode version and macOS version by name. Commit the files to
+review workflow changes like code; apply them with "workflows apply".
+
+Examples:
+  asc xcode-cloud workflows export --app "APP_ID" --dir "./xcode-cloud"
+  asc xcode-cloud workflows export --app "APP_ID" --dir "./xcode-cloud" --format json`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			dirValue := strings.TrimSpace(*dir)
+			if dirValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --dir is required")
+				return flag.ErrHelp
+			}
+			formatValue := strings.ToLower(strings.TrimSpace(*format))
+			if formatValue != workflowFormatYAML && formatValue != workflowFormatJSON {
+				return shared.UsageError("--format must be yaml or json")
+			}
+			if *timeout < 0 {
+				return shared.UsageError("--timeout must be greater than or equal to 0")
+			}
+			resolvedAppID := shared.ResolveAppID(*appID)
+			if resolvedAppID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
+				return flag.ErrHelp
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("xcode-cloud workflows export: %w", err)
+			}
+
+			requestCtx, cancel := contextWithXcodeCloudTimeout(ctx, *timeout)
+			defer cancel()
+
+			result, err := exportWorkflows(requestCtx, client, resolvedAppID, dirValue, formatValue)
+			if err != nil {
+				return fmt.Errorf("xcode-cloud workflows export: %w", err)
+			}
+
+			return shared.PrintOutputWithRenderers(
+				result,
+				*output.Output,
+				*output.Pretty,
+				func() error { return printWorkflowsExportResult(result, asc.RenderTable) },
+				func() error { return printWorkflowsExportResult(result, asc.RenderMarkdown) },
+			)
+		},
+	}
+}
+
+// XcodeCloudWorkflowsApplyCommand returns the xcode-cloud workflows apply subcommand.
+func XcodeCloudWorkflowsApplyCommand() *ffcli.Command {
+	fs := flag.NewFlagSet("apply", flag.ExitOnError)
+
+	appID := shared.ReferenceFlag(fs, shared.ReferenceApp, "", "App Store Connect app ID, bundle ID or app name (or ASC_APP_ID env)")
+	dir := fs.String("dir", "", "Directory of workflow files (required)")
+	dryRun := fs.Bool("dry-run", false, "Preview the plan without mutating App Store Connect")
+	timeout := fs.Duration("timeout", 0, "Timeout for Xcode Cloud requests (0 = use ASC_TIMEOUT or 30m default)")
+	output := shared.BindOutputFlags(fs)
+
+	return &ffcli.Command{
+		Name:       "apply",
+		ShortUsage: "asc xcode-cloud workflows apply --app \"APP_ID\" --dir \"./xcode-cloud\" [flags]",
+		ShortHelp:  "Create or update workflows from exported files.",
+		LongHelp: `Create or update workflows from exported files.
+
+Diffs every *.yaml, *.yml and *.json file in --dir against the remote
+workflows and prints the plan. Repository, Xcode version and macOS version
+names are resolved to IDs.
+
+Notes:
+  - workflows are matched by id, then by name; files without a match are created.
+  - only changed workflows are updated; nothing is deleted.
+  - the repository of an existing workflow cannot be changed.
+
+Examples:
+  asc xcode-cloud workflows apply --app "APP_ID" --dir "./xcode-cloud" --dry-run
+  asc xcode-cloud workflows apply --app "APP_ID" --dir "./xcode-cloud"`,
+		FlagSet:   fs,
+		UsageFunc: shared.DefaultUsageFunc,
+		Exec: func(ctx context.Context, args []string) error {
+			dirValue := strings.TrimSpace(*dir)
+			if dirValue == "" {
+				fmt.Fprintln(os.Stderr, "Error: --dir is required")
+				return flag.ErrHelp
+			}
+			if *timeout < 0 {
+				return shared.UsageError("--timeout must be greater than or equal to 0")
+			}
+			resolvedAppID := shared.ResolveAppID(*appID)
+			if resolvedAppID == "" {
+				fmt.Fprintln(os.Stderr, "Error: --app is required (or set ASC_APP_ID)")
+				return flag.ErrHelp
+			}
+
+			definitions, err := readWorkflowDefinitions(dirValue)
+			if err != nil {
+				return fmt.Errorf("xcode-cloud workflows apply: %w", err)
+			}
+
+			client, err := shared.GetASCClient()
+			if err != nil {
+				return fmt.Errorf("xcode-cloud workflows apply: %w", err)
+			}
+
+			requestCtx, cancel := contextWithXcodeCloudTimeout(ctx, *timeout)
+			defer cancel()
+
+			changes, err := planWorkflowsApply(requestCtx, client, resolvedAppID, definitions)
+			if err != nil {
+				return fmt.Errorf("xcode-cloud workflows apply: %w", err)
+			}
+
+			result := WorkflowsApplyResult{
+				AppID:     resolvedAppID,
+				Dir:       filepath.Clean(dirValue),
+				DryRun:    *dryRun,
+				NoChanges: len(changes) == 0,
+				Changes:   changes,
+			}
+			if !*dryRun && len(changes) > 0 {
+				applied, applyErr := applyWorkflowChanges(requestCtx, client, changes)
+				result.Changes = applied
+				if applyErr != nil {
+					// Report workflows already created or updated, with their IDs.
+					if err := printWorkflowsApplyOutput(result, *output.Output, *output.Pretty); err != nil {
+						return err
+					}
+					return shared.NewReportedError(fmt.Errorf("xcode-cloud workflows apply: %w", applyErr))
+				}
+				result.Applied = true
+			}
+
+			return printWorkflowsApplyOutput(result, *output.Output, *output.Pretty)
+		},
+	}
+}
+
+func printWorkflowsApplyOutput(result WorkflowsApplyResult, format string, pretty bool) error {
+	return shared.PrintOutputWithRenderers(
+		result,
+		format,
+		pretty,
+		func() error { return printWorkflowsApplyResult(result, asc.RenderTable) },
+		func() error { return printWorkflowsApplyResult(result, asc.RenderMarkdown) },
+	)
+}
+
+func exportWorkflows(ctx context.Context, client workflowSyncClient, appID, dir, format string) (*WorkflowsExportResult, error) {
+	product, err := client.ResolveCiProductForApp(ctx, appID)
+	if err != nil {
+		return nil, err
+	}
+	workflows, err := listWorkflowsWithRefs(ctx, client, product.ID)
+	if err != nil {
+		return nil, err
+	}
+	refs, err := loadWorkflowRefs(ctx, client, product.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &WorkflowsExportResult{
+		AppID:     appID,
+		ProductID: product.ID,
+		Dir:       filepath.Clean(dir),
+		Files:     []WorkflowExportFile{},
+	}
+	used := map[string]bool{}
+	for _, workflow := range workflows {
+		definition := workflowDefinitionFromResource(workflow, refs)
+		data, err := encodeWorkflowDefinition(definition, format)
+		if err != nil {
+			return nil, fmt.Errorf("encode workflow %q: %w", definition.Name, err)
+		}
+
+		base := workflowFileSlug(definition.Name)
+		name := base
+		for i := 2; used[name]; i++ {
+			name = fmt.Sprintf("%s-%d", base, i)
+		}
+		used[name] = true
+		path := filepath.Join(dir, name+"."+format)
+		if _, err := shared.WriteFileNoSymlinkOverwrite(path, bytes.NewReader(data), 0o644, ".asc-workflow-*", ".asc-workflow-backup-*"); err != nil {
+			return nil, fmt.Errorf("write %s: %w", path, err)
+		}
+		result.Files = append(result.Files, WorkflowExportFile{
+			Workflow:   definition.Name,
+			WorkflowID: definition.ID,
+			File:       path,
+		})
+	}
+	return result, nil
+}
+
+func planWorkflowsApply(ctx context.Context, client workflowSyncClient, appID string, definitions map[string]WorkflowDefinition) ([]WorkflowSyncChange, error) {
+	product, err := client.ResolveCiProductForApp(ctx, appID)
+	if err != nil {
+		return nil, err
+	}
+	workflows, err := listWorkflowsWithRefs(ctx, client, product.ID)
+	if err != nil {
+		return nil, err
+	}
+	refs, err := loadWorkflowRefs(ctx, client, product.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := map[string]asc.CiWorkflowResource{}
+	byName := map[string][]asc.CiWorkflowResource{}
+	for _, workflow := range workflows {
+		byID[workflow.ID] = workflow
+		byName[workflow.Attributes.Name] = append(byName[workflow.Attributes.Name], workflow)
+	}
+
+	var changes []WorkflowSyncChange
+	for _, file := range sortedWorkflowFiles(definitions) {
+		desired := definitions[file]
+
+		repositoryID, err := resolveWorkflowRef("repository", desired.Repository, refs.repositories)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", file, err)
+		}
+		xcodeID, err := resolveWorkflowRef("xcodeVersion", desired.XcodeVersion, refs.xcodeVersions)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", file, err)
+		}
+		macOSID, err := resolveWorkflowRef("macOsVersion", desired.MacOSVersion, refs.macOSVersions)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", file, err)
+		}
+		// Compare by canonical names so "16.2" and "Xcode 16.2" are the same version.
+		desired.Repository = refLabel(refs.repositories, repositoryID)
+		desired.XcodeVersion = refLabel(refs.xcodeVersions, xcodeID)
+		desired.MacOSVersion = refLabel(refs.macOSVersions, macOSID)
+
+		var remote *asc.CiWorkflowResource
+		if desired.ID != "" {
+			match, ok := byID[desired.ID]
+			if !ok {
+				return nil, fmt.Errorf("%s: workflow id %q not found", file, desired.ID)
+			}
+			remote = &match
+		} else if matches := byName[desired.Name]; len(matches) > 1 {
+			return nil, fmt.Errorf("%s: multiple workflows named %q; add an id", file, desired.Name)
+		} else if len(matches) == 1 {
+			remote = &matches[0]
+		}
+
+		if remote == nil {
+			payload, err := workflowPayload("", desired, map[string]asc.ResourceData{
+				"product":      {Type: asc.ResourceTypeCiProducts, ID: product.ID},
+				"repository":   {Type: asc.ResourceTypeScmRepositories, ID: repositoryID},
+				"xcodeVersion": {Type: asc.ResourceTypeCiXcodeVersions, ID: xcodeID},
+				"macOsVersion": {Type: asc.ResourceTypeCiMacOsVersions, ID: macOSID},
+			})
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", file, err)
+			}
+			changes = append(changes, WorkflowSyncChange{
+				Action:   workflowChangeCreate,
+				Workflow: desired.Name,
+				File:     file,
+				payload:  payload,
+			})
+			continue
+		}
+
+		current := workflowDefinitionFromResource(*remote, refs)
+		desired.ID = current.ID
+		if current.Repository != desired.Repository {
+			return nil, fmt.Errorf("%s: the repository of workflow %q cannot be changed (%s -> %s)", file, desired.Name, current.Repository, desired.Repository)
+		}
+		fields, err := diffWorkflowDefinitions(current, desired)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", file, err)
+		}
+		if len(fields) == 0 {
+			continue
+		}
+		payload, err := workflowPayload(remote.ID, desired, map[string]asc.ResourceData{
+			"xcodeVersion": {Type: asc.ResourceTypeCiXcodeVersions, ID: xcodeID},
+			"macOsVersion": {Type: asc.ResourceTypeCiMacOsVersions, ID: macOSID},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", file, err)
+		}
+		changes = append(changes, WorkflowSyncChange{
+			Action:     workflowChangeUpdate,
+			Workflow:   desired.Name,
+			WorkflowID: remote.ID,
+			File:       file,
+			Fields:     fields,
+			payload:    payload,
+		})
+	}
+	return changes, nil
+}
+
+func applyWorkflowChanges(ctx context.Context, client workflowSyncClient, changes []WorkflowSyncChange) ([]WorkflowSyncChange, error) {
+	applied := make([]WorkflowSyncChange, 0, len(changes))
+	for _, change := range changes {
+		switch change.Action {
+		case workflowChangeCreate:
+			resp, err := client.CreateCiWorkflow(ctx, change.payload)
+			if err != nil {
+				return applied, fmt.Errorf("create workflow %q: %w", change.Workflow, err)
+			}
+			change.WorkflowID = resp.Data.ID
+		case workflowChangeUpdate:
+			if _, err := client.UpdateCiWorkflow(ctx, change.WorkflowID, change.payload); err != nil {
+				return applied, fmt.Errorf("update workflow %q: %w", change.Workflow, err)
+			}
+		}
+		applied = append(applied, change)
+	}
+	return applied, nil
+}
+
+func listWorkflowsWithRefs(ctx context.Context, client workflowSyncClient, productID string) ([]asc.CiWorkflowResource, error) {
+	resp, err := client.GetCiWorkflows(ctx, productID, asc.WithCiWorkflowsLimit(200), asc.WithCiWorkflowsInclude(workflowIncludes...))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workflows: %w", err)
+	}
+	items := resp.Data
+	for next := resp.Links.Next; next != ""; next = resp.Links.Next {
+		if resp, err = client.GetCiWorkflows(ctx, productID, asc.WithCiWorkflowsNextURL(next)); err != nil {
+			return nil, fmt.Errorf("failed to list workflows: %w", err)
+		}
+		items = append(items, resp.Data...)
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].Attributes.Name < items[j].Attributes.Name
+	})
+	return items, nil
+}
+
+func loadWorkflowRefs(ctx context.Context, client workflowSyncClient, productID string) (workflowRefs, error) {
+	var refs workflowRefs
+
+	for _, list := range []func(context.Context, string, ...asc.CiProductRepositoriesOption) (*asc.ScmRepositoriesResponse, error){
+		client.GetCiProductPrimaryRepositories,
+		client.GetCiProductAdditionalRepositories,
+	} {
+		resp, err := list(ctx, productID, asc.WithCiProductRepositoriesLimit(200))
+		for err == nil {
+			for _, repo := range resp.Data {
+				label := repo.Attributes.RepositoryName
+				if repo.Attributes.OwnerName != "" {
+					label = repo.Attributes.OwnerName + "/" + label
+				}
+				refs.repositories = append(refs.repositories, workflowRef{
+					id:     repo.ID,
+					label:  label,
+					labels: []string{label, repo.Attributes.RepositoryName, repo.Attributes.HTTPCloneURL, repo.Attributes.SSHCloneURL},
+				})
+			}
+			if resp.Links.Next == "" {
+				break
+			}
+			resp, err = list(ctx, productID, asc.WithCiProductRepositoriesNextURL(resp.Links.Next))
+		}
+		if err != nil {
+			return refs, fmt.Errorf("failed to list repositories: %w", err)
+		}
+	}
+
+	xcode, err := client.GetCiXcodeVersions(ctx, asc.WithCiXcodeVersionsLimit(200))
+	for err == nil {
+		for _, version := range xcode.Data {
+			refs.xcodeVersions = append(refs.xcodeVersions, workflowRef{
+				id:     version.ID,
+				label:  firstNonEmptyString(version.Attributes.Name, version.Attributes.Version),
+				labels: []string{version.Attributes.Name, version.Attributes.Version},
+			})
+		}
+		if xcode.Links.Next == "" {
+			break
+		}
+		xcode, err = client.GetCiXcodeVersions(ctx, asc.WithCiXcodeVersionsNextURL(xcode.Links.Next))
+	}
+	if err != nil {
+		return refs, fmt.Errorf("failed to list Xcode versions: %w", err)
+	}
+
+	macOS, err := client.GetCiMacOsVersions(ctx, asc.WithCiMacOsVersionsLimit(200))
+	for err == nil {
+		for _, version := range macOS.Data {
+			refs.macOSVersions = append(refs.macOSVersions, workflowRef{
+				id:     version.ID,
+				label:  firstNonEmptyString(version.Attributes.Name, version.Attributes.Version),
+				labels: []string{version.Attributes.Name, version.Attributes.Version},
+			})
+		}
+		if macOS.Links.Next == "" {
+			break
+		}
+		macOS, err = client.GetCiMacOsVersions(ctx, asc.WithCiMacOsVersionsNextURL(macOS.Links.Next))
+	}
+	if err != nil {
+		return refs, fmt.Errorf("failed to list macOS versions: %w", err)
+	}
+	return refs, nil
+}
+
+// resolveWorkflowRef matches value against IDs, then case-insensitively against names.
+func resolveWorkflowRef(field, value string, refs []workflowRef) (string, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return "", fmt.Errorf("%s is required", field)
+	}
+	for _, ref := range refs {
+		if ref.id == value {
+			return ref.id, nil
+		}
+	}
+	var matches []string
+	for _, ref := range refs {
+		for _, label := range ref.labels {
+			if label != "" && strings.EqualFold(label, value) {
+				matches = append(matches, ref.id)
+				break
+			}
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("%s %q not found", field, value)
+	case 1:
+		return matches[0], nil
+	default:
+		return "", fmt.Errorf("%s %q is ambiguous (%d matches); use the ID", field, value, len(matches))
+	}
+}
+
+// refLabel returns the canonical name for id, or the id itself when unknown.
+func refLabel(refs []workflowRef, id string) string {
+	for _, ref := range refs {
+		if ref.id == id {
+			return ref.label
+		}
+	}
+	return id
+}
+
+func workflowDefinitionFromResource(workflow asc.CiWorkflowResource, refs workflowRefs) WorkflowDefinition {
+	attrs := workflow.Attributes
+	definition := WorkflowDefinition{
+		ID:                              workflow.ID,
+		Name:                            attrs.Name,
+		Description:                     attrs.Description,
+		ContainerFilePath:               attrs.ContainerFilePath,
+		IsEnabled:                       attrs.IsEnabled,
+		Clean:                           attrs.Clean,
+		BranchStartCondition:            attrs.BranchStartCondition,
+		TagStartCondition:               attrs.TagStartCondition,
+		PullRequestStartCondition:       attrs.PullRequestStartCondition,
+		ScheduledStartCondition:         attrs.ScheduledStartCondition,
+		ManualBranchStartCondition:      attrs.ManualBranchStartCondition,
+		ManualTagStartCondition:         attrs.ManualTagStartCondition,
+		ManualPullRequestStartCondition: attrs.ManualPullRequestStartCondition,
+		Actions:                         attrs.Actions,
+	}
+	if definition.Actions == nil {
+		definition.Actions = []asc.CiAction{}
+	}
+	if rel := workflow.Relationships; rel != nil {
+		if rel.Repository != nil {
+			definition.Repository = refLabel(refs.repositories, rel.Repository.Data.ID)
+		}
+		if rel.XcodeVersion != nil {
+			definition.XcodeVersion = refLabel(refs.xcodeVersions, rel.XcodeVersion.Data.ID)
+		}
+		if rel.MacOsVersion != nil {
+			definition.MacOSVersion = refLabel(refs.macOSVersions, rel.MacOsVersion.Data.ID)
+		}
+	}
+	return definition
+}
+
+// workflowPayloadAttributes omits nothing so updates clear removed start conditions.
+type workflowPayloadAttributes struct {
+	Name                            string                           `json:"name"`
+	Description                     string                           `json:"description"`
+	BranchStartCondition            *asc.CiBranchStartCondition      `json:"branchStartCondition"`
+	TagStartCondition               *asc.CiTagStartCondition         `json:"tagStartCondition"`
+	PullRequestStartCondition       *asc.CiPullRequestStartCondition `json:"pullRequestStartCondition"`
+	ScheduledStartCondition         *asc.CiScheduledStartCondition   `json:"scheduledStartCondition"`
+	ManualBranchStartCondition      *asc.CiManualStartCondition      `json:"manualBranchStartCondition"`
+	ManualTagStartCondition         *asc.CiManualStartCondition      `json:"manualTagStartCondition"`
+	ManualPullRequestStartCondition *asc.CiManualStartCondition      `json:"manualPullRequestStartCondition"`
+	IsEnabled                       bool                             `json:"isEnabled"`
+	Clean                           bool                             `json:"clean"`
+	ContainerFilePath               string                           `json:"containerFilePath"`
+	Actions                         []asc.CiAction                   `json:"actions"`
+}
+
+func workflowPayload(id string, definition WorkflowDefinition, relationships map[string]asc.ResourceData) (json.RawMessage, error) {
+	rels := make(map[string]asc.Relationship, len(relationships))
+	for name, data := range relationships {
+		rels[name] = asc.Relationship{Data: data}
+	}
+	data := map[string]any{
+		"type": asc.ResourceTypeCiWorkflows,
+		"attributes": workflowPayloadAttributes{
+			Name:                            definition.Name,
+			Description:                     definition.Description,
+			BranchStartCondition:            definition.BranchStartCondition,
+			TagStartCondition:               definition.TagStartCondition,
+			PullRequestStartCondition:       definition.PullRequestStartCondition,
+			ScheduledStartCondition:         definition.ScheduledStartCondition,
+			ManualBranchStartCondition:      definition.ManualBranchStartCondition,
+			ManualTagStartCondition:         definition.ManualTagStartCondition,
+			ManualPullRequestStartCondition: definition.ManualPullRequestStartCondition,
+			IsEnabled:                       definition.IsEnabled,
+			Clean:                           definition.Clean,
+			ContainerFilePath:               definition.ContainerFilePath,
+			Actions:                         definition.Actions,
+		},
+		"relationships": rels,
+	}
+	if id != "" {
+		data["id"] = id
+	}
+	return json.Marshal(map[string]any{"data": data})
+}
+
+// diffWorkflowDefinitions returns the top-level fields that differ, ignoring the ID.
+func diffWorkflowDefinitions(current, desired WorkflowDefinition) ([]string, error) {
+	currentFields, err := workflowDefinitionFields(current)
+	if err != nil {
+		return nil, err
+	}
+	desiredFields, err := workflowDefinitionFields(desired)
+	if err != nil {
+		return nil, err
+	}
+	keys := map[string]bool{}
+	for key := range currentFields {
+		keys[key] = true
+	}
+	for key := range desiredFields {
+		keys[key] = true
+	}
+	delete(keys, "id")
+
+	var fields []string
+	for key := range keys {
+		if !reflect.DeepEqual(currentFields[key], desiredFields[key]) {
+			fields = append(fields, key)
+		}
+	}
+	sort.Strings(fields)
+	return fields, nil
+}
+
+func workflowDefinitionFields(definition WorkflowDefinition) (map[string]any, error) {
+	if definition.Actions == nil {
+		definition.Actions = []asc.CiAction{}
+	}
+	data, err := json.Marshal(definition)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]any
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+// encodeWorkflowDefinition renders a definition with stable field order.
+func encodeWorkflowDefinition(definition WorkflowDefinition, format string) ([]byte, error) {
+	data, err := json.MarshalIndent(definition, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if format == workflowFormatJSON {
+		return append(data, '\n'), nil
+	}
+
+	// JSON is valid YAML; re-encoding the node tree in block style keeps the struct's field order.
+	var node yaml.Node
+	if err := yaml.Unmarshal(data, &node); err != nil {
+		return nil, err
+	}
+	resetYAMLStyle(&node)
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&node); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func resetYAMLStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		resetYAMLStyle(child)
+	}
+}
+
+// decodeWorkflowDefinition parses YAML or JSON strictly, rejecting unknown fields.
+func decodeWorkflowDefinition(data []byte, format string) (WorkflowDefinition, error) {
+	var definition WorkflowDefinition
+	if format != workflowFormatJSON {
+		var value any
+		if err := yaml.Unmarshal(data, &value); err != nil {
+			return definition, err
+		}
+		converted, err := json.Marshal(value)
+		if err != nil {
+			return definition, err
+		}
+		data = converted
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&definition); err != nil {
+		return definition, err
+	}
+	if strings.TrimSpace(definition.Name) == "" {
+		return definition, fmt.Errorf("name is required")
+	}
+	return definition, nil
+}
+
+// readWorkflowDefinitions loads every workflow file in dir, keyed by path.
+func readWorkflowDefinitions(dir string) (map[string]WorkflowDefinition, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("directory %q does not exist", dir)
+		}
+		return nil, err
+	}
+
+	definitions := map[string]WorkflowDefinition{}
+	names := map[string]string{}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		format := ""
+		switch strings.ToLower(filepath.Ext(entry.Name())) {
+		case ".yaml", ".yml":
+			format = workflowFormatYAML
+		case ".json":
+			format = workflowFormatJSON
+		default:
+			continue
+		}
+		path := filepath.Join(dir, entry.Name())
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		definition, err := decodeWorkflowDefinition(data, format)
+		if err != nil {
+			return nil, fmt.Errorf("invalid workflow file %s: %w", path, err)
+		}
+		if other, ok := names[definition.Name]; ok {
+			return nil, fmt.Errorf("workflow %q is defined in both %s and %s", definition.Name, other, path)
+		}
+		names[definition.Name] = path
+		definitions[path] = definition
+	}
+	if len(definitions) == 0 {
+		return nil, fmt.Errorf("no workflow files found in %s", dir)
+	}
+	return definitions, nil
+}
+
+func sortedWorkflowFiles(definitions map[string]WorkflowDefinition) []string {
+	files := make([]string, 0, len(definitions))
+	for file := range definitions {
+		files = append(files, file)
+	}
+	sort.Strings(files)
+	return files
+}
+
+// workflowFileSlug turns a workflow name into a lowercase file name.
+func workflowFileSlug(name string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(strings.TrimSpace(name)) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+			dash = false
+			continue
+		}
+		if !dash && b.Len() > 0 {
+			b.WriteByte('-')
+			dash = true
+		}
+	}
+	slug := strings.TrimSuffix(b.String(), "-")
+	if slug == "" {
+		return "workflow"
+	}
+	return slug
+}
+
+func firstNonEmptyString(values ...string) string {
+	for _, value := range values {
+		if strings.TrimSpace(value) != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+func printWorkflowsExportResult(result *WorkflowsExportResult, render func([]string, [][]string)) error {
+	rows := make([][]string, 0, len(result.Files))
+	for _, file := range result.Files {
+		rows = append(rows, []string{file.Workflow, file.WorkflowID, file.File})
+	}
+	render([]string{"Workflow", "ID", "File"}, rows)
+	return nil
+}
+
+func printWorkflowsApplyResult(result WorkflowsApplyResult, render func([]string, [][]string)) error {
+	fmt.Printf("App ID: %s\n", result.AppID)
+	fmt.Printf("Dir: %s\n", result.Dir)
+	fmt.Printf("Dry Run: %t\n", result.DryRun)
+	fmt.Printf("Applied: %t\n\n", result.Applied)
+	if result.NoChanges {
+		fmt.Println("No changes. Workflows are up to date.")
+		return nil
+	}
+	rows := make([][]string, 0, len(result.Changes))
+	for _, change := range result.Changes {
+		rows = append(rows, []string{change.Action, change.Workflow, change.WorkflowID, change.File, strings.Join(change.Fields, ", ")})
+	}
+	render([]string{"Action", "Workflow", "ID", "File", "Fields"}, rows)
+	return nil
+}
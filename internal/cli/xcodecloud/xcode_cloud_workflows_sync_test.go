@@ -0,0 +1,255 @@
+package xcodecloud
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/rudrankriyam/App-Store-Connect-CLI/internal/asc"
+)
+
+type fakeWorkflowSyncClient struct {
+	workflows []asc.CiWorkflowResource
+	created   []json.RawMessage
+	updated   map[string]json.RawMessage
+	updateErr error
+}
+
+func (f *fakeWorkflowSyncClient) ResolveCiProductForApp(ctx context.Context, appID string) (*asc.CiProductResource, error) {
+	return &asc.CiProductResource{ID: "product-1"}, nil
+}
+
+func (f *fakeWorkflowSyncClient) GetCiWorkflows(ctx context.Context, productID string, opts ...asc.CiWorkflowsOption) (*asc.CiWorkflowsResponse, error) {
+	return &asc.CiWorkflowsResponse{Data: f.workflows}, nil
+}
+
+func (f *fakeWorkflowSyncClient) GetCiProductPrimaryRepositories(ctx context.Context, productID string, opts ...asc.CiProductRepositoriesOption) (*asc.ScmRepositoriesResponse, error) {
+	return &asc.ScmRepositoriesResponse{Data: []asc.ScmRepositoryResource{
+		{ID: "repo-1", Attributes: asc.ScmRepositoryAttributes{OwnerName: "acme", RepositoryName: "app"}},
+	}}, nil
+}
+
+func (f *fakeWorkflowSyncClient) GetCiProductAdditionalRepositories(ctx context.Context, productID string, opts ...asc.CiProductRepositoriesOption) (*asc.ScmRepositoriesResponse, error) {
+	return &asc.ScmRepositoriesResponse{Data: []asc.ScmRepositoryResource{
+		{ID: "repo-2", Attributes: asc.ScmRepositoryAttributes{OwnerName: "acme", RepositoryName: "shared"}},
+	}}, nil
+}
+
+func (f *fakeWorkflowSyncClient) GetCiXcodeVersions(ctx context.Context, opts ...asc.CiXcodeVersionsOption) (*asc.CiXcodeVersionsResponse, error) {
+	return &asc.CiXcodeVersionsResponse{Data: []asc.CiXcodeVersionResource{
+		{ID: "xcode-16", Attributes: asc.CiXcodeVersionAttributes{Name: "Xcode 16.2", Version: "16C5032a"}},
+		{ID: "xcode-latest", Attributes: asc.CiXcodeVersionAttributes{Name: "Latest Release", Version: "latest:stable"}},
+	}}, nil
+}
+
+func (f *fakeWorkflowSyncClient) GetCiMacOsVersions(ctx context.Context, opts ...asc.CiMacOsVersionsOption) (*asc.CiMacOsVersionsResponse, error) {
+	return &asc.CiMacOsVersionsResponse{Data: []asc.CiMacOsVersionResource{
+		{ID: "macos-15", Attributes: asc.CiMacOsVersionAttributes{Name: "macOS Sequoia 15.2", Version: "24C101"}},
+	}}, nil
+}
+
+func (f *fakeWorkflowSyncClient) CreateCiWorkflow(ctx context.Context, payload json.RawMessage) (*asc.CiWorkflowResponse, error) {
+	f.created = append(f.created, payload)
+	return &asc.CiWorkflowResponse{Data: asc.CiWorkflowResource{ID: "wf-new"}}, nil
+}
+
+func (f *fakeWorkflowSyncClient) UpdateCiWorkflow(ctx context.Context, workflowID string, payload json.RawMessage) (*asc.CiWorkflowResponse, error) {
+	if f.updateErr != nil {
+		return nil, f.updateErr
+	}
+	if f.updated == nil {
+		f.updated = map[string]json.RawMessage{}
+	}
+	f.updated[workflowID] = payload
+	return &asc.CiWorkflowResponse{Data: asc.CiWorkflowResource{ID: workflowID}}, nil
+}
+
+func newFakeWorkflowSyncClient() *fakeWorkflowSyncClient {
+	return &fakeWorkflowSyncClient{
+		workflows: []asc.CiWorkflowResource{
+			{
+				ID: "wf-1",
+				Attributes: asc.CiWorkflowAttributes{
+					Name:              "PR Checks",
+					Description:       "Runs tests on pull requests",
+					ContainerFilePath: "App.xcodeproj",
+					IsEnabled:         true,
+					PullRequestStartCondition: &asc.CiPullRequestStartCondition{
+						AutoCancel: true,
+					},
+					Actions: []asc.CiAction{
+						{Name: "Test - iOS", ActionType: "TEST", Scheme: "App", Platform: "IOS", IsRequiredToPass: true},
+					},
+				},
+				Relationships: &asc.CiWorkflowRelationships{
+					Repository:   &asc.Relationship{Data: asc.ResourceData{Type: asc.ResourceTypeScmRepositories, ID: "repo-1"}},
+					XcodeVersion: &asc.Relationship{Data: asc.ResourceData{Type: asc.ResourceTypeCiXcodeVersions, ID: "xcode-16"}},
+					MacOsVersion: &asc.Relationship{Data: asc.ResourceData{Type: asc.ResourceTypeCiMacOsVersions, ID: "macos-15"}},
+				},
+			},
+		},
+	}
+}
+
+func TestExportWorkflowsRoundTrip(t *testing.T) {
+	client := newFakeWorkflowSyncClient()
+	dir := t.TempDir()
+
+	result, err := exportWorkflows(context.Background(), client, "app-1", dir, workflowFormatYAML)
+	if err != nil {
+		t.Fatalf("exportWorkflows error: %v", err)
+	}
+	if len(result.Files) != 1 || result.Files[0].File != filepath.Join(dir, "pr-checks.yaml") {
+		t.Fatalf("unexpected export files %+v", result.Files)
+	}
+
+	data, err := os.ReadFile(result.Files[0].File)
+	if err != nil {
+		t.Fatalf("read export: %v", err)
+	}
+	content := string(data)
+	if !strings.HasPrefix(content, "id: wf-1\nname: PR Checks\n") {
+		t.Fatalf("expected stable field order, got:\n%s", content)
+	}
+	for _, want := range []string{"repository: acme/app\n", "xcodeVersion: Xcode 16.2\n", "macOsVersion: macOS Sequoia 15.2\n"} {
+		if !strings.Contains(content, want) {
+			t.Fatalf("expected %q in:\n%s", want, content)
+		}
+	}
+
+	definitions, err := readWorkflowDefinitions(dir)
+	if err != nil {
+		t.Fatalf("readWorkflowDefinitions error: %v", err)
+	}
+	changes, err := planWorkflowsApply(context.Background(), client, "app-1", definitions)
+	if err != nil {
+		t.Fatalf("planWorkflowsApply error: %v", err)
+	}
+	if len(changes) != 0 {
+		t.Fatalf("expected exported files to plan no changes, got %+v", changes)
+	}
+}
+
+func TestPlanWorkflowsApplyCreatesAndUpdates(t *testing.T) {
+	client := newFakeWorkflowSyncClient()
+	dir := t.TempDir()
+	files := map[string]string{
+		"pr-checks.yaml": `name: PR Checks
+description: Runs tests on pull requests
+repository: acme/app
+xcodeVersion: 16C5032a
+macOsVersion: macos sequoia 15.2
+containerFilePath: App.xcodeproj
+isEnabled: false
+clean: false
+pullRequestStartCondition:
+  autoCancel: true
+actions:
+  - name: Test - iOS
+    actionType: TEST
+    scheme: App
+    platform: IOS
+    isRequiredToPass: true
+`,
+		"release.json": `{"name":"Release","repository":"shared","xcodeVersion":"Latest Release","macOsVersion":"24C101","containerFilePath":"App.xcodeproj","isEnabled":true,"clean":true,"actions":[]}`,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatalf("write: %v", err)
+		}
+	}
+
+	definitions, err := readWorkflowDefinitions(dir)
+	if err != nil {
+		t.Fatalf("readWorkflowDefinitions error: %v", err)
+	}
+	changes, err := planWorkflowsApply(context.Background(), client, "app-1", definitions)
+	if err != nil {
+		t.Fatalf("planWorkflowsApply error: %v", err)
+	}
+	if len(changes) != 2 {
+		t.Fatalf("expected two changes, got %+v", changes)
+	}
+	update, create := changes[0], changes[1]
+	if update.Action != workflowChangeUpdate || update.WorkflowID != "wf-1" || !reflect.DeepEqual(update.Fields, []string{"isEnabled"}) {
+		t.Fatalf("unexpected update %+v", update)
+	}
+	if create.Action != workflowChangeCreate || create.Workflow != "Release" {
+		t.Fatalf("unexpected create %+v", create)
+	}
+
+	applied, err := applyWorkflowChanges(context.Background(), client, changes)
+	if err != nil {
+		t.Fatalf("applyWorkflowChanges error: %v", err)
+	}
+	if applied[1].WorkflowID != "wf-new" {
+		t.Fatalf("expected created workflow ID, got %+v", applied[1])
+	}
+
+	var created struct {
+		Data struct {
+			Attributes    map[string]any              `json:"attributes"`
+			Relationships map[string]asc.Relationship `json:"relationships"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(client.created[0], &created); err != nil {
+		t.Fatalf("decode create payload: %v", err)
+	}
+	rels := created.Data.Relationships
+	if rels["product"].Data.ID != "product-1" || rels["repository"].Data.ID != "repo-2" || rels["xcodeVersion"].Data.ID != "xcode-latest" || rels["macOsVersion"].Data.ID != "macos-15" {
+		t.Fatalf("unexpected create relationships %+v", rels)
+	}
+	if value, ok := created.Data.Attributes["branchStartCondition"]; !ok || value != nil {
+		t.Fatalf("expected explicit null start condition, got %v", created.Data.Attributes)
+	}
+
+	updated := string(client.updated["wf-1"])
+	if !strings.Contains(updated, `"id":"wf-1"`) || !strings.Contains(updated, `"isEnabled":false`) || strings.Contains(updated, `"repository"`) {
+		t.Fatalf("unexpected update payload %s", updated)
+	}
+}
+
+func TestApplyWorkflowChangesReturnsCreatedWorkflowsOnFailure(t *testing.T) {
+	client := newFakeWorkflowSyncClient()
+	client.updateErr = errors.New("conflict")
+	changes := []WorkflowSyncChange{
+		{Action: workflowChangeCreate, Workflow: "Release"},
+		{Action: workflowChangeUpdate, Workflow: "CI", WorkflowID: "wf-1"},
+	}
+
+	applied, err := applyWorkflowChanges(context.Background(), client, changes)
+	if err == nil || !strings.Contains(err.Error(), `update workflow "CI": conflict`) {
+		t.Fatalf("expected update error, got %v", err)
+	}
+	if len(applied) != 1 || applied[0].WorkflowID != "wf-new" {
+		t.Fatalf("expected the created workflow with its ID, got %+v", applied)
+	}
+}
+
+func TestPlanWorkflowsApplyRejectsRepositoryChange(t *testing.T) {
+	client := newFakeWorkflowSyncClient()
+	definitions := map[string]WorkflowDefinition{
+		"pr-checks.yaml": {
+			Name:         "PR Checks",
+			Repository:   "acme/shared",
+			XcodeVersion: "Xcode 16.2",
+			MacOSVersion: "macOS Sequoia 15.2",
+		},
+	}
+
+	_, err := planWorkflowsApply(context.Background(), client, "app-1", definitions)
+	if err == nil || !strings.Contains(err.Error(), "cannot be changed") {
+		t.Fatalf("expected repository change error, got %v", err)
+	}
+
+	definitions["pr-checks.yaml"] = WorkflowDefinition{Name: "PR Checks", Repository: "acme/app", XcodeVersion: "Xcode 99", MacOSVersion: "24C101"}
+	_, err = planWorkflowsApply(context.Background(), client, "app-1", definitions)
+	if err == nil || !strings.Contains(err.Error(), `xcodeVersion "Xcode 99" not found`) {
+		t.Fatalf("expected unresolved Xcode version error, got %v", err)
+	}
+}
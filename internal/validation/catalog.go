@@ -0,0 +1,503 @@
+package validation
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// CatalogLocalization is one localized display entry in a product catalog.
+type CatalogLocalization struct {
+	Locale        string
+	Name          string
+	Description   string
+	CustomAppName string
+}
+
+// CatalogIAP is an in-app purchase declared in a product catalog file.
+type CatalogIAP struct {
+	ProductID     string
+	Name          string
+	Type          string
+	ReviewNote    string
+	BaseTerritory string
+	Localizations []CatalogLocalization
+	Prices        map[string]string
+}
+
+// CatalogOffer is an introductory offer declared for one territory.
+type CatalogOffer struct {
+	Territory       string
+	Duration        string
+	OfferMode       string
+	NumberOfPeriods int
+	Price           string
+}
+
+// CatalogPromotionalOffer is a promotional offer declared by offer code.
+// Free trials list territories; paid offers list a price per territory.
+type CatalogPromotionalOffer struct {
+	OfferCode       string
+	Name            string
+	Duration        string
+	OfferMode       string
+	NumberOfPeriods int
+	Territories     []string
+	Prices          map[string]string
+}
+
+// CatalogSubscription is an auto-renewable subscription declared in a catalog file.
+type CatalogSubscription struct {
+	ProductID          string
+	Name               string
+	Period             string
+	GroupLevel         int
+	ReviewNote         string
+	Localizations      []CatalogLocalization
+	Prices             map[string]string
+	IntroductoryOffers []CatalogOffer
+	PromotionalOffers  []CatalogPromotionalOffer
+}
+
+// CatalogSubscriptionGroup is a subscription group declared in a catalog file.
+type CatalogSubscriptionGroup struct {
+	ReferenceName string
+	Localizations []CatalogLocalization
+	Subscriptions []CatalogSubscription
+}
+
+// CatalogInput collects an offline in-app purchase and subscription catalog.
+type CatalogInput struct {
+	AppID              string
+	IAPs               []CatalogIAP
+	SubscriptionGroups []CatalogSubscriptionGroup
+}
+
+// CatalogReport is the offline catalog validation output.
+type CatalogReport struct {
+	AppID             string        `json:"appId,omitempty"`
+	IAPCount          int           `json:"iapCount"`
+	SubscriptionCount int           `json:"subscriptionCount"`
+	Summary           Summary       `json:"summary"`
+	Checks            []CheckResult `json:"checks"`
+	Strict            bool          `json:"strict,omitempty"`
+}
+
+var (
+	catalogProductIDPattern = regexp.MustCompile(`^[A-Za-z0-9._]+$`)
+	catalogOfferCodePattern = regexp.MustCompile(`^[A-Za-z0-9._-]+$`)
+	catalogTerritoryPattern = regexp.MustCompile(`^[A-Z]{3}$`)
+
+	catalogIAPTypes = map[string]struct{}{
+		"CONSUMABLE":                {},
+		"NON_CONSUMABLE":            {},
+		"NON_RENEWING_SUBSCRIPTION": {},
+	}
+	catalogSubscriptionPeriods = map[string]struct{}{
+		"ONE_WEEK":     {},
+		"ONE_MONTH":    {},
+		"TWO_MONTHS":   {},
+		"THREE_MONTHS": {},
+		"SIX_MONTHS":   {},
+		"ONE_YEAR":     {},
+	}
+	catalogOfferDurations = map[string]struct{}{
+		"THREE_DAYS":   {},
+		"ONE_WEEK":     {},
+		"TWO_WEEKS":    {},
+		"ONE_MONTH":    {},
+		"TWO_MONTHS":   {},
+		"THREE_MONTHS": {},
+		"SIX_MONTHS":   {},
+		"ONE_YEAR":     {},
+	}
+	catalogOfferModes = map[string]struct{}{
+		"FREE_TRIAL":    {},
+		"PAY_AS_YOU_GO": {},
+		"PAY_UP_FRONT":  {},
+	}
+)
+
+// ValidateCatalog validates an in-app purchase and subscription catalog
+// without calling App Store Connect.
+func ValidateCatalog(input CatalogInput, strict bool) CatalogReport {
+	var checks []CheckResult
+	seenProducts := make(map[string]string)
+	subscriptionCount := 0
+
+	for _, iap := range input.IAPs {
+		label := formatIAPLabel(IAP{Name: iap.Name, ProductID: iap.ProductID})
+		checks = append(checks, catalogProductChecks(seenProducts, "inAppPurchaseV2", label, iap.ProductID, iap.Name, iap.ReviewNote)...)
+		if _, ok := catalogIAPTypes[strings.ToUpper(strings.TrimSpace(iap.Type))]; !ok {
+			checks = append(checks, catalogError("catalog.iap.type.invalid", "inAppPurchaseV2", iap.ProductID, "type",
+				fmt.Sprintf("%s has invalid type %q", label, iap.Type),
+				"Use CONSUMABLE, NON_CONSUMABLE, or NON_RENEWING_SUBSCRIPTION"))
+		}
+		if base := strings.TrimSpace(iap.BaseTerritory); base != "" {
+			if _, ok := iap.Prices[strings.ToUpper(base)]; !ok {
+				checks = append(checks, catalogError("catalog.prices.base_territory_missing", "inAppPurchaseV2", iap.ProductID, "baseTerritory",
+					fmt.Sprintf("%s base territory %s has no price", label, strings.ToUpper(base)),
+					"Add a price for the base territory"))
+			}
+		}
+		checks = append(checks, catalogLocalizationChecks("inAppPurchaseV2", label, iap.ProductID, iap.Localizations, true)...)
+		checks = append(checks, catalogPriceChecks("inAppPurchaseV2", label, iap.ProductID, iap.Prices)...)
+	}
+
+	seenGroups := make(map[string]struct{})
+	for _, group := range input.SubscriptionGroups {
+		groupName := strings.TrimSpace(group.ReferenceName)
+		groupLabel := fmt.Sprintf("Subscription group %q", groupName)
+		switch {
+		case groupName == "":
+			groupLabel = "Subscription group"
+			checks = append(checks, catalogError("catalog.group.reference_name.missing", "subscriptionGroup", "", "referenceName",
+				"subscription group is missing a reference name", "Set referenceName for every subscription group"))
+		case utf8.RuneCountInString(groupName) > LimitProductReferenceName:
+			checks = append(checks, catalogError("catalog.group.reference_name.too_long", "subscriptionGroup", groupName, "referenceName",
+				fmt.Sprintf("%s reference name exceeds %d characters", groupLabel, LimitProductReferenceName),
+				fmt.Sprintf("Shorten referenceName to %d characters or fewer", LimitProductReferenceName)))
+		}
+		if groupName != "" {
+			key := strings.ToLower(groupName)
+			if _, ok := seenGroups[key]; ok {
+				checks = append(checks, catalogError("catalog.group.duplicate", "subscriptionGroup", groupName, "referenceName",
+					fmt.Sprintf("%s is declared more than once", groupLabel), "Merge duplicate subscription groups"))
+			}
+			seenGroups[key] = struct{}{}
+		}
+		checks = append(checks, catalogLocalizationChecks("subscriptionGroup", groupLabel, groupName, group.Localizations, false)...)
+
+		seenLevels := make(map[int]string)
+		for _, sub := range group.Subscriptions {
+			subscriptionCount++
+			label := formatSubscriptionLabel(Subscription{Name: sub.Name, ProductID: sub.ProductID})
+			checks = append(checks, catalogProductChecks(seenProducts, "subscription", label, sub.ProductID, sub.Name, sub.ReviewNote)...)
+			if _, ok := catalogSubscriptionPeriods[strings.ToUpper(strings.TrimSpace(sub.Period))]; !ok {
+				checks = append(checks, catalogError("catalog.subscription.period.invalid", "subscription", sub.ProductID, "period",
+					fmt.Sprintf("%s has invalid period %q", label, sub.Period),
+					"Use ONE_WEEK, ONE_MONTH, TWO_MONTHS, THREE_MONTHS, SIX_MONTHS, or ONE_YEAR"))
+			}
+			if sub.GroupLevel > 0 {
+				if other, ok := seenLevels[sub.GroupLevel]; ok {
+					checks = append(checks, CheckResult{
+						ID:           "catalog.subscription.group_level.duplicate",
+						Severity:     SeverityWarning,
+						Field:        "groupLevel",
+						ResourceType: "subscription",
+						ResourceID:   strings.TrimSpace(sub.ProductID),
+						Message:      fmt.Sprintf("%s shares group level %d with %s", label, sub.GroupLevel, other),
+						Remediation:  "Give each subscription in a group its own level unless they are equivalent tiers",
+					})
+				} else {
+					seenLevels[sub.GroupLevel] = strings.TrimSpace(sub.ProductID)
+				}
+			}
+			checks = append(checks, catalogLocalizationChecks("subscription", label, sub.ProductID, sub.Localizations, true)...)
+			checks = append(checks, catalogPriceChecks("subscription", label, sub.ProductID, sub.Prices)...)
+			checks = append(checks, catalogOfferChecks(label, sub.ProductID, sub.IntroductoryOffers)...)
+			checks = append(checks, catalogPromotionalOfferChecks(label, sub.ProductID, sub.PromotionalOffers)...)
+		}
+	}
+
+	return CatalogReport{
+		AppID:             strings.TrimSpace(input.AppID),
+		IAPCount:          len(input.IAPs),
+		SubscriptionCount: subscriptionCount,
+		Summary:           summarize(checks, strict),
+		Checks:            checks,
+		Strict:            strict,
+	}
+}
+
+func catalogProductChecks(seen map[string]string, resourceType, label, productID, name, reviewNote string) []CheckResult {
+	var checks []CheckResult
+	productID = strings.TrimSpace(productID)
+
+	switch {
+	case productID == "":
+		checks = append(checks, catalogError("catalog.product_id.missing", resourceType, "", "productId",
+			fmt.Sprintf("%s is missing a product ID", label), "Set productId for every product"))
+	case !catalogProductIDPattern.MatchString(productID):
+		checks = append(checks, catalogError("catalog.product_id.invalid", resourceType, productID, "productId",
+			fmt.Sprintf("%s product ID may only contain letters, numbers, periods, and underscores", label),
+			"Use a reverse-DNS style product ID such as com.example.app.pro"))
+	}
+	if productID != "" {
+		if other, ok := seen[productID]; ok {
+			checks = append(checks, catalogError("catalog.product_id.duplicate", resourceType, productID, "productId",
+				fmt.Sprintf("product ID %s is declared by both %s and %s", productID, other, label),
+				"Product IDs must be unique across in-app purchases and subscriptions"))
+		} else {
+			seen[productID] = label
+		}
+	}
+
+	name = strings.TrimSpace(name)
+	switch {
+	case name == "":
+		checks = append(checks, catalogError("catalog.reference_name.missing", resourceType, productID, "name",
+			fmt.Sprintf("%s is missing a reference name", label), "Set name for every product"))
+	case utf8.RuneCountInString(name) > LimitProductReferenceName:
+		checks = append(checks, catalogError("catalog.reference_name.too_long", resourceType, productID, "name",
+			fmt.Sprintf("%s reference name exceeds %d characters", label, LimitProductReferenceName),
+			fmt.Sprintf("Shorten name to %d characters or fewer", LimitProductReferenceName)))
+	}
+
+	if utf8.RuneCountInString(reviewNote) > LimitProductReviewNote {
+		checks = append(checks, catalogError("catalog.review_note.too_long", resourceType, productID, "reviewNote",
+			fmt.Sprintf("%s review note exceeds %d characters", label, LimitProductReviewNote),
+			fmt.Sprintf("Shorten reviewNote to %d characters or fewer", LimitProductReviewNote)))
+	}
+	return checks
+}
+
+func catalogLocalizationChecks(resourceType, label, resourceID string, localizations []CatalogLocalization, product bool) []CheckResult {
+	var checks []CheckResult
+	resourceID = strings.TrimSpace(resourceID)
+
+	if len(localizations) == 0 {
+		checks = append(checks, CheckResult{
+			ID:           "catalog.localizations.missing",
+			Severity:     SeverityWarning,
+			ResourceType: resourceType,
+			ResourceID:   resourceID,
+			Message:      fmt.Sprintf("%s has no localizations", label),
+			Remediation:  "Add at least one localization before submitting for review",
+		})
+		return checks
+	}
+
+	seen := make(map[string]struct{}, len(localizations))
+	for _, loc := range localizations {
+		locale := strings.TrimSpace(loc.Locale)
+		key := strings.ToLower(locale)
+		if _, ok := seen[key]; ok {
+			checks = append(checks, catalogLocaleError("catalog.localization.duplicate", resourceType, resourceID, locale, "locale",
+				fmt.Sprintf("%s declares locale %s more than once", label, locale), "Remove the duplicate localization"))
+		}
+		seen[key] = struct{}{}
+
+		name := strings.TrimSpace(loc.Name)
+		if name == "" {
+			checks = append(checks, catalogLocaleError("catalog.localization.name.missing", resourceType, resourceID, locale, "name",
+				fmt.Sprintf("%s localization %s is missing a display name", label, locale), "Set a display name for every localization"))
+		}
+		if !product {
+			continue
+		}
+		if utf8.RuneCountInString(name) > LimitProductDisplayName {
+			checks = append(checks, catalogLocaleError("catalog.localization.name.too_long", resourceType, resourceID, locale, "name",
+				fmt.Sprintf("%s localization %s display name exceeds %d characters", label, locale, LimitProductDisplayName),
+				fmt.Sprintf("Shorten the display name to %d characters or fewer", LimitProductDisplayName)))
+		}
+		if utf8.RuneCountInString(strings.TrimSpace(loc.Description)) > LimitProductDescription {
+			checks = append(checks, catalogLocaleError("catalog.localization.description.too_long", resourceType, resourceID, locale, "description",
+				fmt.Sprintf("%s localization %s description exceeds %d characters", label, locale, LimitProductDescription),
+				fmt.Sprintf("Shorten the description to %d characters or fewer", LimitProductDescription)))
+		}
+	}
+	return checks
+}
+
+func catalogPriceChecks(resourceType, label, resourceID string, prices map[string]string) []CheckResult {
+	var checks []CheckResult
+	resourceID = strings.TrimSpace(resourceID)
+
+	if len(prices) == 0 {
+		checks = append(checks, CheckResult{
+			ID:           "catalog.prices.missing",
+			Severity:     SeverityWarning,
+			Field:        "prices",
+			ResourceType: resourceType,
+			ResourceID:   resourceID,
+			Message:      fmt.Sprintf("%s has no prices", label),
+			Remediation:  "Add at least one territory price so the product can be sold",
+		})
+		return checks
+	}
+
+	for _, territory := range sortedCatalogKeys(prices) {
+		if !catalogTerritoryPattern.MatchString(territory) {
+			checks = append(checks, catalogError("catalog.prices.territory.invalid", resourceType, resourceID, "prices",
+				fmt.Sprintf("%s has invalid territory %q", label, territory), "Use uppercase ISO 3166-1 alpha-3 territory codes such as USA"))
+		}
+		if !validCatalogPrice(prices[territory]) {
+			checks = append(checks, catalogError("catalog.prices.amount.invalid", resourceType, resourceID, "prices",
+				fmt.Sprintf("%s has invalid price %q for %s", label, prices[territory], territory), "Use a non-negative decimal customer price such as 4.99"))
+		}
+	}
+	return checks
+}
+
+func catalogOfferChecks(label, resourceID string, offers []CatalogOffer) []CheckResult {
+	var checks []CheckResult
+	resourceID = strings.TrimSpace(resourceID)
+	seen := make(map[string]struct{}, len(offers))
+
+	for _, offer := range offers {
+		territory := strings.TrimSpace(offer.Territory)
+		if !catalogTerritoryPattern.MatchString(territory) {
+			checks = append(checks, catalogError("catalog.offer.territory.invalid", "subscriptionIntroductoryOffer", resourceID, "territory",
+				fmt.Sprintf("%s introductory offer has invalid territory %q", label, territory), "Use uppercase ISO 3166-1 alpha-3 territory codes such as USA"))
+		} else if _, ok := seen[territory]; ok {
+			checks = append(checks, catalogError("catalog.offer.territory.duplicate", "subscriptionIntroductoryOffer", resourceID, "territory",
+				fmt.Sprintf("%s declares more than one introductory offer for %s", label, territory), "Keep one introductory offer per territory"))
+		}
+		seen[territory] = struct{}{}
+
+		if _, ok := catalogOfferDurations[strings.ToUpper(strings.TrimSpace(offer.Duration))]; !ok {
+			checks = append(checks, catalogError("catalog.offer.duration.invalid", "subscriptionIntroductoryOffer", resourceID, "duration",
+				fmt.Sprintf("%s introductory offer for %s has invalid duration %q", label, territory, offer.Duration), "Use an offer duration such as ONE_WEEK or ONE_MONTH"))
+		}
+		mode := strings.ToUpper(strings.TrimSpace(offer.OfferMode))
+		if _, ok := catalogOfferModes[mode]; !ok {
+			checks = append(checks, catalogError("catalog.offer.mode.invalid", "subscriptionIntroductoryOffer", resourceID, "offerMode",
+				fmt.Sprintf("%s introductory offer for %s has invalid offer mode %q", label, territory, offer.OfferMode), "Use FREE_TRIAL, PAY_AS_YOU_GO, or PAY_UP_FRONT"))
+		}
+		if offer.NumberOfPeriods <= 0 {
+			checks = append(checks, catalogError("catalog.offer.periods.invalid", "subscriptionIntroductoryOffer", resourceID, "numberOfPeriods",
+				fmt.Sprintf("%s introductory offer for %s must run for at least one period", label, territory), "Set numberOfPeriods to 1 or more"))
+		}
+
+		price := strings.TrimSpace(offer.Price)
+		switch {
+		case mode == "FREE_TRIAL" && price != "":
+			checks = append(checks, catalogError("catalog.offer.price.unexpected", "subscriptionIntroductoryOffer", resourceID, "price",
+				fmt.Sprintf("%s free trial for %s must not set a price", label, territory), "Remove price from free trial offers"))
+		case mode != "FREE_TRIAL" && mode != "" && !validCatalogPrice(price):
+			checks = append(checks, catalogError("catalog.offer.price.invalid", "subscriptionIntroductoryOffer", resourceID, "price",
+				fmt.Sprintf("%s %s offer for %s needs a valid price", label, mode, territory), "Set price to a non-negative decimal customer price"))
+		}
+	}
+	return checks
+}
+
+func catalogPromotionalOfferChecks(label, resourceID string, offers []CatalogPromotionalOffer) []CheckResult {
+	const resourceType = "subscriptionPromotionalOffer"
+	var checks []CheckResult
+	resourceID = strings.TrimSpace(resourceID)
+	seen := make(map[string]struct{}, len(offers))
+
+	for _, offer := range offers {
+		code := strings.TrimSpace(offer.OfferCode)
+		switch {
+		case code == "":
+			checks = append(checks, catalogError("catalog.promotional_offer.code.missing", resourceType, resourceID, "offerCode",
+				fmt.Sprintf("%s has a promotional offer without an offer code", label), "Set offerCode for every promotional offer"))
+		case !catalogOfferCodePattern.MatchString(code) || len(code) > LimitPromotionalOfferCode:
+			checks = append(checks, catalogError("catalog.promotional_offer.code.invalid", resourceType, resourceID, "offerCode",
+				fmt.Sprintf("%s promotional offer code %q is invalid", label, code),
+				fmt.Sprintf("Use up to %d letters, numbers, periods, underscores, and hyphens", LimitPromotionalOfferCode)))
+		}
+		if code != "" {
+			if _, ok := seen[code]; ok {
+				checks = append(checks, catalogError("catalog.promotional_offer.code.duplicate", resourceType, resourceID, "offerCode",
+					fmt.Sprintf("%s declares promotional offer %s more than once", label, code), "Give each promotional offer its own offerCode"))
+			}
+			seen[code] = struct{}{}
+		}
+
+		name := strings.TrimSpace(offer.Name)
+		switch {
+		case name == "":
+			checks = append(checks, catalogError("catalog.promotional_offer.name.missing", resourceType, resourceID, "name",
+				fmt.Sprintf("%s promotional offer %s is missing a reference name", label, code), "Set name for every promotional offer"))
+		case utf8.RuneCountInString(name) > LimitProductReferenceName:
+			checks = append(checks, catalogError("catalog.promotional_offer.name.too_long", resourceType, resourceID, "name",
+				fmt.Sprintf("%s promotional offer %s reference name exceeds %d characters", label, code, LimitProductReferenceName),
+				fmt.Sprintf("Shorten name to %d characters or fewer", LimitProductReferenceName)))
+		}
+
+		if _, ok := catalogOfferDurations[strings.ToUpper(strings.TrimSpace(offer.Duration))]; !ok {
+			checks = append(checks, catalogError("catalog.promotional_offer.duration.invalid", resourceType, resourceID, "duration",
+				fmt.Sprintf("%s promotional offer %s has invalid duration %q", label, code, offer.Duration), "Use an offer duration such as ONE_WEEK or ONE_MONTH"))
+		}
+		mode := strings.ToUpper(strings.TrimSpace(offer.OfferMode))
+		if _, ok := catalogOfferModes[mode]; !ok {
+			checks = append(checks, catalogError("catalog.promotional_offer.mode.invalid", resourceType, resourceID, "offerMode",
+				fmt.Sprintf("%s promotional offer %s has invalid offer mode %q", label, code, offer.OfferMode), "Use FREE_TRIAL, PAY_AS_YOU_GO, or PAY_UP_FRONT"))
+		}
+		if offer.NumberOfPeriods <= 0 {
+			checks = append(checks, catalogError("catalog.promotional_offer.periods.invalid", resourceType, resourceID, "numberOfPeriods",
+				fmt.Sprintf("%s promotional offer %s must run for at least one period", label, code), "Set numberOfPeriods to 1 or more"))
+		}
+
+		switch {
+		case mode == "FREE_TRIAL":
+			if len(offer.Prices) > 0 {
+				checks = append(checks, catalogError("catalog.promotional_offer.prices.unexpected", resourceType, resourceID, "prices",
+					fmt.Sprintf("%s free trial promotional offer %s must not set prices", label, code), "List the free trial's territories under territories instead"))
+			}
+			if len(offer.Territories) == 0 {
+				checks = append(checks, catalogError("catalog.promotional_offer.territories.missing", resourceType, resourceID, "territories",
+					fmt.Sprintf("%s free trial promotional offer %s lists no territories", label, code), "Add at least one territory"))
+			}
+			for _, territory := range offer.Territories {
+				if !catalogTerritoryPattern.MatchString(strings.TrimSpace(territory)) {
+					checks = append(checks, catalogError("catalog.promotional_offer.territory.invalid", resourceType, resourceID, "territories",
+						fmt.Sprintf("%s promotional offer %s has invalid territory %q", label, code, territory), "Use uppercase ISO 3166-1 alpha-3 territory codes such as USA"))
+				}
+			}
+		case mode != "":
+			if len(offer.Territories) > 0 {
+				checks = append(checks, catalogError("catalog.promotional_offer.territories.unexpected", resourceType, resourceID, "territories",
+					fmt.Sprintf("%s %s promotional offer %s must set prices instead of territories", label, mode, code), "Declare a price per territory under prices"))
+			}
+			if len(offer.Prices) == 0 {
+				checks = append(checks, catalogError("catalog.promotional_offer.prices.missing", resourceType, resourceID, "prices",
+					fmt.Sprintf("%s %s promotional offer %s has no prices", label, mode, code), "Add at least one territory price"))
+			}
+			for _, territory := range sortedCatalogKeys(offer.Prices) {
+				if !catalogTerritoryPattern.MatchString(territory) {
+					checks = append(checks, catalogError("catalog.promotional_offer.territory.invalid", resourceType, resourceID, "prices",
+						fmt.Sprintf("%s promotional offer %s has invalid territory %q", label, code, territory), "Use uppercase ISO 3166-1 alpha-3 territory codes such as USA"))
+				}
+				if !validCatalogPrice(offer.Prices[territory]) {
+					checks = append(checks, catalogError("catalog.promotional_offer.price.invalid", resourceType, resourceID, "prices",
+						fmt.Sprintf("%s promotional offer %s has invalid price %q for %s", label, code, offer.Prices[territory], territory), "Use a non-negative decimal customer price such as 1.99"))
+				}
+			}
+		}
+	}
+	return checks
+}
+
+func catalogError(id, resourceType, resourceID, field, message, remediation string) CheckResult {
+	return CheckResult{
+		ID:           id,
+		Severity:     SeverityError,
+		Field:        field,
+		ResourceType: resourceType,
+		ResourceID:   strings.TrimSpace(resourceID),
+		Message:      message,
+		Remediation:  remediation,
+	}
+}
+
+func catalogLocaleError(id, resourceType, resourceID, locale, field, message, remediation string) CheckResult {
+	check := catalogError(id, resourceType, resourceID, field, message, remediation)
+	check.Locale = locale
+	return check
+}
+
+func validCatalogPrice(value string) bool {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return false
+	}
+	parsed, err := strconv.ParseFloat(value, 64)
+	return err == nil && parsed >= 0
+}
+
+func sortedCatalogKeys(values map[string]string) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,129 @@
+package validation
+
+import (
+	"strings"
+	"testing"
+)
+
+func validCatalogInput() CatalogInput {
+	return CatalogInput{
+		IAPs: []CatalogIAP{{
+			ProductID:     "com.example.coins",
+			Name:          "Coins",
+			Type:          "CONSUMABLE",
+			BaseTerritory: "USA",
+			Localizations: []CatalogLocalization{{Locale: "en-US", Name: "100 Coins", Description: "A pile of coins"}},
+			Prices:        map[string]string{"USA": "0.99"},
+		}},
+		SubscriptionGroups: []CatalogSubscriptionGroup{{
+			ReferenceName: "Premium",
+			Localizations: []CatalogLocalization{{Locale: "en-US", Name: "Premium"}},
+			Subscriptions: []CatalogSubscription{{
+				ProductID:     "com.example.monthly",
+				Name:          "Monthly",
+				Period:        "ONE_MONTH",
+				GroupLevel:    1,
+				Localizations: []CatalogLocalization{{Locale: "en-US", Name: "Monthly", Description: "Billed monthly"}},
+				Prices:        map[string]string{"USA": "4.99"},
+				IntroductoryOffers: []CatalogOffer{
+					{Territory: "USA", Duration: "ONE_WEEK", OfferMode: "FREE_TRIAL", NumberOfPeriods: 1},
+				},
+				PromotionalOffers: []CatalogPromotionalOffer{
+					{OfferCode: "WINBACK", Name: "Win back", Duration: "ONE_MONTH", OfferMode: "PAY_AS_YOU_GO", NumberOfPeriods: 3, Prices: map[string]string{"USA": "1.99"}},
+					{OfferCode: "RETRY", Name: "Retry", Duration: "ONE_WEEK", OfferMode: "FREE_TRIAL", NumberOfPeriods: 1, Territories: []string{"USA"}},
+				},
+			}},
+		}},
+	}
+}
+
+func TestValidateCatalog_Valid(t *testing.T) {
+	report := ValidateCatalog(validCatalogInput(), true)
+	if len(report.Checks) != 0 {
+		t.Fatalf("expected no checks, got %v", report.Checks)
+	}
+	if report.IAPCount != 1 || report.SubscriptionCount != 1 {
+		t.Fatalf("unexpected counts: %+v", report)
+	}
+}
+
+func TestValidateCatalog_ReportsProductErrors(t *testing.T) {
+	input := validCatalogInput()
+	input.IAPs[0].Type = "SUBSCRIPTION"
+	input.IAPs[0].Localizations[0].Name = strings.Repeat("x", LimitProductDisplayName+1)
+	input.IAPs[0].Prices = map[string]string{"usa": "abc"}
+	input.SubscriptionGroups[0].Subscriptions = append(input.SubscriptionGroups[0].Subscriptions, CatalogSubscription{
+		ProductID:  "com.example.coins",
+		Name:       "Yearly",
+		Period:     "ONE_DECADE",
+		GroupLevel: 1,
+		Prices:     map[string]string{"USA": "39.99"},
+		IntroductoryOffers: []CatalogOffer{
+			{Territory: "USA", Duration: "ONE_WEEK", OfferMode: "PAY_UP_FRONT", NumberOfPeriods: 0},
+		},
+	})
+
+	report := ValidateCatalog(input, false)
+	for _, id := range []string{
+		"catalog.iap.type.invalid",
+		"catalog.localization.name.too_long",
+		"catalog.prices.territory.invalid",
+		"catalog.prices.amount.invalid",
+		"catalog.prices.base_territory_missing",
+		"catalog.product_id.duplicate",
+		"catalog.subscription.period.invalid",
+		"catalog.subscription.group_level.duplicate",
+		"catalog.localizations.missing",
+		"catalog.offer.periods.invalid",
+		"catalog.offer.price.invalid",
+	} {
+		if !hasCheckID(report.Checks, id) {
+			t.Errorf("expected check %s, got %v", id, report.Checks)
+		}
+	}
+	if report.Summary.Errors == 0 || report.Summary.Blocking != report.Summary.Errors {
+		t.Fatalf("unexpected summary: %+v", report.Summary)
+	}
+}
+
+func TestValidateCatalog_ReportsPromotionalOfferErrors(t *testing.T) {
+	input := validCatalogInput()
+	input.SubscriptionGroups[0].Subscriptions[0].PromotionalOffers = []CatalogPromotionalOffer{
+		{OfferCode: "WIN BACK", Name: "Win back", Duration: "ONE_MONTH", OfferMode: "PAY_AS_YOU_GO", NumberOfPeriods: 1},
+		{OfferCode: "TRIAL", Duration: "ONE_DAY", OfferMode: "FREE_TRIAL", NumberOfPeriods: 1, Prices: map[string]string{"USA": "0"}},
+		{OfferCode: "TRIAL", Name: "Again", Duration: "ONE_WEEK", OfferMode: "PAY_UP_FRONT", NumberOfPeriods: 1, Territories: []string{"USA"}, Prices: map[string]string{"usa": "x"}},
+		{Name: "No code", Duration: "ONE_WEEK", OfferMode: "FREE_TRIAL"},
+	}
+
+	report := ValidateCatalog(input, false)
+	for _, id := range []string{
+		"catalog.promotional_offer.code.invalid",
+		"catalog.promotional_offer.code.duplicate",
+		"catalog.promotional_offer.code.missing",
+		"catalog.promotional_offer.name.missing",
+		"catalog.promotional_offer.duration.invalid",
+		"catalog.promotional_offer.periods.invalid",
+		"catalog.promotional_offer.prices.missing",
+		"catalog.promotional_offer.prices.unexpected",
+		"catalog.promotional_offer.territories.missing",
+		"catalog.promotional_offer.territories.unexpected",
+		"catalog.promotional_offer.territory.invalid",
+		"catalog.promotional_offer.price.invalid",
+	} {
+		if !hasCheckID(report.Checks, id) {
+			t.Errorf("expected check %s, got %v", id, report.Checks)
+		}
+	}
+}
+
+func TestValidateCatalog_StrictBlocksOnWarnings(t *testing.T) {
+	input := validCatalogInput()
+	input.IAPs[0].Localizations = nil
+
+	if report := ValidateCatalog(input, false); report.Summary.Blocking != 0 {
+		t.Fatalf("expected no blocking issues without strict, got %+v", report.Summary)
+	}
+	if report := ValidateCatalog(input, true); report.Summary.Blocking != 1 {
+		t.Fatalf("expected one blocking issue with strict, got %+v", report.Summary)
+	}
+}
@@ -9,3 +9,12 @@ const (
 	LimitName            = 30
 	LimitSubtitle        = 30
 )
+
+// In-app purchase and subscription catalog limits.
+const (
+	LimitProductReferenceName = 64
+	LimitProductDisplayName   = 35
+	LimitProductDescription   = 55
+	LimitProductReviewNote    = 4000
+	LimitPromotionalOfferCode = 64
+)